@@ -0,0 +1,2756 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.1
+// source: transactions.proto
+
+package protobuf
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type LastProcessedTick struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	LastProcessedTick uint32                 `protobuf:"varint,1,opt,name=last_processed_tick,json=lastProcessedTick,proto3" json:"last_processed_tick,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *LastProcessedTick) Reset() {
+	*x = LastProcessedTick{}
+	mi := &file_transactions_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LastProcessedTick) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LastProcessedTick) ProtoMessage() {}
+
+func (x *LastProcessedTick) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LastProcessedTick.ProtoReflect.Descriptor instead.
+func (*LastProcessedTick) Descriptor() ([]byte, []int) {
+	return file_transactions_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LastProcessedTick) GetLastProcessedTick() uint32 {
+	if x != nil {
+		return x.LastProcessedTick
+	}
+	return 0
+}
+
+type GetTickRequestV2 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TickNumber    uint32                 `protobuf:"varint,1,opt,name=tick_number,json=tickNumber,proto3" json:"tick_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTickRequestV2) Reset() {
+	*x = GetTickRequestV2{}
+	mi := &file_transactions_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTickRequestV2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTickRequestV2) ProtoMessage() {}
+
+func (x *GetTickRequestV2) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTickRequestV2.ProtoReflect.Descriptor instead.
+func (*GetTickRequestV2) Descriptor() ([]byte, []int) {
+	return file_transactions_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetTickRequestV2) GetTickNumber() uint32 {
+	if x != nil {
+		return x.TickNumber
+	}
+	return 0
+}
+
+type GetTickTransactionsRequestV2 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TickNumber    uint32                 `protobuf:"varint,1,opt,name=tick_number,json=tickNumber,proto3" json:"tick_number,omitempty"`
+	Transfers     bool                   `protobuf:"varint,2,opt,name=transfers,proto3" json:"transfers,omitempty"`
+	Approved      bool                   `protobuf:"varint,3,opt,name=approved,proto3" json:"approved,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTickTransactionsRequestV2) Reset() {
+	*x = GetTickTransactionsRequestV2{}
+	mi := &file_transactions_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTickTransactionsRequestV2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTickTransactionsRequestV2) ProtoMessage() {}
+
+func (x *GetTickTransactionsRequestV2) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTickTransactionsRequestV2.ProtoReflect.Descriptor instead.
+func (*GetTickTransactionsRequestV2) Descriptor() ([]byte, []int) {
+	return file_transactions_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetTickTransactionsRequestV2) GetTickNumber() uint32 {
+	if x != nil {
+		return x.TickNumber
+	}
+	return 0
+}
+
+func (x *GetTickTransactionsRequestV2) GetTransfers() bool {
+	if x != nil {
+		return x.Transfers
+	}
+	return false
+}
+
+func (x *GetTickTransactionsRequestV2) GetApproved() bool {
+	if x != nil {
+		return x.Approved
+	}
+	return false
+}
+
+type GetTickTransactionsResponseV2 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transactions  []*TransactionData     `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTickTransactionsResponseV2) Reset() {
+	*x = GetTickTransactionsResponseV2{}
+	mi := &file_transactions_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTickTransactionsResponseV2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTickTransactionsResponseV2) ProtoMessage() {}
+
+func (x *GetTickTransactionsResponseV2) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTickTransactionsResponseV2.ProtoReflect.Descriptor instead.
+func (*GetTickTransactionsResponseV2) Descriptor() ([]byte, []int) {
+	return file_transactions_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetTickTransactionsResponseV2) GetTransactions() []*TransactionData {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+type GetTickTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TickNumber    uint32                 `protobuf:"varint,1,opt,name=tick_number,json=tickNumber,proto3" json:"tick_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTickTransactionsRequest) Reset() {
+	*x = GetTickTransactionsRequest{}
+	mi := &file_transactions_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTickTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTickTransactionsRequest) ProtoMessage() {}
+
+func (x *GetTickTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTickTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*GetTickTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_transactions_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetTickTransactionsRequest) GetTickNumber() uint32 {
+	if x != nil {
+		return x.TickNumber
+	}
+	return 0
+}
+
+type GetTickApprovedTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TickNumber    uint32                 `protobuf:"varint,1,opt,name=tick_number,json=tickNumber,proto3" json:"tick_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTickApprovedTransactionsRequest) Reset() {
+	*x = GetTickApprovedTransactionsRequest{}
+	mi := &file_transactions_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTickApprovedTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTickApprovedTransactionsRequest) ProtoMessage() {}
+
+func (x *GetTickApprovedTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTickApprovedTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*GetTickApprovedTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_transactions_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetTickApprovedTransactionsRequest) GetTickNumber() uint32 {
+	if x != nil {
+		return x.TickNumber
+	}
+	return 0
+}
+
+type NextAvailableTick struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NextTickNumber uint32                 `protobuf:"varint,1,opt,name=next_tick_number,json=nextTickNumber,proto3" json:"next_tick_number,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NextAvailableTick) Reset() {
+	*x = NextAvailableTick{}
+	mi := &file_transactions_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NextAvailableTick) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NextAvailableTick) ProtoMessage() {}
+
+func (x *NextAvailableTick) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NextAvailableTick.ProtoReflect.Descriptor instead.
+func (*NextAvailableTick) Descriptor() ([]byte, []int) {
+	return file_transactions_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *NextAvailableTick) GetNextTickNumber() uint32 {
+	if x != nil {
+		return x.NextTickNumber
+	}
+	return 0
+}
+
+type GetIdentityTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identity      string                 `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	Desc          bool                   `protobuf:"varint,2,opt,name=desc,proto3" json:"desc,omitempty"`
+	Page          uint32                 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      uint32                 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIdentityTransactionsRequest) Reset() {
+	*x = GetIdentityTransactionsRequest{}
+	mi := &file_transactions_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIdentityTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIdentityTransactionsRequest) ProtoMessage() {}
+
+func (x *GetIdentityTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIdentityTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*GetIdentityTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_transactions_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetIdentityTransactionsRequest) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *GetIdentityTransactionsRequest) GetDesc() bool {
+	if x != nil {
+		return x.Desc
+	}
+	return false
+}
+
+func (x *GetIdentityTransactionsRequest) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetIdentityTransactionsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetIdentityTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pagination    *Pagination            `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Identity      string                 `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
+	Transactions  []*NewTransaction      `protobuf:"bytes,3,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIdentityTransactionsResponse) Reset() {
+	*x = GetIdentityTransactionsResponse{}
+	mi := &file_transactions_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIdentityTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIdentityTransactionsResponse) ProtoMessage() {}
+
+func (x *GetIdentityTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIdentityTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*GetIdentityTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_transactions_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetIdentityTransactionsResponse) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *GetIdentityTransactionsResponse) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *GetIdentityTransactionsResponse) GetTransactions() []*NewTransaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+type Pagination struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TotalRecords  int32                  `protobuf:"varint,1,opt,name=total_records,json=totalRecords,proto3" json:"total_records,omitempty"`
+	CurrentPage   int32                  `protobuf:"varint,2,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	TotalPages    int32                  `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPage      int32                  `protobuf:"varint,5,opt,name=next_page,json=nextPage,proto3" json:"next_page,omitempty"`
+	PreviousPage  int32                  `protobuf:"varint,6,opt,name=previous_page,json=previousPage,proto3" json:"previous_page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Pagination) Reset() {
+	*x = Pagination{}
+	mi := &file_transactions_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pagination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pagination) ProtoMessage() {}
+
+func (x *Pagination) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pagination.ProtoReflect.Descriptor instead.
+func (*Pagination) Descriptor() ([]byte, []int) {
+	return file_transactions_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Pagination) GetTotalRecords() int32 {
+	if x != nil {
+		return x.TotalRecords
+	}
+	return 0
+}
+
+func (x *Pagination) GetCurrentPage() int32 {
+	if x != nil {
+		return x.CurrentPage
+	}
+	return 0
+}
+
+func (x *Pagination) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *Pagination) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *Pagination) GetNextPage() int32 {
+	if x != nil {
+		return x.NextPage
+	}
+	return 0
+}
+
+func (x *Pagination) GetPreviousPage() int32 {
+	if x != nil {
+		return x.PreviousPage
+	}
+	return 0
+}
+
+type Transaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceId      string                 `protobuf:"bytes,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	DestId        string                 `protobuf:"bytes,2,opt,name=dest_id,json=destId,proto3" json:"dest_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	TickNumber    uint32                 `protobuf:"varint,4,opt,name=tick_number,json=tickNumber,proto3" json:"tick_number,omitempty"`
+	InputType     uint32                 `protobuf:"varint,5,opt,name=input_type,json=inputType,proto3" json:"input_type,omitempty"`
+	InputSize     uint32                 `protobuf:"varint,6,opt,name=input_size,json=inputSize,proto3" json:"input_size,omitempty"`
+	InputHex      string                 `protobuf:"bytes,7,opt,name=input_hex,json=inputHex,proto3" json:"input_hex,omitempty"`
+	SignatureHex  string                 `protobuf:"bytes,8,opt,name=signature_hex,json=signatureHex,proto3" json:"signature_hex,omitempty"`
+	TxId          string                 `protobuf:"bytes,9,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Transaction) Reset() {
+	*x = Transaction{}
+	mi := &file_transactions_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Transaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transaction) ProtoMessage() {}
+
+func (x *Transaction) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
+func (*Transaction) Descriptor() ([]byte, []int) {
+	return file_transactions_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Transaction) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *Transaction) GetDestId() string {
+	if x != nil {
+		return x.DestId
+	}
+	return ""
+}
+
+func (x *Transaction) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Transaction) GetTickNumber() uint32 {
+	if x != nil {
+		return x.TickNumber
+	}
+	return 0
+}
+
+func (x *Transaction) GetInputType() uint32 {
+	if x != nil {
+		return x.InputType
+	}
+	return 0
+}
+
+func (x *Transaction) GetInputSize() uint32 {
+	if x != nil {
+		return x.InputSize
+	}
+	return 0
+}
+
+func (x *Transaction) GetInputHex() string {
+	if x != nil {
+		return x.InputHex
+	}
+	return ""
+}
+
+func (x *Transaction) GetSignatureHex() string {
+	if x != nil {
+		return x.SignatureHex
+	}
+	return ""
+}
+
+func (x *Transaction) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+type GetTransferTransactionsPerTickRequestV2 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identity      string                 `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	StartTick     uint32                 `protobuf:"varint,2,opt,name=start_tick,json=startTick,proto3" json:"start_tick,omitempty"`
+	EndTick       uint32                 `protobuf:"varint,3,opt,name=end_tick,json=endTick,proto3" json:"end_tick,omitempty"`
+	ScOnly        bool                   `protobuf:"varint,4,opt,name=sc_only,json=scOnly,proto3" json:"sc_only,omitempty"`
+	Desc          bool                   `protobuf:"varint,5,opt,name=desc,proto3" json:"desc,omitempty"`
+	Page          uint32                 `protobuf:"varint,6,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      uint32                 `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransferTransactionsPerTickRequestV2) Reset() {
+	*x = GetTransferTransactionsPerTickRequestV2{}
+	mi := &file_transactions_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransferTransactionsPerTickRequestV2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransferTransactionsPerTickRequestV2) ProtoMessage() {}
+
+func (x *GetTransferTransactionsPerTickRequestV2) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransferTransactionsPerTickRequestV2.ProtoReflect.Descriptor instead.
+func (*GetTransferTransactionsPerTickRequestV2) Descriptor() ([]byte, []int) {
+	return file_transactions_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetTransferTransactionsPerTickRequestV2) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *GetTransferTransactionsPerTickRequestV2) GetStartTick() uint32 {
+	if x != nil {
+		return x.StartTick
+	}
+	return 0
+}
+
+func (x *GetTransferTransactionsPerTickRequestV2) GetEndTick() uint32 {
+	if x != nil {
+		return x.EndTick
+	}
+	return 0
+}
+
+func (x *GetTransferTransactionsPerTickRequestV2) GetScOnly() bool {
+	if x != nil {
+		return x.ScOnly
+	}
+	return false
+}
+
+func (x *GetTransferTransactionsPerTickRequestV2) GetDesc() bool {
+	if x != nil {
+		return x.Desc
+	}
+	return false
+}
+
+func (x *GetTransferTransactionsPerTickRequestV2) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetTransferTransactionsPerTickRequestV2) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetIdentityTransfersInTickRangeResponseV2 struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Pagination    *Pagination                 `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Transactions  []*PerTickIdentityTransfers `protobuf:"bytes,2,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIdentityTransfersInTickRangeResponseV2) Reset() {
+	*x = GetIdentityTransfersInTickRangeResponseV2{}
+	mi := &file_transactions_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIdentityTransfersInTickRangeResponseV2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIdentityTransfersInTickRangeResponseV2) ProtoMessage() {}
+
+func (x *GetIdentityTransfersInTickRangeResponseV2) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIdentityTransfersInTickRangeResponseV2.ProtoReflect.Descriptor instead.
+func (*GetIdentityTransfersInTickRangeResponseV2) Descriptor() ([]byte, []int) {
+	return file_transactions_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetIdentityTransfersInTickRangeResponseV2) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *GetIdentityTransfersInTickRangeResponseV2) GetTransactions() []*PerTickIdentityTransfers {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+type PerTickIdentityTransfers struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TickNumber    uint32                 `protobuf:"varint,1,opt,name=tick_number,json=tickNumber,proto3" json:"tick_number,omitempty"`
+	Identity      string                 `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
+	Transactions  []*TransactionData     `protobuf:"bytes,3,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PerTickIdentityTransfers) Reset() {
+	*x = PerTickIdentityTransfers{}
+	mi := &file_transactions_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PerTickIdentityTransfers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PerTickIdentityTransfers) ProtoMessage() {}
+
+func (x *PerTickIdentityTransfers) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PerTickIdentityTransfers.ProtoReflect.Descriptor instead.
+func (*PerTickIdentityTransfers) Descriptor() ([]byte, []int) {
+	return file_transactions_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PerTickIdentityTransfers) GetTickNumber() uint32 {
+	if x != nil {
+		return x.TickNumber
+	}
+	return 0
+}
+
+func (x *PerTickIdentityTransfers) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *PerTickIdentityTransfers) GetTransactions() []*TransactionData {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+type NewTransaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceId      string                 `protobuf:"bytes,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	DestId        string                 `protobuf:"bytes,2,opt,name=dest_id,json=destId,proto3" json:"dest_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	TickNumber    uint32                 `protobuf:"varint,4,opt,name=tick_number,json=tickNumber,proto3" json:"tick_number,omitempty"`
+	InputType     uint32                 `protobuf:"varint,5,opt,name=input_type,json=inputType,proto3" json:"input_type,omitempty"`
+	InputSize     uint32                 `protobuf:"varint,6,opt,name=input_size,json=inputSize,proto3" json:"input_size,omitempty"`
+	Input         string                 `protobuf:"bytes,7,opt,name=input,proto3" json:"input,omitempty"`
+	Signature     string                 `protobuf:"bytes,8,opt,name=signature,proto3" json:"signature,omitempty"`
+	TxId          string                 `protobuf:"bytes,9,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Timestamp     uint64                 `protobuf:"varint,10,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	MoneyFlew     bool                   `protobuf:"varint,11,opt,name=money_flew,json=moneyFlew,proto3" json:"money_flew,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NewTransaction) Reset() {
+	*x = NewTransaction{}
+	mi := &file_transactions_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewTransaction) ProtoMessage() {}
+
+func (x *NewTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewTransaction.ProtoReflect.Descriptor instead.
+func (*NewTransaction) Descriptor() ([]byte, []int) {
+	return file_transactions_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *NewTransaction) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *NewTransaction) GetDestId() string {
+	if x != nil {
+		return x.DestId
+	}
+	return ""
+}
+
+func (x *NewTransaction) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *NewTransaction) GetTickNumber() uint32 {
+	if x != nil {
+		return x.TickNumber
+	}
+	return 0
+}
+
+func (x *NewTransaction) GetInputType() uint32 {
+	if x != nil {
+		return x.InputType
+	}
+	return 0
+}
+
+func (x *NewTransaction) GetInputSize() uint32 {
+	if x != nil {
+		return x.InputSize
+	}
+	return 0
+}
+
+func (x *NewTransaction) GetInput() string {
+	if x != nil {
+		return x.Input
+	}
+	return ""
+}
+
+func (x *NewTransaction) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *NewTransaction) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+func (x *NewTransaction) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *NewTransaction) GetMoneyFlew() bool {
+	if x != nil {
+		return x.MoneyFlew
+	}
+	return false
+}
+
+type TransactionData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaction   *Transaction           `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	Timestamp     uint64                 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	MoneyFlew     bool                   `protobuf:"varint,3,opt,name=money_flew,json=moneyFlew,proto3" json:"money_flew,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionData) Reset() {
+	*x = TransactionData{}
+	mi := &file_transactions_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionData) ProtoMessage() {}
+
+func (x *TransactionData) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionData.ProtoReflect.Descriptor instead.
+func (*TransactionData) Descriptor() ([]byte, []int) {
+	return file_transactions_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TransactionData) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *TransactionData) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *TransactionData) GetMoneyFlew() bool {
+	if x != nil {
+		return x.MoneyFlew
+	}
+	return false
+}
+
+type GetTickTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transactions  []*Transaction         `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTickTransactionsResponse) Reset() {
+	*x = GetTickTransactionsResponse{}
+	mi := &file_transactions_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTickTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTickTransactionsResponse) ProtoMessage() {}
+
+func (x *GetTickTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTickTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*GetTickTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_transactions_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetTickTransactionsResponse) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+type GetTickApprovedTransactionsResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	ApprovedTransactions []*Transaction         `protobuf:"bytes,1,rep,name=approved_transactions,json=approvedTransactions,proto3" json:"approved_transactions,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetTickApprovedTransactionsResponse) Reset() {
+	*x = GetTickApprovedTransactionsResponse{}
+	mi := &file_transactions_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTickApprovedTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTickApprovedTransactionsResponse) ProtoMessage() {}
+
+func (x *GetTickApprovedTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTickApprovedTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*GetTickApprovedTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_transactions_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetTickApprovedTransactionsResponse) GetApprovedTransactions() []*Transaction {
+	if x != nil {
+		return x.ApprovedTransactions
+	}
+	return nil
+}
+
+type GetTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TxId          string                 `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionRequest) Reset() {
+	*x = GetTransactionRequest{}
+	mi := &file_transactions_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionRequest) ProtoMessage() {}
+
+func (x *GetTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_transactions_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetTransactionRequest) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+type TransactionStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TxId          string                 `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	MoneyFlew     bool                   `protobuf:"varint,2,opt,name=moneyFlew,proto3" json:"moneyFlew,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionStatus) Reset() {
+	*x = TransactionStatus{}
+	mi := &file_transactions_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionStatus) ProtoMessage() {}
+
+func (x *TransactionStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionStatus.ProtoReflect.Descriptor instead.
+func (*TransactionStatus) Descriptor() ([]byte, []int) {
+	return file_transactions_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *TransactionStatus) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+func (x *TransactionStatus) GetMoneyFlew() bool {
+	if x != nil {
+		return x.MoneyFlew
+	}
+	return false
+}
+
+type GetTransactionStatusResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TransactionStatus *TransactionStatus     `protobuf:"bytes,1,opt,name=transaction_status,json=transactionStatus,proto3" json:"transaction_status,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetTransactionStatusResponse) Reset() {
+	*x = GetTransactionStatusResponse{}
+	mi := &file_transactions_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionStatusResponse) ProtoMessage() {}
+
+func (x *GetTransactionStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionStatusResponse) Descriptor() ([]byte, []int) {
+	return file_transactions_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetTransactionStatusResponse) GetTransactionStatus() *TransactionStatus {
+	if x != nil {
+		return x.TransactionStatus
+	}
+	return nil
+}
+
+type GetTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaction   *Transaction           `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionResponse) Reset() {
+	*x = GetTransactionResponse{}
+	mi := &file_transactions_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionResponse) ProtoMessage() {}
+
+func (x *GetTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_transactions_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetTransactionResponse) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+type GetTransactionResponseV2 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaction   *Transaction           `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	Timestamp     uint64                 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	MoneyFlew     bool                   `protobuf:"varint,3,opt,name=money_flew,json=moneyFlew,proto3" json:"money_flew,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionResponseV2) Reset() {
+	*x = GetTransactionResponseV2{}
+	mi := &file_transactions_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionResponseV2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionResponseV2) ProtoMessage() {}
+
+func (x *GetTransactionResponseV2) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionResponseV2.ProtoReflect.Descriptor instead.
+func (*GetTransactionResponseV2) Descriptor() ([]byte, []int) {
+	return file_transactions_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetTransactionResponseV2) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *GetTransactionResponseV2) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *GetTransactionResponseV2) GetMoneyFlew() bool {
+	if x != nil {
+		return x.MoneyFlew
+	}
+	return false
+}
+
+type TickData struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ComputorIndex  uint32                 `protobuf:"varint,1,opt,name=computor_index,json=computorIndex,proto3" json:"computor_index,omitempty"`
+	Epoch          uint32                 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	TickNumber     uint32                 `protobuf:"varint,3,opt,name=tick_number,json=tickNumber,proto3" json:"tick_number,omitempty"`
+	Timestamp      uint64                 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	VarStruct      []byte                 `protobuf:"bytes,5,opt,name=var_struct,json=varStruct,proto3" json:"var_struct,omitempty"`
+	TimeLock       []byte                 `protobuf:"bytes,6,opt,name=time_lock,json=timeLock,proto3" json:"time_lock,omitempty"`
+	TransactionIds []string               `protobuf:"bytes,7,rep,name=transaction_ids,json=transactionIds,proto3" json:"transaction_ids,omitempty"`
+	ContractFees   []int64                `protobuf:"varint,8,rep,packed,name=contract_fees,json=contractFees,proto3" json:"contract_fees,omitempty"`
+	SignatureHex   string                 `protobuf:"bytes,9,opt,name=signature_hex,json=signatureHex,proto3" json:"signature_hex,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TickData) Reset() {
+	*x = TickData{}
+	mi := &file_transactions_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TickData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TickData) ProtoMessage() {}
+
+func (x *TickData) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TickData.ProtoReflect.Descriptor instead.
+func (*TickData) Descriptor() ([]byte, []int) {
+	return file_transactions_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *TickData) GetComputorIndex() uint32 {
+	if x != nil {
+		return x.ComputorIndex
+	}
+	return 0
+}
+
+func (x *TickData) GetEpoch() uint32 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *TickData) GetTickNumber() uint32 {
+	if x != nil {
+		return x.TickNumber
+	}
+	return 0
+}
+
+func (x *TickData) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *TickData) GetVarStruct() []byte {
+	if x != nil {
+		return x.VarStruct
+	}
+	return nil
+}
+
+func (x *TickData) GetTimeLock() []byte {
+	if x != nil {
+		return x.TimeLock
+	}
+	return nil
+}
+
+func (x *TickData) GetTransactionIds() []string {
+	if x != nil {
+		return x.TransactionIds
+	}
+	return nil
+}
+
+func (x *TickData) GetContractFees() []int64 {
+	if x != nil {
+		return x.ContractFees
+	}
+	return nil
+}
+
+func (x *TickData) GetSignatureHex() string {
+	if x != nil {
+		return x.SignatureHex
+	}
+	return ""
+}
+
+type GetTickDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TickNumber    uint32                 `protobuf:"varint,1,opt,name=tick_number,json=tickNumber,proto3" json:"tick_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTickDataRequest) Reset() {
+	*x = GetTickDataRequest{}
+	mi := &file_transactions_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTickDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTickDataRequest) ProtoMessage() {}
+
+func (x *GetTickDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTickDataRequest.ProtoReflect.Descriptor instead.
+func (*GetTickDataRequest) Descriptor() ([]byte, []int) {
+	return file_transactions_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetTickDataRequest) GetTickNumber() uint32 {
+	if x != nil {
+		return x.TickNumber
+	}
+	return 0
+}
+
+type GetTickDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TickData      *TickData              `protobuf:"bytes,1,opt,name=tick_data,json=tickData,proto3" json:"tick_data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTickDataResponse) Reset() {
+	*x = GetTickDataResponse{}
+	mi := &file_transactions_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTickDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTickDataResponse) ProtoMessage() {}
+
+func (x *GetTickDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTickDataResponse.ProtoReflect.Descriptor instead.
+func (*GetTickDataResponse) Descriptor() ([]byte, []int) {
+	return file_transactions_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetTickDataResponse) GetTickData() *TickData {
+	if x != nil {
+		return x.TickData
+	}
+	return nil
+}
+
+// start of archiver compatible status response
+type GetArchiverStatusResponse struct {
+	state                          protoimpl.MessageState            `protogen:"open.v1"`
+	LastProcessedTick              *ProcessedTick                    `protobuf:"bytes,1,opt,name=last_processed_tick,json=lastProcessedTick,proto3" json:"last_processed_tick,omitempty"`
+	LastProcessedTicksPerEpoch     map[uint32]uint32                 `protobuf:"bytes,2,rep,name=last_processed_ticks_per_epoch,json=lastProcessedTicksPerEpoch,proto3" json:"last_processed_ticks_per_epoch,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	SkippedTicks                   []*SkippedTicksInterval           `protobuf:"bytes,3,rep,name=skipped_ticks,json=skippedTicks,proto3" json:"skipped_ticks,omitempty"`
+	ProcessedTickIntervalsPerEpoch []*ProcessedTickIntervalsPerEpoch `protobuf:"bytes,4,rep,name=processed_tick_intervals_per_epoch,json=processedTickIntervalsPerEpoch,proto3" json:"processed_tick_intervals_per_epoch,omitempty"`
+	EmptyTicksPerEpoch             map[uint32]uint32                 `protobuf:"bytes,5,rep,name=empty_ticks_per_epoch,json=emptyTicksPerEpoch,proto3" json:"empty_ticks_per_epoch,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields                  protoimpl.UnknownFields
+	sizeCache                      protoimpl.SizeCache
+}
+
+func (x *GetArchiverStatusResponse) Reset() {
+	*x = GetArchiverStatusResponse{}
+	mi := &file_transactions_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArchiverStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArchiverStatusResponse) ProtoMessage() {}
+
+func (x *GetArchiverStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArchiverStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetArchiverStatusResponse) Descriptor() ([]byte, []int) {
+	return file_transactions_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetArchiverStatusResponse) GetLastProcessedTick() *ProcessedTick {
+	if x != nil {
+		return x.LastProcessedTick
+	}
+	return nil
+}
+
+func (x *GetArchiverStatusResponse) GetLastProcessedTicksPerEpoch() map[uint32]uint32 {
+	if x != nil {
+		return x.LastProcessedTicksPerEpoch
+	}
+	return nil
+}
+
+func (x *GetArchiverStatusResponse) GetSkippedTicks() []*SkippedTicksInterval {
+	if x != nil {
+		return x.SkippedTicks
+	}
+	return nil
+}
+
+func (x *GetArchiverStatusResponse) GetProcessedTickIntervalsPerEpoch() []*ProcessedTickIntervalsPerEpoch {
+	if x != nil {
+		return x.ProcessedTickIntervalsPerEpoch
+	}
+	return nil
+}
+
+func (x *GetArchiverStatusResponse) GetEmptyTicksPerEpoch() map[uint32]uint32 {
+	if x != nil {
+		return x.EmptyTicksPerEpoch
+	}
+	return nil
+}
+
+type ProcessedTick struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TickNumber    uint32                 `protobuf:"varint,1,opt,name=tick_number,json=tickNumber,proto3" json:"tick_number,omitempty"`
+	Epoch         uint32                 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessedTick) Reset() {
+	*x = ProcessedTick{}
+	mi := &file_transactions_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessedTick) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessedTick) ProtoMessage() {}
+
+func (x *ProcessedTick) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessedTick.ProtoReflect.Descriptor instead.
+func (*ProcessedTick) Descriptor() ([]byte, []int) {
+	return file_transactions_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ProcessedTick) GetTickNumber() uint32 {
+	if x != nil {
+		return x.TickNumber
+	}
+	return 0
+}
+
+func (x *ProcessedTick) GetEpoch() uint32 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+type ProcessedTickInterval struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	InitialProcessedTick uint32                 `protobuf:"varint,1,opt,name=initial_processed_tick,json=initialProcessedTick,proto3" json:"initial_processed_tick,omitempty"`
+	LastProcessedTick    uint32                 `protobuf:"varint,2,opt,name=last_processed_tick,json=lastProcessedTick,proto3" json:"last_processed_tick,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ProcessedTickInterval) Reset() {
+	*x = ProcessedTickInterval{}
+	mi := &file_transactions_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessedTickInterval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessedTickInterval) ProtoMessage() {}
+
+func (x *ProcessedTickInterval) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessedTickInterval.ProtoReflect.Descriptor instead.
+func (*ProcessedTickInterval) Descriptor() ([]byte, []int) {
+	return file_transactions_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ProcessedTickInterval) GetInitialProcessedTick() uint32 {
+	if x != nil {
+		return x.InitialProcessedTick
+	}
+	return 0
+}
+
+func (x *ProcessedTickInterval) GetLastProcessedTick() uint32 {
+	if x != nil {
+		return x.LastProcessedTick
+	}
+	return 0
+}
+
+type ProcessedTickIntervalsPerEpoch struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Epoch         uint32                   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Intervals     []*ProcessedTickInterval `protobuf:"bytes,2,rep,name=intervals,proto3" json:"intervals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessedTickIntervalsPerEpoch) Reset() {
+	*x = ProcessedTickIntervalsPerEpoch{}
+	mi := &file_transactions_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessedTickIntervalsPerEpoch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessedTickIntervalsPerEpoch) ProtoMessage() {}
+
+func (x *ProcessedTickIntervalsPerEpoch) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessedTickIntervalsPerEpoch.ProtoReflect.Descriptor instead.
+func (*ProcessedTickIntervalsPerEpoch) Descriptor() ([]byte, []int) {
+	return file_transactions_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ProcessedTickIntervalsPerEpoch) GetEpoch() uint32 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ProcessedTickIntervalsPerEpoch) GetIntervals() []*ProcessedTickInterval {
+	if x != nil {
+		return x.Intervals
+	}
+	return nil
+}
+
+type SkippedTicksInterval struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartTick     uint32                 `protobuf:"varint,1,opt,name=start_tick,json=startTick,proto3" json:"start_tick,omitempty"`
+	EndTick       uint32                 `protobuf:"varint,2,opt,name=end_tick,json=endTick,proto3" json:"end_tick,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkippedTicksInterval) Reset() {
+	*x = SkippedTicksInterval{}
+	mi := &file_transactions_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkippedTicksInterval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkippedTicksInterval) ProtoMessage() {}
+
+func (x *SkippedTicksInterval) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkippedTicksInterval.ProtoReflect.Descriptor instead.
+func (*SkippedTicksInterval) Descriptor() ([]byte, []int) {
+	return file_transactions_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SkippedTicksInterval) GetStartTick() uint32 {
+	if x != nil {
+		return x.StartTick
+	}
+	return 0
+}
+
+func (x *SkippedTicksInterval) GetEndTick() uint32 {
+	if x != nil {
+		return x.EndTick
+	}
+	return 0
+}
+
+type SkippedTicksIntervalList struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	SkippedTicks  []*SkippedTicksInterval `protobuf:"bytes,1,rep,name=skipped_ticks,json=skippedTicks,proto3" json:"skipped_ticks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkippedTicksIntervalList) Reset() {
+	*x = SkippedTicksIntervalList{}
+	mi := &file_transactions_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkippedTicksIntervalList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkippedTicksIntervalList) ProtoMessage() {}
+
+func (x *SkippedTicksIntervalList) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkippedTicksIntervalList.ProtoReflect.Descriptor instead.
+func (*SkippedTicksIntervalList) Descriptor() ([]byte, []int) {
+	return file_transactions_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SkippedTicksIntervalList) GetSkippedTicks() []*SkippedTicksInterval {
+	if x != nil {
+		return x.SkippedTicks
+	}
+	return nil
+}
+
+type Computors struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Epoch         uint32                 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Identities    []string               `protobuf:"bytes,2,rep,name=identities,proto3" json:"identities,omitempty"`
+	SignatureHex  string                 `protobuf:"bytes,3,opt,name=signature_hex,json=signatureHex,proto3" json:"signature_hex,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Computors) Reset() {
+	*x = Computors{}
+	mi := &file_transactions_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Computors) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Computors) ProtoMessage() {}
+
+func (x *Computors) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Computors.ProtoReflect.Descriptor instead.
+func (*Computors) Descriptor() ([]byte, []int) {
+	return file_transactions_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Computors) GetEpoch() uint32 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *Computors) GetIdentities() []string {
+	if x != nil {
+		return x.Identities
+	}
+	return nil
+}
+
+func (x *Computors) GetSignatureHex() string {
+	if x != nil {
+		return x.SignatureHex
+	}
+	return ""
+}
+
+type GetComputorsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Epoch         uint32                 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetComputorsRequest) Reset() {
+	*x = GetComputorsRequest{}
+	mi := &file_transactions_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetComputorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetComputorsRequest) ProtoMessage() {}
+
+func (x *GetComputorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetComputorsRequest.ProtoReflect.Descriptor instead.
+func (*GetComputorsRequest) Descriptor() ([]byte, []int) {
+	return file_transactions_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetComputorsRequest) GetEpoch() uint32 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+type GetComputorsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Computors     *Computors             `protobuf:"bytes,1,opt,name=computors,proto3" json:"computors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetComputorsResponse) Reset() {
+	*x = GetComputorsResponse{}
+	mi := &file_transactions_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetComputorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetComputorsResponse) ProtoMessage() {}
+
+func (x *GetComputorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetComputorsResponse.ProtoReflect.Descriptor instead.
+func (*GetComputorsResponse) Descriptor() ([]byte, []int) {
+	return file_transactions_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetComputorsResponse) GetComputors() *Computors {
+	if x != nil {
+		return x.Computors
+	}
+	return nil
+}
+
+type GetLatestTickResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LatestTick    uint32                 `protobuf:"varint,1,opt,name=latest_tick,json=latestTick,proto3" json:"latest_tick,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLatestTickResponse) Reset() {
+	*x = GetLatestTickResponse{}
+	mi := &file_transactions_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLatestTickResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLatestTickResponse) ProtoMessage() {}
+
+func (x *GetLatestTickResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLatestTickResponse.ProtoReflect.Descriptor instead.
+func (*GetLatestTickResponse) Descriptor() ([]byte, []int) {
+	return file_transactions_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetLatestTickResponse) GetLatestTick() uint32 {
+	if x != nil {
+		return x.LatestTick
+	}
+	return 0
+}
+
+type GetEpochTickListRequestV2 struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// only the current and the previous epochs can be queried.
+	Epoch uint32 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// page numbering starts at '1' (default value: '1').
+	Page int32 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	// max page size: 1000. value modulo 10 must be zero. defaults to '10'.
+	PageSize      int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Desc          bool  `protobuf:"varint,4,opt,name=desc,proto3" json:"desc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEpochTickListRequestV2) Reset() {
+	*x = GetEpochTickListRequestV2{}
+	mi := &file_transactions_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEpochTickListRequestV2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEpochTickListRequestV2) ProtoMessage() {}
+
+func (x *GetEpochTickListRequestV2) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEpochTickListRequestV2.ProtoReflect.Descriptor instead.
+func (*GetEpochTickListRequestV2) Descriptor() ([]byte, []int) {
+	return file_transactions_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetEpochTickListRequestV2) GetEpoch() uint32 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *GetEpochTickListRequestV2) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetEpochTickListRequestV2) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetEpochTickListRequestV2) GetDesc() bool {
+	if x != nil {
+		return x.Desc
+	}
+	return false
+}
+
+type GetEpochTickListResponseV2 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pagination    *Pagination            `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Ticks         []*Tick                `protobuf:"bytes,2,rep,name=ticks,proto3" json:"ticks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEpochTickListResponseV2) Reset() {
+	*x = GetEpochTickListResponseV2{}
+	mi := &file_transactions_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEpochTickListResponseV2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEpochTickListResponseV2) ProtoMessage() {}
+
+func (x *GetEpochTickListResponseV2) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEpochTickListResponseV2.ProtoReflect.Descriptor instead.
+func (*GetEpochTickListResponseV2) Descriptor() ([]byte, []int) {
+	return file_transactions_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetEpochTickListResponseV2) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *GetEpochTickListResponseV2) GetTicks() []*Tick {
+	if x != nil {
+		return x.Ticks
+	}
+	return nil
+}
+
+// Data is returned in ascending order
+type GetEpochEmptyTickListRequestV2 struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// only the current and the previous epochs can be queried.
+	Epoch uint32 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// page numbering starts at '1' (default value: '1').
+	Page int32 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	// max page size: 1000. value modulo 10 must be zero. defaults to '10'.
+	PageSize      int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEpochEmptyTickListRequestV2) Reset() {
+	*x = GetEpochEmptyTickListRequestV2{}
+	mi := &file_transactions_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEpochEmptyTickListRequestV2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEpochEmptyTickListRequestV2) ProtoMessage() {}
+
+func (x *GetEpochEmptyTickListRequestV2) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEpochEmptyTickListRequestV2.ProtoReflect.Descriptor instead.
+func (*GetEpochEmptyTickListRequestV2) Descriptor() ([]byte, []int) {
+	return file_transactions_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetEpochEmptyTickListRequestV2) GetEpoch() uint32 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *GetEpochEmptyTickListRequestV2) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetEpochEmptyTickListRequestV2) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetEpochEmptyTickListResponseV2 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pagination    *Pagination            `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	EmptyTicks    []uint32               `protobuf:"varint,2,rep,packed,name=emptyTicks,proto3" json:"emptyTicks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEpochEmptyTickListResponseV2) Reset() {
+	*x = GetEpochEmptyTickListResponseV2{}
+	mi := &file_transactions_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEpochEmptyTickListResponseV2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEpochEmptyTickListResponseV2) ProtoMessage() {}
+
+func (x *GetEpochEmptyTickListResponseV2) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEpochEmptyTickListResponseV2.ProtoReflect.Descriptor instead.
+func (*GetEpochEmptyTickListResponseV2) Descriptor() ([]byte, []int) {
+	return file_transactions_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetEpochEmptyTickListResponseV2) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *GetEpochEmptyTickListResponseV2) GetEmptyTicks() []uint32 {
+	if x != nil {
+		return x.EmptyTicks
+	}
+	return nil
+}
+
+type Tick struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TickNumber    uint32                 `protobuf:"varint,1,opt,name=tick_number,json=tickNumber,proto3" json:"tick_number,omitempty"`
+	IsEmpty       bool                   `protobuf:"varint,2,opt,name=is_empty,json=isEmpty,proto3" json:"is_empty,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tick) Reset() {
+	*x = Tick{}
+	mi := &file_transactions_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tick) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tick) ProtoMessage() {}
+
+func (x *Tick) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tick.ProtoReflect.Descriptor instead.
+func (*Tick) Descriptor() ([]byte, []int) {
+	return file_transactions_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *Tick) GetTickNumber() uint32 {
+	if x != nil {
+		return x.TickNumber
+	}
+	return 0
+}
+
+func (x *Tick) GetIsEmpty() bool {
+	if x != nil {
+		return x.IsEmpty
+	}
+	return false
+}
+
+var File_transactions_proto protoreflect.FileDescriptor
+
+const file_transactions_proto_rawDesc = "" +
+	"\n" +
+	"\x12transactions.proto\x12\x19qubic.lts.transactions.pb\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\"C\n" +
+	"\x11LastProcessedTick\x12.\n" +
+	"\x13last_processed_tick\x18\x01 \x01(\rR\x11lastProcessedTick\"3\n" +
+	"\x10GetTickRequestV2\x12\x1f\n" +
+	"\vtick_number\x18\x01 \x01(\rR\n" +
+	"tickNumber\"y\n" +
+	"\x1cGetTickTransactionsRequestV2\x12\x1f\n" +
+	"\vtick_number\x18\x01 \x01(\rR\n" +
+	"tickNumber\x12\x1c\n" +
+	"\ttransfers\x18\x02 \x01(\bR\ttransfers\x12\x1a\n" +
+	"\bapproved\x18\x03 \x01(\bR\bapproved\"o\n" +
+	"\x1dGetTickTransactionsResponseV2\x12N\n" +
+	"\ftransactions\x18\x01 \x03(\v2*.qubic.lts.transactions.pb.TransactionDataR\ftransactions\"=\n" +
+	"\x1aGetTickTransactionsRequest\x12\x1f\n" +
+	"\vtick_number\x18\x01 \x01(\rR\n" +
+	"tickNumber\"E\n" +
+	"\"GetTickApprovedTransactionsRequest\x12\x1f\n" +
+	"\vtick_number\x18\x01 \x01(\rR\n" +
+	"tickNumber\"=\n" +
+	"\x11NextAvailableTick\x12(\n" +
+	"\x10next_tick_number\x18\x01 \x01(\rR\x0enextTickNumber\"\x81\x01\n" +
+	"\x1eGetIdentityTransactionsRequest\x12\x1a\n" +
+	"\bidentity\x18\x01 \x01(\tR\bidentity\x12\x12\n" +
+	"\x04desc\x18\x02 \x01(\bR\x04desc\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\rR\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\rR\bpageSize\"\xd3\x01\n" +
+	"\x1fGetIdentityTransactionsResponse\x12E\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2%.qubic.lts.transactions.pb.PaginationR\n" +
+	"pagination\x12\x1a\n" +
+	"\bidentity\x18\x02 \x01(\tR\bidentity\x12M\n" +
+	"\ftransactions\x18\x03 \x03(\v2).qubic.lts.transactions.pb.NewTransactionR\ftransactions\"\xd4\x01\n" +
+	"\n" +
+	"Pagination\x12#\n" +
+	"\rtotal_records\x18\x01 \x01(\x05R\ftotalRecords\x12!\n" +
+	"\fcurrent_page\x18\x02 \x01(\x05R\vcurrentPage\x12\x1f\n" +
+	"\vtotal_pages\x18\x03 \x01(\x05R\n" +
+	"totalPages\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1b\n" +
+	"\tnext_page\x18\x05 \x01(\x05R\bnextPage\x12#\n" +
+	"\rprevious_page\x18\x06 \x01(\x05R\fpreviousPage\"\x91\x02\n" +
+	"\vTransaction\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\tR\bsourceId\x12\x17\n" +
+	"\adest_id\x18\x02 \x01(\tR\x06destId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\x12\x1f\n" +
+	"\vtick_number\x18\x04 \x01(\rR\n" +
+	"tickNumber\x12\x1d\n" +
+	"\n" +
+	"input_type\x18\x05 \x01(\rR\tinputType\x12\x1d\n" +
+	"\n" +
+	"input_size\x18\x06 \x01(\rR\tinputSize\x12\x1b\n" +
+	"\tinput_hex\x18\a \x01(\tR\binputHex\x12#\n" +
+	"\rsignature_hex\x18\b \x01(\tR\fsignatureHex\x12\x13\n" +
+	"\x05tx_id\x18\t \x01(\tR\x04txId\"\xdd\x01\n" +
+	"'GetTransferTransactionsPerTickRequestV2\x12\x1a\n" +
+	"\bidentity\x18\x01 \x01(\tR\bidentity\x12\x1d\n" +
+	"\n" +
+	"start_tick\x18\x02 \x01(\rR\tstartTick\x12\x19\n" +
+	"\bend_tick\x18\x03 \x01(\rR\aendTick\x12\x17\n" +
+	"\asc_only\x18\x04 \x01(\bR\x06scOnly\x12\x12\n" +
+	"\x04desc\x18\x05 \x01(\bR\x04desc\x12\x12\n" +
+	"\x04page\x18\x06 \x01(\rR\x04page\x12\x1b\n" +
+	"\tpage_size\x18\a \x01(\rR\bpageSize\"\xcb\x01\n" +
+	")GetIdentityTransfersInTickRangeResponseV2\x12E\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2%.qubic.lts.transactions.pb.PaginationR\n" +
+	"pagination\x12W\n" +
+	"\ftransactions\x18\x02 \x03(\v23.qubic.lts.transactions.pb.PerTickIdentityTransfersR\ftransactions\"\xa7\x01\n" +
+	"\x18PerTickIdentityTransfers\x12\x1f\n" +
+	"\vtick_number\x18\x01 \x01(\rR\n" +
+	"tickNumber\x12\x1a\n" +
+	"\bidentity\x18\x02 \x01(\tR\bidentity\x12N\n" +
+	"\ftransactions\x18\x03 \x03(\v2*.qubic.lts.transactions.pb.TransactionDataR\ftransactions\"\xc3\x02\n" +
+	"\x0eNewTransaction\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\tR\bsourceId\x12\x17\n" +
+	"\adest_id\x18\x02 \x01(\tR\x06destId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\x12\x1f\n" +
+	"\vtick_number\x18\x04 \x01(\rR\n" +
+	"tickNumber\x12\x1d\n" +
+	"\n" +
+	"input_type\x18\x05 \x01(\rR\tinputType\x12\x1d\n" +
+	"\n" +
+	"input_size\x18\x06 \x01(\rR\tinputSize\x12\x14\n" +
+	"\x05input\x18\a \x01(\tR\x05input\x12\x1c\n" +
+	"\tsignature\x18\b \x01(\tR\tsignature\x12\x13\n" +
+	"\x05tx_id\x18\t \x01(\tR\x04txId\x12\x1c\n" +
+	"\ttimestamp\x18\n" +
+	" \x01(\x04R\ttimestamp\x12\x1d\n" +
+	"\n" +
+	"money_flew\x18\v \x01(\bR\tmoneyFlew\"\x98\x01\n" +
+	"\x0fTransactionData\x12H\n" +
+	"\vtransaction\x18\x01 \x01(\v2&.qubic.lts.transactions.pb.TransactionR\vtransaction\x12\x1c\n" +
+	"\ttimestamp\x18\x02 \x01(\x04R\ttimestamp\x12\x1d\n" +
+	"\n" +
+	"money_flew\x18\x03 \x01(\bR\tmoneyFlew\"i\n" +
+	"\x1bGetTickTransactionsResponse\x12J\n" +
+	"\ftransactions\x18\x01 \x03(\v2&.qubic.lts.transactions.pb.TransactionR\ftransactions\"\x82\x01\n" +
+	"#GetTickApprovedTransactionsResponse\x12[\n" +
+	"\x15approved_transactions\x18\x01 \x03(\v2&.qubic.lts.transactions.pb.TransactionR\x14approvedTransactions\",\n" +
+	"\x15GetTransactionRequest\x12\x13\n" +
+	"\x05tx_id\x18\x01 \x01(\tR\x04txId\"F\n" +
+	"\x11TransactionStatus\x12\x13\n" +
+	"\x05tx_id\x18\x01 \x01(\tR\x04txId\x12\x1c\n" +
+	"\tmoneyFlew\x18\x02 \x01(\bR\tmoneyFlew\"{\n" +
+	"\x1cGetTransactionStatusResponse\x12[\n" +
+	"\x12transaction_status\x18\x01 \x01(\v2,.qubic.lts.transactions.pb.TransactionStatusR\x11transactionStatus\"b\n" +
+	"\x16GetTransactionResponse\x12H\n" +
+	"\vtransaction\x18\x01 \x01(\v2&.qubic.lts.transactions.pb.TransactionR\vtransaction\"\xa1\x01\n" +
+	"\x18GetTransactionResponseV2\x12H\n" +
+	"\vtransaction\x18\x01 \x01(\v2&.qubic.lts.transactions.pb.TransactionR\vtransaction\x12\x1c\n" +
+	"\ttimestamp\x18\x02 \x01(\x04R\ttimestamp\x12\x1d\n" +
+	"\n" +
+	"money_flew\x18\x03 \x01(\bR\tmoneyFlew\"\xb5\x02\n" +
+	"\bTickData\x12%\n" +
+	"\x0ecomputor_index\x18\x01 \x01(\rR\rcomputorIndex\x12\x14\n" +
+	"\x05epoch\x18\x02 \x01(\rR\x05epoch\x12\x1f\n" +
+	"\vtick_number\x18\x03 \x01(\rR\n" +
+	"tickNumber\x12\x1c\n" +
+	"\ttimestamp\x18\x04 \x01(\x04R\ttimestamp\x12\x1d\n" +
+	"\n" +
+	"var_struct\x18\x05 \x01(\fR\tvarStruct\x12\x1b\n" +
+	"\ttime_lock\x18\x06 \x01(\fR\btimeLock\x12'\n" +
+	"\x0ftransaction_ids\x18\a \x03(\tR\x0etransactionIds\x12#\n" +
+	"\rcontract_fees\x18\b \x03(\x03R\fcontractFees\x12#\n" +
+	"\rsignature_hex\x18\t \x01(\tR\fsignatureHex\"5\n" +
+	"\x12GetTickDataRequest\x12\x1f\n" +
+	"\vtick_number\x18\x01 \x01(\rR\n" +
+	"tickNumber\"W\n" +
+	"\x13GetTickDataResponse\x12@\n" +
+	"\ttick_data\x18\x01 \x01(\v2#.qubic.lts.transactions.pb.TickDataR\btickData\"\x85\x06\n" +
+	"\x19GetArchiverStatusResponse\x12X\n" +
+	"\x13last_processed_tick\x18\x01 \x01(\v2(.qubic.lts.transactions.pb.ProcessedTickR\x11lastProcessedTick\x12\x98\x01\n" +
+	"\x1elast_processed_ticks_per_epoch\x18\x02 \x03(\v2T.qubic.lts.transactions.pb.GetArchiverStatusResponse.LastProcessedTicksPerEpochEntryR\x1alastProcessedTicksPerEpoch\x12T\n" +
+	"\rskipped_ticks\x18\x03 \x03(\v2/.qubic.lts.transactions.pb.SkippedTicksIntervalR\fskippedTicks\x12\x85\x01\n" +
+	"\"processed_tick_intervals_per_epoch\x18\x04 \x03(\v29.qubic.lts.transactions.pb.ProcessedTickIntervalsPerEpochR\x1eprocessedTickIntervalsPerEpoch\x12\x7f\n" +
+	"\x15empty_ticks_per_epoch\x18\x05 \x03(\v2L.qubic.lts.transactions.pb.GetArchiverStatusResponse.EmptyTicksPerEpochEntryR\x12emptyTicksPerEpoch\x1aM\n" +
+	"\x1fLastProcessedTicksPerEpochEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\rR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\rR\x05value:\x028\x01\x1aE\n" +
+	"\x17EmptyTicksPerEpochEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\rR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\rR\x05value:\x028\x01\"F\n" +
+	"\rProcessedTick\x12\x1f\n" +
+	"\vtick_number\x18\x01 \x01(\rR\n" +
+	"tickNumber\x12\x14\n" +
+	"\x05epoch\x18\x02 \x01(\rR\x05epoch\"}\n" +
+	"\x15ProcessedTickInterval\x124\n" +
+	"\x16initial_processed_tick\x18\x01 \x01(\rR\x14initialProcessedTick\x12.\n" +
+	"\x13last_processed_tick\x18\x02 \x01(\rR\x11lastProcessedTick\"\x86\x01\n" +
+	"\x1eProcessedTickIntervalsPerEpoch\x12\x14\n" +
+	"\x05epoch\x18\x01 \x01(\rR\x05epoch\x12N\n" +
+	"\tintervals\x18\x02 \x03(\v20.qubic.lts.transactions.pb.ProcessedTickIntervalR\tintervals\"P\n" +
+	"\x14SkippedTicksInterval\x12\x1d\n" +
+	"\n" +
+	"start_tick\x18\x01 \x01(\rR\tstartTick\x12\x19\n" +
+	"\bend_tick\x18\x02 \x01(\rR\aendTick\"p\n" +
+	"\x18SkippedTicksIntervalList\x12T\n" +
+	"\rskipped_ticks\x18\x01 \x03(\v2/.qubic.lts.transactions.pb.SkippedTicksIntervalR\fskippedTicks\"f\n" +
+	"\tComputors\x12\x14\n" +
+	"\x05epoch\x18\x01 \x01(\rR\x05epoch\x12\x1e\n" +
+	"\n" +
+	"identities\x18\x02 \x03(\tR\n" +
+	"identities\x12#\n" +
+	"\rsignature_hex\x18\x03 \x01(\tR\fsignatureHex\"+\n" +
+	"\x13GetComputorsRequest\x12\x14\n" +
+	"\x05epoch\x18\x01 \x01(\rR\x05epoch\"Z\n" +
+	"\x14GetComputorsResponse\x12B\n" +
+	"\tcomputors\x18\x01 \x01(\v2$.qubic.lts.transactions.pb.ComputorsR\tcomputors\"8\n" +
+	"\x15GetLatestTickResponse\x12\x1f\n" +
+	"\vlatest_tick\x18\x01 \x01(\rR\n" +
+	"latestTick\"v\n" +
+	"\x19GetEpochTickListRequestV2\x12\x14\n" +
+	"\x05epoch\x18\x01 \x01(\rR\x05epoch\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x12\n" +
+	"\x04desc\x18\x04 \x01(\bR\x04desc\"\x9a\x01\n" +
+	"\x1aGetEpochTickListResponseV2\x12E\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2%.qubic.lts.transactions.pb.PaginationR\n" +
+	"pagination\x125\n" +
+	"\x05ticks\x18\x02 \x03(\v2\x1f.qubic.lts.transactions.pb.TickR\x05ticks\"g\n" +
+	"\x1eGetEpochEmptyTickListRequestV2\x12\x14\n" +
+	"\x05epoch\x18\x01 \x01(\rR\x05epoch\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\x88\x01\n" +
+	"\x1fGetEpochEmptyTickListResponseV2\x12E\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2%.qubic.lts.transactions.pb.PaginationR\n" +
+	"pagination\x12\x1e\n" +
+	"\n" +
+	"emptyTicks\x18\x02 \x03(\rR\n" +
+	"emptyTicks\"B\n" +
+	"\x04Tick\x12\x1f\n" +
+	"\vtick_number\x18\x01 \x01(\rR\n" +
+	"tickNumber\x12\x19\n" +
+	"\bis_empty\x18\x02 \x01(\bR\aisEmpty2\xd2\x12\n" +
+	"\x13TransactionsService\x12\xc0\x01\n" +
+	"\x17GetIdentityTransactions\x129.qubic.lts.transactions.pb.GetIdentityTransactionsRequest\x1a:.qubic.lts.transactions.pb.GetIdentityTransactionsResponse\".\x82\xd3\xe4\x93\x02(\x12&/v2/identities/{identity}/transactions\x12\xda\x01\n" +
+	"!GetIdentityTransfersInTickRangeV2\x12B.qubic.lts.transactions.pb.GetTransferTransactionsPerTickRequestV2\x1aD.qubic.lts.transactions.pb.GetIdentityTransfersInTickRangeResponseV2\"+\x82\xd3\xe4\x93\x02%\x12#/v2/identities/{identity}/transfers\x12\xb8\x01\n" +
+	"\x15GetTickTransactionsV2\x127.qubic.lts.transactions.pb.GetTickTransactionsRequestV2\x1a8.qubic.lts.transactions.pb.GetTickTransactionsResponseV2\",\x82\xd3\xe4\x93\x02&\x12$/v2/ticks/{tick_number}/transactions\x12\xb2\x01\n" +
+	"\x13GetTickTransactions\x125.qubic.lts.transactions.pb.GetTickTransactionsRequest\x1a6.qubic.lts.transactions.pb.GetTickTransactionsResponse\",\x82\xd3\xe4\x93\x02&\x12$/v1/ticks/{tick_number}/transactions\x12\xd3\x01\n" +
+	"\x1bGetTickApprovedTransactions\x12=.qubic.lts.transactions.pb.GetTickApprovedTransactionsRequest\x1a>.qubic.lts.transactions.pb.GetTickApprovedTransactionsResponse\"5\x82\xd3\xe4\x93\x02/\x12-/v1/ticks/{tick_number}/approved-transactions\x12\x97\x01\n" +
+	"\x0eGetTransaction\x120.qubic.lts.transactions.pb.GetTransactionRequest\x1a1.qubic.lts.transactions.pb.GetTransactionResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/transactions/{tx_id}\x12\xa0\x01\n" +
+	"\x14GetTransactionStatus\x120.qubic.lts.transactions.pb.GetTransactionRequest\x1a7.qubic.lts.transactions.pb.GetTransactionStatusResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/tx-status/{tx_id}\x12\x9b\x01\n" +
+	"\x10GetTransactionV2\x120.qubic.lts.transactions.pb.GetTransactionRequest\x1a3.qubic.lts.transactions.pb.GetTransactionResponseV2\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v2/transactions/{tx_id}\x12\x97\x01\n" +
+	"\vGetTickData\x12-.qubic.lts.transactions.pb.GetTickDataRequest\x1a..qubic.lts.transactions.pb.GetTickDataResponse\")\x82\xd3\xe4\x93\x02#\x12!/v1/ticks/{tick_number}/tick-data\x12u\n" +
+	"\x11GetArchiverStatus\x12\x16.google.protobuf.Empty\x1a4.qubic.lts.transactions.pb.GetArchiverStatusResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v1/status\x12\x99\x01\n" +
+	"\x10GetComputorsList\x12..qubic.lts.transactions.pb.GetComputorsRequest\x1a/.qubic.lts.transactions.pb.GetComputorsResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/epochs/{epoch}/computors\x12q\n" +
+	"\rGetLatestTick\x12\x16.google.protobuf.Empty\x1a0.qubic.lts.transactions.pb.GetLatestTickResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/latestTick\x12\xa3\x01\n" +
+	"\x12GetEpochTickListV2\x124.qubic.lts.transactions.pb.GetEpochTickListRequestV2\x1a5.qubic.lts.transactions.pb.GetEpochTickListResponseV2\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v2/epochs/{epoch}/ticks\x12\xb3\x01\n" +
+	"\x12GetEmptyTickListV2\x129.qubic.lts.transactions.pb.GetEpochEmptyTickListRequestV2\x1a:.qubic.lts.transactions.pb.GetEpochEmptyTickListResponseV2\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v2/epochs/{epoch}/empty-ticksBUZSgithub.com/qubic/archive-query-service/v2/api/archive-query-service/legacy;protobufb\x06proto3"
+
+var (
+	file_transactions_proto_rawDescOnce sync.Once
+	file_transactions_proto_rawDescData []byte
+)
+
+func file_transactions_proto_rawDescGZIP() []byte {
+	file_transactions_proto_rawDescOnce.Do(func() {
+		file_transactions_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_transactions_proto_rawDesc), len(file_transactions_proto_rawDesc)))
+	})
+	return file_transactions_proto_rawDescData
+}
+
+var file_transactions_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
+var file_transactions_proto_goTypes = []any{
+	(*LastProcessedTick)(nil),                         // 0: qubic.lts.transactions.pb.LastProcessedTick
+	(*GetTickRequestV2)(nil),                          // 1: qubic.lts.transactions.pb.GetTickRequestV2
+	(*GetTickTransactionsRequestV2)(nil),              // 2: qubic.lts.transactions.pb.GetTickTransactionsRequestV2
+	(*GetTickTransactionsResponseV2)(nil),             // 3: qubic.lts.transactions.pb.GetTickTransactionsResponseV2
+	(*GetTickTransactionsRequest)(nil),                // 4: qubic.lts.transactions.pb.GetTickTransactionsRequest
+	(*GetTickApprovedTransactionsRequest)(nil),        // 5: qubic.lts.transactions.pb.GetTickApprovedTransactionsRequest
+	(*NextAvailableTick)(nil),                         // 6: qubic.lts.transactions.pb.NextAvailableTick
+	(*GetIdentityTransactionsRequest)(nil),            // 7: qubic.lts.transactions.pb.GetIdentityTransactionsRequest
+	(*GetIdentityTransactionsResponse)(nil),           // 8: qubic.lts.transactions.pb.GetIdentityTransactionsResponse
+	(*Pagination)(nil),                                // 9: qubic.lts.transactions.pb.Pagination
+	(*Transaction)(nil),                               // 10: qubic.lts.transactions.pb.Transaction
+	(*GetTransferTransactionsPerTickRequestV2)(nil),   // 11: qubic.lts.transactions.pb.GetTransferTransactionsPerTickRequestV2
+	(*GetIdentityTransfersInTickRangeResponseV2)(nil), // 12: qubic.lts.transactions.pb.GetIdentityTransfersInTickRangeResponseV2
+	(*PerTickIdentityTransfers)(nil),                  // 13: qubic.lts.transactions.pb.PerTickIdentityTransfers
+	(*NewTransaction)(nil),                            // 14: qubic.lts.transactions.pb.NewTransaction
+	(*TransactionData)(nil),                           // 15: qubic.lts.transactions.pb.TransactionData
+	(*GetTickTransactionsResponse)(nil),               // 16: qubic.lts.transactions.pb.GetTickTransactionsResponse
+	(*GetTickApprovedTransactionsResponse)(nil),       // 17: qubic.lts.transactions.pb.GetTickApprovedTransactionsResponse
+	(*GetTransactionRequest)(nil),                     // 18: qubic.lts.transactions.pb.GetTransactionRequest
+	(*TransactionStatus)(nil),                         // 19: qubic.lts.transactions.pb.TransactionStatus
+	(*GetTransactionStatusResponse)(nil),              // 20: qubic.lts.transactions.pb.GetTransactionStatusResponse
+	(*GetTransactionResponse)(nil),                    // 21: qubic.lts.transactions.pb.GetTransactionResponse
+	(*GetTransactionResponseV2)(nil),                  // 22: qubic.lts.transactions.pb.GetTransactionResponseV2
+	(*TickData)(nil),                                  // 23: qubic.lts.transactions.pb.TickData
+	(*GetTickDataRequest)(nil),                        // 24: qubic.lts.transactions.pb.GetTickDataRequest
+	(*GetTickDataResponse)(nil),                       // 25: qubic.lts.transactions.pb.GetTickDataResponse
+	(*GetArchiverStatusResponse)(nil),                 // 26: qubic.lts.transactions.pb.GetArchiverStatusResponse
+	(*ProcessedTick)(nil),                             // 27: qubic.lts.transactions.pb.ProcessedTick
+	(*ProcessedTickInterval)(nil),                     // 28: qubic.lts.transactions.pb.ProcessedTickInterval
+	(*ProcessedTickIntervalsPerEpoch)(nil),            // 29: qubic.lts.transactions.pb.ProcessedTickIntervalsPerEpoch
+	(*SkippedTicksInterval)(nil),                      // 30: qubic.lts.transactions.pb.SkippedTicksInterval
+	(*SkippedTicksIntervalList)(nil),                  // 31: qubic.lts.transactions.pb.SkippedTicksIntervalList
+	(*Computors)(nil),                                 // 32: qubic.lts.transactions.pb.Computors
+	(*GetComputorsRequest)(nil),                       // 33: qubic.lts.transactions.pb.GetComputorsRequest
+	(*GetComputorsResponse)(nil),                      // 34: qubic.lts.transactions.pb.GetComputorsResponse
+	(*GetLatestTickResponse)(nil),                     // 35: qubic.lts.transactions.pb.GetLatestTickResponse
+	(*GetEpochTickListRequestV2)(nil),                 // 36: qubic.lts.transactions.pb.GetEpochTickListRequestV2
+	(*GetEpochTickListResponseV2)(nil),                // 37: qubic.lts.transactions.pb.GetEpochTickListResponseV2
+	(*GetEpochEmptyTickListRequestV2)(nil),            // 38: qubic.lts.transactions.pb.GetEpochEmptyTickListRequestV2
+	(*GetEpochEmptyTickListResponseV2)(nil),           // 39: qubic.lts.transactions.pb.GetEpochEmptyTickListResponseV2
+	(*Tick)(nil),                                      // 40: qubic.lts.transactions.pb.Tick
+	nil,                                               // 41: qubic.lts.transactions.pb.GetArchiverStatusResponse.LastProcessedTicksPerEpochEntry
+	nil,                                               // 42: qubic.lts.transactions.pb.GetArchiverStatusResponse.EmptyTicksPerEpochEntry
+	(*emptypb.Empty)(nil),                             // 43: google.protobuf.Empty
+}
+var file_transactions_proto_depIdxs = []int32{
+	15, // 0: qubic.lts.transactions.pb.GetTickTransactionsResponseV2.transactions:type_name -> qubic.lts.transactions.pb.TransactionData
+	9,  // 1: qubic.lts.transactions.pb.GetIdentityTransactionsResponse.pagination:type_name -> qubic.lts.transactions.pb.Pagination
+	14, // 2: qubic.lts.transactions.pb.GetIdentityTransactionsResponse.transactions:type_name -> qubic.lts.transactions.pb.NewTransaction
+	9,  // 3: qubic.lts.transactions.pb.GetIdentityTransfersInTickRangeResponseV2.pagination:type_name -> qubic.lts.transactions.pb.Pagination
+	13, // 4: qubic.lts.transactions.pb.GetIdentityTransfersInTickRangeResponseV2.transactions:type_name -> qubic.lts.transactions.pb.PerTickIdentityTransfers
+	15, // 5: qubic.lts.transactions.pb.PerTickIdentityTransfers.transactions:type_name -> qubic.lts.transactions.pb.TransactionData
+	10, // 6: qubic.lts.transactions.pb.TransactionData.transaction:type_name -> qubic.lts.transactions.pb.Transaction
+	10, // 7: qubic.lts.transactions.pb.GetTickTransactionsResponse.transactions:type_name -> qubic.lts.transactions.pb.Transaction
+	10, // 8: qubic.lts.transactions.pb.GetTickApprovedTransactionsResponse.approved_transactions:type_name -> qubic.lts.transactions.pb.Transaction
+	19, // 9: qubic.lts.transactions.pb.GetTransactionStatusResponse.transaction_status:type_name -> qubic.lts.transactions.pb.TransactionStatus
+	10, // 10: qubic.lts.transactions.pb.GetTransactionResponse.transaction:type_name -> qubic.lts.transactions.pb.Transaction
+	10, // 11: qubic.lts.transactions.pb.GetTransactionResponseV2.transaction:type_name -> qubic.lts.transactions.pb.Transaction
+	23, // 12: qubic.lts.transactions.pb.GetTickDataResponse.tick_data:type_name -> qubic.lts.transactions.pb.TickData
+	27, // 13: qubic.lts.transactions.pb.GetArchiverStatusResponse.last_processed_tick:type_name -> qubic.lts.transactions.pb.ProcessedTick
+	41, // 14: qubic.lts.transactions.pb.GetArchiverStatusResponse.last_processed_ticks_per_epoch:type_name -> qubic.lts.transactions.pb.GetArchiverStatusResponse.LastProcessedTicksPerEpochEntry
+	30, // 15: qubic.lts.transactions.pb.GetArchiverStatusResponse.skipped_ticks:type_name -> qubic.lts.transactions.pb.SkippedTicksInterval
+	29, // 16: qubic.lts.transactions.pb.GetArchiverStatusResponse.processed_tick_intervals_per_epoch:type_name -> qubic.lts.transactions.pb.ProcessedTickIntervalsPerEpoch
+	42, // 17: qubic.lts.transactions.pb.GetArchiverStatusResponse.empty_ticks_per_epoch:type_name -> qubic.lts.transactions.pb.GetArchiverStatusResponse.EmptyTicksPerEpochEntry
+	28, // 18: qubic.lts.transactions.pb.ProcessedTickIntervalsPerEpoch.intervals:type_name -> qubic.lts.transactions.pb.ProcessedTickInterval
+	30, // 19: qubic.lts.transactions.pb.SkippedTicksIntervalList.skipped_ticks:type_name -> qubic.lts.transactions.pb.SkippedTicksInterval
+	32, // 20: qubic.lts.transactions.pb.GetComputorsResponse.computors:type_name -> qubic.lts.transactions.pb.Computors
+	9,  // 21: qubic.lts.transactions.pb.GetEpochTickListResponseV2.pagination:type_name -> qubic.lts.transactions.pb.Pagination
+	40, // 22: qubic.lts.transactions.pb.GetEpochTickListResponseV2.ticks:type_name -> qubic.lts.transactions.pb.Tick
+	9,  // 23: qubic.lts.transactions.pb.GetEpochEmptyTickListResponseV2.pagination:type_name -> qubic.lts.transactions.pb.Pagination
+	7,  // 24: qubic.lts.transactions.pb.TransactionsService.GetIdentityTransactions:input_type -> qubic.lts.transactions.pb.GetIdentityTransactionsRequest
+	11, // 25: qubic.lts.transactions.pb.TransactionsService.GetIdentityTransfersInTickRangeV2:input_type -> qubic.lts.transactions.pb.GetTransferTransactionsPerTickRequestV2
+	2,  // 26: qubic.lts.transactions.pb.TransactionsService.GetTickTransactionsV2:input_type -> qubic.lts.transactions.pb.GetTickTransactionsRequestV2
+	4,  // 27: qubic.lts.transactions.pb.TransactionsService.GetTickTransactions:input_type -> qubic.lts.transactions.pb.GetTickTransactionsRequest
+	5,  // 28: qubic.lts.transactions.pb.TransactionsService.GetTickApprovedTransactions:input_type -> qubic.lts.transactions.pb.GetTickApprovedTransactionsRequest
+	18, // 29: qubic.lts.transactions.pb.TransactionsService.GetTransaction:input_type -> qubic.lts.transactions.pb.GetTransactionRequest
+	18, // 30: qubic.lts.transactions.pb.TransactionsService.GetTransactionStatus:input_type -> qubic.lts.transactions.pb.GetTransactionRequest
+	18, // 31: qubic.lts.transactions.pb.TransactionsService.GetTransactionV2:input_type -> qubic.lts.transactions.pb.GetTransactionRequest
+	24, // 32: qubic.lts.transactions.pb.TransactionsService.GetTickData:input_type -> qubic.lts.transactions.pb.GetTickDataRequest
+	43, // 33: qubic.lts.transactions.pb.TransactionsService.GetArchiverStatus:input_type -> google.protobuf.Empty
+	33, // 34: qubic.lts.transactions.pb.TransactionsService.GetComputorsList:input_type -> qubic.lts.transactions.pb.GetComputorsRequest
+	43, // 35: qubic.lts.transactions.pb.TransactionsService.GetLatestTick:input_type -> google.protobuf.Empty
+	36, // 36: qubic.lts.transactions.pb.TransactionsService.GetEpochTickListV2:input_type -> qubic.lts.transactions.pb.GetEpochTickListRequestV2
+	38, // 37: qubic.lts.transactions.pb.TransactionsService.GetEmptyTickListV2:input_type -> qubic.lts.transactions.pb.GetEpochEmptyTickListRequestV2
+	8,  // 38: qubic.lts.transactions.pb.TransactionsService.GetIdentityTransactions:output_type -> qubic.lts.transactions.pb.GetIdentityTransactionsResponse
+	12, // 39: qubic.lts.transactions.pb.TransactionsService.GetIdentityTransfersInTickRangeV2:output_type -> qubic.lts.transactions.pb.GetIdentityTransfersInTickRangeResponseV2
+	3,  // 40: qubic.lts.transactions.pb.TransactionsService.GetTickTransactionsV2:output_type -> qubic.lts.transactions.pb.GetTickTransactionsResponseV2
+	16, // 41: qubic.lts.transactions.pb.TransactionsService.GetTickTransactions:output_type -> qubic.lts.transactions.pb.GetTickTransactionsResponse
+	17, // 42: qubic.lts.transactions.pb.TransactionsService.GetTickApprovedTransactions:output_type -> qubic.lts.transactions.pb.GetTickApprovedTransactionsResponse
+	21, // 43: qubic.lts.transactions.pb.TransactionsService.GetTransaction:output_type -> qubic.lts.transactions.pb.GetTransactionResponse
+	20, // 44: qubic.lts.transactions.pb.TransactionsService.GetTransactionStatus:output_type -> qubic.lts.transactions.pb.GetTransactionStatusResponse
+	22, // 45: qubic.lts.transactions.pb.TransactionsService.GetTransactionV2:output_type -> qubic.lts.transactions.pb.GetTransactionResponseV2
+	25, // 46: qubic.lts.transactions.pb.TransactionsService.GetTickData:output_type -> qubic.lts.transactions.pb.GetTickDataResponse
+	26, // 47: qubic.lts.transactions.pb.TransactionsService.GetArchiverStatus:output_type -> qubic.lts.transactions.pb.GetArchiverStatusResponse
+	34, // 48: qubic.lts.transactions.pb.TransactionsService.GetComputorsList:output_type -> qubic.lts.transactions.pb.GetComputorsResponse
+	35, // 49: qubic.lts.transactions.pb.TransactionsService.GetLatestTick:output_type -> qubic.lts.transactions.pb.GetLatestTickResponse
+	37, // 50: qubic.lts.transactions.pb.TransactionsService.GetEpochTickListV2:output_type -> qubic.lts.transactions.pb.GetEpochTickListResponseV2
+	39, // 51: qubic.lts.transactions.pb.TransactionsService.GetEmptyTickListV2:output_type -> qubic.lts.transactions.pb.GetEpochEmptyTickListResponseV2
+	38, // [38:52] is the sub-list for method output_type
+	24, // [24:38] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
+}
+
+func init() { file_transactions_proto_init() }
+func file_transactions_proto_init() {
+	if File_transactions_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_transactions_proto_rawDesc), len(file_transactions_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   43,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_transactions_proto_goTypes,
+		DependencyIndexes: file_transactions_proto_depIdxs,
+		MessageInfos:      file_transactions_proto_msgTypes,
+	}.Build()
+	File_transactions_proto = out.File
+	file_transactions_proto_goTypes = nil
+	file_transactions_proto_depIdxs = nil
+}
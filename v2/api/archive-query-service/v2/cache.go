@@ -14,6 +14,8 @@ const (
 	getTransactionsForTickPrefix     = "ttfr"
 	getTransactionsForIdentityPrefix = "ttfir"
 	getEventsRequestPrefix           = "ger"
+	getEventLogRequestPrefix         = "gelr"
+	getEventLogsForTransactionPrefix = "geltr"
 )
 
 func (r *GetTickDataRequest) GetCacheKey() (string, error) {
@@ -52,3 +54,17 @@ func (r *GetEventLogsRequest) GetCacheKey() (string, error) {
 	sum := sha256.Sum256(b)
 	return getEventsRequestPrefix + ":" + hex.EncodeToString(sum[:]), nil
 }
+
+func (r *GetEventLogRequest) GetCacheKey() (string, error) {
+	return getEventLogRequestPrefix + ":" + strconv.FormatUint(uint64(r.Epoch), 10) + ":" + strconv.FormatUint(r.LogId, 10), nil
+}
+
+func (r *GetEventLogsForTransactionRequest) GetCacheKey() (string, error) {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(r)
+	if err != nil {
+		return "", fmt.Errorf("marshalling request: %w", err)
+	}
+
+	sum := sha256.Sum256(b)
+	return getEventLogsForTransactionPrefix + ":" + hex.EncodeToString(sum[:]), nil
+}
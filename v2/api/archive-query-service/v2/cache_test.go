@@ -1,6 +1,7 @@
 package api
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -186,3 +187,24 @@ func Test_GetTransactionsForTickRequest_GetCacheKey_DifferentRequestsDifferentKe
 
 	require.NotEqual(t, firstKey, secondKey, "different requests should have different cache keys")
 }
+
+func Test_GetEventLogRequest_GetCacheKey(t *testing.T) {
+	request := GetEventLogRequest{Epoch: 100, LogId: 42}
+	key, err := request.GetCacheKey()
+	require.NoError(t, err)
+	require.Equal(t, "gelr:100:42", key)
+}
+
+func Test_GetEventLogsForTransactionRequest_GetCacheKey(t *testing.T) {
+	afterLogID := uint64(0)
+	first := GetEventLogsForTransactionRequest{TransactionHash: "hash", Size: 10}
+	second := GetEventLogsForTransactionRequest{TransactionHash: "hash", Size: 10, AfterLogId: &afterLogID}
+
+	firstKey, err := first.GetCacheKey()
+	require.NoError(t, err)
+	secondKey, err := second.GetCacheKey()
+	require.NoError(t, err)
+
+	require.True(t, strings.HasPrefix(firstKey, "geltr:"))
+	require.NotEqual(t, firstKey, secondKey, "explicit zero cursor must not share the key without cursor")
+}
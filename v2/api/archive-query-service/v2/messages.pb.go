@@ -22,6 +22,56 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ExecutionStatus
+type ExecutionStatus int32
+
+const (
+	ExecutionStatus_EXECUTION_STATUS_UNKNOWN      ExecutionStatus = 0
+	ExecutionStatus_EXECUTION_STATUS_EXECUTED     ExecutionStatus = 1
+	ExecutionStatus_EXECUTION_STATUS_NOT_EXECUTED ExecutionStatus = 2
+)
+
+// Enum value maps for ExecutionStatus.
+var (
+	ExecutionStatus_name = map[int32]string{
+		0: "EXECUTION_STATUS_UNKNOWN",
+		1: "EXECUTION_STATUS_EXECUTED",
+		2: "EXECUTION_STATUS_NOT_EXECUTED",
+	}
+	ExecutionStatus_value = map[string]int32{
+		"EXECUTION_STATUS_UNKNOWN":      0,
+		"EXECUTION_STATUS_EXECUTED":     1,
+		"EXECUTION_STATUS_NOT_EXECUTED": 2,
+	}
+)
+
+func (x ExecutionStatus) Enum() *ExecutionStatus {
+	p := new(ExecutionStatus)
+	*p = x
+	return p
+}
+
+func (x ExecutionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExecutionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_messages_proto_enumTypes[0].Descriptor()
+}
+
+func (ExecutionStatus) Type() protoreflect.EnumType {
+	return &file_messages_proto_enumTypes[0]
+}
+
+func (x ExecutionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExecutionStatus.Descriptor instead.
+func (ExecutionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{0}
+}
+
 type LastProcessedTick struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TickNumber    uint32                 `protobuf:"varint,1,opt,name=tick_number,json=tickNumber,proto3" json:"tick_number,omitempty"`
@@ -112,20 +162,22 @@ func (x *NextAvailableTick) GetNextTickNumber() uint32 {
 
 // Transaction
 type Transaction struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Hash          string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	Amount        uint64                 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Source        string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
-	Destination   string                 `protobuf:"bytes,4,opt,name=destination,proto3" json:"destination,omitempty"`
-	TickNumber    uint32                 `protobuf:"varint,5,opt,name=tick_number,json=tickNumber,proto3" json:"tick_number,omitempty"`
-	Timestamp     uint64                 `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	InputType     uint32                 `protobuf:"varint,7,opt,name=input_type,json=inputType,proto3" json:"input_type,omitempty"`
-	InputSize     uint32                 `protobuf:"varint,8,opt,name=input_size,json=inputSize,proto3" json:"input_size,omitempty"`
-	InputData     string                 `protobuf:"bytes,9,opt,name=input_data,json=inputData,proto3" json:"input_data,omitempty"`
-	Signature     string                 `protobuf:"bytes,10,opt,name=signature,proto3" json:"signature,omitempty"`
-	MoneyFlew     bool                   `protobuf:"varint,11,opt,name=money_flew,json=moneyFlew,proto3" json:"money_flew,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Hash            string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Amount          uint64                 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Source          string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Destination     string                 `protobuf:"bytes,4,opt,name=destination,proto3" json:"destination,omitempty"`
+	TickNumber      uint32                 `protobuf:"varint,5,opt,name=tick_number,json=tickNumber,proto3" json:"tick_number,omitempty"`
+	Timestamp       uint64                 `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	InputType       uint32                 `protobuf:"varint,7,opt,name=input_type,json=inputType,proto3" json:"input_type,omitempty"`
+	InputSize       uint32                 `protobuf:"varint,8,opt,name=input_size,json=inputSize,proto3" json:"input_size,omitempty"`
+	InputData       string                 `protobuf:"bytes,9,opt,name=input_data,json=inputData,proto3" json:"input_data,omitempty"`
+	Signature       string                 `protobuf:"bytes,10,opt,name=signature,proto3" json:"signature,omitempty"`
+	MoneyFlew       bool                   `protobuf:"varint,11,opt,name=money_flew,json=moneyFlew,proto3" json:"money_flew,omitempty"`
+	DecodedInput    *DecodedInput          `protobuf:"bytes,12,opt,name=decoded_input,json=decodedInput,proto3" json:"decoded_input,omitempty"`
+	ExecutionStatus ExecutionStatus        `protobuf:"varint,13,opt,name=execution_status,json=executionStatus,proto3,enum=qubic.v2.archive.pb.ExecutionStatus" json:"execution_status,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Transaction) Reset() {
@@ -235,36 +287,48 @@ func (x *Transaction) GetMoneyFlew() bool {
 	return false
 }
 
-// TickData
-type TickData struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	TickNumber        uint32                 `protobuf:"varint,1,opt,name=tick_number,json=tickNumber,proto3" json:"tick_number,omitempty"`
-	Epoch             uint32                 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	ComputorIndex     uint32                 `protobuf:"varint,3,opt,name=computor_index,json=computorIndex,proto3" json:"computor_index,omitempty"`
-	Timestamp         uint64                 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	VarStruct         string                 `protobuf:"bytes,5,opt,name=var_struct,json=varStruct,proto3" json:"var_struct,omitempty"`
-	TimeLock          string                 `protobuf:"bytes,6,opt,name=time_lock,json=timeLock,proto3" json:"time_lock,omitempty"`
-	TransactionHashes []string               `protobuf:"bytes,7,rep,name=transaction_hashes,json=transactionHashes,proto3" json:"transaction_hashes,omitempty"`
-	ContractFees      []int64                `protobuf:"varint,8,rep,packed,name=contract_fees,json=contractFees,proto3" json:"contract_fees,omitempty"`
-	Signature         string                 `protobuf:"bytes,9,opt,name=signature,proto3" json:"signature,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *Transaction) GetDecodedInput() *DecodedInput {
+	if x != nil {
+		return x.DecodedInput
+	}
+	return nil
 }
 
-func (x *TickData) Reset() {
-	*x = TickData{}
+func (x *Transaction) GetExecutionStatus() ExecutionStatus {
+	if x != nil {
+		return x.ExecutionStatus
+	}
+	return ExecutionStatus_EXECUTION_STATUS_UNKNOWN
+}
+
+// DecodedInput
+type DecodedInput struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Input:
+	//
+	//	*DecodedInput_SendMany
+	//	*DecodedInput_QxIssueAsset
+	//	*DecodedInput_QxTransferShare
+	//	*DecodedInput_QxOrder
+	Input         isDecodedInput_Input `protobuf_oneof:"input"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecodedInput) Reset() {
+	*x = DecodedInput{}
 	mi := &file_messages_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TickData) String() string {
+func (x *DecodedInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TickData) ProtoMessage() {}
+func (*DecodedInput) ProtoMessage() {}
 
-func (x *TickData) ProtoReflect() protoreflect.Message {
+func (x *DecodedInput) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -276,98 +340,105 @@ func (x *TickData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TickData.ProtoReflect.Descriptor instead.
-func (*TickData) Descriptor() ([]byte, []int) {
+// Deprecated: Use DecodedInput.ProtoReflect.Descriptor instead.
+func (*DecodedInput) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *TickData) GetTickNumber() uint32 {
+func (x *DecodedInput) GetInput() isDecodedInput_Input {
 	if x != nil {
-		return x.TickNumber
+		return x.Input
 	}
-	return 0
+	return nil
 }
 
-func (x *TickData) GetEpoch() uint32 {
+func (x *DecodedInput) GetSendMany() *SendManyInput {
 	if x != nil {
-		return x.Epoch
+		if x, ok := x.Input.(*DecodedInput_SendMany); ok {
+			return x.SendMany
+		}
 	}
-	return 0
+	return nil
 }
 
-func (x *TickData) GetComputorIndex() uint32 {
+func (x *DecodedInput) GetQxIssueAsset() *QxIssueAssetInput {
 	if x != nil {
-		return x.ComputorIndex
+		if x, ok := x.Input.(*DecodedInput_QxIssueAsset); ok {
+			return x.QxIssueAsset
+		}
 	}
-	return 0
+	return nil
 }
 
-func (x *TickData) GetTimestamp() uint64 {
+func (x *DecodedInput) GetQxTransferShare() *QxTransferShareInput {
 	if x != nil {
-		return x.Timestamp
+		if x, ok := x.Input.(*DecodedInput_QxTransferShare); ok {
+			return x.QxTransferShare
+		}
 	}
-	return 0
+	return nil
 }
 
-func (x *TickData) GetVarStruct() string {
+func (x *DecodedInput) GetQxOrder() *QxOrderInput {
 	if x != nil {
-		return x.VarStruct
+		if x, ok := x.Input.(*DecodedInput_QxOrder); ok {
+			return x.QxOrder
+		}
 	}
-	return ""
+	return nil
 }
 
-func (x *TickData) GetTimeLock() string {
-	if x != nil {
-		return x.TimeLock
-	}
-	return ""
+type isDecodedInput_Input interface {
+	isDecodedInput_Input()
 }
 
-func (x *TickData) GetTransactionHashes() []string {
-	if x != nil {
-		return x.TransactionHashes
-	}
-	return nil
+type DecodedInput_SendMany struct {
+	SendMany *SendManyInput `protobuf:"bytes,1,opt,name=send_many,json=sendMany,proto3,oneof"`
 }
 
-func (x *TickData) GetContractFees() []int64 {
-	if x != nil {
-		return x.ContractFees
-	}
-	return nil
+type DecodedInput_QxIssueAsset struct {
+	QxIssueAsset *QxIssueAssetInput `protobuf:"bytes,2,opt,name=qx_issue_asset,json=qxIssueAsset,proto3,oneof"`
 }
 
-func (x *TickData) GetSignature() string {
-	if x != nil {
-		return x.Signature
-	}
-	return ""
+type DecodedInput_QxTransferShare struct {
+	QxTransferShare *QxTransferShareInput `protobuf:"bytes,3,opt,name=qx_transfer_share,json=qxTransferShare,proto3,oneof"`
 }
 
-// ProcessedTickInterval
-type ProcessedTickInterval struct {
+type DecodedInput_QxOrder struct {
+	QxOrder *QxOrderInput `protobuf:"bytes,4,opt,name=qx_order,json=qxOrder,proto3,oneof"`
+}
+
+func (*DecodedInput_SendMany) isDecodedInput_Input() {}
+
+func (*DecodedInput_QxIssueAsset) isDecodedInput_Input() {}
+
+func (*DecodedInput_QxTransferShare) isDecodedInput_Input() {}
+
+func (*DecodedInput_QxOrder) isDecodedInput_Input() {}
+
+// SendManyInput QUTIL send many procedure
+type SendManyInput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Epoch         uint32                 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	FirstTick     uint32                 `protobuf:"varint,2,opt,name=first_tick,json=firstTick,proto3" json:"first_tick,omitempty"`
-	LastTick      uint32                 `protobuf:"varint,3,opt,name=last_tick,json=lastTick,proto3" json:"last_tick,omitempty"`
+	Transfers     []*SendManyTransfer    `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	TotalAmount   int64                  `protobuf:"varint,2,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProcessedTickInterval) Reset() {
-	*x = ProcessedTickInterval{}
+func (x *SendManyInput) Reset() {
+	*x = SendManyInput{}
 	mi := &file_messages_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProcessedTickInterval) String() string {
+func (x *SendManyInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessedTickInterval) ProtoMessage() {}
+func (*SendManyInput) ProtoMessage() {}
 
-func (x *ProcessedTickInterval) ProtoReflect() protoreflect.Message {
+func (x *SendManyInput) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -379,55 +450,48 @@ func (x *ProcessedTickInterval) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessedTickInterval.ProtoReflect.Descriptor instead.
-func (*ProcessedTickInterval) Descriptor() ([]byte, []int) {
+// Deprecated: Use SendManyInput.ProtoReflect.Descriptor instead.
+func (*SendManyInput) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ProcessedTickInterval) GetEpoch() uint32 {
-	if x != nil {
-		return x.Epoch
-	}
-	return 0
-}
-
-func (x *ProcessedTickInterval) GetFirstTick() uint32 {
+func (x *SendManyInput) GetTransfers() []*SendManyTransfer {
 	if x != nil {
-		return x.FirstTick
+		return x.Transfers
 	}
-	return 0
+	return nil
 }
 
-func (x *ProcessedTickInterval) GetLastTick() uint32 {
+func (x *SendManyInput) GetTotalAmount() int64 {
 	if x != nil {
-		return x.LastTick
+		return x.TotalAmount
 	}
 	return 0
 }
 
-// Pagination
-type Pagination struct {
+// SendManyTransfer
+type SendManyTransfer struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Offset        uint32                 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
-	Size          uint32                 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Destination   string                 `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Pagination) Reset() {
-	*x = Pagination{}
+func (x *SendManyTransfer) Reset() {
+	*x = SendManyTransfer{}
 	mi := &file_messages_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Pagination) String() string {
+func (x *SendManyTransfer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pagination) ProtoMessage() {}
+func (*SendManyTransfer) ProtoMessage() {}
 
-func (x *Pagination) ProtoReflect() protoreflect.Message {
+func (x *SendManyTransfer) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -439,47 +503,50 @@ func (x *Pagination) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pagination.ProtoReflect.Descriptor instead.
-func (*Pagination) Descriptor() ([]byte, []int) {
+// Deprecated: Use SendManyTransfer.ProtoReflect.Descriptor instead.
+func (*SendManyTransfer) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Pagination) GetOffset() uint32 {
+func (x *SendManyTransfer) GetDestination() string {
 	if x != nil {
-		return x.Offset
+		return x.Destination
 	}
-	return 0
+	return ""
 }
 
-func (x *Pagination) GetSize() uint32 {
+func (x *SendManyTransfer) GetAmount() int64 {
 	if x != nil {
-		return x.Size
+		return x.Amount
 	}
 	return 0
 }
 
-// GetTransactionByHashRequest
-type GetTransactionByHashRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Hash          string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// QxIssueAssetInput QX issue asset procedure
+type QxIssueAssetInput struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	AssetName             string                 `protobuf:"bytes,1,opt,name=asset_name,json=assetName,proto3" json:"asset_name,omitempty"`
+	NumberOfShares        int64                  `protobuf:"varint,2,opt,name=number_of_shares,json=numberOfShares,proto3" json:"number_of_shares,omitempty"`
+	UnitOfMeasurement     uint64                 `protobuf:"varint,3,opt,name=unit_of_measurement,json=unitOfMeasurement,proto3" json:"unit_of_measurement,omitempty"`
+	NumberOfDecimalPlaces int32                  `protobuf:"varint,4,opt,name=number_of_decimal_places,json=numberOfDecimalPlaces,proto3" json:"number_of_decimal_places,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
-func (x *GetTransactionByHashRequest) Reset() {
-	*x = GetTransactionByHashRequest{}
+func (x *QxIssueAssetInput) Reset() {
+	*x = QxIssueAssetInput{}
 	mi := &file_messages_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTransactionByHashRequest) String() string {
+func (x *QxIssueAssetInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTransactionByHashRequest) ProtoMessage() {}
+func (*QxIssueAssetInput) ProtoMessage() {}
 
-func (x *GetTransactionByHashRequest) ProtoReflect() protoreflect.Message {
+func (x *QxIssueAssetInput) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -491,40 +558,64 @@ func (x *GetTransactionByHashRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTransactionByHashRequest.ProtoReflect.Descriptor instead.
-func (*GetTransactionByHashRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use QxIssueAssetInput.ProtoReflect.Descriptor instead.
+func (*QxIssueAssetInput) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetTransactionByHashRequest) GetHash() string {
+func (x *QxIssueAssetInput) GetAssetName() string {
 	if x != nil {
-		return x.Hash
+		return x.AssetName
 	}
 	return ""
 }
 
-// GetTransactionByHashResponse
-type GetTransactionByHashResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Transaction   *Transaction           `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *QxIssueAssetInput) GetNumberOfShares() int64 {
+	if x != nil {
+		return x.NumberOfShares
+	}
+	return 0
 }
 
-func (x *GetTransactionByHashResponse) Reset() {
-	*x = GetTransactionByHashResponse{}
+func (x *QxIssueAssetInput) GetUnitOfMeasurement() uint64 {
+	if x != nil {
+		return x.UnitOfMeasurement
+	}
+	return 0
+}
+
+func (x *QxIssueAssetInput) GetNumberOfDecimalPlaces() int32 {
+	if x != nil {
+		return x.NumberOfDecimalPlaces
+	}
+	return 0
+}
+
+// QxTransferShareInput QX transfer share ownership and possession procedure
+type QxTransferShareInput struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Issuer               string                 `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	NewOwnerAndPossessor string                 `protobuf:"bytes,2,opt,name=new_owner_and_possessor,json=newOwnerAndPossessor,proto3" json:"new_owner_and_possessor,omitempty"`
+	AssetName            string                 `protobuf:"bytes,3,opt,name=asset_name,json=assetName,proto3" json:"asset_name,omitempty"`
+	NumberOfShares       int64                  `protobuf:"varint,4,opt,name=number_of_shares,json=numberOfShares,proto3" json:"number_of_shares,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *QxTransferShareInput) Reset() {
+	*x = QxTransferShareInput{}
 	mi := &file_messages_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTransactionByHashResponse) String() string {
+func (x *QxTransferShareInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTransactionByHashResponse) ProtoMessage() {}
+func (*QxTransferShareInput) ProtoMessage() {}
 
-func (x *GetTransactionByHashResponse) ProtoReflect() protoreflect.Message {
+func (x *QxTransferShareInput) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -536,43 +627,64 @@ func (x *GetTransactionByHashResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTransactionByHashResponse.ProtoReflect.Descriptor instead.
-func (*GetTransactionByHashResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use QxTransferShareInput.ProtoReflect.Descriptor instead.
+func (*QxTransferShareInput) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetTransactionByHashResponse) GetTransaction() *Transaction {
+func (x *QxTransferShareInput) GetIssuer() string {
 	if x != nil {
-		return x.Transaction
+		return x.Issuer
 	}
-	return nil
+	return ""
 }
 
-// GetTransactionsForTickRequest
-type GetTransactionsForTickRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// the tick number to get the transactions for.
-	TickNumber    uint32            `protobuf:"varint,1,opt,name=tick_number,json=tickNumber,proto3" json:"tick_number,omitempty"`
-	Filters       map[string]string `protobuf:"bytes,2,rep,name=filters,proto3" json:"filters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Ranges        map[string]*Range `protobuf:"bytes,3,rep,name=ranges,proto3" json:"ranges,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *QxTransferShareInput) GetNewOwnerAndPossessor() string {
+	if x != nil {
+		return x.NewOwnerAndPossessor
+	}
+	return ""
 }
 
-func (x *GetTransactionsForTickRequest) Reset() {
-	*x = GetTransactionsForTickRequest{}
+func (x *QxTransferShareInput) GetAssetName() string {
+	if x != nil {
+		return x.AssetName
+	}
+	return ""
+}
+
+func (x *QxTransferShareInput) GetNumberOfShares() int64 {
+	if x != nil {
+		return x.NumberOfShares
+	}
+	return 0
+}
+
+// QxOrderInput QX add to or remove from ask or bid order procedures
+type QxOrderInput struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Issuer         string                 `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	AssetName      string                 `protobuf:"bytes,2,opt,name=asset_name,json=assetName,proto3" json:"asset_name,omitempty"`
+	Price          int64                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	NumberOfShares int64                  `protobuf:"varint,4,opt,name=number_of_shares,json=numberOfShares,proto3" json:"number_of_shares,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *QxOrderInput) Reset() {
+	*x = QxOrderInput{}
 	mi := &file_messages_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTransactionsForTickRequest) String() string {
+func (x *QxOrderInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTransactionsForTickRequest) ProtoMessage() {}
+func (*QxOrderInput) ProtoMessage() {}
 
-func (x *GetTransactionsForTickRequest) ProtoReflect() protoreflect.Message {
+func (x *QxOrderInput) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -584,55 +696,2512 @@ func (x *GetTransactionsForTickRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTransactionsForTickRequest.ProtoReflect.Descriptor instead.
-func (*GetTransactionsForTickRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use QxOrderInput.ProtoReflect.Descriptor instead.
+func (*QxOrderInput) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetTransactionsForTickRequest) GetTickNumber() uint32 {
+func (x *QxOrderInput) GetIssuer() string {
 	if x != nil {
-		return x.TickNumber
+		return x.Issuer
 	}
-	return 0
+	return ""
 }
 
-func (x *GetTransactionsForTickRequest) GetFilters() map[string]string {
+func (x *QxOrderInput) GetAssetName() string {
+	if x != nil {
+		return x.AssetName
+	}
+	return ""
+}
+
+func (x *QxOrderInput) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *QxOrderInput) GetNumberOfShares() int64 {
+	if x != nil {
+		return x.NumberOfShares
+	}
+	return 0
+}
+
+// TickData
+type TickData struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TickNumber        uint32                 `protobuf:"varint,1,opt,name=tick_number,json=tickNumber,proto3" json:"tick_number,omitempty"`
+	Epoch             uint32                 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	ComputorIndex     uint32                 `protobuf:"varint,3,opt,name=computor_index,json=computorIndex,proto3" json:"computor_index,omitempty"`
+	Timestamp         uint64                 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	VarStruct         string                 `protobuf:"bytes,5,opt,name=var_struct,json=varStruct,proto3" json:"var_struct,omitempty"`
+	TimeLock          string                 `protobuf:"bytes,6,opt,name=time_lock,json=timeLock,proto3" json:"time_lock,omitempty"`
+	TransactionHashes []string               `protobuf:"bytes,7,rep,name=transaction_hashes,json=transactionHashes,proto3" json:"transaction_hashes,omitempty"`
+	ContractFees      []int64                `protobuf:"varint,8,rep,packed,name=contract_fees,json=contractFees,proto3" json:"contract_fees,omitempty"`
+	Signature         string                 `protobuf:"bytes,9,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *TickData) Reset() {
+	*x = TickData{}
+	mi := &file_messages_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TickData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TickData) ProtoMessage() {}
+
+func (x *TickData) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TickData.ProtoReflect.Descriptor instead.
+func (*TickData) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TickData) GetTickNumber() uint32 {
+	if x != nil {
+		return x.TickNumber
+	}
+	return 0
+}
+
+func (x *TickData) GetEpoch() uint32 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *TickData) GetComputorIndex() uint32 {
+	if x != nil {
+		return x.ComputorIndex
+	}
+	return 0
+}
+
+func (x *TickData) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *TickData) GetVarStruct() string {
+	if x != nil {
+		return x.VarStruct
+	}
+	return ""
+}
+
+func (x *TickData) GetTimeLock() string {
+	if x != nil {
+		return x.TimeLock
+	}
+	return ""
+}
+
+func (x *TickData) GetTransactionHashes() []string {
+	if x != nil {
+		return x.TransactionHashes
+	}
+	return nil
+}
+
+func (x *TickData) GetContractFees() []int64 {
+	if x != nil {
+		return x.ContractFees
+	}
+	return nil
+}
+
+func (x *TickData) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+// EpochTick
+type EpochTick struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TickNumber    uint32                 `protobuf:"varint,1,opt,name=tick_number,json=tickNumber,proto3" json:"tick_number,omitempty"`
+	IsEmpty       bool                   `protobuf:"varint,2,opt,name=is_empty,json=isEmpty,proto3" json:"is_empty,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EpochTick) Reset() {
+	*x = EpochTick{}
+	mi := &file_messages_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EpochTick) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpochTick) ProtoMessage() {}
+
+func (x *EpochTick) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EpochTick.ProtoReflect.Descriptor instead.
+func (*EpochTick) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *EpochTick) GetTickNumber() uint32 {
+	if x != nil {
+		return x.TickNumber
+	}
+	return 0
+}
+
+func (x *EpochTick) GetIsEmpty() bool {
+	if x != nil {
+		return x.IsEmpty
+	}
+	return false
+}
+
+// GetTicksForEpochRequest
+type GetTicksForEpochRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Epoch         uint32                 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Pagination    *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Desc          bool                   `protobuf:"varint,3,opt,name=desc,proto3" json:"desc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTicksForEpochRequest) Reset() {
+	*x = GetTicksForEpochRequest{}
+	mi := &file_messages_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTicksForEpochRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTicksForEpochRequest) ProtoMessage() {}
+
+func (x *GetTicksForEpochRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTicksForEpochRequest.ProtoReflect.Descriptor instead.
+func (*GetTicksForEpochRequest) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetTicksForEpochRequest) GetEpoch() uint32 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *GetTicksForEpochRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *GetTicksForEpochRequest) GetDesc() bool {
+	if x != nil {
+		return x.Desc
+	}
+	return false
+}
+
+// GetTicksForEpochResponse
+type GetTicksForEpochResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hits          *Hits                  `protobuf:"bytes,1,opt,name=hits,proto3" json:"hits,omitempty"`
+	Ticks         []*EpochTick           `protobuf:"bytes,2,rep,name=ticks,proto3" json:"ticks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTicksForEpochResponse) Reset() {
+	*x = GetTicksForEpochResponse{}
+	mi := &file_messages_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTicksForEpochResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTicksForEpochResponse) ProtoMessage() {}
+
+func (x *GetTicksForEpochResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTicksForEpochResponse.ProtoReflect.Descriptor instead.
+func (*GetTicksForEpochResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetTicksForEpochResponse) GetHits() *Hits {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+func (x *GetTicksForEpochResponse) GetTicks() []*EpochTick {
+	if x != nil {
+		return x.Ticks
+	}
+	return nil
+}
+
+// GetEmptyTicksForEpochRequest
+type GetEmptyTicksForEpochRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Epoch         uint32                 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Pagination    *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEmptyTicksForEpochRequest) Reset() {
+	*x = GetEmptyTicksForEpochRequest{}
+	mi := &file_messages_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEmptyTicksForEpochRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEmptyTicksForEpochRequest) ProtoMessage() {}
+
+func (x *GetEmptyTicksForEpochRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEmptyTicksForEpochRequest.ProtoReflect.Descriptor instead.
+func (*GetEmptyTicksForEpochRequest) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetEmptyTicksForEpochRequest) GetEpoch() uint32 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *GetEmptyTicksForEpochRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// GetEmptyTicksForEpochResponse
+type GetEmptyTicksForEpochResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hits          *Hits                  `protobuf:"bytes,1,opt,name=hits,proto3" json:"hits,omitempty"`
+	EmptyTicks    []uint32               `protobuf:"varint,2,rep,packed,name=empty_ticks,json=emptyTicks,proto3" json:"empty_ticks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEmptyTicksForEpochResponse) Reset() {
+	*x = GetEmptyTicksForEpochResponse{}
+	mi := &file_messages_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEmptyTicksForEpochResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEmptyTicksForEpochResponse) ProtoMessage() {}
+
+func (x *GetEmptyTicksForEpochResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEmptyTicksForEpochResponse.ProtoReflect.Descriptor instead.
+func (*GetEmptyTicksForEpochResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetEmptyTicksForEpochResponse) GetHits() *Hits {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+func (x *GetEmptyTicksForEpochResponse) GetEmptyTicks() []uint32 {
+	if x != nil {
+		return x.EmptyTicks
+	}
+	return nil
+}
+
+// ProcessedTickInterval
+type ProcessedTickInterval struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Epoch         uint32                 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	FirstTick     uint32                 `protobuf:"varint,2,opt,name=first_tick,json=firstTick,proto3" json:"first_tick,omitempty"`
+	LastTick      uint32                 `protobuf:"varint,3,opt,name=last_tick,json=lastTick,proto3" json:"last_tick,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessedTickInterval) Reset() {
+	*x = ProcessedTickInterval{}
+	mi := &file_messages_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessedTickInterval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessedTickInterval) ProtoMessage() {}
+
+func (x *ProcessedTickInterval) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessedTickInterval.ProtoReflect.Descriptor instead.
+func (*ProcessedTickInterval) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ProcessedTickInterval) GetEpoch() uint32 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ProcessedTickInterval) GetFirstTick() uint32 {
+	if x != nil {
+		return x.FirstTick
+	}
+	return 0
+}
+
+func (x *ProcessedTickInterval) GetLastTick() uint32 {
+	if x != nil {
+		return x.LastTick
+	}
+	return 0
+}
+
+// Pagination
+type Pagination struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Offset        uint32                 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Size          uint32                 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Pagination) Reset() {
+	*x = Pagination{}
+	mi := &file_messages_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pagination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pagination) ProtoMessage() {}
+
+func (x *Pagination) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pagination.ProtoReflect.Descriptor instead.
+func (*Pagination) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Pagination) GetOffset() uint32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *Pagination) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// GetTransactionByHashRequest
+type GetTransactionByHashRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hash          string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	DecodeInput   bool                   `protobuf:"varint,2,opt,name=decode_input,json=decodeInput,proto3" json:"decode_input,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionByHashRequest) Reset() {
+	*x = GetTransactionByHashRequest{}
+	mi := &file_messages_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionByHashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionByHashRequest) ProtoMessage() {}
+
+func (x *GetTransactionByHashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionByHashRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionByHashRequest) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetTransactionByHashRequest) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *GetTransactionByHashRequest) GetDecodeInput() bool {
+	if x != nil {
+		return x.DecodeInput
+	}
+	return false
+}
+
+// GetTransactionByHashResponse
+type GetTransactionByHashResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaction   *Transaction           `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionByHashResponse) Reset() {
+	*x = GetTransactionByHashResponse{}
+	mi := &file_messages_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionByHashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionByHashResponse) ProtoMessage() {}
+
+func (x *GetTransactionByHashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionByHashResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionByHashResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetTransactionByHashResponse) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+// GetTransactionDetailsRequest
+type GetTransactionDetailsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hash          string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	DecodeInput   bool                   `protobuf:"varint,2,opt,name=decode_input,json=decodeInput,proto3" json:"decode_input,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionDetailsRequest) Reset() {
+	*x = GetTransactionDetailsRequest{}
+	mi := &file_messages_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionDetailsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionDetailsRequest) ProtoMessage() {}
+
+func (x *GetTransactionDetailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionDetailsRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionDetailsRequest) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetTransactionDetailsRequest) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *GetTransactionDetailsRequest) GetDecodeInput() bool {
+	if x != nil {
+		return x.DecodeInput
+	}
+	return false
+}
+
+// GetTransactionDetailsResponse
+type GetTransactionDetailsResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Transaction        *Transaction           `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	Epoch              uint32                 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	TickTimestamp      uint64                 `protobuf:"varint,3,opt,name=tick_timestamp,json=tickTimestamp,proto3" json:"tick_timestamp,omitempty"`
+	ComputorIndex      uint32                 `protobuf:"varint,4,opt,name=computor_index,json=computorIndex,proto3" json:"computor_index,omitempty"`
+	IncludedInTick     bool                   `protobuf:"varint,5,opt,name=included_in_tick,json=includedInTick,proto3" json:"included_in_tick,omitempty"`
+	EventLogsAvailable bool                   `protobuf:"varint,6,opt,name=event_logs_available,json=eventLogsAvailable,proto3" json:"event_logs_available,omitempty"`
+	EventLogs          []*Event               `protobuf:"bytes,7,rep,name=event_logs,json=eventLogs,proto3" json:"event_logs,omitempty"`
+	HasMoreEventLogs   bool                   `protobuf:"varint,8,opt,name=has_more_event_logs,json=hasMoreEventLogs,proto3" json:"has_more_event_logs,omitempty"`
+	ValidForTick       uint32                 `protobuf:"varint,9,opt,name=valid_for_tick,json=validForTick,proto3" json:"valid_for_tick,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetTransactionDetailsResponse) Reset() {
+	*x = GetTransactionDetailsResponse{}
+	mi := &file_messages_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionDetailsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionDetailsResponse) ProtoMessage() {}
+
+func (x *GetTransactionDetailsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionDetailsResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionDetailsResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetTransactionDetailsResponse) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *GetTransactionDetailsResponse) GetEpoch() uint32 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *GetTransactionDetailsResponse) GetTickTimestamp() uint64 {
+	if x != nil {
+		return x.TickTimestamp
+	}
+	return 0
+}
+
+func (x *GetTransactionDetailsResponse) GetComputorIndex() uint32 {
+	if x != nil {
+		return x.ComputorIndex
+	}
+	return 0
+}
+
+func (x *GetTransactionDetailsResponse) GetIncludedInTick() bool {
+	if x != nil {
+		return x.IncludedInTick
+	}
+	return false
+}
+
+func (x *GetTransactionDetailsResponse) GetEventLogsAvailable() bool {
+	if x != nil {
+		return x.EventLogsAvailable
+	}
+	return false
+}
+
+func (x *GetTransactionDetailsResponse) GetEventLogs() []*Event {
+	if x != nil {
+		return x.EventLogs
+	}
+	return nil
+}
+
+func (x *GetTransactionDetailsResponse) GetHasMoreEventLogs() bool {
+	if x != nil {
+		return x.HasMoreEventLogs
+	}
+	return false
+}
+
+func (x *GetTransactionDetailsResponse) GetValidForTick() uint32 {
+	if x != nil {
+		return x.ValidForTick
+	}
+	return 0
+}
+
+// VerifyTransactionRequest
+type VerifyTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hash          string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Transaction   *Transaction           `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyTransactionRequest) Reset() {
+	*x = VerifyTransactionRequest{}
+	mi := &file_messages_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyTransactionRequest) ProtoMessage() {}
+
+func (x *VerifyTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyTransactionRequest.ProtoReflect.Descriptor instead.
+func (*VerifyTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *VerifyTransactionRequest) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *VerifyTransactionRequest) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+// VerifyTransactionResponse
+type VerifyTransactionResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Hash           string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	CalculatedHash string                 `protobuf:"bytes,2,opt,name=calculated_hash,json=calculatedHash,proto3" json:"calculated_hash,omitempty"`
+	HashValid      bool                   `protobuf:"varint,3,opt,name=hash_valid,json=hashValid,proto3" json:"hash_valid,omitempty"`
+	SignatureValid bool                   `protobuf:"varint,4,opt,name=signature_valid,json=signatureValid,proto3" json:"signature_valid,omitempty"`
+	Valid          bool                   `protobuf:"varint,5,opt,name=valid,proto3" json:"valid,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *VerifyTransactionResponse) Reset() {
+	*x = VerifyTransactionResponse{}
+	mi := &file_messages_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyTransactionResponse) ProtoMessage() {}
+
+func (x *VerifyTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyTransactionResponse.ProtoReflect.Descriptor instead.
+func (*VerifyTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *VerifyTransactionResponse) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *VerifyTransactionResponse) GetCalculatedHash() string {
+	if x != nil {
+		return x.CalculatedHash
+	}
+	return ""
+}
+
+func (x *VerifyTransactionResponse) GetHashValid() bool {
+	if x != nil {
+		return x.HashValid
+	}
+	return false
+}
+
+func (x *VerifyTransactionResponse) GetSignatureValid() bool {
+	if x != nil {
+		return x.SignatureValid
+	}
+	return false
+}
+
+func (x *VerifyTransactionResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+// GetTransactionsForTickRequest
+type GetTransactionsForTickRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the tick number to get the transactions for.
+	TickNumber    uint32            `protobuf:"varint,1,opt,name=tick_number,json=tickNumber,proto3" json:"tick_number,omitempty"`
+	Filters       map[string]string `protobuf:"bytes,2,rep,name=filters,proto3" json:"filters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Ranges        map[string]*Range `protobuf:"bytes,3,rep,name=ranges,proto3" json:"ranges,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	DecodeInput   bool              `protobuf:"varint,4,opt,name=decode_input,json=decodeInput,proto3" json:"decode_input,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionsForTickRequest) Reset() {
+	*x = GetTransactionsForTickRequest{}
+	mi := &file_messages_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionsForTickRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionsForTickRequest) ProtoMessage() {}
+
+func (x *GetTransactionsForTickRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionsForTickRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionsForTickRequest) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetTransactionsForTickRequest) GetTickNumber() uint32 {
+	if x != nil {
+		return x.TickNumber
+	}
+	return 0
+}
+
+func (x *GetTransactionsForTickRequest) GetFilters() map[string]string {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *GetTransactionsForTickRequest) GetRanges() map[string]*Range {
+	if x != nil {
+		return x.Ranges
+	}
+	return nil
+}
+
+func (x *GetTransactionsForTickRequest) GetDecodeInput() bool {
+	if x != nil {
+		return x.DecodeInput
+	}
+	return false
+}
+
+// GetTransactionsForTickResponse
+type GetTransactionsForTickResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transactions  []*Transaction         `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionsForTickResponse) Reset() {
+	*x = GetTransactionsForTickResponse{}
+	mi := &file_messages_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionsForTickResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionsForTickResponse) ProtoMessage() {}
+
+func (x *GetTransactionsForTickResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionsForTickResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionsForTickResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetTransactionsForTickResponse) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+// Range filter
+//
+// | Name      | Type   | Necessity | Description                               |
+// |-----------|--------|-----------|-------------------------------------------|
+// | gt        | string | optional  | Greater than.                             |
+// | gte       | string | optional  | Greater than or equal to.                 |
+// | lt        | string | optional  | Less than.                                |
+// | lte       | string | optional  | Less than or equal to.                    |
+//
+// One lower bound and one upper bound can be specified. One bound is needed. A range with size of 0 or 1 is not allowed.
+//
+// # Examples
+//
+// ```
+// "amount": { "gt": "1000000" }
+// "tickNumber": { "gte": "25563000", "lte": "28300000" }
+// ```
+type Range struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to LowerBound:
+	//
+	//	*Range_Gt
+	//	*Range_Gte
+	LowerBound isRange_LowerBound `protobuf_oneof:"lower_bound"`
+	// Types that are valid to be assigned to UpperBound:
+	//
+	//	*Range_Lt
+	//	*Range_Lte
+	UpperBound    isRange_UpperBound `protobuf_oneof:"upper_bound"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Range) Reset() {
+	*x = Range{}
+	mi := &file_messages_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Range) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Range) ProtoMessage() {}
+
+func (x *Range) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Range.ProtoReflect.Descriptor instead.
+func (*Range) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Range) GetLowerBound() isRange_LowerBound {
+	if x != nil {
+		return x.LowerBound
+	}
+	return nil
+}
+
+func (x *Range) GetGt() string {
+	if x != nil {
+		if x, ok := x.LowerBound.(*Range_Gt); ok {
+			return x.Gt
+		}
+	}
+	return ""
+}
+
+func (x *Range) GetGte() string {
+	if x != nil {
+		if x, ok := x.LowerBound.(*Range_Gte); ok {
+			return x.Gte
+		}
+	}
+	return ""
+}
+
+func (x *Range) GetUpperBound() isRange_UpperBound {
+	if x != nil {
+		return x.UpperBound
+	}
+	return nil
+}
+
+func (x *Range) GetLt() string {
+	if x != nil {
+		if x, ok := x.UpperBound.(*Range_Lt); ok {
+			return x.Lt
+		}
+	}
+	return ""
+}
+
+func (x *Range) GetLte() string {
+	if x != nil {
+		if x, ok := x.UpperBound.(*Range_Lte); ok {
+			return x.Lte
+		}
+	}
+	return ""
+}
+
+type isRange_LowerBound interface {
+	isRange_LowerBound()
+}
+
+type Range_Gt struct {
+	Gt string `protobuf:"bytes,1,opt,name=gt,proto3,oneof"`
+}
+
+type Range_Gte struct {
+	Gte string `protobuf:"bytes,2,opt,name=gte,proto3,oneof"`
+}
+
+func (*Range_Gt) isRange_LowerBound() {}
+
+func (*Range_Gte) isRange_LowerBound() {}
+
+type isRange_UpperBound interface {
+	isRange_UpperBound()
+}
+
+type Range_Lt struct {
+	Lt string `protobuf:"bytes,3,opt,name=lt,proto3,oneof"`
+}
+
+type Range_Lte struct {
+	Lte string `protobuf:"bytes,4,opt,name=lte,proto3,oneof"`
+}
+
+func (*Range_Lt) isRange_UpperBound() {}
+
+func (*Range_Lte) isRange_UpperBound() {}
+
+// Should Filters
+//
+// One should filter can contain multiple terms and ranges. It needs at least two query clauses.
+// At least one of them has to match by default. See term and range filter documentation for examples.
+//
+// # Example
+//
+// ```
+// "should": [
+//
+//	{
+//	  "terms": {
+//	    "source": "BAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAARMID",
+//	    "destination": "BAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAARMID"
+//	  }
+//	}
+//
+// ]
+// ```
+type ShouldFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Terms         map[string]string      `protobuf:"bytes,1,rep,name=terms,proto3" json:"terms,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Ranges        map[string]*Range      `protobuf:"bytes,2,rep,name=ranges,proto3" json:"ranges,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShouldFilter) Reset() {
+	*x = ShouldFilter{}
+	mi := &file_messages_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShouldFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShouldFilter) ProtoMessage() {}
+
+func (x *ShouldFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShouldFilter.ProtoReflect.Descriptor instead.
+func (*ShouldFilter) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ShouldFilter) GetTerms() map[string]string {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+func (x *ShouldFilter) GetRanges() map[string]*Range {
+	if x != nil {
+		return x.Ranges
+	}
+	return nil
+}
+
+// GetTransactionsForIdentityRequest
+type GetTransactionsForIdentityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identity      string                 `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	Filters       map[string]string      `protobuf:"bytes,2,rep,name=filters,proto3" json:"filters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Exclude       map[string]string      `protobuf:"bytes,3,rep,name=exclude,proto3" json:"exclude,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Ranges        map[string]*Range      `protobuf:"bytes,6,rep,name=ranges,proto3" json:"ranges,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Pagination    *Pagination            `protobuf:"bytes,9,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	DecodeInput   bool                   `protobuf:"varint,10,opt,name=decode_input,json=decodeInput,proto3" json:"decode_input,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionsForIdentityRequest) Reset() {
+	*x = GetTransactionsForIdentityRequest{}
+	mi := &file_messages_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionsForIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionsForIdentityRequest) ProtoMessage() {}
+
+func (x *GetTransactionsForIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionsForIdentityRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionsForIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetTransactionsForIdentityRequest) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *GetTransactionsForIdentityRequest) GetFilters() map[string]string {
 	if x != nil {
 		return x.Filters
 	}
 	return nil
 }
 
-func (x *GetTransactionsForTickRequest) GetRanges() map[string]*Range {
+func (x *GetTransactionsForIdentityRequest) GetExclude() map[string]string {
+	if x != nil {
+		return x.Exclude
+	}
+	return nil
+}
+
+func (x *GetTransactionsForIdentityRequest) GetRanges() map[string]*Range {
+	if x != nil {
+		return x.Ranges
+	}
+	return nil
+}
+
+func (x *GetTransactionsForIdentityRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *GetTransactionsForIdentityRequest) GetDecodeInput() bool {
+	if x != nil {
+		return x.DecodeInput
+	}
+	return false
+}
+
+// Hits
+type Hits struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         uint32                 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	From          uint32                 `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	Size          uint32                 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Hits) Reset() {
+	*x = Hits{}
+	mi := &file_messages_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Hits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Hits) ProtoMessage() {}
+
+func (x *Hits) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Hits.ProtoReflect.Descriptor instead.
+func (*Hits) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Hits) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *Hits) GetFrom() uint32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *Hits) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// GetTransactionsForIdentityResponse
+type GetTransactionsForIdentityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ValidForTick  uint32                 `protobuf:"varint,1,opt,name=valid_for_tick,json=validForTick,proto3" json:"valid_for_tick,omitempty"`
+	Hits          *Hits                  `protobuf:"bytes,2,opt,name=hits,proto3" json:"hits,omitempty"`
+	Transactions  []*Transaction         `protobuf:"bytes,3,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionsForIdentityResponse) Reset() {
+	*x = GetTransactionsForIdentityResponse{}
+	mi := &file_messages_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionsForIdentityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionsForIdentityResponse) ProtoMessage() {}
+
+func (x *GetTransactionsForIdentityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionsForIdentityResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionsForIdentityResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetTransactionsForIdentityResponse) GetValidForTick() uint32 {
+	if x != nil {
+		return x.ValidForTick
+	}
+	return 0
+}
+
+func (x *GetTransactionsForIdentityResponse) GetHits() *Hits {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+func (x *GetTransactionsForIdentityResponse) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+// GetTickDataRequest
+type GetTickDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TickNumber    uint32                 `protobuf:"varint,1,opt,name=tick_number,json=tickNumber,proto3" json:"tick_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTickDataRequest) Reset() {
+	*x = GetTickDataRequest{}
+	mi := &file_messages_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTickDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTickDataRequest) ProtoMessage() {}
+
+func (x *GetTickDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTickDataRequest.ProtoReflect.Descriptor instead.
+func (*GetTickDataRequest) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetTickDataRequest) GetTickNumber() uint32 {
+	if x != nil {
+		return x.TickNumber
+	}
+	return 0
+}
+
+// GetTickDataResponse
+type GetTickDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TickData      *TickData              `protobuf:"bytes,1,opt,name=tick_data,json=tickData,proto3" json:"tick_data,omitempty"`
+	IsEmpty       bool                   `protobuf:"varint,2,opt,name=is_empty,json=isEmpty,proto3" json:"is_empty,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTickDataResponse) Reset() {
+	*x = GetTickDataResponse{}
+	mi := &file_messages_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTickDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTickDataResponse) ProtoMessage() {}
+
+func (x *GetTickDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTickDataResponse.ProtoReflect.Descriptor instead.
+func (*GetTickDataResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetTickDataResponse) GetTickData() *TickData {
+	if x != nil {
+		return x.TickData
+	}
+	return nil
+}
+
+func (x *GetTickDataResponse) GetIsEmpty() bool {
+	if x != nil {
+		return x.IsEmpty
+	}
+	return false
+}
+
+// GetTickDataRangeRequest
+type GetTickDataRangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartTick     uint32                 `protobuf:"varint,1,opt,name=start_tick,json=startTick,proto3" json:"start_tick,omitempty"`
+	EndTick       uint32                 `protobuf:"varint,2,opt,name=end_tick,json=endTick,proto3" json:"end_tick,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTickDataRangeRequest) Reset() {
+	*x = GetTickDataRangeRequest{}
+	mi := &file_messages_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTickDataRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTickDataRangeRequest) ProtoMessage() {}
+
+func (x *GetTickDataRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTickDataRangeRequest.ProtoReflect.Descriptor instead.
+func (*GetTickDataRangeRequest) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetTickDataRangeRequest) GetStartTick() uint32 {
+	if x != nil {
+		return x.StartTick
+	}
+	return 0
+}
+
+func (x *GetTickDataRangeRequest) GetEndTick() uint32 {
+	if x != nil {
+		return x.EndTick
+	}
+	return 0
+}
+
+// RangeTickData
+type RangeTickData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TickNumber    uint32                 `protobuf:"varint,1,opt,name=tick_number,json=tickNumber,proto3" json:"tick_number,omitempty"`
+	IsEmpty       bool                   `protobuf:"varint,2,opt,name=is_empty,json=isEmpty,proto3" json:"is_empty,omitempty"`
+	IsSkipped     bool                   `protobuf:"varint,3,opt,name=is_skipped,json=isSkipped,proto3" json:"is_skipped,omitempty"`
+	TickData      *TickData              `protobuf:"bytes,4,opt,name=tick_data,json=tickData,proto3" json:"tick_data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RangeTickData) Reset() {
+	*x = RangeTickData{}
+	mi := &file_messages_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RangeTickData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RangeTickData) ProtoMessage() {}
+
+func (x *RangeTickData) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RangeTickData.ProtoReflect.Descriptor instead.
+func (*RangeTickData) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RangeTickData) GetTickNumber() uint32 {
+	if x != nil {
+		return x.TickNumber
+	}
+	return 0
+}
+
+func (x *RangeTickData) GetIsEmpty() bool {
+	if x != nil {
+		return x.IsEmpty
+	}
+	return false
+}
+
+func (x *RangeTickData) GetIsSkipped() bool {
+	if x != nil {
+		return x.IsSkipped
+	}
+	return false
+}
+
+func (x *RangeTickData) GetTickData() *TickData {
+	if x != nil {
+		return x.TickData
+	}
+	return nil
+}
+
+// GetTickDataRangeResponse
+type GetTickDataRangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ticks         []*RangeTickData       `protobuf:"bytes,1,rep,name=ticks,proto3" json:"ticks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTickDataRangeResponse) Reset() {
+	*x = GetTickDataRangeResponse{}
+	mi := &file_messages_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTickDataRangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTickDataRangeResponse) ProtoMessage() {}
+
+func (x *GetTickDataRangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTickDataRangeResponse.ProtoReflect.Descriptor instead.
+func (*GetTickDataRangeResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetTickDataRangeResponse) GetTicks() []*RangeTickData {
+	if x != nil {
+		return x.Ticks
+	}
+	return nil
+}
+
+// VerifyTickRequest
+type VerifyTickRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TickNumber    uint32                 `protobuf:"varint,1,opt,name=tick_number,json=tickNumber,proto3" json:"tick_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyTickRequest) Reset() {
+	*x = VerifyTickRequest{}
+	mi := &file_messages_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyTickRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyTickRequest) ProtoMessage() {}
+
+func (x *VerifyTickRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyTickRequest.ProtoReflect.Descriptor instead.
+func (*VerifyTickRequest) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *VerifyTickRequest) GetTickNumber() uint32 {
+	if x != nil {
+		return x.TickNumber
+	}
+	return 0
+}
+
+// VerifyTickResponse
+type VerifyTickResponse struct {
+	state                      protoimpl.MessageState `protogen:"open.v1"`
+	TickNumber                 uint32                 `protobuf:"varint,1,opt,name=tick_number,json=tickNumber,proto3" json:"tick_number,omitempty"`
+	IsEmpty                    bool                   `protobuf:"varint,2,opt,name=is_empty,json=isEmpty,proto3" json:"is_empty,omitempty"`
+	ComputorListSignatureValid bool                   `protobuf:"varint,3,opt,name=computor_list_signature_valid,json=computorListSignatureValid,proto3" json:"computor_list_signature_valid,omitempty"`
+	TickSignatureValid         bool                   `protobuf:"varint,4,opt,name=tick_signature_valid,json=tickSignatureValid,proto3" json:"tick_signature_valid,omitempty"`
+	TransactionsComplete       bool                   `protobuf:"varint,5,opt,name=transactions_complete,json=transactionsComplete,proto3" json:"transactions_complete,omitempty"`
+	MissingTransactionHashes   []string               `protobuf:"bytes,6,rep,name=missing_transaction_hashes,json=missingTransactionHashes,proto3" json:"missing_transaction_hashes,omitempty"`
+	Valid                      bool                   `protobuf:"varint,7,opt,name=valid,proto3" json:"valid,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *VerifyTickResponse) Reset() {
+	*x = VerifyTickResponse{}
+	mi := &file_messages_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyTickResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyTickResponse) ProtoMessage() {}
+
+func (x *VerifyTickResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyTickResponse.ProtoReflect.Descriptor instead.
+func (*VerifyTickResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *VerifyTickResponse) GetTickNumber() uint32 {
+	if x != nil {
+		return x.TickNumber
+	}
+	return 0
+}
+
+func (x *VerifyTickResponse) GetIsEmpty() bool {
+	if x != nil {
+		return x.IsEmpty
+	}
+	return false
+}
+
+func (x *VerifyTickResponse) GetComputorListSignatureValid() bool {
+	if x != nil {
+		return x.ComputorListSignatureValid
+	}
+	return false
+}
+
+func (x *VerifyTickResponse) GetTickSignatureValid() bool {
+	if x != nil {
+		return x.TickSignatureValid
+	}
+	return false
+}
+
+func (x *VerifyTickResponse) GetTransactionsComplete() bool {
+	if x != nil {
+		return x.TransactionsComplete
+	}
+	return false
+}
+
+func (x *VerifyTickResponse) GetMissingTransactionHashes() []string {
+	if x != nil {
+		return x.MissingTransactionHashes
+	}
+	return nil
+}
+
+func (x *VerifyTickResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+// GetProcessedTickIntervalsResponse
+type GetProcessedTickIntervalsResponse struct {
+	state                  protoimpl.MessageState   `protogen:"open.v1"`
+	ProcessedTickIntervals []*ProcessedTickInterval `protobuf:"bytes,1,rep,name=processed_tick_intervals,json=processedTickIntervals,proto3" json:"processed_tick_intervals,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetProcessedTickIntervalsResponse) Reset() {
+	*x = GetProcessedTickIntervalsResponse{}
+	mi := &file_messages_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProcessedTickIntervalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProcessedTickIntervalsResponse) ProtoMessage() {}
+
+func (x *GetProcessedTickIntervalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProcessedTickIntervalsResponse.ProtoReflect.Descriptor instead.
+func (*GetProcessedTickIntervalsResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetProcessedTickIntervalsResponse) GetProcessedTickIntervals() []*ProcessedTickInterval {
+	if x != nil {
+		return x.ProcessedTickIntervals
+	}
+	return nil
+}
+
+// GetLastProcessedTickResponse
+type GetLastProcessedTickResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	TickNumber           uint32                 `protobuf:"varint,1,opt,name=tick_number,json=tickNumber,proto3" json:"tick_number,omitempty"`
+	Epoch                uint32                 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	IntervalInitialTick  uint32                 `protobuf:"varint,3,opt,name=interval_initial_tick,json=intervalInitialTick,proto3" json:"interval_initial_tick,omitempty"`
+	LastProcessedLogTick uint32                 `protobuf:"varint,4,opt,name=last_processed_log_tick,json=lastProcessedLogTick,proto3" json:"last_processed_log_tick,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetLastProcessedTickResponse) Reset() {
+	*x = GetLastProcessedTickResponse{}
+	mi := &file_messages_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLastProcessedTickResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLastProcessedTickResponse) ProtoMessage() {}
+
+func (x *GetLastProcessedTickResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLastProcessedTickResponse.ProtoReflect.Descriptor instead.
+func (*GetLastProcessedTickResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetLastProcessedTickResponse) GetTickNumber() uint32 {
+	if x != nil {
+		return x.TickNumber
+	}
+	return 0
+}
+
+func (x *GetLastProcessedTickResponse) GetEpoch() uint32 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *GetLastProcessedTickResponse) GetIntervalInitialTick() uint32 {
+	if x != nil {
+		return x.IntervalInitialTick
+	}
+	return 0
+}
+
+func (x *GetLastProcessedTickResponse) GetLastProcessedLogTick() uint32 {
+	if x != nil {
+		return x.LastProcessedLogTick
+	}
+	return 0
+}
+
+// GetComputorListsForEpochRequest
+type GetComputorListsForEpochRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Epoch         uint32                 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetComputorListsForEpochRequest) Reset() {
+	*x = GetComputorListsForEpochRequest{}
+	mi := &file_messages_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetComputorListsForEpochRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetComputorListsForEpochRequest) ProtoMessage() {}
+
+func (x *GetComputorListsForEpochRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetComputorListsForEpochRequest.ProtoReflect.Descriptor instead.
+func (*GetComputorListsForEpochRequest) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetComputorListsForEpochRequest) GetEpoch() uint32 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+// ComputorList
+type ComputorList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Epoch         uint32                 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	TickNumber    uint32                 `protobuf:"varint,2,opt,name=tick_number,json=tickNumber,proto3" json:"tick_number,omitempty"`
+	Identities    []string               `protobuf:"bytes,3,rep,name=identities,proto3" json:"identities,omitempty"`
+	Signature     string                 `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ComputorList) Reset() {
+	*x = ComputorList{}
+	mi := &file_messages_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComputorList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComputorList) ProtoMessage() {}
+
+func (x *ComputorList) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComputorList.ProtoReflect.Descriptor instead.
+func (*ComputorList) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ComputorList) GetEpoch() uint32 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ComputorList) GetTickNumber() uint32 {
+	if x != nil {
+		return x.TickNumber
+	}
+	return 0
+}
+
+func (x *ComputorList) GetIdentities() []string {
+	if x != nil {
+		return x.Identities
+	}
+	return nil
+}
+
+func (x *ComputorList) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+// GetComputorListsForEpochResponse
+type GetComputorListsForEpochResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ComputorsLists []*ComputorList        `protobuf:"bytes,1,rep,name=computors_lists,json=computorsLists,proto3" json:"computors_lists,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetComputorListsForEpochResponse) Reset() {
+	*x = GetComputorListsForEpochResponse{}
+	mi := &file_messages_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetComputorListsForEpochResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetComputorListsForEpochResponse) ProtoMessage() {}
+
+func (x *GetComputorListsForEpochResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetComputorListsForEpochResponse.ProtoReflect.Descriptor instead.
+func (*GetComputorListsForEpochResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetComputorListsForEpochResponse) GetComputorsLists() []*ComputorList {
+	if x != nil {
+		return x.ComputorsLists
+	}
+	return nil
+}
+
+// GetComputorMembershipRequest
+type GetComputorMembershipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identity      string                 `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetComputorMembershipRequest) Reset() {
+	*x = GetComputorMembershipRequest{}
+	mi := &file_messages_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetComputorMembershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetComputorMembershipRequest) ProtoMessage() {}
+
+func (x *GetComputorMembershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[42]
 	if x != nil {
-		return x.Ranges
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetComputorMembershipRequest.ProtoReflect.Descriptor instead.
+func (*GetComputorMembershipRequest) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetComputorMembershipRequest) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+// ComputorMembership
+type ComputorMembership struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Epoch         uint32                 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	TickNumber    uint32                 `protobuf:"varint,2,opt,name=tick_number,json=tickNumber,proto3" json:"tick_number,omitempty"`
+	ComputorIndex uint32                 `protobuf:"varint,3,opt,name=computor_index,json=computorIndex,proto3" json:"computor_index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ComputorMembership) Reset() {
+	*x = ComputorMembership{}
+	mi := &file_messages_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComputorMembership) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComputorMembership) ProtoMessage() {}
+
+func (x *ComputorMembership) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComputorMembership.ProtoReflect.Descriptor instead.
+func (*ComputorMembership) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ComputorMembership) GetEpoch() uint32 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ComputorMembership) GetTickNumber() uint32 {
+	if x != nil {
+		return x.TickNumber
+	}
+	return 0
+}
+
+func (x *ComputorMembership) GetComputorIndex() uint32 {
+	if x != nil {
+		return x.ComputorIndex
+	}
+	return 0
+}
+
+// GetComputorMembershipResponse
+type GetComputorMembershipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Memberships   []*ComputorMembership  `protobuf:"bytes,1,rep,name=memberships,proto3" json:"memberships,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetComputorMembershipResponse) Reset() {
+	*x = GetComputorMembershipResponse{}
+	mi := &file_messages_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetComputorMembershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetComputorMembershipResponse) ProtoMessage() {}
+
+func (x *GetComputorMembershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetComputorMembershipResponse.ProtoReflect.Descriptor instead.
+func (*GetComputorMembershipResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetComputorMembershipResponse) GetMemberships() []*ComputorMembership {
+	if x != nil {
+		return x.Memberships
 	}
 	return nil
 }
 
-// GetTransactionsForTickResponse
-type GetTransactionsForTickResponse struct {
+// GetComputorListsForEpochRangeRequest
+type GetComputorListsForEpochRangeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Transactions  []*Transaction         `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	StartEpoch    uint32                 `protobuf:"varint,1,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	EndEpoch      uint32                 `protobuf:"varint,2,opt,name=end_epoch,json=endEpoch,proto3" json:"end_epoch,omitempty"`
+	DiffsOnly     bool                   `protobuf:"varint,3,opt,name=diffs_only,json=diffsOnly,proto3" json:"diffs_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetComputorListsForEpochRangeRequest) Reset() {
+	*x = GetComputorListsForEpochRangeRequest{}
+	mi := &file_messages_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetComputorListsForEpochRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetComputorListsForEpochRangeRequest) ProtoMessage() {}
+
+func (x *GetComputorListsForEpochRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetComputorListsForEpochRangeRequest.ProtoReflect.Descriptor instead.
+func (*GetComputorListsForEpochRangeRequest) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetComputorListsForEpochRangeRequest) GetStartEpoch() uint32 {
+	if x != nil {
+		return x.StartEpoch
+	}
+	return 0
+}
+
+func (x *GetComputorListsForEpochRangeRequest) GetEndEpoch() uint32 {
+	if x != nil {
+		return x.EndEpoch
+	}
+	return 0
+}
+
+func (x *GetComputorListsForEpochRangeRequest) GetDiffsOnly() bool {
+	if x != nil {
+		return x.DiffsOnly
+	}
+	return false
+}
+
+// ComputorListDiff
+type ComputorListDiff struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Epoch              uint32                 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	TickNumber         uint32                 `protobuf:"varint,2,opt,name=tick_number,json=tickNumber,proto3" json:"tick_number,omitempty"`
+	PreviousEpoch      uint32                 `protobuf:"varint,3,opt,name=previous_epoch,json=previousEpoch,proto3" json:"previous_epoch,omitempty"`
+	PreviousTickNumber uint32                 `protobuf:"varint,4,opt,name=previous_tick_number,json=previousTickNumber,proto3" json:"previous_tick_number,omitempty"`
+	Joined             []string               `protobuf:"bytes,5,rep,name=joined,proto3" json:"joined,omitempty"`
+	Left               []string               `protobuf:"bytes,6,rep,name=left,proto3" json:"left,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ComputorListDiff) Reset() {
+	*x = ComputorListDiff{}
+	mi := &file_messages_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComputorListDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComputorListDiff) ProtoMessage() {}
+
+func (x *ComputorListDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComputorListDiff.ProtoReflect.Descriptor instead.
+func (*ComputorListDiff) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ComputorListDiff) GetEpoch() uint32 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ComputorListDiff) GetTickNumber() uint32 {
+	if x != nil {
+		return x.TickNumber
+	}
+	return 0
+}
+
+func (x *ComputorListDiff) GetPreviousEpoch() uint32 {
+	if x != nil {
+		return x.PreviousEpoch
+	}
+	return 0
+}
+
+func (x *ComputorListDiff) GetPreviousTickNumber() uint32 {
+	if x != nil {
+		return x.PreviousTickNumber
+	}
+	return 0
+}
+
+func (x *ComputorListDiff) GetJoined() []string {
+	if x != nil {
+		return x.Joined
+	}
+	return nil
+}
+
+func (x *ComputorListDiff) GetLeft() []string {
+	if x != nil {
+		return x.Left
+	}
+	return nil
+}
+
+// GetComputorListsForEpochRangeResponse
+type GetComputorListsForEpochRangeResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ComputorsLists []*ComputorList        `protobuf:"bytes,1,rep,name=computors_lists,json=computorsLists,proto3" json:"computors_lists,omitempty"`
+	Diffs          []*ComputorListDiff    `protobuf:"bytes,2,rep,name=diffs,proto3" json:"diffs,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetComputorListsForEpochRangeResponse) Reset() {
+	*x = GetComputorListsForEpochRangeResponse{}
+	mi := &file_messages_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetComputorListsForEpochRangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetComputorListsForEpochRangeResponse) ProtoMessage() {}
+
+func (x *GetComputorListsForEpochRangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetComputorListsForEpochRangeResponse.ProtoReflect.Descriptor instead.
+func (*GetComputorListsForEpochRangeResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetComputorListsForEpochRangeResponse) GetComputorsLists() []*ComputorList {
+	if x != nil {
+		return x.ComputorsLists
+	}
+	return nil
+}
+
+func (x *GetComputorListsForEpochRangeResponse) GetDiffs() []*ComputorListDiff {
+	if x != nil {
+		return x.Diffs
+	}
+	return nil
+}
+
+// GetComputorTickStatsRequest
+type GetComputorTickStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Epoch         uint32                 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTransactionsForTickResponse) Reset() {
-	*x = GetTransactionsForTickResponse{}
-	mi := &file_messages_proto_msgTypes[9]
+func (x *GetComputorTickStatsRequest) Reset() {
+	*x = GetComputorTickStatsRequest{}
+	mi := &file_messages_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTransactionsForTickResponse) String() string {
+func (x *GetComputorTickStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTransactionsForTickResponse) ProtoMessage() {}
+func (*GetComputorTickStatsRequest) ProtoMessage() {}
 
-func (x *GetTransactionsForTickResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[9]
+func (x *GetComputorTickStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -643,66 +3212,44 @@ func (x *GetTransactionsForTickResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTransactionsForTickResponse.ProtoReflect.Descriptor instead.
-func (*GetTransactionsForTickResponse) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use GetComputorTickStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetComputorTickStatsRequest) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *GetTransactionsForTickResponse) GetTransactions() []*Transaction {
+func (x *GetComputorTickStatsRequest) GetEpoch() uint32 {
 	if x != nil {
-		return x.Transactions
+		return x.Epoch
 	}
-	return nil
+	return 0
 }
 
-// Range filter
-//
-// | Name      | Type   | Necessity | Description                               |
-// |-----------|--------|-----------|-------------------------------------------|
-// | gt        | string | optional  | Greater than.                             |
-// | gte       | string | optional  | Greater than or equal to.                 |
-// | lt        | string | optional  | Less than.                                |
-// | lte       | string | optional  | Less than or equal to.                    |
-//
-// One lower bound and one upper bound can be specified. One bound is needed. A range with size of 0 or 1 is not allowed.
-//
-// # Examples
-//
-// ```
-// "amount": { "gt": "1000000" }
-// "tickNumber": { "gte": "25563000", "lte": "28300000" }
-// ```
-type Range struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to LowerBound:
-	//
-	//	*Range_Gt
-	//	*Range_Gte
-	LowerBound isRange_LowerBound `protobuf_oneof:"lower_bound"`
-	// Types that are valid to be assigned to UpperBound:
-	//
-	//	*Range_Lt
-	//	*Range_Lte
-	UpperBound    isRange_UpperBound `protobuf_oneof:"upper_bound"`
+// ComputorTickStats
+type ComputorTickStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ComputorIndex uint32                 `protobuf:"varint,1,opt,name=computor_index,json=computorIndex,proto3" json:"computor_index,omitempty"`
+	Identity      string                 `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
+	ProducedTicks uint32                 `protobuf:"varint,3,opt,name=produced_ticks,json=producedTicks,proto3" json:"produced_ticks,omitempty"`
+	EmptyTicks    uint32                 `protobuf:"varint,4,opt,name=empty_ticks,json=emptyTicks,proto3" json:"empty_ticks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Range) Reset() {
-	*x = Range{}
-	mi := &file_messages_proto_msgTypes[10]
+func (x *ComputorTickStats) Reset() {
+	*x = ComputorTickStats{}
+	mi := &file_messages_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Range) String() string {
+func (x *ComputorTickStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Range) ProtoMessage() {}
+func (*ComputorTickStats) ProtoMessage() {}
 
-func (x *Range) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[10]
+func (x *ComputorTickStats) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -713,135 +3260,161 @@ func (x *Range) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Range.ProtoReflect.Descriptor instead.
-func (*Range) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ComputorTickStats.ProtoReflect.Descriptor instead.
+func (*ComputorTickStats) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *Range) GetLowerBound() isRange_LowerBound {
+func (x *ComputorTickStats) GetComputorIndex() uint32 {
 	if x != nil {
-		return x.LowerBound
+		return x.ComputorIndex
 	}
-	return nil
+	return 0
 }
 
-func (x *Range) GetGt() string {
+func (x *ComputorTickStats) GetIdentity() string {
 	if x != nil {
-		if x, ok := x.LowerBound.(*Range_Gt); ok {
-			return x.Gt
-		}
+		return x.Identity
 	}
 	return ""
 }
 
-func (x *Range) GetGte() string {
+func (x *ComputorTickStats) GetProducedTicks() uint32 {
 	if x != nil {
-		if x, ok := x.LowerBound.(*Range_Gte); ok {
-			return x.Gte
-		}
+		return x.ProducedTicks
 	}
-	return ""
+	return 0
 }
 
-func (x *Range) GetUpperBound() isRange_UpperBound {
+func (x *ComputorTickStats) GetEmptyTicks() uint32 {
 	if x != nil {
-		return x.UpperBound
+		return x.EmptyTicks
 	}
-	return nil
+	return 0
 }
 
-func (x *Range) GetLt() string {
+// GetComputorTickStatsResponse
+type GetComputorTickStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Epoch         uint32                 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Computors     []*ComputorTickStats   `protobuf:"bytes,2,rep,name=computors,proto3" json:"computors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetComputorTickStatsResponse) Reset() {
+	*x = GetComputorTickStatsResponse{}
+	mi := &file_messages_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetComputorTickStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetComputorTickStatsResponse) ProtoMessage() {}
+
+func (x *GetComputorTickStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[50]
 	if x != nil {
-		if x, ok := x.UpperBound.(*Range_Lt); ok {
-			return x.Lt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Range) GetLte() string {
+// Deprecated: Use GetComputorTickStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetComputorTickStatsResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetComputorTickStatsResponse) GetEpoch() uint32 {
 	if x != nil {
-		if x, ok := x.UpperBound.(*Range_Lte); ok {
-			return x.Lte
-		}
+		return x.Epoch
 	}
-	return ""
+	return 0
 }
 
-type isRange_LowerBound interface {
-	isRange_LowerBound()
+func (x *GetComputorTickStatsResponse) GetComputors() []*ComputorTickStats {
+	if x != nil {
+		return x.Computors
+	}
+	return nil
 }
 
-type Range_Gt struct {
-	Gt string `protobuf:"bytes,1,opt,name=gt,proto3,oneof"`
+// GetEpochSummaryRequest
+type GetEpochSummaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Epoch         uint32                 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-type Range_Gte struct {
-	Gte string `protobuf:"bytes,2,opt,name=gte,proto3,oneof"`
+func (x *GetEpochSummaryRequest) Reset() {
+	*x = GetEpochSummaryRequest{}
+	mi := &file_messages_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (*Range_Gt) isRange_LowerBound() {}
+func (x *GetEpochSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func (*Range_Gte) isRange_LowerBound() {}
+func (*GetEpochSummaryRequest) ProtoMessage() {}
 
-type isRange_UpperBound interface {
-	isRange_UpperBound()
+func (x *GetEpochSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type Range_Lt struct {
-	Lt string `protobuf:"bytes,3,opt,name=lt,proto3,oneof"`
+// Deprecated: Use GetEpochSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetEpochSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{51}
 }
 
-type Range_Lte struct {
-	Lte string `protobuf:"bytes,4,opt,name=lte,proto3,oneof"`
+func (x *GetEpochSummaryRequest) GetEpoch() uint32 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
 }
 
-func (*Range_Lt) isRange_UpperBound() {}
-
-func (*Range_Lte) isRange_UpperBound() {}
-
-// Should Filters
-//
-// One should filter can contain multiple terms and ranges. It needs at least two query clauses.
-// At least one of them has to match by default. See term and range filter documentation for examples.
-//
-// # Example
-//
-// ```
-// "should": [
-//
-//	{
-//	  "terms": {
-//	    "source": "BAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAARMID",
-//	    "destination": "BAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAARMID"
-//	  }
-//	}
-//
-// ]
-// ```
-type ShouldFilter struct {
+// EventTypeCount
+type EventTypeCount struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Terms         map[string]string      `protobuf:"bytes,1,rep,name=terms,proto3" json:"terms,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Ranges        map[string]*Range      `protobuf:"bytes,2,rep,name=ranges,proto3" json:"ranges,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	LogType       uint32                 `protobuf:"varint,1,opt,name=log_type,json=logType,proto3" json:"log_type,omitempty"`
+	Count         uint64                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ShouldFilter) Reset() {
-	*x = ShouldFilter{}
-	mi := &file_messages_proto_msgTypes[11]
+func (x *EventTypeCount) Reset() {
+	*x = EventTypeCount{}
+	mi := &file_messages_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ShouldFilter) String() string {
+func (x *EventTypeCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShouldFilter) ProtoMessage() {}
+func (*EventTypeCount) ProtoMessage() {}
 
-func (x *ShouldFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[11]
+func (x *EventTypeCount) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -852,52 +3425,61 @@ func (x *ShouldFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShouldFilter.ProtoReflect.Descriptor instead.
-func (*ShouldFilter) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use EventTypeCount.ProtoReflect.Descriptor instead.
+func (*EventTypeCount) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *ShouldFilter) GetTerms() map[string]string {
+func (x *EventTypeCount) GetLogType() uint32 {
 	if x != nil {
-		return x.Terms
+		return x.LogType
 	}
-	return nil
+	return 0
 }
 
-func (x *ShouldFilter) GetRanges() map[string]*Range {
+func (x *EventTypeCount) GetCount() uint64 {
 	if x != nil {
-		return x.Ranges
+		return x.Count
 	}
-	return nil
-}
-
-// GetTransactionsForIdentityRequest
-type GetTransactionsForIdentityRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Identity      string                 `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
-	Filters       map[string]string      `protobuf:"bytes,2,rep,name=filters,proto3" json:"filters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Exclude       map[string]string      `protobuf:"bytes,3,rep,name=exclude,proto3" json:"exclude,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Ranges        map[string]*Range      `protobuf:"bytes,6,rep,name=ranges,proto3" json:"ranges,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Pagination    *Pagination            `protobuf:"bytes,9,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return 0
 }
 
-func (x *GetTransactionsForIdentityRequest) Reset() {
-	*x = GetTransactionsForIdentityRequest{}
-	mi := &file_messages_proto_msgTypes[12]
+// EpochSummary
+type EpochSummary struct {
+	state              protoimpl.MessageState   `protogen:"open.v1"`
+	Epoch              uint32                   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Closed             bool                     `protobuf:"varint,2,opt,name=closed,proto3" json:"closed,omitempty"`
+	TickIntervals      []*ProcessedTickInterval `protobuf:"bytes,3,rep,name=tick_intervals,json=tickIntervals,proto3" json:"tick_intervals,omitempty"`
+	FirstTick          uint32                   `protobuf:"varint,4,opt,name=first_tick,json=firstTick,proto3" json:"first_tick,omitempty"`
+	FirstTickTimestamp uint64                   `protobuf:"varint,5,opt,name=first_tick_timestamp,json=firstTickTimestamp,proto3" json:"first_tick_timestamp,omitempty"`
+	LastTick           uint32                   `protobuf:"varint,6,opt,name=last_tick,json=lastTick,proto3" json:"last_tick,omitempty"`
+	LastTickTimestamp  uint64                   `protobuf:"varint,7,opt,name=last_tick_timestamp,json=lastTickTimestamp,proto3" json:"last_tick_timestamp,omitempty"`
+	TickDataCount      uint64                   `protobuf:"varint,8,opt,name=tick_data_count,json=tickDataCount,proto3" json:"tick_data_count,omitempty"`
+	TransactionCount   uint64                   `protobuf:"varint,9,opt,name=transaction_count,json=transactionCount,proto3" json:"transaction_count,omitempty"`
+	TransactionVolume  uint64                   `protobuf:"varint,10,opt,name=transaction_volume,json=transactionVolume,proto3" json:"transaction_volume,omitempty"`
+	EventCounts        []*EventTypeCount        `protobuf:"bytes,11,rep,name=event_counts,json=eventCounts,proto3" json:"event_counts,omitempty"`
+	BurnedAmount       uint64                   `protobuf:"varint,12,opt,name=burned_amount,json=burnedAmount,proto3" json:"burned_amount,omitempty"`
+	ComputorList       *ComputorList            `protobuf:"bytes,13,opt,name=computor_list,json=computorList,proto3" json:"computor_list,omitempty"`
+	EventsValidForTick uint32                   `protobuf:"varint,14,opt,name=events_valid_for_tick,json=eventsValidForTick,proto3" json:"events_valid_for_tick,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *EpochSummary) Reset() {
+	*x = EpochSummary{}
+	mi := &file_messages_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTransactionsForIdentityRequest) String() string {
+func (x *EpochSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTransactionsForIdentityRequest) ProtoMessage() {}
+func (*EpochSummary) ProtoMessage() {}
 
-func (x *GetTransactionsForIdentityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[12]
+func (x *EpochSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -908,132 +3490,132 @@ func (x *GetTransactionsForIdentityRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTransactionsForIdentityRequest.ProtoReflect.Descriptor instead.
-func (*GetTransactionsForIdentityRequest) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use EpochSummary.ProtoReflect.Descriptor instead.
+func (*EpochSummary) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *GetTransactionsForIdentityRequest) GetIdentity() string {
+func (x *EpochSummary) GetEpoch() uint32 {
 	if x != nil {
-		return x.Identity
+		return x.Epoch
 	}
-	return ""
+	return 0
 }
 
-func (x *GetTransactionsForIdentityRequest) GetFilters() map[string]string {
+func (x *EpochSummary) GetClosed() bool {
 	if x != nil {
-		return x.Filters
+		return x.Closed
 	}
-	return nil
+	return false
 }
 
-func (x *GetTransactionsForIdentityRequest) GetExclude() map[string]string {
+func (x *EpochSummary) GetTickIntervals() []*ProcessedTickInterval {
 	if x != nil {
-		return x.Exclude
+		return x.TickIntervals
 	}
 	return nil
 }
 
-func (x *GetTransactionsForIdentityRequest) GetRanges() map[string]*Range {
+func (x *EpochSummary) GetFirstTick() uint32 {
 	if x != nil {
-		return x.Ranges
+		return x.FirstTick
 	}
-	return nil
+	return 0
 }
 
-func (x *GetTransactionsForIdentityRequest) GetPagination() *Pagination {
+func (x *EpochSummary) GetFirstTickTimestamp() uint64 {
 	if x != nil {
-		return x.Pagination
+		return x.FirstTickTimestamp
 	}
-	return nil
+	return 0
 }
 
-// Hits
-type Hits struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Total         uint32                 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
-	From          uint32                 `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
-	Size          uint32                 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *EpochSummary) GetLastTick() uint32 {
+	if x != nil {
+		return x.LastTick
+	}
+	return 0
 }
 
-func (x *Hits) Reset() {
-	*x = Hits{}
-	mi := &file_messages_proto_msgTypes[13]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *EpochSummary) GetLastTickTimestamp() uint64 {
+	if x != nil {
+		return x.LastTickTimestamp
+	}
+	return 0
+}
+
+func (x *EpochSummary) GetTickDataCount() uint64 {
+	if x != nil {
+		return x.TickDataCount
+	}
+	return 0
 }
 
-func (x *Hits) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *EpochSummary) GetTransactionCount() uint64 {
+	if x != nil {
+		return x.TransactionCount
+	}
+	return 0
 }
 
-func (*Hits) ProtoMessage() {}
-
-func (x *Hits) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[13]
+func (x *EpochSummary) GetTransactionVolume() uint64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.TransactionVolume
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use Hits.ProtoReflect.Descriptor instead.
-func (*Hits) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{13}
+func (x *EpochSummary) GetEventCounts() []*EventTypeCount {
+	if x != nil {
+		return x.EventCounts
+	}
+	return nil
 }
 
-func (x *Hits) GetTotal() uint32 {
+func (x *EpochSummary) GetBurnedAmount() uint64 {
 	if x != nil {
-		return x.Total
+		return x.BurnedAmount
 	}
 	return 0
 }
 
-func (x *Hits) GetFrom() uint32 {
+func (x *EpochSummary) GetComputorList() *ComputorList {
 	if x != nil {
-		return x.From
+		return x.ComputorList
 	}
-	return 0
+	return nil
 }
 
-func (x *Hits) GetSize() uint32 {
+func (x *EpochSummary) GetEventsValidForTick() uint32 {
 	if x != nil {
-		return x.Size
+		return x.EventsValidForTick
 	}
 	return 0
 }
 
-// GetTransactionsForIdentityResponse
-type GetTransactionsForIdentityResponse struct {
+// GetEpochSummaryResponse
+type GetEpochSummaryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ValidForTick  uint32                 `protobuf:"varint,1,opt,name=valid_for_tick,json=validForTick,proto3" json:"valid_for_tick,omitempty"`
-	Hits          *Hits                  `protobuf:"bytes,2,opt,name=hits,proto3" json:"hits,omitempty"`
-	Transactions  []*Transaction         `protobuf:"bytes,3,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	Summary       *EpochSummary          `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTransactionsForIdentityResponse) Reset() {
-	*x = GetTransactionsForIdentityResponse{}
-	mi := &file_messages_proto_msgTypes[14]
+func (x *GetEpochSummaryResponse) Reset() {
+	*x = GetEpochSummaryResponse{}
+	mi := &file_messages_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTransactionsForIdentityResponse) String() string {
+func (x *GetEpochSummaryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTransactionsForIdentityResponse) ProtoMessage() {}
+func (*GetEpochSummaryResponse) ProtoMessage() {}
 
-func (x *GetTransactionsForIdentityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[14]
+func (x *GetEpochSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1044,55 +3626,42 @@ func (x *GetTransactionsForIdentityResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTransactionsForIdentityResponse.ProtoReflect.Descriptor instead.
-func (*GetTransactionsForIdentityResponse) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *GetTransactionsForIdentityResponse) GetValidForTick() uint32 {
-	if x != nil {
-		return x.ValidForTick
-	}
-	return 0
-}
-
-func (x *GetTransactionsForIdentityResponse) GetHits() *Hits {
-	if x != nil {
-		return x.Hits
-	}
-	return nil
+// Deprecated: Use GetEpochSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetEpochSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *GetTransactionsForIdentityResponse) GetTransactions() []*Transaction {
+func (x *GetEpochSummaryResponse) GetSummary() *EpochSummary {
 	if x != nil {
-		return x.Transactions
+		return x.Summary
 	}
 	return nil
 }
 
-// GetTickDataRequest
-type GetTickDataRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TickNumber    uint32                 `protobuf:"varint,1,opt,name=tick_number,json=tickNumber,proto3" json:"tick_number,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// GetIdentityOverviewRequest
+type GetIdentityOverviewRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Identity          string                 `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	TopCounterparties uint32                 `protobuf:"varint,2,opt,name=top_counterparties,json=topCounterparties,proto3" json:"top_counterparties,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *GetTickDataRequest) Reset() {
-	*x = GetTickDataRequest{}
-	mi := &file_messages_proto_msgTypes[15]
+func (x *GetIdentityOverviewRequest) Reset() {
+	*x = GetIdentityOverviewRequest{}
+	mi := &file_messages_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTickDataRequest) String() string {
+func (x *GetIdentityOverviewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTickDataRequest) ProtoMessage() {}
+func (*GetIdentityOverviewRequest) ProtoMessage() {}
 
-func (x *GetTickDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[15]
+func (x *GetIdentityOverviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1103,41 +3672,50 @@ func (x *GetTickDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTickDataRequest.ProtoReflect.Descriptor instead.
-func (*GetTickDataRequest) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use GetIdentityOverviewRequest.ProtoReflect.Descriptor instead.
+func (*GetIdentityOverviewRequest) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *GetTickDataRequest) GetTickNumber() uint32 {
+func (x *GetIdentityOverviewRequest) GetIdentity() string {
 	if x != nil {
-		return x.TickNumber
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *GetIdentityOverviewRequest) GetTopCounterparties() uint32 {
+	if x != nil {
+		return x.TopCounterparties
 	}
 	return 0
 }
 
-// GetTickDataResponse
-type GetTickDataResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TickData      *TickData              `protobuf:"bytes,1,opt,name=tick_data,json=tickData,proto3" json:"tick_data,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Counterparty
+type Counterparty struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Identity         string                 `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	TransactionCount uint64                 `protobuf:"varint,2,opt,name=transaction_count,json=transactionCount,proto3" json:"transaction_count,omitempty"`
+	Volume           uint64                 `protobuf:"varint,3,opt,name=volume,proto3" json:"volume,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *GetTickDataResponse) Reset() {
-	*x = GetTickDataResponse{}
-	mi := &file_messages_proto_msgTypes[16]
+func (x *Counterparty) Reset() {
+	*x = Counterparty{}
+	mi := &file_messages_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTickDataResponse) String() string {
+func (x *Counterparty) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTickDataResponse) ProtoMessage() {}
+func (*Counterparty) ProtoMessage() {}
 
-func (x *GetTickDataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[16]
+func (x *Counterparty) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1148,41 +3726,56 @@ func (x *GetTickDataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTickDataResponse.ProtoReflect.Descriptor instead.
-func (*GetTickDataResponse) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use Counterparty.ProtoReflect.Descriptor instead.
+func (*Counterparty) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *GetTickDataResponse) GetTickData() *TickData {
+func (x *Counterparty) GetIdentity() string {
 	if x != nil {
-		return x.TickData
+		return x.Identity
 	}
-	return nil
+	return ""
 }
 
-// GetProcessedTickIntervalsResponse
-type GetProcessedTickIntervalsResponse struct {
-	state                  protoimpl.MessageState   `protogen:"open.v1"`
-	ProcessedTickIntervals []*ProcessedTickInterval `protobuf:"bytes,1,rep,name=processed_tick_intervals,json=processedTickIntervals,proto3" json:"processed_tick_intervals,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+func (x *Counterparty) GetTransactionCount() uint64 {
+	if x != nil {
+		return x.TransactionCount
+	}
+	return 0
 }
 
-func (x *GetProcessedTickIntervalsResponse) Reset() {
-	*x = GetProcessedTickIntervalsResponse{}
-	mi := &file_messages_proto_msgTypes[17]
+func (x *Counterparty) GetVolume() uint64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+// IdentityAsset
+type IdentityAsset struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssetIssuer   string                 `protobuf:"bytes,1,opt,name=asset_issuer,json=assetIssuer,proto3" json:"asset_issuer,omitempty"`
+	AssetName     string                 `protobuf:"bytes,2,opt,name=asset_name,json=assetName,proto3" json:"asset_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IdentityAsset) Reset() {
+	*x = IdentityAsset{}
+	mi := &file_messages_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProcessedTickIntervalsResponse) String() string {
+func (x *IdentityAsset) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProcessedTickIntervalsResponse) ProtoMessage() {}
+func (*IdentityAsset) ProtoMessage() {}
 
-func (x *GetProcessedTickIntervalsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[17]
+func (x *IdentityAsset) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1193,44 +3786,62 @@ func (x *GetProcessedTickIntervalsResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProcessedTickIntervalsResponse.ProtoReflect.Descriptor instead.
-func (*GetProcessedTickIntervalsResponse) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use IdentityAsset.ProtoReflect.Descriptor instead.
+func (*IdentityAsset) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *GetProcessedTickIntervalsResponse) GetProcessedTickIntervals() []*ProcessedTickInterval {
+func (x *IdentityAsset) GetAssetIssuer() string {
 	if x != nil {
-		return x.ProcessedTickIntervals
+		return x.AssetIssuer
 	}
-	return nil
+	return ""
 }
 
-// GetLastProcessedTickResponse
-type GetLastProcessedTickResponse struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	TickNumber           uint32                 `protobuf:"varint,1,opt,name=tick_number,json=tickNumber,proto3" json:"tick_number,omitempty"`
-	Epoch                uint32                 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	IntervalInitialTick  uint32                 `protobuf:"varint,3,opt,name=interval_initial_tick,json=intervalInitialTick,proto3" json:"interval_initial_tick,omitempty"`
-	LastProcessedLogTick uint32                 `protobuf:"varint,4,opt,name=last_processed_log_tick,json=lastProcessedLogTick,proto3" json:"last_processed_log_tick,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+func (x *IdentityAsset) GetAssetName() string {
+	if x != nil {
+		return x.AssetName
+	}
+	return ""
 }
 
-func (x *GetLastProcessedTickResponse) Reset() {
-	*x = GetLastProcessedTickResponse{}
-	mi := &file_messages_proto_msgTypes[18]
+// IdentityOverview
+type IdentityOverview struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	Identity                  string                 `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	ValidForTick              uint32                 `protobuf:"varint,2,opt,name=valid_for_tick,json=validForTick,proto3" json:"valid_for_tick,omitempty"`
+	FirstSeenTick             uint32                 `protobuf:"varint,3,opt,name=first_seen_tick,json=firstSeenTick,proto3" json:"first_seen_tick,omitempty"`
+	FirstSeenTimestamp        uint64                 `protobuf:"varint,4,opt,name=first_seen_timestamp,json=firstSeenTimestamp,proto3" json:"first_seen_timestamp,omitempty"`
+	LastSeenTick              uint32                 `protobuf:"varint,5,opt,name=last_seen_tick,json=lastSeenTick,proto3" json:"last_seen_tick,omitempty"`
+	LastSeenTimestamp         uint64                 `protobuf:"varint,6,opt,name=last_seen_timestamp,json=lastSeenTimestamp,proto3" json:"last_seen_timestamp,omitempty"`
+	IncomingTransactionCount  uint64                 `protobuf:"varint,7,opt,name=incoming_transaction_count,json=incomingTransactionCount,proto3" json:"incoming_transaction_count,omitempty"`
+	OutgoingTransactionCount  uint64                 `protobuf:"varint,8,opt,name=outgoing_transaction_count,json=outgoingTransactionCount,proto3" json:"outgoing_transaction_count,omitempty"`
+	TopIncomingCounterparties []*Counterparty        `protobuf:"bytes,9,rep,name=top_incoming_counterparties,json=topIncomingCounterparties,proto3" json:"top_incoming_counterparties,omitempty"`
+	TopOutgoingCounterparties []*Counterparty        `protobuf:"bytes,10,rep,name=top_outgoing_counterparties,json=topOutgoingCounterparties,proto3" json:"top_outgoing_counterparties,omitempty"`
+	QuTransfersReceivedCount  uint64                 `protobuf:"varint,11,opt,name=qu_transfers_received_count,json=quTransfersReceivedCount,proto3" json:"qu_transfers_received_count,omitempty"`
+	QuTransfersReceivedAmount uint64                 `protobuf:"varint,12,opt,name=qu_transfers_received_amount,json=quTransfersReceivedAmount,proto3" json:"qu_transfers_received_amount,omitempty"`
+	QuTransfersSentCount      uint64                 `protobuf:"varint,13,opt,name=qu_transfers_sent_count,json=quTransfersSentCount,proto3" json:"qu_transfers_sent_count,omitempty"`
+	QuTransfersSentAmount     uint64                 `protobuf:"varint,14,opt,name=qu_transfers_sent_amount,json=quTransfersSentAmount,proto3" json:"qu_transfers_sent_amount,omitempty"`
+	Assets                    []*IdentityAsset       `protobuf:"bytes,15,rep,name=assets,proto3" json:"assets,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *IdentityOverview) Reset() {
+	*x = IdentityOverview{}
+	mi := &file_messages_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLastProcessedTickResponse) String() string {
+func (x *IdentityOverview) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLastProcessedTickResponse) ProtoMessage() {}
+func (*IdentityOverview) ProtoMessage() {}
 
-func (x *GetLastProcessedTickResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[18]
+func (x *IdentityOverview) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1241,176 +3852,139 @@ func (x *GetLastProcessedTickResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLastProcessedTickResponse.ProtoReflect.Descriptor instead.
-func (*GetLastProcessedTickResponse) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use IdentityOverview.ProtoReflect.Descriptor instead.
+func (*IdentityOverview) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *GetLastProcessedTickResponse) GetTickNumber() uint32 {
+func (x *IdentityOverview) GetIdentity() string {
 	if x != nil {
-		return x.TickNumber
+		return x.Identity
 	}
-	return 0
+	return ""
 }
 
-func (x *GetLastProcessedTickResponse) GetEpoch() uint32 {
+func (x *IdentityOverview) GetValidForTick() uint32 {
 	if x != nil {
-		return x.Epoch
+		return x.ValidForTick
 	}
 	return 0
 }
 
-func (x *GetLastProcessedTickResponse) GetIntervalInitialTick() uint32 {
+func (x *IdentityOverview) GetFirstSeenTick() uint32 {
 	if x != nil {
-		return x.IntervalInitialTick
+		return x.FirstSeenTick
 	}
 	return 0
 }
 
-func (x *GetLastProcessedTickResponse) GetLastProcessedLogTick() uint32 {
+func (x *IdentityOverview) GetFirstSeenTimestamp() uint64 {
 	if x != nil {
-		return x.LastProcessedLogTick
+		return x.FirstSeenTimestamp
 	}
 	return 0
 }
 
-// GetComputorListsForEpochRequest
-type GetComputorListsForEpochRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Epoch         uint32                 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetComputorListsForEpochRequest) Reset() {
-	*x = GetComputorListsForEpochRequest{}
-	mi := &file_messages_proto_msgTypes[19]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetComputorListsForEpochRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetComputorListsForEpochRequest) ProtoMessage() {}
-
-func (x *GetComputorListsForEpochRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[19]
+func (x *IdentityOverview) GetLastSeenTick() uint32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.LastSeenTick
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetComputorListsForEpochRequest.ProtoReflect.Descriptor instead.
-func (*GetComputorListsForEpochRequest) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{19}
+	return 0
 }
 
-func (x *GetComputorListsForEpochRequest) GetEpoch() uint32 {
+func (x *IdentityOverview) GetLastSeenTimestamp() uint64 {
 	if x != nil {
-		return x.Epoch
+		return x.LastSeenTimestamp
 	}
 	return 0
 }
 
-// ComputorList
-type ComputorList struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Epoch         uint32                 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	TickNumber    uint32                 `protobuf:"varint,2,opt,name=tick_number,json=tickNumber,proto3" json:"tick_number,omitempty"`
-	Identities    []string               `protobuf:"bytes,3,rep,name=identities,proto3" json:"identities,omitempty"`
-	Signature     string                 `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *IdentityOverview) GetIncomingTransactionCount() uint64 {
+	if x != nil {
+		return x.IncomingTransactionCount
+	}
+	return 0
 }
 
-func (x *ComputorList) Reset() {
-	*x = ComputorList{}
-	mi := &file_messages_proto_msgTypes[20]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *IdentityOverview) GetOutgoingTransactionCount() uint64 {
+	if x != nil {
+		return x.OutgoingTransactionCount
+	}
+	return 0
 }
 
-func (x *ComputorList) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *IdentityOverview) GetTopIncomingCounterparties() []*Counterparty {
+	if x != nil {
+		return x.TopIncomingCounterparties
+	}
+	return nil
 }
 
-func (*ComputorList) ProtoMessage() {}
-
-func (x *ComputorList) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[20]
+func (x *IdentityOverview) GetTopOutgoingCounterparties() []*Counterparty {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.TopOutgoingCounterparties
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ComputorList.ProtoReflect.Descriptor instead.
-func (*ComputorList) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{20}
+func (x *IdentityOverview) GetQuTransfersReceivedCount() uint64 {
+	if x != nil {
+		return x.QuTransfersReceivedCount
+	}
+	return 0
 }
 
-func (x *ComputorList) GetEpoch() uint32 {
+func (x *IdentityOverview) GetQuTransfersReceivedAmount() uint64 {
 	if x != nil {
-		return x.Epoch
+		return x.QuTransfersReceivedAmount
 	}
 	return 0
 }
 
-func (x *ComputorList) GetTickNumber() uint32 {
+func (x *IdentityOverview) GetQuTransfersSentCount() uint64 {
 	if x != nil {
-		return x.TickNumber
+		return x.QuTransfersSentCount
 	}
 	return 0
 }
 
-func (x *ComputorList) GetIdentities() []string {
+func (x *IdentityOverview) GetQuTransfersSentAmount() uint64 {
 	if x != nil {
-		return x.Identities
+		return x.QuTransfersSentAmount
 	}
-	return nil
+	return 0
 }
 
-func (x *ComputorList) GetSignature() string {
+func (x *IdentityOverview) GetAssets() []*IdentityAsset {
 	if x != nil {
-		return x.Signature
+		return x.Assets
 	}
-	return ""
+	return nil
 }
 
-// GetComputorListsForEpochResponse
-type GetComputorListsForEpochResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	ComputorsLists []*ComputorList        `protobuf:"bytes,1,rep,name=computors_lists,json=computorsLists,proto3" json:"computors_lists,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// GetIdentityOverviewResponse
+type GetIdentityOverviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Overview      *IdentityOverview      `protobuf:"bytes,1,opt,name=overview,proto3" json:"overview,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetComputorListsForEpochResponse) Reset() {
-	*x = GetComputorListsForEpochResponse{}
-	mi := &file_messages_proto_msgTypes[21]
+func (x *GetIdentityOverviewResponse) Reset() {
+	*x = GetIdentityOverviewResponse{}
+	mi := &file_messages_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetComputorListsForEpochResponse) String() string {
+func (x *GetIdentityOverviewResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetComputorListsForEpochResponse) ProtoMessage() {}
+func (*GetIdentityOverviewResponse) ProtoMessage() {}
 
-func (x *GetComputorListsForEpochResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[21]
+func (x *GetIdentityOverviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1421,14 +3995,14 @@ func (x *GetComputorListsForEpochResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetComputorListsForEpochResponse.ProtoReflect.Descriptor instead.
-func (*GetComputorListsForEpochResponse) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use GetIdentityOverviewResponse.ProtoReflect.Descriptor instead.
+func (*GetIdentityOverviewResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *GetComputorListsForEpochResponse) GetComputorsLists() []*ComputorList {
+func (x *GetIdentityOverviewResponse) GetOverview() *IdentityOverview {
 	if x != nil {
-		return x.ComputorsLists
+		return x.Overview
 	}
 	return nil
 }
@@ -1443,7 +4017,7 @@ type HealthResponse struct {
 
 func (x *HealthResponse) Reset() {
 	*x = HealthResponse{}
-	mi := &file_messages_proto_msgTypes[22]
+	mi := &file_messages_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1455,7 +4029,7 @@ func (x *HealthResponse) String() string {
 func (*HealthResponse) ProtoMessage() {}
 
 func (x *HealthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[22]
+	mi := &file_messages_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1468,7 +4042,7 @@ func (x *HealthResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthResponse.ProtoReflect.Descriptor instead.
 func (*HealthResponse) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{22}
+	return file_messages_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *HealthResponse) GetStatus() string {
@@ -1490,7 +4064,7 @@ type QuTransferData struct {
 
 func (x *QuTransferData) Reset() {
 	*x = QuTransferData{}
-	mi := &file_messages_proto_msgTypes[23]
+	mi := &file_messages_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1502,7 +4076,7 @@ func (x *QuTransferData) String() string {
 func (*QuTransferData) ProtoMessage() {}
 
 func (x *QuTransferData) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[23]
+	mi := &file_messages_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1515,7 +4089,7 @@ func (x *QuTransferData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuTransferData.ProtoReflect.Descriptor instead.
 func (*QuTransferData) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{23}
+	return file_messages_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *QuTransferData) GetSource() string {
@@ -1554,7 +4128,7 @@ type AssetIssuanceData struct {
 
 func (x *AssetIssuanceData) Reset() {
 	*x = AssetIssuanceData{}
-	mi := &file_messages_proto_msgTypes[24]
+	mi := &file_messages_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1566,7 +4140,7 @@ func (x *AssetIssuanceData) String() string {
 func (*AssetIssuanceData) ProtoMessage() {}
 
 func (x *AssetIssuanceData) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[24]
+	mi := &file_messages_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1579,7 +4153,7 @@ func (x *AssetIssuanceData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssetIssuanceData.ProtoReflect.Descriptor instead.
 func (*AssetIssuanceData) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{24}
+	return file_messages_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *AssetIssuanceData) GetAssetIssuer() string {
@@ -1638,7 +4212,7 @@ type AssetOwnershipChangeData struct {
 
 func (x *AssetOwnershipChangeData) Reset() {
 	*x = AssetOwnershipChangeData{}
-	mi := &file_messages_proto_msgTypes[25]
+	mi := &file_messages_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1650,7 +4224,7 @@ func (x *AssetOwnershipChangeData) String() string {
 func (*AssetOwnershipChangeData) ProtoMessage() {}
 
 func (x *AssetOwnershipChangeData) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[25]
+	mi := &file_messages_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1663,7 +4237,7 @@ func (x *AssetOwnershipChangeData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssetOwnershipChangeData.ProtoReflect.Descriptor instead.
 func (*AssetOwnershipChangeData) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{25}
+	return file_messages_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *AssetOwnershipChangeData) GetSource() string {
@@ -1715,7 +4289,7 @@ type AssetPossessionChangeData struct {
 
 func (x *AssetPossessionChangeData) Reset() {
 	*x = AssetPossessionChangeData{}
-	mi := &file_messages_proto_msgTypes[26]
+	mi := &file_messages_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1727,7 +4301,7 @@ func (x *AssetPossessionChangeData) String() string {
 func (*AssetPossessionChangeData) ProtoMessage() {}
 
 func (x *AssetPossessionChangeData) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[26]
+	mi := &file_messages_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1740,7 +4314,7 @@ func (x *AssetPossessionChangeData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssetPossessionChangeData.ProtoReflect.Descriptor instead.
 func (*AssetPossessionChangeData) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{26}
+	return file_messages_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *AssetPossessionChangeData) GetSource() string {
@@ -1790,7 +4364,7 @@ type BurningData struct {
 
 func (x *BurningData) Reset() {
 	*x = BurningData{}
-	mi := &file_messages_proto_msgTypes[27]
+	mi := &file_messages_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1802,7 +4376,7 @@ func (x *BurningData) String() string {
 func (*BurningData) ProtoMessage() {}
 
 func (x *BurningData) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[27]
+	mi := &file_messages_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1815,7 +4389,7 @@ func (x *BurningData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BurningData.ProtoReflect.Descriptor instead.
 func (*BurningData) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{27}
+	return file_messages_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *BurningData) GetSource() string {
@@ -1851,7 +4425,7 @@ type ContractReserveDeductionData struct {
 
 func (x *ContractReserveDeductionData) Reset() {
 	*x = ContractReserveDeductionData{}
-	mi := &file_messages_proto_msgTypes[28]
+	mi := &file_messages_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1863,7 +4437,7 @@ func (x *ContractReserveDeductionData) String() string {
 func (*ContractReserveDeductionData) ProtoMessage() {}
 
 func (x *ContractReserveDeductionData) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[28]
+	mi := &file_messages_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1876,7 +4450,7 @@ func (x *ContractReserveDeductionData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ContractReserveDeductionData.ProtoReflect.Descriptor instead.
 func (*ContractReserveDeductionData) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{28}
+	return file_messages_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *ContractReserveDeductionData) GetDeductedAmount() uint64 {
@@ -1910,7 +4484,7 @@ type SmartContractMessageData struct {
 
 func (x *SmartContractMessageData) Reset() {
 	*x = SmartContractMessageData{}
-	mi := &file_messages_proto_msgTypes[29]
+	mi := &file_messages_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1922,7 +4496,7 @@ func (x *SmartContractMessageData) String() string {
 func (*SmartContractMessageData) ProtoMessage() {}
 
 func (x *SmartContractMessageData) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[29]
+	mi := &file_messages_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1935,7 +4509,7 @@ func (x *SmartContractMessageData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SmartContractMessageData.ProtoReflect.Descriptor instead.
 func (*SmartContractMessageData) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{29}
+	return file_messages_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *SmartContractMessageData) GetContractIndex() uint64 {
@@ -1961,7 +4535,7 @@ type CustomMessageData struct {
 
 func (x *CustomMessageData) Reset() {
 	*x = CustomMessageData{}
-	mi := &file_messages_proto_msgTypes[30]
+	mi := &file_messages_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1973,7 +4547,7 @@ func (x *CustomMessageData) String() string {
 func (*CustomMessageData) ProtoMessage() {}
 
 func (x *CustomMessageData) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[30]
+	mi := &file_messages_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1986,7 +4560,7 @@ func (x *CustomMessageData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CustomMessageData.ProtoReflect.Descriptor instead.
 func (*CustomMessageData) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{30}
+	return file_messages_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *CustomMessageData) GetValue() uint64 {
@@ -2011,7 +4585,7 @@ type AssetOwnershipManagingContractChangeData struct {
 
 func (x *AssetOwnershipManagingContractChangeData) Reset() {
 	*x = AssetOwnershipManagingContractChangeData{}
-	mi := &file_messages_proto_msgTypes[31]
+	mi := &file_messages_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2023,7 +4597,7 @@ func (x *AssetOwnershipManagingContractChangeData) String() string {
 func (*AssetOwnershipManagingContractChangeData) ProtoMessage() {}
 
 func (x *AssetOwnershipManagingContractChangeData) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[31]
+	mi := &file_messages_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2036,7 +4610,7 @@ func (x *AssetOwnershipManagingContractChangeData) ProtoReflect() protoreflect.M
 
 // Deprecated: Use AssetOwnershipManagingContractChangeData.ProtoReflect.Descriptor instead.
 func (*AssetOwnershipManagingContractChangeData) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{31}
+	return file_messages_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *AssetOwnershipManagingContractChangeData) GetAssetName() string {
@@ -2097,7 +4671,7 @@ type AssetPossessionManagingContractChangeData struct {
 
 func (x *AssetPossessionManagingContractChangeData) Reset() {
 	*x = AssetPossessionManagingContractChangeData{}
-	mi := &file_messages_proto_msgTypes[32]
+	mi := &file_messages_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2109,7 +4683,7 @@ func (x *AssetPossessionManagingContractChangeData) String() string {
 func (*AssetPossessionManagingContractChangeData) ProtoMessage() {}
 
 func (x *AssetPossessionManagingContractChangeData) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[32]
+	mi := &file_messages_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2122,7 +4696,7 @@ func (x *AssetPossessionManagingContractChangeData) ProtoReflect() protoreflect.
 
 // Deprecated: Use AssetPossessionManagingContractChangeData.ProtoReflect.Descriptor instead.
 func (*AssetPossessionManagingContractChangeData) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{32}
+	return file_messages_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *AssetPossessionManagingContractChangeData) GetAssetName() string {
@@ -2205,7 +4779,7 @@ type Event struct {
 
 func (x *Event) Reset() {
 	*x = Event{}
-	mi := &file_messages_proto_msgTypes[33]
+	mi := &file_messages_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2217,7 +4791,7 @@ func (x *Event) String() string {
 func (*Event) ProtoMessage() {}
 
 func (x *Event) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[33]
+	mi := &file_messages_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2230,7 +4804,7 @@ func (x *Event) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Event.ProtoReflect.Descriptor instead.
 func (*Event) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{33}
+	return file_messages_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *Event) GetEpoch() uint32 {
@@ -2471,7 +5045,7 @@ type GetEventLogsRequest struct {
 
 func (x *GetEventLogsRequest) Reset() {
 	*x = GetEventLogsRequest{}
-	mi := &file_messages_proto_msgTypes[34]
+	mi := &file_messages_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2483,7 +5057,7 @@ func (x *GetEventLogsRequest) String() string {
 func (*GetEventLogsRequest) ProtoMessage() {}
 
 func (x *GetEventLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[34]
+	mi := &file_messages_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2496,7 +5070,7 @@ func (x *GetEventLogsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEventLogsRequest.ProtoReflect.Descriptor instead.
 func (*GetEventLogsRequest) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{34}
+	return file_messages_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *GetEventLogsRequest) GetFilters() map[string]string {
@@ -2546,7 +5120,7 @@ type GetEventLogsResponse struct {
 
 func (x *GetEventLogsResponse) Reset() {
 	*x = GetEventLogsResponse{}
-	mi := &file_messages_proto_msgTypes[35]
+	mi := &file_messages_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2558,7 +5132,7 @@ func (x *GetEventLogsResponse) String() string {
 func (*GetEventLogsResponse) ProtoMessage() {}
 
 func (x *GetEventLogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[35]
+	mi := &file_messages_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2571,7 +5145,7 @@ func (x *GetEventLogsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEventLogsResponse.ProtoReflect.Descriptor instead.
 func (*GetEventLogsResponse) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{35}
+	return file_messages_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *GetEventLogsResponse) GetHits() *Hits {
@@ -2595,6 +5169,234 @@ func (x *GetEventLogsResponse) GetValidForTick() uint32 {
 	return 0
 }
 
+// GetEventLogRequest
+type GetEventLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Epoch         uint32                 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	LogId         uint64                 `protobuf:"varint,2,opt,name=log_id,json=logId,proto3" json:"log_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventLogRequest) Reset() {
+	*x = GetEventLogRequest{}
+	mi := &file_messages_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventLogRequest) ProtoMessage() {}
+
+func (x *GetEventLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventLogRequest.ProtoReflect.Descriptor instead.
+func (*GetEventLogRequest) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetEventLogRequest) GetEpoch() uint32 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *GetEventLogRequest) GetLogId() uint64 {
+	if x != nil {
+		return x.LogId
+	}
+	return 0
+}
+
+// GetEventLogResponse
+type GetEventLogResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventLog      *Event                 `protobuf:"bytes,1,opt,name=event_log,json=eventLog,proto3" json:"event_log,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventLogResponse) Reset() {
+	*x = GetEventLogResponse{}
+	mi := &file_messages_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventLogResponse) ProtoMessage() {}
+
+func (x *GetEventLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventLogResponse.ProtoReflect.Descriptor instead.
+func (*GetEventLogResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetEventLogResponse) GetEventLog() *Event {
+	if x != nil {
+		return x.EventLog
+	}
+	return nil
+}
+
+// GetEventLogsForTransactionRequest
+type GetEventLogsForTransactionRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TransactionHash string                 `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	AfterLogId      *uint64                `protobuf:"varint,2,opt,name=after_log_id,json=afterLogId,proto3,oneof" json:"after_log_id,omitempty"`
+	Size            uint32                 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetEventLogsForTransactionRequest) Reset() {
+	*x = GetEventLogsForTransactionRequest{}
+	mi := &file_messages_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventLogsForTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventLogsForTransactionRequest) ProtoMessage() {}
+
+func (x *GetEventLogsForTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventLogsForTransactionRequest.ProtoReflect.Descriptor instead.
+func (*GetEventLogsForTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GetEventLogsForTransactionRequest) GetTransactionHash() string {
+	if x != nil {
+		return x.TransactionHash
+	}
+	return ""
+}
+
+func (x *GetEventLogsForTransactionRequest) GetAfterLogId() uint64 {
+	if x != nil && x.AfterLogId != nil {
+		return *x.AfterLogId
+	}
+	return 0
+}
+
+func (x *GetEventLogsForTransactionRequest) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// GetEventLogsForTransactionResponse
+type GetEventLogsForTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ValidForTick  uint32                 `protobuf:"varint,1,opt,name=valid_for_tick,json=validForTick,proto3" json:"valid_for_tick,omitempty"`
+	TickNumber    uint32                 `protobuf:"varint,2,opt,name=tick_number,json=tickNumber,proto3" json:"tick_number,omitempty"`
+	EventLogs     []*Event               `protobuf:"bytes,3,rep,name=event_logs,json=eventLogs,proto3" json:"event_logs,omitempty"`
+	HasMore       bool                   `protobuf:"varint,4,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventLogsForTransactionResponse) Reset() {
+	*x = GetEventLogsForTransactionResponse{}
+	mi := &file_messages_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventLogsForTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventLogsForTransactionResponse) ProtoMessage() {}
+
+func (x *GetEventLogsForTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventLogsForTransactionResponse.ProtoReflect.Descriptor instead.
+func (*GetEventLogsForTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetEventLogsForTransactionResponse) GetValidForTick() uint32 {
+	if x != nil {
+		return x.ValidForTick
+	}
+	return 0
+}
+
+func (x *GetEventLogsForTransactionResponse) GetTickNumber() uint32 {
+	if x != nil {
+		return x.TickNumber
+	}
+	return 0
+}
+
+func (x *GetEventLogsForTransactionResponse) GetEventLogs() []*Event {
+	if x != nil {
+		return x.EventLogs
+	}
+	return nil
+}
+
+func (x *GetEventLogsForTransactionResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
 var File_messages_proto protoreflect.FileDescriptor
 
 const file_messages_proto_rawDesc = "" +
@@ -2604,7 +5406,7 @@ const file_messages_proto_rawDesc = "" +
 	"\vtick_number\x18\x01 \x01(\rR\n" +
 	"tickNumber\"=\n" +
 	"\x11NextAvailableTick\x12(\n" +
-	"\x10next_tick_number\x18\x01 \x01(\rR\x0enextTickNumber\"\xda\x05\n" +
+	"\x10next_tick_number\x18\x01 \x01(\rR\x0enextTickNumber\"\xc5\b\n" +
 	"\vTransaction\x12\x12\n" +
 	"\x04hash\x18\x01 \x01(\tR\x04hash\x12\x16\n" +
 	"\x06amount\x18\x02 \x01(\x04R\x06amount\x12\x16\n" +
@@ -2622,7 +5424,39 @@ const file_messages_proto_rawDesc = "" +
 	"\tsignature\x18\n" +
 	" \x01(\tBJ\xbaGG\x92\x02DBase64 encoded byte array representing the transactions's signature.R\tsignature\x12\x83\x01\n" +
 	"\n" +
-	"money_flew\x18\v \x01(\bBd\xbaGa\x92\x02^Money flew is an additional information provided by some nodes with the tx status addon patch.R\tmoneyFlew\"\xb4\x02\n" +
+	"money_flew\x18\v \x01(\bBd\xbaGa\x92\x02^Money flew is an additional information provided by some nodes with the tx status addon patch.R\tmoneyFlew\x12\x9f\x01\n" +
+	"\rdecoded_input\x18\f \x01(\v2!.qubic.v2.archive.pb.DecodedInputBW\xbaGT\x92\x02QDecoded smart contract payload. Only set if requested and the procedure is known.R\fdecodedInput\x12\xc6\x01\n" +
+	"\x10execution_status\x18\r \x01(\x0e2$.qubic.v2.archive.pb.ExecutionStatusBu\xbaGr\x92\x02oExecution status derived from money flew. For send many transactions the status is recomputed from the payload.R\x0fexecutionStatus\"\xc3\x02\n" +
+	"\fDecodedInput\x12A\n" +
+	"\tsend_many\x18\x01 \x01(\v2\".qubic.v2.archive.pb.SendManyInputH\x00R\bsendMany\x12N\n" +
+	"\x0eqx_issue_asset\x18\x02 \x01(\v2&.qubic.v2.archive.pb.QxIssueAssetInputH\x00R\fqxIssueAsset\x12W\n" +
+	"\x11qx_transfer_share\x18\x03 \x01(\v2).qubic.v2.archive.pb.QxTransferShareInputH\x00R\x0fqxTransferShare\x12>\n" +
+	"\bqx_order\x18\x04 \x01(\v2!.qubic.v2.archive.pb.QxOrderInputH\x00R\aqxOrderB\a\n" +
+	"\x05input\"\xdb\x01\n" +
+	"\rSendManyInput\x12l\n" +
+	"\ttransfers\x18\x01 \x03(\v2%.qubic.v2.archive.pb.SendManyTransferB'\xbaG$\x92\x02!The transfers with a destination.R\ttransfers\x12\\\n" +
+	"\ftotal_amount\x18\x02 \x01(\x03B9\xbaG6\x92\x023Sum of the transfer amounts plus the send many fee.R\vtotalAmount\"L\n" +
+	"\x10SendManyTransfer\x12 \n" +
+	"\vdestination\x18\x01 \x01(\tR\vdestination\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\"\xc5\x01\n" +
+	"\x11QxIssueAssetInput\x12\x1d\n" +
+	"\n" +
+	"asset_name\x18\x01 \x01(\tR\tassetName\x12(\n" +
+	"\x10number_of_shares\x18\x02 \x01(\x03R\x0enumberOfShares\x12.\n" +
+	"\x13unit_of_measurement\x18\x03 \x01(\x04R\x11unitOfMeasurement\x127\n" +
+	"\x18number_of_decimal_places\x18\x04 \x01(\x05R\x15numberOfDecimalPlaces\"\xae\x01\n" +
+	"\x14QxTransferShareInput\x12\x16\n" +
+	"\x06issuer\x18\x01 \x01(\tR\x06issuer\x125\n" +
+	"\x17new_owner_and_possessor\x18\x02 \x01(\tR\x14newOwnerAndPossessor\x12\x1d\n" +
+	"\n" +
+	"asset_name\x18\x03 \x01(\tR\tassetName\x12(\n" +
+	"\x10number_of_shares\x18\x04 \x01(\x03R\x0enumberOfShares\"\x85\x01\n" +
+	"\fQxOrderInput\x12\x16\n" +
+	"\x06issuer\x18\x01 \x01(\tR\x06issuer\x12\x1d\n" +
+	"\n" +
+	"asset_name\x18\x02 \x01(\tR\tassetName\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x03R\x05price\x12(\n" +
+	"\x10number_of_shares\x18\x04 \x01(\x03R\x0enumberOfShares\"\xb4\x02\n" +
 	"\bTickData\x12\x1f\n" +
 	"\vtick_number\x18\x01 \x01(\rR\n" +
 	"tickNumber\x12\x14\n" +
@@ -2634,7 +5468,29 @@ const file_messages_proto_rawDesc = "" +
 	"\ttime_lock\x18\x06 \x01(\tR\btimeLock\x12-\n" +
 	"\x12transaction_hashes\x18\a \x03(\tR\x11transactionHashes\x12#\n" +
 	"\rcontract_fees\x18\b \x03(\x03R\fcontractFees\x12\x1c\n" +
-	"\tsignature\x18\t \x01(\tR\tsignature\"\xf1\x01\n" +
+	"\tsignature\x18\t \x01(\tR\tsignature\"|\n" +
+	"\tEpochTick\x12\x1f\n" +
+	"\vtick_number\x18\x01 \x01(\rR\n" +
+	"tickNumber\x12N\n" +
+	"\bis_empty\x18\x02 \x01(\bB3\xbaG0\x92\x02-True, if there is no tick data for this tick.R\aisEmpty\"\x97\x02\n" +
+	"\x17GetTicksForEpochRequest\x12;\n" +
+	"\x05epoch\x18\x01 \x01(\rB%\xbaG\"\x92\x02\x1fThe epoch to get the ticks for.R\x05epoch\x12~\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x1f.qubic.v2.archive.pb.PaginationB=\xbaG:\x92\x027Optional paging information. The offset is not limited.R\n" +
+	"pagination\x12?\n" +
+	"\x04desc\x18\x03 \x01(\bB+\xbaG(\x92\x02%Return the ticks in descending order.R\x04desc\"\xd7\x01\n" +
+	"\x18GetTicksForEpochResponse\x12\\\n" +
+	"\x04hits\x18\x01 \x01(\v2\x19.qubic.v2.archive.pb.HitsB-\xbaG*\x92\x02'Information about the returned results.R\x04hits\x12]\n" +
+	"\x05ticks\x18\x02 \x03(\v2\x1e.qubic.v2.archive.pb.EpochTickB'\xbaG$\x92\x02!The processed ticks of the epoch.R\x05ticks\"\xe1\x01\n" +
+	"\x1cGetEmptyTicksForEpochRequest\x12A\n" +
+	"\x05epoch\x18\x01 \x01(\rB+\xbaG(\x92\x02%The epoch to get the empty ticks for.R\x05epoch\x12~\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x1f.qubic.v2.archive.pb.PaginationB=\xbaG:\x92\x027Optional paging information. The offset is not limited.R\n" +
+	"pagination\"\xd6\x01\n" +
+	"\x1dGetEmptyTicksForEpochResponse\x12\\\n" +
+	"\x04hits\x18\x01 \x01(\v2\x19.qubic.v2.archive.pb.HitsB-\xbaG*\x92\x02'Information about the returned results.R\x04hits\x12W\n" +
+	"\vempty_ticks\x18\x02 \x03(\rB6\xbaG3\x92\x020The empty ticks of the epoch in ascending order.R\n" +
+	"emptyTicks\"\xf1\x01\n" +
 	"\x15ProcessedTickInterval\x129\n" +
 	"\x05epoch\x18\x01 \x01(\rB#\xbaG \x92\x02\x1dThe epoch the interval is in.R\x05epoch\x12P\n" +
 	"\n" +
@@ -2643,16 +5499,42 @@ const file_messages_proto_rawDesc = "" +
 	"\n" +
 	"Pagination\x12m\n" +
 	"\x06offset\x18\x01 \x01(\rBU\xbaGR\x92\x02OThe offset specifies the starting point of the returned data. Defaults to zero.R\x06offset\x12a\n" +
-	"\x04size\x18\x02 \x01(\rBM\xbaGJ\x92\x02GThe size specifies how many results should be returned. Defaults to 10.R\x04size:H\xbaGE\x92\x02BThe number of maximum results (offset + size) is limited to 10000.\"U\n" +
+	"\x04size\x18\x02 \x01(\rBM\xbaGJ\x92\x02GThe size specifies how many results should be returned. Defaults to 10.R\x04size:H\xbaGE\x92\x02BThe number of maximum results (offset + size) is limited to 10000.\"\xb9\x01\n" +
 	"\x1bGetTransactionByHashRequest\x126\n" +
-	"\x04hash\x18\x01 \x01(\tB\"\xbaG\x1f\x92\x02\x1cThe hash of the transaction.R\x04hash\"\x91\x01\n" +
+	"\x04hash\x18\x01 \x01(\tB\"\xbaG\x1f\x92\x02\x1cThe hash of the transaction.R\x04hash\x12b\n" +
+	"\fdecode_input\x18\x02 \x01(\bB?\xbaG<\x92\x029Decode the input data of known smart contract procedures.R\vdecodeInput\"\x91\x01\n" +
 	"\x1cGetTransactionByHashResponse\x12q\n" +
-	"\vtransaction\x18\x01 \x01(\v2 .qubic.v2.archive.pb.TransactionB-\xbaG*\x92\x02'The transaction for the requested hash.R\vtransaction\"\xa8\x06\n" +
+	"\vtransaction\x18\x01 \x01(\v2 .qubic.v2.archive.pb.TransactionB-\xbaG*\x92\x02'The transaction for the requested hash.R\vtransaction\"\xba\x01\n" +
+	"\x1cGetTransactionDetailsRequest\x126\n" +
+	"\x04hash\x18\x01 \x01(\tB\"\xbaG\x1f\x92\x02\x1cThe hash of the transaction.R\x04hash\x12b\n" +
+	"\fdecode_input\x18\x02 \x01(\bB?\xbaG<\x92\x029Decode the input data of known smart contract procedures.R\vdecodeInput\"\x8f\b\n" +
+	"\x1dGetTransactionDetailsResponse\x12q\n" +
+	"\vtransaction\x18\x01 \x01(\v2 .qubic.v2.archive.pb.TransactionB-\xbaG*\x92\x02'The transaction for the requested hash.R\vtransaction\x12N\n" +
+	"\x05epoch\x18\x02 \x01(\rB8\xbaG5\x92\x022The epoch of the tick. Zero, if the tick is empty.R\x05epoch\x12c\n" +
+	"\x0etick_timestamp\x18\x03 \x01(\x04B<\xbaG9\x92\x026The timestamp of the tick. Zero, if the tick is empty.R\rtickTimestamp\x12y\n" +
+	"\x0ecomputor_index\x18\x04 \x01(\rBR\xbaGO\x92\x02LThe index of the computor that created the tick. Zero, if the tick is empty.R\rcomputorIndex\x12|\n" +
+	"\x10included_in_tick\x18\x05 \x01(\bBR\xbaGO\x92\x02LTrue, if the transaction hash is part of the transaction hashes of the tick.R\x0eincludedInTick\x12\x82\x01\n" +
+	"\x14event_logs_available\x18\x06 \x01(\bBP\xbaGM\x92\x02JFalse, if the tick of the transaction is not processed yet for event logs.R\x12eventLogsAvailable\x12\x83\x01\n" +
+	"\n" +
+	"event_logs\x18\a \x03(\v2\x1a.qubic.v2.archive.pb.EventBH\xbaGE\x92\x02BList of event logs of the transaction ordered by log id ascending.R\teventLogs\x12f\n" +
+	"\x13has_more_event_logs\x18\b \x01(\bB7\xbaG4\x92\x021True, if there are more event logs than returned.R\x10hasMoreEventLogs\x12Z\n" +
+	"\x0evalid_for_tick\x18\t \x01(\rB4\xbaG1\x92\x02.The event logs are valid for this tick number.R\fvalidForTick\"\xed\x02\n" +
+	"\x18VerifyTransactionRequest\x12}\n" +
+	"\x04hash\x18\x01 \x01(\tBi\xbaGf\x92\x02cThe hash of an archived transaction that should be verified. Ignored, if a transaction is supplied.R\x04hash\x12\xd1\x01\n" +
+	"\vtransaction\x18\x02 \x01(\v2 .qubic.v2.archive.pb.TransactionB\x8c\x01\xbaG\x88\x01\x92\x02\x84\x01A transaction that should be verified. Only the hash, source, destination, amount, tick number, input and signature fields are used.R\vtransaction\"\xc7\x03\n" +
+	"\x19VerifyTransactionResponse\x12?\n" +
+	"\x04hash\x18\x01 \x01(\tB+\xbaG(\x92\x02%The hash of the verified transaction.R\x04hash\x12]\n" +
+	"\x0fcalculated_hash\x18\x02 \x01(\tB4\xbaG1\x92\x02.The hash calculated from the transaction data.R\x0ecalculatedHash\x12f\n" +
+	"\n" +
+	"hash_valid\x18\x03 \x01(\bBG\xbaGD\x92\x02ATrue, if the calculated hash matches the hash of the transaction.R\thashValid\x12i\n" +
+	"\x0fsignature_valid\x18\x04 \x01(\bB@\xbaG=\x92\x02:True, if the transaction is signed by the source identity.R\x0esignatureValid\x127\n" +
+	"\x05valid\x18\x05 \x01(\bB!\xbaG\x1e\x92\x02\x1bTrue, if all checks passed.R\x05valid\"\x8c\a\n" +
 	"\x1dGetTransactionsForTickRequest\x12S\n" +
 	"\vtick_number\x18\x01 \x01(\rB2\xbaG/\x92\x02,The tick number to get the transactions for.R\n" +
 	"tickNumber\x12\xd2\x01\n" +
 	"\afilters\x18\x02 \x03(\v2?.qubic.v2.archive.pb.GetTransactionsForTickRequest.FiltersEntryBw\xbaGt\x92\x02qInclude filters: the value must appear in the matching documents. Allowed: source, destination, amount, inputTypeR\afilters\x12\xb4\x01\n" +
-	"\x06ranges\x18\x03 \x03(\v2>.qubic.v2.archive.pb.GetTransactionsForTickRequest.RangesEntryB\\\xbaGY\x92\x02VRanges restrict the results by a maximum and minimum value. Allowed: amount, inputTypeR\x06ranges\x1a:\n" +
+	"\x06ranges\x18\x03 \x03(\v2>.qubic.v2.archive.pb.GetTransactionsForTickRequest.RangesEntryB\\\xbaGY\x92\x02VRanges restrict the results by a maximum and minimum value. Allowed: amount, inputTypeR\x06ranges\x12b\n" +
+	"\fdecode_input\x18\x04 \x01(\bB?\xbaG<\x92\x029Decode the input data of known smart contract procedures.R\vdecodeInput\x1a:\n" +
 	"\fFiltersEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aU\n" +
@@ -2683,7 +5565,8 @@ const file_messages_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aU\n" +
 	"\vRangesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x120\n" +
-	"\x05value\x18\x02 \x01(\v2\x1a.qubic.v2.archive.pb.RangeR\x05value:\x028\x01\"\xde\t\n" +
+	"\x05value\x18\x02 \x01(\v2\x1a.qubic.v2.archive.pb.RangeR\x05value:\x028\x01\"\xc2\n" +
+	"\n" +
 	"!GetTransactionsForIdentityRequest\x12\x86\x01\n" +
 	"\bidentity\x18\x01 \x01(\tBj\xbaGg\x92\x02dThe identity to get the transactions for. Incoming and outgoing transactions are queried by default.R\bidentity\x12\xa6\x01\n" +
 	"\afilters\x18\x02 \x03(\v2C.qubic.v2.archive.pb.GetTransactionsForIdentityRequest.FiltersEntryBG\xbaGD\x92\x02AInclude filters: the value must appear in the matching documents.R\afilters\x12\xaa\x01\n" +
@@ -2691,7 +5574,9 @@ const file_messages_proto_rawDesc = "" +
 	"\x06ranges\x18\x06 \x03(\v2B.qubic.v2.archive.pb.GetTransactionsForIdentityRequest.RangesEntryBD\xbaGA\x92\x02>Ranges restrict the results by a maximum and/or minimum value.R\x06ranges\x12d\n" +
 	"\n" +
 	"pagination\x18\t \x01(\v2\x1f.qubic.v2.archive.pb.PaginationB#\xbaG \x92\x02\x1dOptional paging information .R\n" +
-	"pagination\x1a:\n" +
+	"pagination\x12b\n" +
+	"\fdecode_input\x18\n" +
+	" \x01(\bB?\xbaG<\x92\x029Decode the input data of known smart contract procedures.R\vdecodeInput\x1a:\n" +
 	"\fFiltersEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a:\n" +
@@ -2720,9 +5605,35 @@ const file_messages_proto_rawDesc = "" +
 	"\ftransactions\x18\x03 \x03(\v2 .qubic.v2.archive.pb.TransactionB<\xbaG9\x92\x026List of transactions that matched the search criteria.R\ftransactions\"o\n" +
 	"\x12GetTickDataRequest\x12Y\n" +
 	"\vtick_number\x18\x01 \x01(\rB8\xbaG5\x92\x022The number of tick the tick data is requested for.R\n" +
-	"tickNumber\"}\n" +
+	"tickNumber\"\xcd\x01\n" +
 	"\x13GetTickDataResponse\x12f\n" +
-	"\ttick_data\x18\x01 \x01(\v2\x1d.qubic.v2.archive.pb.TickDataB*\xbaG'\x92\x02$The tick data of the requested tick.R\btickData\"\xbf\x01\n" +
+	"\ttick_data\x18\x01 \x01(\v2\x1d.qubic.v2.archive.pb.TickDataB*\xbaG'\x92\x02$The tick data of the requested tick.R\btickData\x12N\n" +
+	"\bis_empty\x18\x02 \x01(\bB3\xbaG0\x92\x02-True, if there is no tick data for this tick.R\aisEmpty\"\xd3\x01\n" +
+	"\x17GetTickDataRangeRequest\x12M\n" +
+	"\n" +
+	"start_tick\x18\x01 \x01(\rB.\xbaG+\x92\x02(The first tick of the range (inclusive).R\tstartTick\x12i\n" +
+	"\bend_tick\x18\x02 \x01(\rBN\xbaGK\x92\x02HThe last tick of the range (inclusive). Maximum range size is 100 ticks.R\aendTick\"\xf5\x02\n" +
+	"\rRangeTickData\x12\x1f\n" +
+	"\vtick_number\x18\x01 \x01(\rR\n" +
+	"tickNumber\x12N\n" +
+	"\bis_empty\x18\x02 \x01(\bB3\xbaG0\x92\x02-True, if there is no tick data for this tick.R\aisEmpty\x12|\n" +
+	"\n" +
+	"is_skipped\x18\x03 \x01(\bB]\xbaGZ\x92\x02WTrue, if the tick was skipped by the archive (not within the processed tick intervals).R\tisSkipped\x12u\n" +
+	"\ttick_data\x18\x04 \x01(\v2\x1d.qubic.v2.archive.pb.TickDataB9\xbaG6\x92\x023The tick data. Not set for empty and skipped ticks.R\btickData\"\x8f\x01\n" +
+	"\x18GetTickDataRangeResponse\x12s\n" +
+	"\x05ticks\x18\x01 \x03(\v2\".qubic.v2.archive.pb.RangeTickDataB9\xbaG6\x92\x023One entry per tick of the range in ascending order.R\x05ticks\"k\n" +
+	"\x11VerifyTickRequest\x12V\n" +
+	"\vtick_number\x18\x01 \x01(\rB5\xbaG2\x92\x02/The number of the tick that should be verified.R\n" +
+	"tickNumber\"\x8d\x06\n" +
+	"\x12VerifyTickResponse\x12\x1f\n" +
+	"\vtick_number\x18\x01 \x01(\rR\n" +
+	"tickNumber\x12n\n" +
+	"\bis_empty\x18\x02 \x01(\bBS\xbaGP\x92\x02MTrue, if there is no tick data for this tick. Empty ticks cannot be verified.R\aisEmpty\x12\x8c\x01\n" +
+	"\x1dcomputor_list_signature_valid\x18\x03 \x01(\bBI\xbaGF\x92\x02CTrue, if the computor list of the tick is signed by the arbitrator.R\x1acomputorListSignatureValid\x12\x81\x01\n" +
+	"\x14tick_signature_valid\x18\x04 \x01(\bBO\xbaGL\x92\x02ITrue, if the tick data is signed by the tick leader of the computor list.R\x12tickSignatureValid\x12\x83\x01\n" +
+	"\x15transactions_complete\x18\x05 \x01(\bBN\xbaGK\x92\x02HTrue, if all transactions of the tick data are available in the archive.R\x14transactionsComplete\x12\x93\x01\n" +
+	"\x1amissing_transaction_hashes\x18\x06 \x03(\tBU\xbaGR\x92\x02OThe hashes of the tick data transactions that are not available in the archive.R\x18missingTransactionHashes\x127\n" +
+	"\x05valid\x18\a \x01(\bB!\xbaG\x1e\x92\x02\x1bTrue, if all checks passed.R\x05valid\"\xbf\x01\n" +
 	"!GetProcessedTickIntervalsResponse\x12\x99\x01\n" +
 	"\x18processed_tick_intervals\x18\x01 \x03(\v2*.qubic.v2.archive.pb.ProcessedTickIntervalB3\xbaG0\x92\x02-A list of tick intervals that were processed.R\x16processedTickIntervals\"\x96\x03\n" +
 	"\x1cGetLastProcessedTickResponse\x12`\n" +
@@ -2742,7 +5653,99 @@ const file_messages_proto_rawDesc = "" +
 	"identities\x12@\n" +
 	"\tsignature\x18\x04 \x01(\tB\"\xbaG\x1f\x92\x02\x1cSignature of the arbitrator.R\tsignature\"\xa7\x01\n" +
 	" GetComputorListsForEpochResponse\x12\x82\x01\n" +
-	"\x0fcomputors_lists\x18\x01 \x03(\v2!.qubic.v2.archive.pb.ComputorListB6\xbaG3\x92\x020The lists of computors that voted in this epoch.R\x0ecomputorsLists\"J\n" +
+	"\x0fcomputors_lists\x18\x01 \x03(\v2!.qubic.v2.archive.pb.ComputorListB6\xbaG3\x92\x020The lists of computors that voted in this epoch.R\x0ecomputorsLists\"_\n" +
+	"\x1cGetComputorMembershipRequest\x12?\n" +
+	"\bidentity\x18\x01 \x01(\tB#\xbaG \x92\x02\x1dThe identity of the computor.R\bidentity\"\xa5\x02\n" +
+	"\x12ComputorMembership\x12;\n" +
+	"\x05epoch\x18\x01 \x01(\rB%\xbaG\"\x92\x02\x1fThe epoch of the computor list.R\x05epoch\x12t\n" +
+	"\vtick_number\x18\x02 \x01(\rBS\xbaGP\x92\x02MTick number when the list was received by the archive after it was published.R\n" +
+	"tickNumber\x12\\\n" +
+	"\x0ecomputor_index\x18\x03 \x01(\rB5\xbaG2\x92\x02/The index of the identity in the computor list.R\rcomputorIndex\"\xc0\x01\n" +
+	"\x1dGetComputorMembershipResponse\x12\x9e\x01\n" +
+	"\vmemberships\x18\x01 \x03(\v2'.qubic.v2.archive.pb.ComputorMembershipBS\xbaGP\x92\x02MThe computor lists the identity is part of ordered by tick number descending.R\vmemberships\"\xd8\x02\n" +
+	"$GetComputorListsForEpochRangeRequest\x12P\n" +
+	"\vstart_epoch\x18\x01 \x01(\rB/\xbaG,\x92\x02)The first epoch of the range (inclusive).R\n" +
+	"startEpoch\x12l\n" +
+	"\tend_epoch\x18\x02 \x01(\rBO\xbaGL\x92\x02IThe last epoch of the range (inclusive). Maximum range size is 50 epochs.R\bendEpoch\x12p\n" +
+	"\n" +
+	"diffs_only\x18\x03 \x01(\bBQ\xbaGN\x92\x02KOnly return the differences between the lists and not the lists themselves.R\tdiffsOnly\"\xee\x03\n" +
+	"\x10ComputorListDiff\x122\n" +
+	"\x05epoch\x18\x01 \x01(\rB\x1c\xbaG\x19\x92\x02\x16The epoch of the list.R\x05epoch\x12C\n" +
+	"\vtick_number\x18\x02 \x01(\rB\"\xbaG\x1f\x92\x02\x1cThe tick number of the list.R\n" +
+	"tickNumber\x12L\n" +
+	"\x0eprevious_epoch\x18\x03 \x01(\rB%\xbaG\"\x92\x02\x1fThe epoch of the previous list.R\rpreviousEpoch\x12]\n" +
+	"\x14previous_tick_number\x18\x04 \x01(\rB+\xbaG(\x92\x02%The tick number of the previous list.R\x12previousTickNumber\x12[\n" +
+	"\x06joined\x18\x05 \x03(\tBC\xbaG@\x92\x02=Identities that are in the list but not in the previous list.R\x06joined\x12W\n" +
+	"\x04left\x18\x06 \x03(\tBC\xbaG@\x92\x02=Identities that are in the previous list but not in the list.R\x04left\"\x81\x03\n" +
+	"%GetComputorListsForEpochRangeResponse\x12\xbd\x01\n" +
+	"\x0fcomputors_lists\x18\x01 \x03(\v2!.qubic.v2.archive.pb.ComputorListBq\xbaGn\x92\x02kThe computor lists of the epoch range ordered by tick number ascending. Empty, if only diffs are requested.R\x0ecomputorsLists\x12\x97\x01\n" +
+	"\x05diffs\x18\x02 \x03(\v2%.qubic.v2.archive.pb.ComputorListDiffBZ\xbaGW\x92\x02TThe differences between consecutive computor lists ordered by tick number ascending.R\x05diffs\"m\n" +
+	"\x1bGetComputorTickStatsRequest\x12N\n" +
+	"\x05epoch\x18\x01 \x01(\rB8\xbaG5\x92\x022The epoch to get the computor tick statistics for.R\x05epoch\"\xd2\x03\n" +
+	"\x11ComputorTickStats\x12\\\n" +
+	"\x0ecomputor_index\x18\x01 \x01(\rB5\xbaG2\x92\x02/The index of the computor in the computor list.R\rcomputorIndex\x12\x8d\x01\n" +
+	"\bidentity\x18\x02 \x01(\tBq\xbaGn\x92\x02kThe identity of the computor in the latest computor list of the epoch. Empty, if there is no computor list.R\bidentity\x12o\n" +
+	"\x0eproduced_ticks\x18\x03 \x01(\rBH\xbaGE\x92\x02BNumber of ticks with tick data that were produced by the computor.R\rproducedTicks\x12^\n" +
+	"\vempty_ticks\x18\x04 \x01(\rB=\xbaG:\x92\x027Number of empty ticks the computor was tick leader for.R\n" +
+	"emptyTicks\"\xbe\x01\n" +
+	"\x1cGetComputorTickStatsResponse\x12\x14\n" +
+	"\x05epoch\x18\x01 \x01(\rR\x05epoch\x12\x87\x01\n" +
+	"\tcomputors\x18\x02 \x03(\v2&.qubic.v2.archive.pb.ComputorTickStatsBA\xbaG>\x92\x02;The tick statistics per computor ordered by computor index.R\tcomputors\"W\n" +
+	"\x16GetEpochSummaryRequest\x12=\n" +
+	"\x05epoch\x18\x01 \x01(\rB'\xbaG$\x92\x02!The epoch to get the summary for.R\x05epoch\"\x86\x01\n" +
+	"\x0eEventTypeCount\x124\n" +
+	"\blog_type\x18\x01 \x01(\rB\x19\xbaG\x16\x92\x02\x13The event log type.R\alogType\x12>\n" +
+	"\x05count\x18\x02 \x01(\x04B(\xbaG%\x92\x02\"Number of event logs of this type.R\x05count\"\xe2\n" +
+	"\n" +
+	"\fEpochSummary\x12\x14\n" +
+	"\x05epoch\x18\x01 \x01(\rR\x05epoch\x12\x86\x01\n" +
+	"\x06closed\x18\x02 \x01(\bBn\xbaGk\x92\x02hTrue, if the epoch is closed and fully processed. The summary of a closed epoch does not change anymore.R\x06closed\x12\x83\x01\n" +
+	"\x0etick_intervals\x18\x03 \x03(\v2*.qubic.v2.archive.pb.ProcessedTickIntervalB0\xbaG-\x92\x02*The processed tick intervals of the epoch.R\rtickIntervals\x12C\n" +
+	"\n" +
+	"first_tick\x18\x04 \x01(\rB$\xbaG!\x92\x02\x1eThe first tick with tick data.R\tfirstTick\x12g\n" +
+	"\x14first_tick_timestamp\x18\x05 \x01(\x04B5\xbaG2\x92\x02/The timestamp of the first tick with tick data.R\x12firstTickTimestamp\x12@\n" +
+	"\tlast_tick\x18\x06 \x01(\rB#\xbaG \x92\x02\x1dThe last tick with tick data.R\blastTick\x12d\n" +
+	"\x13last_tick_timestamp\x18\a \x01(\x04B4\xbaG1\x92\x02.The timestamp of the last tick with tick data.R\x11lastTickTimestamp\x12_\n" +
+	"\x0ftick_data_count\x18\b \x01(\x04B7\xbaG4\x92\x021Number of ticks with tick data (non empty ticks).R\rtickDataCount\x12J\n" +
+	"\x11transaction_count\x18\t \x01(\x04B\x1d\xbaG\x1a\x92\x02\x17Number of transactions.R\x10transactionCount\x12Z\n" +
+	"\x12transaction_volume\x18\n" +
+	" \x01(\x04B+\xbaG(\x92\x02%Sum of the transaction amounts in QU.R\x11transactionVolume\x12\x84\x01\n" +
+	"\fevent_counts\x18\v \x03(\v2#.qubic.v2.archive.pb.EventTypeCountB<\xbaG9\x92\x026Number of event logs per log type ordered by log type.R\veventCounts\x12\\\n" +
+	"\rburned_amount\x18\f \x01(\x04B7\xbaG4\x92\x021Sum of the burned amounts (burning events) in QU.R\fburnedAmount\x12t\n" +
+	"\rcomputor_list\x18\r \x01(\v2!.qubic.v2.archive.pb.ComputorListB,\xbaG)\x92\x02&The latest computor list of the epoch.R\fcomputorList\x12s\n" +
+	"\x15events_valid_for_tick\x18\x0e \x01(\rB@\xbaG=\x92\x02:The event log information is valid up to this tick number.R\x12eventsValidForTick\"V\n" +
+	"\x17GetEpochSummaryResponse\x12;\n" +
+	"\asummary\x18\x01 \x01(\v2!.qubic.v2.archive.pb.EpochSummaryR\asummary\"\xf2\x01\n" +
+	"\x1aGetIdentityOverviewRequest\x12G\n" +
+	"\bidentity\x18\x01 \x01(\tB+\xbaG(\x92\x02%The identity to get the overview for.R\bidentity\x12\x8a\x01\n" +
+	"\x12top_counterparties\x18\x02 \x01(\rB[\xbaGX\x92\x02UNumber of top counterparties to return per direction. Defaults to 10. Maximum is 100.R\x11topCounterparties\"\xe7\x01\n" +
+	"\fCounterparty\x12\x1a\n" +
+	"\bidentity\x18\x01 \x01(\tR\bidentity\x12`\n" +
+	"\x11transaction_count\x18\x02 \x01(\x04B3\xbaG0\x92\x02-Number of transactions with the counterparty.R\x10transactionCount\x12Y\n" +
+	"\x06volume\x18\x03 \x01(\x04BA\xbaG>\x92\x02;Sum of the transaction amounts with the counterparty in QU.R\x06volume\"Q\n" +
+	"\rIdentityAsset\x12!\n" +
+	"\fasset_issuer\x18\x01 \x01(\tR\vassetIssuer\x12\x1d\n" +
+	"\n" +
+	"asset_name\x18\x02 \x01(\tR\tassetName\"\xdb\x0e\n" +
+	"\x10IdentityOverview\x12\x1a\n" +
+	"\bidentity\x18\x01 \x01(\tR\bidentity\x12\\\n" +
+	"\x0evalid_for_tick\x18\x02 \x01(\rB6\xbaG3\x92\x020All information is valid up to this tick number.R\fvalidForTick\x12\x82\x01\n" +
+	"\x0ffirst_seen_tick\x18\x03 \x01(\rBZ\xbaGW\x92\x02TThe tick of the first transaction of the identity. Zero, if there is no transaction.R\rfirstSeenTick\x12o\n" +
+	"\x14first_seen_timestamp\x18\x04 \x01(\x04B=\xbaG:\x92\x027The timestamp of the first transaction of the identity.R\x12firstSeenTimestamp\x12\x7f\n" +
+	"\x0elast_seen_tick\x18\x05 \x01(\rBY\xbaGV\x92\x02SThe tick of the last transaction of the identity. Zero, if there is no transaction.R\flastSeenTick\x12l\n" +
+	"\x13last_seen_timestamp\x18\x06 \x01(\x04B<\xbaG9\x92\x026The timestamp of the last transaction of the identity.R\x11lastSeenTimestamp\x12|\n" +
+	"\x1aincoming_transaction_count\x18\a \x01(\x04B>\xbaG;\x92\x028Number of transactions with the identity as destination.R\x18incomingTransactionCount\x12w\n" +
+	"\x1aoutgoing_transaction_count\x18\b \x01(\x04B9\xbaG6\x92\x023Number of transactions with the identity as source.R\x18outgoingTransactionCount\x12\xa6\x01\n" +
+	"\x1btop_incoming_counterparties\x18\t \x03(\v2!.qubic.v2.archive.pb.CounterpartyBC\xbaG@\x92\x02=The sources of incoming transactions with the highest volume.R\x19topIncomingCounterparties\x12\xab\x01\n" +
+	"\x1btop_outgoing_counterparties\x18\n" +
+	" \x03(\v2!.qubic.v2.archive.pb.CounterpartyBH\xbaGE\x92\x02BThe destinations of outgoing transactions with the highest volume.R\x19topOutgoingCounterparties\x12\x83\x01\n" +
+	"\x1bqu_transfers_received_count\x18\v \x01(\x04BD\xbaGA\x92\x02>Number of QU transfer events with the identity as destination.R\x18quTransfersReceivedCount\x12\x8d\x01\n" +
+	"\x1cqu_transfers_received_amount\x18\f \x01(\x04BL\xbaGI\x92\x02FSum of the QU transfer event amounts with the identity as destination.R\x19quTransfersReceivedAmount\x12v\n" +
+	"\x17qu_transfers_sent_count\x18\r \x01(\x04B?\xbaG<\x92\x029Number of QU transfer events with the identity as source.R\x14quTransfersSentCount\x12\x80\x01\n" +
+	"\x18qu_transfers_sent_amount\x18\x0e \x01(\x04BG\xbaGD\x92\x02ASum of the QU transfer event amounts with the identity as source.R\x15quTransfersSentAmount\x12\x88\x01\n" +
+	"\x06assets\x18\x0f \x03(\v2\".qubic.v2.archive.pb.IdentityAssetBL\xbaGI\x92\x02FThe assets the identity interacted with in asset events (maximum 100).R\x06assets\"`\n" +
+	"\x1bGetIdentityOverviewResponse\x12A\n" +
+	"\boverview\x18\x01 \x01(\v2%.qubic.v2.archive.pb.IdentityOverviewR\boverview\"J\n" +
 	"\x0eHealthResponse\x128\n" +
 	"\x06status\x18\x01 \x01(\tB \xbaG\x1d\x92\x02\x1aHealth status information.R\x06status\"b\n" +
 	"\x0eQuTransferData\x12\x16\n" +
@@ -2858,7 +5861,30 @@ const file_messages_proto_rawDesc = "" +
 	"\x04hits\x18\x01 \x01(\v2\x19.qubic.v2.archive.pb.HitsB-\xbaG*\x92\x02'Information about the returned results.R\x04hits\x12u\n" +
 	"\n" +
 	"event_logs\x18\x02 \x03(\v2\x1a.qubic.v2.archive.pb.EventB:\xbaG7\x92\x024List of event logs that matched the search criteria.R\teventLogs\x12W\n" +
-	"\x0evalid_for_tick\x18\x03 \x01(\rB1\xbaG.\x92\x02+The response is valid for this tick number.R\fvalidForTickB,Z*github.com/qubic/archive-query-service/apib\x06proto3"
+	"\x0evalid_for_tick\x18\x03 \x01(\rB1\xbaG.\x92\x02+The response is valid for this tick number.R\fvalidForTick\"\x9d\x01\n" +
+	"\x12GetEventLogRequest\x127\n" +
+	"\x05epoch\x18\x01 \x01(\rB!\xbaG\x1e\x92\x02\x1bThe epoch of the event log.R\x05epoch\x12N\n" +
+	"\x06log_id\x18\x02 \x01(\x04B7\xbaG4\x92\x021The id of the event log. Unique within one epoch.R\x05logId\"\x87\x01\n" +
+	"\x13GetEventLogResponse\x12p\n" +
+	"\tevent_log\x18\x01 \x01(\v2\x1a.qubic.v2.archive.pb.EventB7\xbaG4\x92\x021The event log for the requested epoch and log id.R\beventLog\"\xb7\x03\n" +
+	"!GetEventLogsForTransactionRequest\x12M\n" +
+	"\x10transaction_hash\x18\x01 \x01(\tB\"\xbaG\x1f\x92\x02\x1cThe hash of the transaction.R\x0ftransactionHash\x12{\n" +
+	"\fafter_log_id\x18\x02 \x01(\x04BT\xbaGQ\x92\x02NOnly return event logs with a log id greater than this value. Used for paging.H\x00R\n" +
+	"afterLogId\x88\x01\x01\x12U\n" +
+	"\x04size\x18\x03 \x01(\rBA\xbaG>\x92\x02;The maximum number of event logs to return. Defaults to 10.R\x04size:^\xbaG[:Y\x12WtransactionHash: zvqvtjzvgwgpegmalkkjedhbdrnckqcfthpzfqzxbcljttljzidmvaxalxyz\n" +
+	"size: 100B\x0f\n" +
+	"\r_after_log_id\"\xbb\x03\n" +
+	"\"GetEventLogsForTransactionResponse\x12W\n" +
+	"\x0evalid_for_tick\x18\x01 \x01(\rB1\xbaG.\x92\x02+The response is valid for this tick number.R\fvalidForTick\x12J\n" +
+	"\vtick_number\x18\x02 \x01(\rB)\xbaG&\x92\x02#The tick number of the transaction.R\n" +
+	"tickNumber\x12\x83\x01\n" +
+	"\n" +
+	"event_logs\x18\x03 \x03(\v2\x1a.qubic.v2.archive.pb.EventBH\xbaGE\x92\x02BList of event logs of the transaction ordered by log id ascending.R\teventLogs\x12j\n" +
+	"\bhas_more\x18\x04 \x01(\bBO\xbaGL\x92\x02ITrue, if there are more event logs available after the last returned one.R\ahasMore*q\n" +
+	"\x0fExecutionStatus\x12\x1c\n" +
+	"\x18EXECUTION_STATUS_UNKNOWN\x10\x00\x12\x1d\n" +
+	"\x19EXECUTION_STATUS_EXECUTED\x10\x01\x12!\n" +
+	"\x1dEXECUTION_STATUS_NOT_EXECUTED\x10\x02B,Z*github.com/qubic/archive-query-service/apib\x06proto3"
 
 var (
 	file_messages_proto_rawDescOnce sync.Once
@@ -2872,97 +5898,172 @@ func file_messages_proto_rawDescGZIP() []byte {
 	return file_messages_proto_rawDescData
 }
 
-var file_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
+var file_messages_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 88)
 var file_messages_proto_goTypes = []any{
-	(*LastProcessedTick)(nil),                         // 0: qubic.v2.archive.pb.LastProcessedTick
-	(*NextAvailableTick)(nil),                         // 1: qubic.v2.archive.pb.NextAvailableTick
-	(*Transaction)(nil),                               // 2: qubic.v2.archive.pb.Transaction
-	(*TickData)(nil),                                  // 3: qubic.v2.archive.pb.TickData
-	(*ProcessedTickInterval)(nil),                     // 4: qubic.v2.archive.pb.ProcessedTickInterval
-	(*Pagination)(nil),                                // 5: qubic.v2.archive.pb.Pagination
-	(*GetTransactionByHashRequest)(nil),               // 6: qubic.v2.archive.pb.GetTransactionByHashRequest
-	(*GetTransactionByHashResponse)(nil),              // 7: qubic.v2.archive.pb.GetTransactionByHashResponse
-	(*GetTransactionsForTickRequest)(nil),             // 8: qubic.v2.archive.pb.GetTransactionsForTickRequest
-	(*GetTransactionsForTickResponse)(nil),            // 9: qubic.v2.archive.pb.GetTransactionsForTickResponse
-	(*Range)(nil),                                     // 10: qubic.v2.archive.pb.Range
-	(*ShouldFilter)(nil),                              // 11: qubic.v2.archive.pb.ShouldFilter
-	(*GetTransactionsForIdentityRequest)(nil),         // 12: qubic.v2.archive.pb.GetTransactionsForIdentityRequest
-	(*Hits)(nil),                                      // 13: qubic.v2.archive.pb.Hits
-	(*GetTransactionsForIdentityResponse)(nil),        // 14: qubic.v2.archive.pb.GetTransactionsForIdentityResponse
-	(*GetTickDataRequest)(nil),                        // 15: qubic.v2.archive.pb.GetTickDataRequest
-	(*GetTickDataResponse)(nil),                       // 16: qubic.v2.archive.pb.GetTickDataResponse
-	(*GetProcessedTickIntervalsResponse)(nil),         // 17: qubic.v2.archive.pb.GetProcessedTickIntervalsResponse
-	(*GetLastProcessedTickResponse)(nil),              // 18: qubic.v2.archive.pb.GetLastProcessedTickResponse
-	(*GetComputorListsForEpochRequest)(nil),           // 19: qubic.v2.archive.pb.GetComputorListsForEpochRequest
-	(*ComputorList)(nil),                              // 20: qubic.v2.archive.pb.ComputorList
-	(*GetComputorListsForEpochResponse)(nil),          // 21: qubic.v2.archive.pb.GetComputorListsForEpochResponse
-	(*HealthResponse)(nil),                            // 22: qubic.v2.archive.pb.HealthResponse
-	(*QuTransferData)(nil),                            // 23: qubic.v2.archive.pb.QuTransferData
-	(*AssetIssuanceData)(nil),                         // 24: qubic.v2.archive.pb.AssetIssuanceData
-	(*AssetOwnershipChangeData)(nil),                  // 25: qubic.v2.archive.pb.AssetOwnershipChangeData
-	(*AssetPossessionChangeData)(nil),                 // 26: qubic.v2.archive.pb.AssetPossessionChangeData
-	(*BurningData)(nil),                               // 27: qubic.v2.archive.pb.BurningData
-	(*ContractReserveDeductionData)(nil),              // 28: qubic.v2.archive.pb.ContractReserveDeductionData
-	(*SmartContractMessageData)(nil),                  // 29: qubic.v2.archive.pb.SmartContractMessageData
-	(*CustomMessageData)(nil),                         // 30: qubic.v2.archive.pb.CustomMessageData
-	(*AssetOwnershipManagingContractChangeData)(nil),  // 31: qubic.v2.archive.pb.AssetOwnershipManagingContractChangeData
-	(*AssetPossessionManagingContractChangeData)(nil), // 32: qubic.v2.archive.pb.AssetPossessionManagingContractChangeData
-	(*Event)(nil),                                     // 33: qubic.v2.archive.pb.Event
-	(*GetEventLogsRequest)(nil),                       // 34: qubic.v2.archive.pb.GetEventLogsRequest
-	(*GetEventLogsResponse)(nil),                      // 35: qubic.v2.archive.pb.GetEventLogsResponse
-	nil,                                               // 36: qubic.v2.archive.pb.GetTransactionsForTickRequest.FiltersEntry
-	nil,                                               // 37: qubic.v2.archive.pb.GetTransactionsForTickRequest.RangesEntry
-	nil,                                               // 38: qubic.v2.archive.pb.ShouldFilter.TermsEntry
-	nil,                                               // 39: qubic.v2.archive.pb.ShouldFilter.RangesEntry
-	nil,                                               // 40: qubic.v2.archive.pb.GetTransactionsForIdentityRequest.FiltersEntry
-	nil,                                               // 41: qubic.v2.archive.pb.GetTransactionsForIdentityRequest.ExcludeEntry
-	nil,                                               // 42: qubic.v2.archive.pb.GetTransactionsForIdentityRequest.RangesEntry
-	nil,                                               // 43: qubic.v2.archive.pb.GetEventLogsRequest.FiltersEntry
-	nil,                                               // 44: qubic.v2.archive.pb.GetEventLogsRequest.ExcludeEntry
-	nil,                                               // 45: qubic.v2.archive.pb.GetEventLogsRequest.RangesEntry
+	(ExecutionStatus)(0),                              // 0: qubic.v2.archive.pb.ExecutionStatus
+	(*LastProcessedTick)(nil),                         // 1: qubic.v2.archive.pb.LastProcessedTick
+	(*NextAvailableTick)(nil),                         // 2: qubic.v2.archive.pb.NextAvailableTick
+	(*Transaction)(nil),                               // 3: qubic.v2.archive.pb.Transaction
+	(*DecodedInput)(nil),                              // 4: qubic.v2.archive.pb.DecodedInput
+	(*SendManyInput)(nil),                             // 5: qubic.v2.archive.pb.SendManyInput
+	(*SendManyTransfer)(nil),                          // 6: qubic.v2.archive.pb.SendManyTransfer
+	(*QxIssueAssetInput)(nil),                         // 7: qubic.v2.archive.pb.QxIssueAssetInput
+	(*QxTransferShareInput)(nil),                      // 8: qubic.v2.archive.pb.QxTransferShareInput
+	(*QxOrderInput)(nil),                              // 9: qubic.v2.archive.pb.QxOrderInput
+	(*TickData)(nil),                                  // 10: qubic.v2.archive.pb.TickData
+	(*EpochTick)(nil),                                 // 11: qubic.v2.archive.pb.EpochTick
+	(*GetTicksForEpochRequest)(nil),                   // 12: qubic.v2.archive.pb.GetTicksForEpochRequest
+	(*GetTicksForEpochResponse)(nil),                  // 13: qubic.v2.archive.pb.GetTicksForEpochResponse
+	(*GetEmptyTicksForEpochRequest)(nil),              // 14: qubic.v2.archive.pb.GetEmptyTicksForEpochRequest
+	(*GetEmptyTicksForEpochResponse)(nil),             // 15: qubic.v2.archive.pb.GetEmptyTicksForEpochResponse
+	(*ProcessedTickInterval)(nil),                     // 16: qubic.v2.archive.pb.ProcessedTickInterval
+	(*Pagination)(nil),                                // 17: qubic.v2.archive.pb.Pagination
+	(*GetTransactionByHashRequest)(nil),               // 18: qubic.v2.archive.pb.GetTransactionByHashRequest
+	(*GetTransactionByHashResponse)(nil),              // 19: qubic.v2.archive.pb.GetTransactionByHashResponse
+	(*GetTransactionDetailsRequest)(nil),              // 20: qubic.v2.archive.pb.GetTransactionDetailsRequest
+	(*GetTransactionDetailsResponse)(nil),             // 21: qubic.v2.archive.pb.GetTransactionDetailsResponse
+	(*VerifyTransactionRequest)(nil),                  // 22: qubic.v2.archive.pb.VerifyTransactionRequest
+	(*VerifyTransactionResponse)(nil),                 // 23: qubic.v2.archive.pb.VerifyTransactionResponse
+	(*GetTransactionsForTickRequest)(nil),             // 24: qubic.v2.archive.pb.GetTransactionsForTickRequest
+	(*GetTransactionsForTickResponse)(nil),            // 25: qubic.v2.archive.pb.GetTransactionsForTickResponse
+	(*Range)(nil),                                     // 26: qubic.v2.archive.pb.Range
+	(*ShouldFilter)(nil),                              // 27: qubic.v2.archive.pb.ShouldFilter
+	(*GetTransactionsForIdentityRequest)(nil),         // 28: qubic.v2.archive.pb.GetTransactionsForIdentityRequest
+	(*Hits)(nil),                                      // 29: qubic.v2.archive.pb.Hits
+	(*GetTransactionsForIdentityResponse)(nil),        // 30: qubic.v2.archive.pb.GetTransactionsForIdentityResponse
+	(*GetTickDataRequest)(nil),                        // 31: qubic.v2.archive.pb.GetTickDataRequest
+	(*GetTickDataResponse)(nil),                       // 32: qubic.v2.archive.pb.GetTickDataResponse
+	(*GetTickDataRangeRequest)(nil),                   // 33: qubic.v2.archive.pb.GetTickDataRangeRequest
+	(*RangeTickData)(nil),                             // 34: qubic.v2.archive.pb.RangeTickData
+	(*GetTickDataRangeResponse)(nil),                  // 35: qubic.v2.archive.pb.GetTickDataRangeResponse
+	(*VerifyTickRequest)(nil),                         // 36: qubic.v2.archive.pb.VerifyTickRequest
+	(*VerifyTickResponse)(nil),                        // 37: qubic.v2.archive.pb.VerifyTickResponse
+	(*GetProcessedTickIntervalsResponse)(nil),         // 38: qubic.v2.archive.pb.GetProcessedTickIntervalsResponse
+	(*GetLastProcessedTickResponse)(nil),              // 39: qubic.v2.archive.pb.GetLastProcessedTickResponse
+	(*GetComputorListsForEpochRequest)(nil),           // 40: qubic.v2.archive.pb.GetComputorListsForEpochRequest
+	(*ComputorList)(nil),                              // 41: qubic.v2.archive.pb.ComputorList
+	(*GetComputorListsForEpochResponse)(nil),          // 42: qubic.v2.archive.pb.GetComputorListsForEpochResponse
+	(*GetComputorMembershipRequest)(nil),              // 43: qubic.v2.archive.pb.GetComputorMembershipRequest
+	(*ComputorMembership)(nil),                        // 44: qubic.v2.archive.pb.ComputorMembership
+	(*GetComputorMembershipResponse)(nil),             // 45: qubic.v2.archive.pb.GetComputorMembershipResponse
+	(*GetComputorListsForEpochRangeRequest)(nil),      // 46: qubic.v2.archive.pb.GetComputorListsForEpochRangeRequest
+	(*ComputorListDiff)(nil),                          // 47: qubic.v2.archive.pb.ComputorListDiff
+	(*GetComputorListsForEpochRangeResponse)(nil),     // 48: qubic.v2.archive.pb.GetComputorListsForEpochRangeResponse
+	(*GetComputorTickStatsRequest)(nil),               // 49: qubic.v2.archive.pb.GetComputorTickStatsRequest
+	(*ComputorTickStats)(nil),                         // 50: qubic.v2.archive.pb.ComputorTickStats
+	(*GetComputorTickStatsResponse)(nil),              // 51: qubic.v2.archive.pb.GetComputorTickStatsResponse
+	(*GetEpochSummaryRequest)(nil),                    // 52: qubic.v2.archive.pb.GetEpochSummaryRequest
+	(*EventTypeCount)(nil),                            // 53: qubic.v2.archive.pb.EventTypeCount
+	(*EpochSummary)(nil),                              // 54: qubic.v2.archive.pb.EpochSummary
+	(*GetEpochSummaryResponse)(nil),                   // 55: qubic.v2.archive.pb.GetEpochSummaryResponse
+	(*GetIdentityOverviewRequest)(nil),                // 56: qubic.v2.archive.pb.GetIdentityOverviewRequest
+	(*Counterparty)(nil),                              // 57: qubic.v2.archive.pb.Counterparty
+	(*IdentityAsset)(nil),                             // 58: qubic.v2.archive.pb.IdentityAsset
+	(*IdentityOverview)(nil),                          // 59: qubic.v2.archive.pb.IdentityOverview
+	(*GetIdentityOverviewResponse)(nil),               // 60: qubic.v2.archive.pb.GetIdentityOverviewResponse
+	(*HealthResponse)(nil),                            // 61: qubic.v2.archive.pb.HealthResponse
+	(*QuTransferData)(nil),                            // 62: qubic.v2.archive.pb.QuTransferData
+	(*AssetIssuanceData)(nil),                         // 63: qubic.v2.archive.pb.AssetIssuanceData
+	(*AssetOwnershipChangeData)(nil),                  // 64: qubic.v2.archive.pb.AssetOwnershipChangeData
+	(*AssetPossessionChangeData)(nil),                 // 65: qubic.v2.archive.pb.AssetPossessionChangeData
+	(*BurningData)(nil),                               // 66: qubic.v2.archive.pb.BurningData
+	(*ContractReserveDeductionData)(nil),              // 67: qubic.v2.archive.pb.ContractReserveDeductionData
+	(*SmartContractMessageData)(nil),                  // 68: qubic.v2.archive.pb.SmartContractMessageData
+	(*CustomMessageData)(nil),                         // 69: qubic.v2.archive.pb.CustomMessageData
+	(*AssetOwnershipManagingContractChangeData)(nil),  // 70: qubic.v2.archive.pb.AssetOwnershipManagingContractChangeData
+	(*AssetPossessionManagingContractChangeData)(nil), // 71: qubic.v2.archive.pb.AssetPossessionManagingContractChangeData
+	(*Event)(nil),                                     // 72: qubic.v2.archive.pb.Event
+	(*GetEventLogsRequest)(nil),                       // 73: qubic.v2.archive.pb.GetEventLogsRequest
+	(*GetEventLogsResponse)(nil),                      // 74: qubic.v2.archive.pb.GetEventLogsResponse
+	(*GetEventLogRequest)(nil),                        // 75: qubic.v2.archive.pb.GetEventLogRequest
+	(*GetEventLogResponse)(nil),                       // 76: qubic.v2.archive.pb.GetEventLogResponse
+	(*GetEventLogsForTransactionRequest)(nil),         // 77: qubic.v2.archive.pb.GetEventLogsForTransactionRequest
+	(*GetEventLogsForTransactionResponse)(nil),        // 78: qubic.v2.archive.pb.GetEventLogsForTransactionResponse
+	nil, // 79: qubic.v2.archive.pb.GetTransactionsForTickRequest.FiltersEntry
+	nil, // 80: qubic.v2.archive.pb.GetTransactionsForTickRequest.RangesEntry
+	nil, // 81: qubic.v2.archive.pb.ShouldFilter.TermsEntry
+	nil, // 82: qubic.v2.archive.pb.ShouldFilter.RangesEntry
+	nil, // 83: qubic.v2.archive.pb.GetTransactionsForIdentityRequest.FiltersEntry
+	nil, // 84: qubic.v2.archive.pb.GetTransactionsForIdentityRequest.ExcludeEntry
+	nil, // 85: qubic.v2.archive.pb.GetTransactionsForIdentityRequest.RangesEntry
+	nil, // 86: qubic.v2.archive.pb.GetEventLogsRequest.FiltersEntry
+	nil, // 87: qubic.v2.archive.pb.GetEventLogsRequest.ExcludeEntry
+	nil, // 88: qubic.v2.archive.pb.GetEventLogsRequest.RangesEntry
 }
 var file_messages_proto_depIdxs = []int32{
-	2,  // 0: qubic.v2.archive.pb.GetTransactionByHashResponse.transaction:type_name -> qubic.v2.archive.pb.Transaction
-	36, // 1: qubic.v2.archive.pb.GetTransactionsForTickRequest.filters:type_name -> qubic.v2.archive.pb.GetTransactionsForTickRequest.FiltersEntry
-	37, // 2: qubic.v2.archive.pb.GetTransactionsForTickRequest.ranges:type_name -> qubic.v2.archive.pb.GetTransactionsForTickRequest.RangesEntry
-	2,  // 3: qubic.v2.archive.pb.GetTransactionsForTickResponse.transactions:type_name -> qubic.v2.archive.pb.Transaction
-	38, // 4: qubic.v2.archive.pb.ShouldFilter.terms:type_name -> qubic.v2.archive.pb.ShouldFilter.TermsEntry
-	39, // 5: qubic.v2.archive.pb.ShouldFilter.ranges:type_name -> qubic.v2.archive.pb.ShouldFilter.RangesEntry
-	40, // 6: qubic.v2.archive.pb.GetTransactionsForIdentityRequest.filters:type_name -> qubic.v2.archive.pb.GetTransactionsForIdentityRequest.FiltersEntry
-	41, // 7: qubic.v2.archive.pb.GetTransactionsForIdentityRequest.exclude:type_name -> qubic.v2.archive.pb.GetTransactionsForIdentityRequest.ExcludeEntry
-	42, // 8: qubic.v2.archive.pb.GetTransactionsForIdentityRequest.ranges:type_name -> qubic.v2.archive.pb.GetTransactionsForIdentityRequest.RangesEntry
-	5,  // 9: qubic.v2.archive.pb.GetTransactionsForIdentityRequest.pagination:type_name -> qubic.v2.archive.pb.Pagination
-	13, // 10: qubic.v2.archive.pb.GetTransactionsForIdentityResponse.hits:type_name -> qubic.v2.archive.pb.Hits
-	2,  // 11: qubic.v2.archive.pb.GetTransactionsForIdentityResponse.transactions:type_name -> qubic.v2.archive.pb.Transaction
-	3,  // 12: qubic.v2.archive.pb.GetTickDataResponse.tick_data:type_name -> qubic.v2.archive.pb.TickData
-	4,  // 13: qubic.v2.archive.pb.GetProcessedTickIntervalsResponse.processed_tick_intervals:type_name -> qubic.v2.archive.pb.ProcessedTickInterval
-	20, // 14: qubic.v2.archive.pb.GetComputorListsForEpochResponse.computors_lists:type_name -> qubic.v2.archive.pb.ComputorList
-	23, // 15: qubic.v2.archive.pb.Event.qu_transfer:type_name -> qubic.v2.archive.pb.QuTransferData
-	24, // 16: qubic.v2.archive.pb.Event.asset_issuance:type_name -> qubic.v2.archive.pb.AssetIssuanceData
-	25, // 17: qubic.v2.archive.pb.Event.asset_ownership_change:type_name -> qubic.v2.archive.pb.AssetOwnershipChangeData
-	26, // 18: qubic.v2.archive.pb.Event.asset_possession_change:type_name -> qubic.v2.archive.pb.AssetPossessionChangeData
-	27, // 19: qubic.v2.archive.pb.Event.burning:type_name -> qubic.v2.archive.pb.BurningData
-	28, // 20: qubic.v2.archive.pb.Event.contract_reserve_deduction:type_name -> qubic.v2.archive.pb.ContractReserveDeductionData
-	29, // 21: qubic.v2.archive.pb.Event.smart_contract_message:type_name -> qubic.v2.archive.pb.SmartContractMessageData
-	30, // 22: qubic.v2.archive.pb.Event.custom_message:type_name -> qubic.v2.archive.pb.CustomMessageData
-	31, // 23: qubic.v2.archive.pb.Event.asset_ownership_managing_contract_change:type_name -> qubic.v2.archive.pb.AssetOwnershipManagingContractChangeData
-	32, // 24: qubic.v2.archive.pb.Event.asset_possession_managing_contract_change:type_name -> qubic.v2.archive.pb.AssetPossessionManagingContractChangeData
-	43, // 25: qubic.v2.archive.pb.GetEventLogsRequest.filters:type_name -> qubic.v2.archive.pb.GetEventLogsRequest.FiltersEntry
-	44, // 26: qubic.v2.archive.pb.GetEventLogsRequest.exclude:type_name -> qubic.v2.archive.pb.GetEventLogsRequest.ExcludeEntry
-	11, // 27: qubic.v2.archive.pb.GetEventLogsRequest.should:type_name -> qubic.v2.archive.pb.ShouldFilter
-	45, // 28: qubic.v2.archive.pb.GetEventLogsRequest.ranges:type_name -> qubic.v2.archive.pb.GetEventLogsRequest.RangesEntry
-	5,  // 29: qubic.v2.archive.pb.GetEventLogsRequest.pagination:type_name -> qubic.v2.archive.pb.Pagination
-	13, // 30: qubic.v2.archive.pb.GetEventLogsResponse.hits:type_name -> qubic.v2.archive.pb.Hits
-	33, // 31: qubic.v2.archive.pb.GetEventLogsResponse.event_logs:type_name -> qubic.v2.archive.pb.Event
-	10, // 32: qubic.v2.archive.pb.GetTransactionsForTickRequest.RangesEntry.value:type_name -> qubic.v2.archive.pb.Range
-	10, // 33: qubic.v2.archive.pb.ShouldFilter.RangesEntry.value:type_name -> qubic.v2.archive.pb.Range
-	10, // 34: qubic.v2.archive.pb.GetTransactionsForIdentityRequest.RangesEntry.value:type_name -> qubic.v2.archive.pb.Range
-	10, // 35: qubic.v2.archive.pb.GetEventLogsRequest.RangesEntry.value:type_name -> qubic.v2.archive.pb.Range
-	36, // [36:36] is the sub-list for method output_type
-	36, // [36:36] is the sub-list for method input_type
-	36, // [36:36] is the sub-list for extension type_name
-	36, // [36:36] is the sub-list for extension extendee
-	0,  // [0:36] is the sub-list for field type_name
+	4,  // 0: qubic.v2.archive.pb.Transaction.decoded_input:type_name -> qubic.v2.archive.pb.DecodedInput
+	0,  // 1: qubic.v2.archive.pb.Transaction.execution_status:type_name -> qubic.v2.archive.pb.ExecutionStatus
+	5,  // 2: qubic.v2.archive.pb.DecodedInput.send_many:type_name -> qubic.v2.archive.pb.SendManyInput
+	7,  // 3: qubic.v2.archive.pb.DecodedInput.qx_issue_asset:type_name -> qubic.v2.archive.pb.QxIssueAssetInput
+	8,  // 4: qubic.v2.archive.pb.DecodedInput.qx_transfer_share:type_name -> qubic.v2.archive.pb.QxTransferShareInput
+	9,  // 5: qubic.v2.archive.pb.DecodedInput.qx_order:type_name -> qubic.v2.archive.pb.QxOrderInput
+	6,  // 6: qubic.v2.archive.pb.SendManyInput.transfers:type_name -> qubic.v2.archive.pb.SendManyTransfer
+	17, // 7: qubic.v2.archive.pb.GetTicksForEpochRequest.pagination:type_name -> qubic.v2.archive.pb.Pagination
+	29, // 8: qubic.v2.archive.pb.GetTicksForEpochResponse.hits:type_name -> qubic.v2.archive.pb.Hits
+	11, // 9: qubic.v2.archive.pb.GetTicksForEpochResponse.ticks:type_name -> qubic.v2.archive.pb.EpochTick
+	17, // 10: qubic.v2.archive.pb.GetEmptyTicksForEpochRequest.pagination:type_name -> qubic.v2.archive.pb.Pagination
+	29, // 11: qubic.v2.archive.pb.GetEmptyTicksForEpochResponse.hits:type_name -> qubic.v2.archive.pb.Hits
+	3,  // 12: qubic.v2.archive.pb.GetTransactionByHashResponse.transaction:type_name -> qubic.v2.archive.pb.Transaction
+	3,  // 13: qubic.v2.archive.pb.GetTransactionDetailsResponse.transaction:type_name -> qubic.v2.archive.pb.Transaction
+	72, // 14: qubic.v2.archive.pb.GetTransactionDetailsResponse.event_logs:type_name -> qubic.v2.archive.pb.Event
+	3,  // 15: qubic.v2.archive.pb.VerifyTransactionRequest.transaction:type_name -> qubic.v2.archive.pb.Transaction
+	79, // 16: qubic.v2.archive.pb.GetTransactionsForTickRequest.filters:type_name -> qubic.v2.archive.pb.GetTransactionsForTickRequest.FiltersEntry
+	80, // 17: qubic.v2.archive.pb.GetTransactionsForTickRequest.ranges:type_name -> qubic.v2.archive.pb.GetTransactionsForTickRequest.RangesEntry
+	3,  // 18: qubic.v2.archive.pb.GetTransactionsForTickResponse.transactions:type_name -> qubic.v2.archive.pb.Transaction
+	81, // 19: qubic.v2.archive.pb.ShouldFilter.terms:type_name -> qubic.v2.archive.pb.ShouldFilter.TermsEntry
+	82, // 20: qubic.v2.archive.pb.ShouldFilter.ranges:type_name -> qubic.v2.archive.pb.ShouldFilter.RangesEntry
+	83, // 21: qubic.v2.archive.pb.GetTransactionsForIdentityRequest.filters:type_name -> qubic.v2.archive.pb.GetTransactionsForIdentityRequest.FiltersEntry
+	84, // 22: qubic.v2.archive.pb.GetTransactionsForIdentityRequest.exclude:type_name -> qubic.v2.archive.pb.GetTransactionsForIdentityRequest.ExcludeEntry
+	85, // 23: qubic.v2.archive.pb.GetTransactionsForIdentityRequest.ranges:type_name -> qubic.v2.archive.pb.GetTransactionsForIdentityRequest.RangesEntry
+	17, // 24: qubic.v2.archive.pb.GetTransactionsForIdentityRequest.pagination:type_name -> qubic.v2.archive.pb.Pagination
+	29, // 25: qubic.v2.archive.pb.GetTransactionsForIdentityResponse.hits:type_name -> qubic.v2.archive.pb.Hits
+	3,  // 26: qubic.v2.archive.pb.GetTransactionsForIdentityResponse.transactions:type_name -> qubic.v2.archive.pb.Transaction
+	10, // 27: qubic.v2.archive.pb.GetTickDataResponse.tick_data:type_name -> qubic.v2.archive.pb.TickData
+	10, // 28: qubic.v2.archive.pb.RangeTickData.tick_data:type_name -> qubic.v2.archive.pb.TickData
+	34, // 29: qubic.v2.archive.pb.GetTickDataRangeResponse.ticks:type_name -> qubic.v2.archive.pb.RangeTickData
+	16, // 30: qubic.v2.archive.pb.GetProcessedTickIntervalsResponse.processed_tick_intervals:type_name -> qubic.v2.archive.pb.ProcessedTickInterval
+	41, // 31: qubic.v2.archive.pb.GetComputorListsForEpochResponse.computors_lists:type_name -> qubic.v2.archive.pb.ComputorList
+	44, // 32: qubic.v2.archive.pb.GetComputorMembershipResponse.memberships:type_name -> qubic.v2.archive.pb.ComputorMembership
+	41, // 33: qubic.v2.archive.pb.GetComputorListsForEpochRangeResponse.computors_lists:type_name -> qubic.v2.archive.pb.ComputorList
+	47, // 34: qubic.v2.archive.pb.GetComputorListsForEpochRangeResponse.diffs:type_name -> qubic.v2.archive.pb.ComputorListDiff
+	50, // 35: qubic.v2.archive.pb.GetComputorTickStatsResponse.computors:type_name -> qubic.v2.archive.pb.ComputorTickStats
+	16, // 36: qubic.v2.archive.pb.EpochSummary.tick_intervals:type_name -> qubic.v2.archive.pb.ProcessedTickInterval
+	53, // 37: qubic.v2.archive.pb.EpochSummary.event_counts:type_name -> qubic.v2.archive.pb.EventTypeCount
+	41, // 38: qubic.v2.archive.pb.EpochSummary.computor_list:type_name -> qubic.v2.archive.pb.ComputorList
+	54, // 39: qubic.v2.archive.pb.GetEpochSummaryResponse.summary:type_name -> qubic.v2.archive.pb.EpochSummary
+	57, // 40: qubic.v2.archive.pb.IdentityOverview.top_incoming_counterparties:type_name -> qubic.v2.archive.pb.Counterparty
+	57, // 41: qubic.v2.archive.pb.IdentityOverview.top_outgoing_counterparties:type_name -> qubic.v2.archive.pb.Counterparty
+	58, // 42: qubic.v2.archive.pb.IdentityOverview.assets:type_name -> qubic.v2.archive.pb.IdentityAsset
+	59, // 43: qubic.v2.archive.pb.GetIdentityOverviewResponse.overview:type_name -> qubic.v2.archive.pb.IdentityOverview
+	62, // 44: qubic.v2.archive.pb.Event.qu_transfer:type_name -> qubic.v2.archive.pb.QuTransferData
+	63, // 45: qubic.v2.archive.pb.Event.asset_issuance:type_name -> qubic.v2.archive.pb.AssetIssuanceData
+	64, // 46: qubic.v2.archive.pb.Event.asset_ownership_change:type_name -> qubic.v2.archive.pb.AssetOwnershipChangeData
+	65, // 47: qubic.v2.archive.pb.Event.asset_possession_change:type_name -> qubic.v2.archive.pb.AssetPossessionChangeData
+	66, // 48: qubic.v2.archive.pb.Event.burning:type_name -> qubic.v2.archive.pb.BurningData
+	67, // 49: qubic.v2.archive.pb.Event.contract_reserve_deduction:type_name -> qubic.v2.archive.pb.ContractReserveDeductionData
+	68, // 50: qubic.v2.archive.pb.Event.smart_contract_message:type_name -> qubic.v2.archive.pb.SmartContractMessageData
+	69, // 51: qubic.v2.archive.pb.Event.custom_message:type_name -> qubic.v2.archive.pb.CustomMessageData
+	70, // 52: qubic.v2.archive.pb.Event.asset_ownership_managing_contract_change:type_name -> qubic.v2.archive.pb.AssetOwnershipManagingContractChangeData
+	71, // 53: qubic.v2.archive.pb.Event.asset_possession_managing_contract_change:type_name -> qubic.v2.archive.pb.AssetPossessionManagingContractChangeData
+	86, // 54: qubic.v2.archive.pb.GetEventLogsRequest.filters:type_name -> qubic.v2.archive.pb.GetEventLogsRequest.FiltersEntry
+	87, // 55: qubic.v2.archive.pb.GetEventLogsRequest.exclude:type_name -> qubic.v2.archive.pb.GetEventLogsRequest.ExcludeEntry
+	27, // 56: qubic.v2.archive.pb.GetEventLogsRequest.should:type_name -> qubic.v2.archive.pb.ShouldFilter
+	88, // 57: qubic.v2.archive.pb.GetEventLogsRequest.ranges:type_name -> qubic.v2.archive.pb.GetEventLogsRequest.RangesEntry
+	17, // 58: qubic.v2.archive.pb.GetEventLogsRequest.pagination:type_name -> qubic.v2.archive.pb.Pagination
+	29, // 59: qubic.v2.archive.pb.GetEventLogsResponse.hits:type_name -> qubic.v2.archive.pb.Hits
+	72, // 60: qubic.v2.archive.pb.GetEventLogsResponse.event_logs:type_name -> qubic.v2.archive.pb.Event
+	72, // 61: qubic.v2.archive.pb.GetEventLogResponse.event_log:type_name -> qubic.v2.archive.pb.Event
+	72, // 62: qubic.v2.archive.pb.GetEventLogsForTransactionResponse.event_logs:type_name -> qubic.v2.archive.pb.Event
+	26, // 63: qubic.v2.archive.pb.GetTransactionsForTickRequest.RangesEntry.value:type_name -> qubic.v2.archive.pb.Range
+	26, // 64: qubic.v2.archive.pb.ShouldFilter.RangesEntry.value:type_name -> qubic.v2.archive.pb.Range
+	26, // 65: qubic.v2.archive.pb.GetTransactionsForIdentityRequest.RangesEntry.value:type_name -> qubic.v2.archive.pb.Range
+	26, // 66: qubic.v2.archive.pb.GetEventLogsRequest.RangesEntry.value:type_name -> qubic.v2.archive.pb.Range
+	67, // [67:67] is the sub-list for method output_type
+	67, // [67:67] is the sub-list for method input_type
+	67, // [67:67] is the sub-list for extension type_name
+	67, // [67:67] is the sub-list for extension extendee
+	0,  // [0:67] is the sub-list for field type_name
 }
 
 func init() { file_messages_proto_init() }
@@ -2970,13 +6071,19 @@ func file_messages_proto_init() {
 	if File_messages_proto != nil {
 		return
 	}
-	file_messages_proto_msgTypes[10].OneofWrappers = []any{
+	file_messages_proto_msgTypes[3].OneofWrappers = []any{
+		(*DecodedInput_SendMany)(nil),
+		(*DecodedInput_QxIssueAsset)(nil),
+		(*DecodedInput_QxTransferShare)(nil),
+		(*DecodedInput_QxOrder)(nil),
+	}
+	file_messages_proto_msgTypes[25].OneofWrappers = []any{
 		(*Range_Gt)(nil),
 		(*Range_Gte)(nil),
 		(*Range_Lt)(nil),
 		(*Range_Lte)(nil),
 	}
-	file_messages_proto_msgTypes[33].OneofWrappers = []any{
+	file_messages_proto_msgTypes[71].OneofWrappers = []any{
 		(*Event_QuTransfer)(nil),
 		(*Event_AssetIssuance)(nil),
 		(*Event_AssetOwnershipChange)(nil),
@@ -2988,18 +6095,20 @@ func file_messages_proto_init() {
 		(*Event_AssetOwnershipManagingContractChange)(nil),
 		(*Event_AssetPossessionManagingContractChange)(nil),
 	}
+	file_messages_proto_msgTypes[76].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_messages_proto_rawDesc), len(file_messages_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   46,
+			NumEnums:      1,
+			NumMessages:   88,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_messages_proto_goTypes,
 		DependencyIndexes: file_messages_proto_depIdxs,
+		EnumInfos:         file_messages_proto_enumTypes,
 		MessageInfos:      file_messages_proto_msgTypes,
 	}.Build()
 	File_messages_proto = out.File
@@ -27,24 +27,51 @@ var File_query_services_proto protoreflect.FileDescriptor
 
 const file_query_services_proto_rawDesc = "" +
 	"\n" +
-	"\x14query_services.proto\x12\x13qubic.v2.archive.pb\x1a\x0emessages.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1bopenapiv3/annotations.proto2\xd4\x0e\n" +
+	"\x14query_services.proto\x12\x13qubic.v2.archive.pb\x1a\x0emessages.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1bopenapiv3/annotations.proto2\xa2\"\n" +
 	"\x13ArchiveQueryService\x12\xfb\x01\n" +
 	"\x14GetTransactionByHash\x120.qubic.v2.archive.pb.GetTransactionByHashRequest\x1a1.qubic.v2.archive.pb.GetTransactionByHashResponse\"~\xbaGN\n" +
-	"\fTransactions\x12\x17Get Transaction By Hash\x1a%Get a single transaction by its hash.\x82\xd3\xe4\x93\x02':\x01*b\vtransaction\"\x15/getTransactionByHash\x12\xdf\x01\n" +
+	"\fTransactions\x12\x17Get Transaction By Hash\x1a%Get a single transaction by its hash.\x82\xd3\xe4\x93\x02':\x01*b\vtransaction\"\x15/getTransactionByHash\x12\xcb\x01\n" +
+	"\x15GetTransactionDetails\x121.qubic.v2.archive.pb.GetTransactionDetailsRequest\x1a2.qubic.v2.archive.pb.GetTransactionDetailsResponse\"K\xbaG'\n" +
+	"\fTransactions\x12\x17Get Transaction Details\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/getTransactionDetails\x12\xb6\x01\n" +
+	"\x11VerifyTransaction\x12-.qubic.v2.archive.pb.VerifyTransactionRequest\x1a..qubic.v2.archive.pb.VerifyTransactionResponse\"B\xbaG\"\n" +
+	"\fTransactions\x12\x12Verify Transaction\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/verifyTransaction\x12\xdf\x01\n" +
 	"\x16GetTransactionsForTick\x122.qubic.v2.archive.pb.GetTransactionsForTickRequest\x1a3.qubic.v2.archive.pb.GetTransactionsForTickResponse\"\\\xbaG)\n" +
 	"\fTransactions\x12\x19Get Transactions For Tick\x82\xd3\xe4\x93\x02*:\x01*b\ftransactions\"\x17/getTransactionsForTick\x12\xe5\x01\n" +
 	"\x1aGetTransactionsForIdentity\x126.qubic.v2.archive.pb.GetTransactionsForIdentityRequest\x1a7.qubic.v2.archive.pb.GetTransactionsForIdentityResponse\"V\xbaG-\n" +
-	"\fTransactions\x12\x1dGet Transactions For Identity\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/getTransactionsForIdentity\x12\xb3\x01\n" +
+	"\fTransactions\x12\x1dGet Transactions For Identity\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/getTransactionsForIdentity\x12\xcb\x01\n" +
+	"\x13GetIdentityOverview\x12/.qubic.v2.archive.pb.GetIdentityOverviewRequest\x1a0.qubic.v2.archive.pb.GetIdentityOverviewResponse\"Q\xbaG%\n" +
+	"\fTransactions\x12\x15Get Identity Overview\x82\xd3\xe4\x93\x02#:\x01*b\boverview\"\x14/getIdentityOverview\x12\xb3\x01\n" +
 	"\vGetTickData\x12'.qubic.v2.archive.pb.GetTickDataRequest\x1a(.qubic.v2.archive.pb.GetTickDataResponse\"Q\xbaG7\n" +
-	"\x05Ticks\x12\rGet Tick Data\x1a\x1fGet the tick data for one tick.\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/getTickData\x12\xcf\x01\n" +
+	"\x05Ticks\x12\rGet Tick Data\x1a\x1fGet the tick data for one tick.\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/getTickData\x12\xac\x01\n" +
+	"\x10GetTickDataRange\x12,.qubic.v2.archive.pb.GetTickDataRangeRequest\x1a-.qubic.v2.archive.pb.GetTickDataRangeResponse\";\xbaG\x1c\n" +
+	"\x05Ticks\x12\x13Get Tick Data Range\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/getTickDataRange\x12\x8c\x01\n" +
+	"\n" +
+	"VerifyTick\x12&.qubic.v2.archive.pb.VerifyTickRequest\x1a'.qubic.v2.archive.pb.VerifyTickResponse\"-\xbaG\x14\n" +
+	"\x05Ticks\x12\vVerify Tick\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/verifyTick\x12\xac\x01\n" +
+	"\x10GetTicksForEpoch\x12,.qubic.v2.archive.pb.GetTicksForEpochRequest\x1a-.qubic.v2.archive.pb.GetTicksForEpochResponse\";\xbaG\x1c\n" +
+	"\x05Ticks\x12\x13Get Ticks For Epoch\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/getTicksForEpoch\x12\xc6\x01\n" +
+	"\x15GetEmptyTicksForEpoch\x121.qubic.v2.archive.pb.GetEmptyTicksForEpochRequest\x1a2.qubic.v2.archive.pb.GetEmptyTicksForEpochResponse\"F\xbaG\"\n" +
+	"\x05Ticks\x12\x19Get Empty Ticks For Epoch\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/getEmptyTicksForEpoch\x12\xcf\x01\n" +
 	"\x19GetComputorsListsForEpoch\x124.qubic.v2.archive.pb.GetComputorListsForEpochRequest\x1a5.qubic.v2.archive.pb.GetComputorListsForEpochResponse\"E\xbaG\x1e\n" +
-	"\aNetwork\x12\x13Get Epoch Computors\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/getComputorListsForEpoch\x12\xa5\x01\n" +
+	"\aNetwork\x12\x13Get Epoch Computors\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/getComputorListsForEpoch\x12\xf1\x01\n" +
+	"\x1dGetComputorListsForEpochRange\x129.qubic.v2.archive.pb.GetComputorListsForEpochRangeRequest\x1a:.qubic.v2.archive.pb.GetComputorListsForEpochRangeResponse\"Y\xbaG-\n" +
+	"\aNetwork\x12\"Get Computor Lists For Epoch Range\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/getComputorListsForEpochRange\x12\xc6\x01\n" +
+	"\x15GetComputorMembership\x121.qubic.v2.archive.pb.GetComputorMembershipRequest\x1a2.qubic.v2.archive.pb.GetComputorMembershipResponse\"F\xbaG\"\n" +
+	"\aNetwork\x12\x17Get Computor Membership\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/getComputorMembership\x12\xc7\x01\n" +
+	"\x14GetComputorTickStats\x120.qubic.v2.archive.pb.GetComputorTickStatsRequest\x1a1.qubic.v2.archive.pb.GetComputorTickStatsResponse\"J\xbaG'\n" +
+	"\aNetwork\x12\x1cGet Computor Tick Statistics\x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/getComputorTickStats\x12\xb1\x01\n" +
+	"\x0fGetEpochSummary\x12+.qubic.v2.archive.pb.GetEpochSummaryRequest\x1a,.qubic.v2.archive.pb.GetEpochSummaryResponse\"C\xbaG\x1c\n" +
+	"\aNetwork\x12\x11Get Epoch Summary\x82\xd3\xe4\x93\x02\x1e:\x01*b\asummary\"\x10/getEpochSummary\x12\xa5\x01\n" +
 	"\x14GetLastProcessedTick\x12\x16.google.protobuf.Empty\x1a1.qubic.v2.archive.pb.GetLastProcessedTickResponse\"B\xbaG\"\n" +
 	"\aArchive\x12\x17Get Last Processed Tick\x82\xd3\xe4\x93\x02\x17\x12\x15/getLastProcessedTick\x12\xd3\x01\n" +
 	"\x19GetProcessedTickIntervals\x12\x16.google.protobuf.Empty\x1a6.qubic.v2.archive.pb.GetProcessedTickIntervalsResponse\"f\xbaG'\n" +
-	"\aArchive\x12\x1cGet Processed Tick Intervals\x82\xd3\xe4\x93\x026b\x18processed_tick_intervals\x12\x1a/getProcessedTickIntervals\x12\x9e\x01\n" +
-	"\fGetEventLogs\x12(.qubic.v2.archive.pb.GetEventLogsRequest\x1a).qubic.v2.archive.pb.GetEventLogsResponse\"9\xbaG\x1e\n" +
-	"\fEvent (Beta)\x12\x0eGet Event Logs\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/getEventLogs\x12\xcd\x01\n" +
+	"\aArchive\x12\x1cGet Processed Tick Intervals\x82\xd3\xe4\x93\x026b\x18processed_tick_intervals\x12\x1a/getProcessedTickIntervals\x12\x9f\x01\n" +
+	"\fGetEventLogs\x12(.qubic.v2.archive.pb.GetEventLogsRequest\x1a).qubic.v2.archive.pb.GetEventLogsResponse\":\xbaG\x1f\n" +
+	"\rEvents (Beta)\x12\x0eGet Event Logs\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/getEventLogs\x12\x9a\x01\n" +
+	"\vGetEventLog\x12'.qubic.v2.archive.pb.GetEventLogRequest\x1a(.qubic.v2.archive.pb.GetEventLogResponse\"8\xbaG\x1e\n" +
+	"\rEvents (Beta)\x12\rGet Event Log\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/getEventLog\x12\xe7\x01\n" +
+	"\x1aGetEventLogsForTransaction\x126.qubic.v2.archive.pb.GetEventLogsForTransactionRequest\x1a7.qubic.v2.archive.pb.GetEventLogsForTransactionResponse\"X\xbaG/\n" +
+	"\rEvents (Beta)\x12\x1eGet Event Logs For Transaction\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/getEventLogsForTransaction\x12\xcd\x01\n" +
 	"\tGetHealth\x12\x16.google.protobuf.Empty\x1a#.qubic.v2.archive.pb.HealthResponse\"\x82\x01\xbaGp\x12\n" +
 	"Get Health\x1abHealth check. This is for internal use only and can change any time. Do not rely on this endpoint.\x82\xd3\xe4\x93\x02\t\x12\a/healthB\xfe\x03\xbaG\xce\x03\x12H\n" +
 	"\x0fQubic Query API\x12.API for querying historical Qubic ledger data.2\x051.0.0\x1a \n" +
@@ -57,44 +84,96 @@ const file_query_services_proto_rawDesc = "" +
 	"\x06GitHub\x12.https://github.com/qubic/archive-query-serviceZ*github.com/qubic/archive-query-service/apib\x06proto3"
 
 var file_query_services_proto_goTypes = []any{
-	(*GetTransactionByHashRequest)(nil),        // 0: qubic.v2.archive.pb.GetTransactionByHashRequest
-	(*GetTransactionsForTickRequest)(nil),      // 1: qubic.v2.archive.pb.GetTransactionsForTickRequest
-	(*GetTransactionsForIdentityRequest)(nil),  // 2: qubic.v2.archive.pb.GetTransactionsForIdentityRequest
-	(*GetTickDataRequest)(nil),                 // 3: qubic.v2.archive.pb.GetTickDataRequest
-	(*GetComputorListsForEpochRequest)(nil),    // 4: qubic.v2.archive.pb.GetComputorListsForEpochRequest
-	(*emptypb.Empty)(nil),                      // 5: google.protobuf.Empty
-	(*GetEventLogsRequest)(nil),                // 6: qubic.v2.archive.pb.GetEventLogsRequest
-	(*GetTransactionByHashResponse)(nil),       // 7: qubic.v2.archive.pb.GetTransactionByHashResponse
-	(*GetTransactionsForTickResponse)(nil),     // 8: qubic.v2.archive.pb.GetTransactionsForTickResponse
-	(*GetTransactionsForIdentityResponse)(nil), // 9: qubic.v2.archive.pb.GetTransactionsForIdentityResponse
-	(*GetTickDataResponse)(nil),                // 10: qubic.v2.archive.pb.GetTickDataResponse
-	(*GetComputorListsForEpochResponse)(nil),   // 11: qubic.v2.archive.pb.GetComputorListsForEpochResponse
-	(*GetLastProcessedTickResponse)(nil),       // 12: qubic.v2.archive.pb.GetLastProcessedTickResponse
-	(*GetProcessedTickIntervalsResponse)(nil),  // 13: qubic.v2.archive.pb.GetProcessedTickIntervalsResponse
-	(*GetEventLogsResponse)(nil),               // 14: qubic.v2.archive.pb.GetEventLogsResponse
-	(*HealthResponse)(nil),                     // 15: qubic.v2.archive.pb.HealthResponse
+	(*GetTransactionByHashRequest)(nil),           // 0: qubic.v2.archive.pb.GetTransactionByHashRequest
+	(*GetTransactionDetailsRequest)(nil),          // 1: qubic.v2.archive.pb.GetTransactionDetailsRequest
+	(*VerifyTransactionRequest)(nil),              // 2: qubic.v2.archive.pb.VerifyTransactionRequest
+	(*GetTransactionsForTickRequest)(nil),         // 3: qubic.v2.archive.pb.GetTransactionsForTickRequest
+	(*GetTransactionsForIdentityRequest)(nil),     // 4: qubic.v2.archive.pb.GetTransactionsForIdentityRequest
+	(*GetIdentityOverviewRequest)(nil),            // 5: qubic.v2.archive.pb.GetIdentityOverviewRequest
+	(*GetTickDataRequest)(nil),                    // 6: qubic.v2.archive.pb.GetTickDataRequest
+	(*GetTickDataRangeRequest)(nil),               // 7: qubic.v2.archive.pb.GetTickDataRangeRequest
+	(*VerifyTickRequest)(nil),                     // 8: qubic.v2.archive.pb.VerifyTickRequest
+	(*GetTicksForEpochRequest)(nil),               // 9: qubic.v2.archive.pb.GetTicksForEpochRequest
+	(*GetEmptyTicksForEpochRequest)(nil),          // 10: qubic.v2.archive.pb.GetEmptyTicksForEpochRequest
+	(*GetComputorListsForEpochRequest)(nil),       // 11: qubic.v2.archive.pb.GetComputorListsForEpochRequest
+	(*GetComputorListsForEpochRangeRequest)(nil),  // 12: qubic.v2.archive.pb.GetComputorListsForEpochRangeRequest
+	(*GetComputorMembershipRequest)(nil),          // 13: qubic.v2.archive.pb.GetComputorMembershipRequest
+	(*GetComputorTickStatsRequest)(nil),           // 14: qubic.v2.archive.pb.GetComputorTickStatsRequest
+	(*GetEpochSummaryRequest)(nil),                // 15: qubic.v2.archive.pb.GetEpochSummaryRequest
+	(*emptypb.Empty)(nil),                         // 16: google.protobuf.Empty
+	(*GetEventLogsRequest)(nil),                   // 17: qubic.v2.archive.pb.GetEventLogsRequest
+	(*GetEventLogRequest)(nil),                    // 18: qubic.v2.archive.pb.GetEventLogRequest
+	(*GetEventLogsForTransactionRequest)(nil),     // 19: qubic.v2.archive.pb.GetEventLogsForTransactionRequest
+	(*GetTransactionByHashResponse)(nil),          // 20: qubic.v2.archive.pb.GetTransactionByHashResponse
+	(*GetTransactionDetailsResponse)(nil),         // 21: qubic.v2.archive.pb.GetTransactionDetailsResponse
+	(*VerifyTransactionResponse)(nil),             // 22: qubic.v2.archive.pb.VerifyTransactionResponse
+	(*GetTransactionsForTickResponse)(nil),        // 23: qubic.v2.archive.pb.GetTransactionsForTickResponse
+	(*GetTransactionsForIdentityResponse)(nil),    // 24: qubic.v2.archive.pb.GetTransactionsForIdentityResponse
+	(*GetIdentityOverviewResponse)(nil),           // 25: qubic.v2.archive.pb.GetIdentityOverviewResponse
+	(*GetTickDataResponse)(nil),                   // 26: qubic.v2.archive.pb.GetTickDataResponse
+	(*GetTickDataRangeResponse)(nil),              // 27: qubic.v2.archive.pb.GetTickDataRangeResponse
+	(*VerifyTickResponse)(nil),                    // 28: qubic.v2.archive.pb.VerifyTickResponse
+	(*GetTicksForEpochResponse)(nil),              // 29: qubic.v2.archive.pb.GetTicksForEpochResponse
+	(*GetEmptyTicksForEpochResponse)(nil),         // 30: qubic.v2.archive.pb.GetEmptyTicksForEpochResponse
+	(*GetComputorListsForEpochResponse)(nil),      // 31: qubic.v2.archive.pb.GetComputorListsForEpochResponse
+	(*GetComputorListsForEpochRangeResponse)(nil), // 32: qubic.v2.archive.pb.GetComputorListsForEpochRangeResponse
+	(*GetComputorMembershipResponse)(nil),         // 33: qubic.v2.archive.pb.GetComputorMembershipResponse
+	(*GetComputorTickStatsResponse)(nil),          // 34: qubic.v2.archive.pb.GetComputorTickStatsResponse
+	(*GetEpochSummaryResponse)(nil),               // 35: qubic.v2.archive.pb.GetEpochSummaryResponse
+	(*GetLastProcessedTickResponse)(nil),          // 36: qubic.v2.archive.pb.GetLastProcessedTickResponse
+	(*GetProcessedTickIntervalsResponse)(nil),     // 37: qubic.v2.archive.pb.GetProcessedTickIntervalsResponse
+	(*GetEventLogsResponse)(nil),                  // 38: qubic.v2.archive.pb.GetEventLogsResponse
+	(*GetEventLogResponse)(nil),                   // 39: qubic.v2.archive.pb.GetEventLogResponse
+	(*GetEventLogsForTransactionResponse)(nil),    // 40: qubic.v2.archive.pb.GetEventLogsForTransactionResponse
+	(*HealthResponse)(nil),                        // 41: qubic.v2.archive.pb.HealthResponse
 }
 var file_query_services_proto_depIdxs = []int32{
 	0,  // 0: qubic.v2.archive.pb.ArchiveQueryService.GetTransactionByHash:input_type -> qubic.v2.archive.pb.GetTransactionByHashRequest
-	1,  // 1: qubic.v2.archive.pb.ArchiveQueryService.GetTransactionsForTick:input_type -> qubic.v2.archive.pb.GetTransactionsForTickRequest
-	2,  // 2: qubic.v2.archive.pb.ArchiveQueryService.GetTransactionsForIdentity:input_type -> qubic.v2.archive.pb.GetTransactionsForIdentityRequest
-	3,  // 3: qubic.v2.archive.pb.ArchiveQueryService.GetTickData:input_type -> qubic.v2.archive.pb.GetTickDataRequest
-	4,  // 4: qubic.v2.archive.pb.ArchiveQueryService.GetComputorsListsForEpoch:input_type -> qubic.v2.archive.pb.GetComputorListsForEpochRequest
-	5,  // 5: qubic.v2.archive.pb.ArchiveQueryService.GetLastProcessedTick:input_type -> google.protobuf.Empty
-	5,  // 6: qubic.v2.archive.pb.ArchiveQueryService.GetProcessedTickIntervals:input_type -> google.protobuf.Empty
-	6,  // 7: qubic.v2.archive.pb.ArchiveQueryService.GetEventLogs:input_type -> qubic.v2.archive.pb.GetEventLogsRequest
-	5,  // 8: qubic.v2.archive.pb.ArchiveQueryService.GetHealth:input_type -> google.protobuf.Empty
-	7,  // 9: qubic.v2.archive.pb.ArchiveQueryService.GetTransactionByHash:output_type -> qubic.v2.archive.pb.GetTransactionByHashResponse
-	8,  // 10: qubic.v2.archive.pb.ArchiveQueryService.GetTransactionsForTick:output_type -> qubic.v2.archive.pb.GetTransactionsForTickResponse
-	9,  // 11: qubic.v2.archive.pb.ArchiveQueryService.GetTransactionsForIdentity:output_type -> qubic.v2.archive.pb.GetTransactionsForIdentityResponse
-	10, // 12: qubic.v2.archive.pb.ArchiveQueryService.GetTickData:output_type -> qubic.v2.archive.pb.GetTickDataResponse
-	11, // 13: qubic.v2.archive.pb.ArchiveQueryService.GetComputorsListsForEpoch:output_type -> qubic.v2.archive.pb.GetComputorListsForEpochResponse
-	12, // 14: qubic.v2.archive.pb.ArchiveQueryService.GetLastProcessedTick:output_type -> qubic.v2.archive.pb.GetLastProcessedTickResponse
-	13, // 15: qubic.v2.archive.pb.ArchiveQueryService.GetProcessedTickIntervals:output_type -> qubic.v2.archive.pb.GetProcessedTickIntervalsResponse
-	14, // 16: qubic.v2.archive.pb.ArchiveQueryService.GetEventLogs:output_type -> qubic.v2.archive.pb.GetEventLogsResponse
-	15, // 17: qubic.v2.archive.pb.ArchiveQueryService.GetHealth:output_type -> qubic.v2.archive.pb.HealthResponse
-	9,  // [9:18] is the sub-list for method output_type
-	0,  // [0:9] is the sub-list for method input_type
+	1,  // 1: qubic.v2.archive.pb.ArchiveQueryService.GetTransactionDetails:input_type -> qubic.v2.archive.pb.GetTransactionDetailsRequest
+	2,  // 2: qubic.v2.archive.pb.ArchiveQueryService.VerifyTransaction:input_type -> qubic.v2.archive.pb.VerifyTransactionRequest
+	3,  // 3: qubic.v2.archive.pb.ArchiveQueryService.GetTransactionsForTick:input_type -> qubic.v2.archive.pb.GetTransactionsForTickRequest
+	4,  // 4: qubic.v2.archive.pb.ArchiveQueryService.GetTransactionsForIdentity:input_type -> qubic.v2.archive.pb.GetTransactionsForIdentityRequest
+	5,  // 5: qubic.v2.archive.pb.ArchiveQueryService.GetIdentityOverview:input_type -> qubic.v2.archive.pb.GetIdentityOverviewRequest
+	6,  // 6: qubic.v2.archive.pb.ArchiveQueryService.GetTickData:input_type -> qubic.v2.archive.pb.GetTickDataRequest
+	7,  // 7: qubic.v2.archive.pb.ArchiveQueryService.GetTickDataRange:input_type -> qubic.v2.archive.pb.GetTickDataRangeRequest
+	8,  // 8: qubic.v2.archive.pb.ArchiveQueryService.VerifyTick:input_type -> qubic.v2.archive.pb.VerifyTickRequest
+	9,  // 9: qubic.v2.archive.pb.ArchiveQueryService.GetTicksForEpoch:input_type -> qubic.v2.archive.pb.GetTicksForEpochRequest
+	10, // 10: qubic.v2.archive.pb.ArchiveQueryService.GetEmptyTicksForEpoch:input_type -> qubic.v2.archive.pb.GetEmptyTicksForEpochRequest
+	11, // 11: qubic.v2.archive.pb.ArchiveQueryService.GetComputorsListsForEpoch:input_type -> qubic.v2.archive.pb.GetComputorListsForEpochRequest
+	12, // 12: qubic.v2.archive.pb.ArchiveQueryService.GetComputorListsForEpochRange:input_type -> qubic.v2.archive.pb.GetComputorListsForEpochRangeRequest
+	13, // 13: qubic.v2.archive.pb.ArchiveQueryService.GetComputorMembership:input_type -> qubic.v2.archive.pb.GetComputorMembershipRequest
+	14, // 14: qubic.v2.archive.pb.ArchiveQueryService.GetComputorTickStats:input_type -> qubic.v2.archive.pb.GetComputorTickStatsRequest
+	15, // 15: qubic.v2.archive.pb.ArchiveQueryService.GetEpochSummary:input_type -> qubic.v2.archive.pb.GetEpochSummaryRequest
+	16, // 16: qubic.v2.archive.pb.ArchiveQueryService.GetLastProcessedTick:input_type -> google.protobuf.Empty
+	16, // 17: qubic.v2.archive.pb.ArchiveQueryService.GetProcessedTickIntervals:input_type -> google.protobuf.Empty
+	17, // 18: qubic.v2.archive.pb.ArchiveQueryService.GetEventLogs:input_type -> qubic.v2.archive.pb.GetEventLogsRequest
+	18, // 19: qubic.v2.archive.pb.ArchiveQueryService.GetEventLog:input_type -> qubic.v2.archive.pb.GetEventLogRequest
+	19, // 20: qubic.v2.archive.pb.ArchiveQueryService.GetEventLogsForTransaction:input_type -> qubic.v2.archive.pb.GetEventLogsForTransactionRequest
+	16, // 21: qubic.v2.archive.pb.ArchiveQueryService.GetHealth:input_type -> google.protobuf.Empty
+	20, // 22: qubic.v2.archive.pb.ArchiveQueryService.GetTransactionByHash:output_type -> qubic.v2.archive.pb.GetTransactionByHashResponse
+	21, // 23: qubic.v2.archive.pb.ArchiveQueryService.GetTransactionDetails:output_type -> qubic.v2.archive.pb.GetTransactionDetailsResponse
+	22, // 24: qubic.v2.archive.pb.ArchiveQueryService.VerifyTransaction:output_type -> qubic.v2.archive.pb.VerifyTransactionResponse
+	23, // 25: qubic.v2.archive.pb.ArchiveQueryService.GetTransactionsForTick:output_type -> qubic.v2.archive.pb.GetTransactionsForTickResponse
+	24, // 26: qubic.v2.archive.pb.ArchiveQueryService.GetTransactionsForIdentity:output_type -> qubic.v2.archive.pb.GetTransactionsForIdentityResponse
+	25, // 27: qubic.v2.archive.pb.ArchiveQueryService.GetIdentityOverview:output_type -> qubic.v2.archive.pb.GetIdentityOverviewResponse
+	26, // 28: qubic.v2.archive.pb.ArchiveQueryService.GetTickData:output_type -> qubic.v2.archive.pb.GetTickDataResponse
+	27, // 29: qubic.v2.archive.pb.ArchiveQueryService.GetTickDataRange:output_type -> qubic.v2.archive.pb.GetTickDataRangeResponse
+	28, // 30: qubic.v2.archive.pb.ArchiveQueryService.VerifyTick:output_type -> qubic.v2.archive.pb.VerifyTickResponse
+	29, // 31: qubic.v2.archive.pb.ArchiveQueryService.GetTicksForEpoch:output_type -> qubic.v2.archive.pb.GetTicksForEpochResponse
+	30, // 32: qubic.v2.archive.pb.ArchiveQueryService.GetEmptyTicksForEpoch:output_type -> qubic.v2.archive.pb.GetEmptyTicksForEpochResponse
+	31, // 33: qubic.v2.archive.pb.ArchiveQueryService.GetComputorsListsForEpoch:output_type -> qubic.v2.archive.pb.GetComputorListsForEpochResponse
+	32, // 34: qubic.v2.archive.pb.ArchiveQueryService.GetComputorListsForEpochRange:output_type -> qubic.v2.archive.pb.GetComputorListsForEpochRangeResponse
+	33, // 35: qubic.v2.archive.pb.ArchiveQueryService.GetComputorMembership:output_type -> qubic.v2.archive.pb.GetComputorMembershipResponse
+	34, // 36: qubic.v2.archive.pb.ArchiveQueryService.GetComputorTickStats:output_type -> qubic.v2.archive.pb.GetComputorTickStatsResponse
+	35, // 37: qubic.v2.archive.pb.ArchiveQueryService.GetEpochSummary:output_type -> qubic.v2.archive.pb.GetEpochSummaryResponse
+	36, // 38: qubic.v2.archive.pb.ArchiveQueryService.GetLastProcessedTick:output_type -> qubic.v2.archive.pb.GetLastProcessedTickResponse
+	37, // 39: qubic.v2.archive.pb.ArchiveQueryService.GetProcessedTickIntervals:output_type -> qubic.v2.archive.pb.GetProcessedTickIntervalsResponse
+	38, // 40: qubic.v2.archive.pb.ArchiveQueryService.GetEventLogs:output_type -> qubic.v2.archive.pb.GetEventLogsResponse
+	39, // 41: qubic.v2.archive.pb.ArchiveQueryService.GetEventLog:output_type -> qubic.v2.archive.pb.GetEventLogResponse
+	40, // 42: qubic.v2.archive.pb.ArchiveQueryService.GetEventLogsForTransaction:output_type -> qubic.v2.archive.pb.GetEventLogsForTransactionResponse
+	41, // 43: qubic.v2.archive.pb.ArchiveQueryService.GetHealth:output_type -> qubic.v2.archive.pb.HealthResponse
+	22, // [22:44] is the sub-list for method output_type
+	0,  // [0:22] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -20,15 +20,28 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ArchiveQueryService_GetTransactionByHash_FullMethodName       = "/qubic.v2.archive.pb.ArchiveQueryService/GetTransactionByHash"
-	ArchiveQueryService_GetTransactionsForTick_FullMethodName     = "/qubic.v2.archive.pb.ArchiveQueryService/GetTransactionsForTick"
-	ArchiveQueryService_GetTransactionsForIdentity_FullMethodName = "/qubic.v2.archive.pb.ArchiveQueryService/GetTransactionsForIdentity"
-	ArchiveQueryService_GetTickData_FullMethodName                = "/qubic.v2.archive.pb.ArchiveQueryService/GetTickData"
-	ArchiveQueryService_GetComputorsListsForEpoch_FullMethodName  = "/qubic.v2.archive.pb.ArchiveQueryService/GetComputorsListsForEpoch"
-	ArchiveQueryService_GetLastProcessedTick_FullMethodName       = "/qubic.v2.archive.pb.ArchiveQueryService/GetLastProcessedTick"
-	ArchiveQueryService_GetProcessedTickIntervals_FullMethodName  = "/qubic.v2.archive.pb.ArchiveQueryService/GetProcessedTickIntervals"
-	ArchiveQueryService_GetEventLogs_FullMethodName               = "/qubic.v2.archive.pb.ArchiveQueryService/GetEventLogs"
-	ArchiveQueryService_GetHealth_FullMethodName                  = "/qubic.v2.archive.pb.ArchiveQueryService/GetHealth"
+	ArchiveQueryService_GetTransactionByHash_FullMethodName          = "/qubic.v2.archive.pb.ArchiveQueryService/GetTransactionByHash"
+	ArchiveQueryService_GetTransactionDetails_FullMethodName         = "/qubic.v2.archive.pb.ArchiveQueryService/GetTransactionDetails"
+	ArchiveQueryService_VerifyTransaction_FullMethodName             = "/qubic.v2.archive.pb.ArchiveQueryService/VerifyTransaction"
+	ArchiveQueryService_GetTransactionsForTick_FullMethodName        = "/qubic.v2.archive.pb.ArchiveQueryService/GetTransactionsForTick"
+	ArchiveQueryService_GetTransactionsForIdentity_FullMethodName    = "/qubic.v2.archive.pb.ArchiveQueryService/GetTransactionsForIdentity"
+	ArchiveQueryService_GetIdentityOverview_FullMethodName           = "/qubic.v2.archive.pb.ArchiveQueryService/GetIdentityOverview"
+	ArchiveQueryService_GetTickData_FullMethodName                   = "/qubic.v2.archive.pb.ArchiveQueryService/GetTickData"
+	ArchiveQueryService_GetTickDataRange_FullMethodName              = "/qubic.v2.archive.pb.ArchiveQueryService/GetTickDataRange"
+	ArchiveQueryService_VerifyTick_FullMethodName                    = "/qubic.v2.archive.pb.ArchiveQueryService/VerifyTick"
+	ArchiveQueryService_GetTicksForEpoch_FullMethodName              = "/qubic.v2.archive.pb.ArchiveQueryService/GetTicksForEpoch"
+	ArchiveQueryService_GetEmptyTicksForEpoch_FullMethodName         = "/qubic.v2.archive.pb.ArchiveQueryService/GetEmptyTicksForEpoch"
+	ArchiveQueryService_GetComputorsListsForEpoch_FullMethodName     = "/qubic.v2.archive.pb.ArchiveQueryService/GetComputorsListsForEpoch"
+	ArchiveQueryService_GetComputorListsForEpochRange_FullMethodName = "/qubic.v2.archive.pb.ArchiveQueryService/GetComputorListsForEpochRange"
+	ArchiveQueryService_GetComputorMembership_FullMethodName         = "/qubic.v2.archive.pb.ArchiveQueryService/GetComputorMembership"
+	ArchiveQueryService_GetComputorTickStats_FullMethodName          = "/qubic.v2.archive.pb.ArchiveQueryService/GetComputorTickStats"
+	ArchiveQueryService_GetEpochSummary_FullMethodName               = "/qubic.v2.archive.pb.ArchiveQueryService/GetEpochSummary"
+	ArchiveQueryService_GetLastProcessedTick_FullMethodName          = "/qubic.v2.archive.pb.ArchiveQueryService/GetLastProcessedTick"
+	ArchiveQueryService_GetProcessedTickIntervals_FullMethodName     = "/qubic.v2.archive.pb.ArchiveQueryService/GetProcessedTickIntervals"
+	ArchiveQueryService_GetEventLogs_FullMethodName                  = "/qubic.v2.archive.pb.ArchiveQueryService/GetEventLogs"
+	ArchiveQueryService_GetEventLog_FullMethodName                   = "/qubic.v2.archive.pb.ArchiveQueryService/GetEventLog"
+	ArchiveQueryService_GetEventLogsForTransaction_FullMethodName    = "/qubic.v2.archive.pb.ArchiveQueryService/GetEventLogsForTransaction"
+	ArchiveQueryService_GetHealth_FullMethodName                     = "/qubic.v2.archive.pb.ArchiveQueryService/GetHealth"
 )
 
 // ArchiveQueryServiceClient is the client API for ArchiveQueryService service.
@@ -38,6 +51,22 @@ const (
 // Qubic Query API
 type ArchiveQueryServiceClient interface {
 	GetTransactionByHash(ctx context.Context, in *GetTransactionByHashRequest, opts ...grpc.CallOption) (*GetTransactionByHashResponse, error)
+	// Get a single transaction together with its event logs and information about the tick it was scheduled for.
+	//
+	// The event logs are returned in execution order (log id ascending). If the transaction has more event logs than
+	// the maximum page size `hasMoreEventLogs` is set and the remaining event logs can be queried with
+	// `/getEventLogsForTransaction`. If the tick of the transaction is not processed yet for event logs the
+	// transaction is returned without event logs and `eventLogsAvailable` is false.
+	//
+	// `includedInTick` tells if the transaction hash is part of the transaction hashes of the tick data. The tick
+	// information is empty, if the tick is empty.
+	GetTransactionDetails(ctx context.Context, in *GetTransactionDetailsRequest, opts ...grpc.CallOption) (*GetTransactionDetailsResponse, error)
+	// Verify the hash and the signature of a transaction.
+	//
+	// The transaction digest is reconstructed from the transaction data and compared with the transaction hash. The
+	// signature is verified against the public key of the source identity. Either the hash of an archived transaction
+	// or a complete transaction can be passed.
+	VerifyTransaction(ctx context.Context, in *VerifyTransactionRequest, opts ...grpc.CallOption) (*VerifyTransactionResponse, error)
 	// Get the transactions that are in included in one tick.
 	//
 	// ###  Request structure
@@ -141,11 +170,57 @@ type ArchiveQueryServiceClient interface {
 	// | offset | uint32 | optional  | The offset of the first record to return. Defaults to zero (first record). Maximum offset is 10000. |
 	// | size   | uint32 | optional  | Defaults to 10. Maximum size is 1000. Zero value is ignored (uses default). |
 	GetTransactionsForIdentity(ctx context.Context, in *GetTransactionsForIdentityRequest, opts ...grpc.CallOption) (*GetTransactionsForIdentityResponse, error)
+	// Get an overview of the activity of one identity.
+	//
+	// Contains the first and last seen tick, the number of incoming and outgoing transactions, the top counterparties by
+	// volume, QU transfer event totals and the assets the identity interacted with. All information is valid up to
+	// `validForTick`.
+	GetIdentityOverview(ctx context.Context, in *GetIdentityOverviewRequest, opts ...grpc.CallOption) (*GetIdentityOverviewResponse, error)
 	GetTickData(ctx context.Context, in *GetTickDataRequest, opts ...grpc.CallOption) (*GetTickDataResponse, error)
+	// Get the tick data for a contiguous range of ticks (maximum 100 ticks).
+	//
+	// Returns one entry per tick. Empty ticks (ticks without tick data) are flagged with `isEmpty` and ticks that were
+	// skipped by the archive are flagged with `isSkipped`.
+	GetTickDataRange(ctx context.Context, in *GetTickDataRangeRequest, opts ...grpc.CallOption) (*GetTickDataRangeResponse, error)
+	// Verify the integrity of the tick data of one tick.
+	//
+	// Checks that the computor list of the tick's epoch is signed by the arbitrator, that the tick data is signed by the
+	// tick leader and that all transactions referenced by the tick data are available in the archive.
+	VerifyTick(ctx context.Context, in *VerifyTickRequest, opts ...grpc.CallOption) (*VerifyTickResponse, error)
+	// Get the processed ticks of one epoch.
+	//
+	// Only the ticks within the processed tick intervals of the epoch are returned. Empty ticks (ticks without tick data)
+	// are flagged with `isEmpty`. The offset of the pagination is not limited for this endpoint.
+	GetTicksForEpoch(ctx context.Context, in *GetTicksForEpochRequest, opts ...grpc.CallOption) (*GetTicksForEpochResponse, error)
+	// Get the empty ticks (ticks without tick data) of one epoch in ascending order.
+	//
+	// Only the ticks within the processed tick intervals of the epoch are considered. The offset of the pagination is
+	// not limited for this endpoint.
+	GetEmptyTicksForEpoch(ctx context.Context, in *GetEmptyTicksForEpochRequest, opts ...grpc.CallOption) (*GetEmptyTicksForEpochResponse, error)
 	// Get the list(s) of computors for one epoch. These are the computors (IDs signed by the arbitrator) that
 	// are allowed to make quorum decisions. It is possible that this list changes within the epoch in case of
 	// arbitrator intervention.
 	GetComputorsListsForEpoch(ctx context.Context, in *GetComputorListsForEpochRequest, opts ...grpc.CallOption) (*GetComputorListsForEpochResponse, error)
+	// Get the computor lists for a range of epochs (maximum 50 epochs) and the differences between consecutive lists.
+	//
+	// The differences contain the identities that joined and left the computor list compared to the previous list
+	// within the range. The first list of the range has no diff.
+	GetComputorListsForEpochRange(ctx context.Context, in *GetComputorListsForEpochRangeRequest, opts ...grpc.CallOption) (*GetComputorListsForEpochRangeResponse, error)
+	// Get the computor lists an identity is part of and the index of the identity within each list.
+	GetComputorMembership(ctx context.Context, in *GetComputorMembershipRequest, opts ...grpc.CallOption) (*GetComputorMembershipResponse, error)
+	// Get the number of produced and empty ticks per computor for the processed ticks of one epoch.
+	//
+	// The produced ticks are the ticks with tick data created by the computor. The empty ticks are the ticks without
+	// tick data the computor was tick leader for (the tick leader index is the tick number modulo the number of
+	// computors).
+	GetComputorTickStats(ctx context.Context, in *GetComputorTickStatsRequest, opts ...grpc.CallOption) (*GetComputorTickStatsResponse, error)
+	// Get a summary of one epoch.
+	//
+	// Contains the processed tick intervals, information about the first and last tick, transaction and event log
+	// statistics, the burned amount and the latest computor list of the epoch. The summary of the current epoch is
+	// based on the data processed so far. Once the epoch is closed (`closed` is true) the summary does not change
+	// anymore.
+	GetEpochSummary(ctx context.Context, in *GetEpochSummaryRequest, opts ...grpc.CallOption) (*GetEpochSummaryResponse, error)
 	// Get the last processed tick and other processing information from the archive.
 	// All data queried from the archive is only fully processed up to this tick.
 	// Before calling the service you should check the last processed tick to be sure to get
@@ -257,6 +332,30 @@ type ArchiveQueryServiceClient interface {
 	// | offset | uint32 | optional  | The offset of the first record to return. Defaults to zero (first record). Maximum offset is 10000. |
 	// | size   | uint32 | optional  | Defaults to 10. Maximum size is 1000. Zero value is ignored (uses default). |
 	GetEventLogs(ctx context.Context, in *GetEventLogsRequest, opts ...grpc.CallOption) (*GetEventLogsResponse, error)
+	// Get a single event log by its epoch and log id. The log id is unique within one epoch.
+	//
+	// Please note: beta version – may be subject to incompatible changes.
+	//
+	// If the event log is not found a NotFound error is returned that contains the last processed tick
+	// for event logs in the details. The event log might not be processed yet.
+	GetEventLog(ctx context.Context, in *GetEventLogRequest, opts ...grpc.CallOption) (*GetEventLogResponse, error)
+	// Get all event logs of one transaction in execution order (log id ascending).
+	//
+	// Please note: beta version – may be subject to incompatible changes.
+	//
+	// The results are paged by log id and not by offset, so there is no limit on the number of event logs that can be
+	// retrieved. Use the log id of the last returned event log as `afterLogId` to get the next page. If the tick of the
+	// transaction is not processed yet for event logs a NotFound error is returned that contains the last processed
+	// tick for event logs in the details.
+	//
+	// ###  Request structure
+	//
+	// | Name            | Type   | Necessity | Description                                                          |
+	// |-----------------|--------|-----------|----------------------------------------------------------------------|
+	// | transactionHash | string | required  | 60 characters lowercase transaction hash.                            |
+	// | afterLogId      | uint64 | optional  | Only return event logs with a log id greater than this value.        |
+	// | size            | uint32 | optional  | Defaults to 10. Maximum size is 1000. Zero value is ignored.         |
+	GetEventLogsForTransaction(ctx context.Context, in *GetEventLogsForTransactionRequest, opts ...grpc.CallOption) (*GetEventLogsForTransactionResponse, error)
 	GetHealth(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*HealthResponse, error)
 }
 
@@ -278,6 +377,26 @@ func (c *archiveQueryServiceClient) GetTransactionByHash(ctx context.Context, in
 	return out, nil
 }
 
+func (c *archiveQueryServiceClient) GetTransactionDetails(ctx context.Context, in *GetTransactionDetailsRequest, opts ...grpc.CallOption) (*GetTransactionDetailsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTransactionDetailsResponse)
+	err := c.cc.Invoke(ctx, ArchiveQueryService_GetTransactionDetails_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *archiveQueryServiceClient) VerifyTransaction(ctx context.Context, in *VerifyTransactionRequest, opts ...grpc.CallOption) (*VerifyTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyTransactionResponse)
+	err := c.cc.Invoke(ctx, ArchiveQueryService_VerifyTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *archiveQueryServiceClient) GetTransactionsForTick(ctx context.Context, in *GetTransactionsForTickRequest, opts ...grpc.CallOption) (*GetTransactionsForTickResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetTransactionsForTickResponse)
@@ -298,6 +417,16 @@ func (c *archiveQueryServiceClient) GetTransactionsForIdentity(ctx context.Conte
 	return out, nil
 }
 
+func (c *archiveQueryServiceClient) GetIdentityOverview(ctx context.Context, in *GetIdentityOverviewRequest, opts ...grpc.CallOption) (*GetIdentityOverviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetIdentityOverviewResponse)
+	err := c.cc.Invoke(ctx, ArchiveQueryService_GetIdentityOverview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *archiveQueryServiceClient) GetTickData(ctx context.Context, in *GetTickDataRequest, opts ...grpc.CallOption) (*GetTickDataResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetTickDataResponse)
@@ -308,6 +437,46 @@ func (c *archiveQueryServiceClient) GetTickData(ctx context.Context, in *GetTick
 	return out, nil
 }
 
+func (c *archiveQueryServiceClient) GetTickDataRange(ctx context.Context, in *GetTickDataRangeRequest, opts ...grpc.CallOption) (*GetTickDataRangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTickDataRangeResponse)
+	err := c.cc.Invoke(ctx, ArchiveQueryService_GetTickDataRange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *archiveQueryServiceClient) VerifyTick(ctx context.Context, in *VerifyTickRequest, opts ...grpc.CallOption) (*VerifyTickResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyTickResponse)
+	err := c.cc.Invoke(ctx, ArchiveQueryService_VerifyTick_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *archiveQueryServiceClient) GetTicksForEpoch(ctx context.Context, in *GetTicksForEpochRequest, opts ...grpc.CallOption) (*GetTicksForEpochResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTicksForEpochResponse)
+	err := c.cc.Invoke(ctx, ArchiveQueryService_GetTicksForEpoch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *archiveQueryServiceClient) GetEmptyTicksForEpoch(ctx context.Context, in *GetEmptyTicksForEpochRequest, opts ...grpc.CallOption) (*GetEmptyTicksForEpochResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEmptyTicksForEpochResponse)
+	err := c.cc.Invoke(ctx, ArchiveQueryService_GetEmptyTicksForEpoch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *archiveQueryServiceClient) GetComputorsListsForEpoch(ctx context.Context, in *GetComputorListsForEpochRequest, opts ...grpc.CallOption) (*GetComputorListsForEpochResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetComputorListsForEpochResponse)
@@ -318,6 +487,46 @@ func (c *archiveQueryServiceClient) GetComputorsListsForEpoch(ctx context.Contex
 	return out, nil
 }
 
+func (c *archiveQueryServiceClient) GetComputorListsForEpochRange(ctx context.Context, in *GetComputorListsForEpochRangeRequest, opts ...grpc.CallOption) (*GetComputorListsForEpochRangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetComputorListsForEpochRangeResponse)
+	err := c.cc.Invoke(ctx, ArchiveQueryService_GetComputorListsForEpochRange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *archiveQueryServiceClient) GetComputorMembership(ctx context.Context, in *GetComputorMembershipRequest, opts ...grpc.CallOption) (*GetComputorMembershipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetComputorMembershipResponse)
+	err := c.cc.Invoke(ctx, ArchiveQueryService_GetComputorMembership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *archiveQueryServiceClient) GetComputorTickStats(ctx context.Context, in *GetComputorTickStatsRequest, opts ...grpc.CallOption) (*GetComputorTickStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetComputorTickStatsResponse)
+	err := c.cc.Invoke(ctx, ArchiveQueryService_GetComputorTickStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *archiveQueryServiceClient) GetEpochSummary(ctx context.Context, in *GetEpochSummaryRequest, opts ...grpc.CallOption) (*GetEpochSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEpochSummaryResponse)
+	err := c.cc.Invoke(ctx, ArchiveQueryService_GetEpochSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *archiveQueryServiceClient) GetLastProcessedTick(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetLastProcessedTickResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetLastProcessedTickResponse)
@@ -348,6 +557,26 @@ func (c *archiveQueryServiceClient) GetEventLogs(ctx context.Context, in *GetEve
 	return out, nil
 }
 
+func (c *archiveQueryServiceClient) GetEventLog(ctx context.Context, in *GetEventLogRequest, opts ...grpc.CallOption) (*GetEventLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEventLogResponse)
+	err := c.cc.Invoke(ctx, ArchiveQueryService_GetEventLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *archiveQueryServiceClient) GetEventLogsForTransaction(ctx context.Context, in *GetEventLogsForTransactionRequest, opts ...grpc.CallOption) (*GetEventLogsForTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEventLogsForTransactionResponse)
+	err := c.cc.Invoke(ctx, ArchiveQueryService_GetEventLogsForTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *archiveQueryServiceClient) GetHealth(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*HealthResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(HealthResponse)
@@ -365,6 +594,22 @@ func (c *archiveQueryServiceClient) GetHealth(ctx context.Context, in *emptypb.E
 // Qubic Query API
 type ArchiveQueryServiceServer interface {
 	GetTransactionByHash(context.Context, *GetTransactionByHashRequest) (*GetTransactionByHashResponse, error)
+	// Get a single transaction together with its event logs and information about the tick it was scheduled for.
+	//
+	// The event logs are returned in execution order (log id ascending). If the transaction has more event logs than
+	// the maximum page size `hasMoreEventLogs` is set and the remaining event logs can be queried with
+	// `/getEventLogsForTransaction`. If the tick of the transaction is not processed yet for event logs the
+	// transaction is returned without event logs and `eventLogsAvailable` is false.
+	//
+	// `includedInTick` tells if the transaction hash is part of the transaction hashes of the tick data. The tick
+	// information is empty, if the tick is empty.
+	GetTransactionDetails(context.Context, *GetTransactionDetailsRequest) (*GetTransactionDetailsResponse, error)
+	// Verify the hash and the signature of a transaction.
+	//
+	// The transaction digest is reconstructed from the transaction data and compared with the transaction hash. The
+	// signature is verified against the public key of the source identity. Either the hash of an archived transaction
+	// or a complete transaction can be passed.
+	VerifyTransaction(context.Context, *VerifyTransactionRequest) (*VerifyTransactionResponse, error)
 	// Get the transactions that are in included in one tick.
 	//
 	// ###  Request structure
@@ -468,11 +713,57 @@ type ArchiveQueryServiceServer interface {
 	// | offset | uint32 | optional  | The offset of the first record to return. Defaults to zero (first record). Maximum offset is 10000. |
 	// | size   | uint32 | optional  | Defaults to 10. Maximum size is 1000. Zero value is ignored (uses default). |
 	GetTransactionsForIdentity(context.Context, *GetTransactionsForIdentityRequest) (*GetTransactionsForIdentityResponse, error)
+	// Get an overview of the activity of one identity.
+	//
+	// Contains the first and last seen tick, the number of incoming and outgoing transactions, the top counterparties by
+	// volume, QU transfer event totals and the assets the identity interacted with. All information is valid up to
+	// `validForTick`.
+	GetIdentityOverview(context.Context, *GetIdentityOverviewRequest) (*GetIdentityOverviewResponse, error)
 	GetTickData(context.Context, *GetTickDataRequest) (*GetTickDataResponse, error)
+	// Get the tick data for a contiguous range of ticks (maximum 100 ticks).
+	//
+	// Returns one entry per tick. Empty ticks (ticks without tick data) are flagged with `isEmpty` and ticks that were
+	// skipped by the archive are flagged with `isSkipped`.
+	GetTickDataRange(context.Context, *GetTickDataRangeRequest) (*GetTickDataRangeResponse, error)
+	// Verify the integrity of the tick data of one tick.
+	//
+	// Checks that the computor list of the tick's epoch is signed by the arbitrator, that the tick data is signed by the
+	// tick leader and that all transactions referenced by the tick data are available in the archive.
+	VerifyTick(context.Context, *VerifyTickRequest) (*VerifyTickResponse, error)
+	// Get the processed ticks of one epoch.
+	//
+	// Only the ticks within the processed tick intervals of the epoch are returned. Empty ticks (ticks without tick data)
+	// are flagged with `isEmpty`. The offset of the pagination is not limited for this endpoint.
+	GetTicksForEpoch(context.Context, *GetTicksForEpochRequest) (*GetTicksForEpochResponse, error)
+	// Get the empty ticks (ticks without tick data) of one epoch in ascending order.
+	//
+	// Only the ticks within the processed tick intervals of the epoch are considered. The offset of the pagination is
+	// not limited for this endpoint.
+	GetEmptyTicksForEpoch(context.Context, *GetEmptyTicksForEpochRequest) (*GetEmptyTicksForEpochResponse, error)
 	// Get the list(s) of computors for one epoch. These are the computors (IDs signed by the arbitrator) that
 	// are allowed to make quorum decisions. It is possible that this list changes within the epoch in case of
 	// arbitrator intervention.
 	GetComputorsListsForEpoch(context.Context, *GetComputorListsForEpochRequest) (*GetComputorListsForEpochResponse, error)
+	// Get the computor lists for a range of epochs (maximum 50 epochs) and the differences between consecutive lists.
+	//
+	// The differences contain the identities that joined and left the computor list compared to the previous list
+	// within the range. The first list of the range has no diff.
+	GetComputorListsForEpochRange(context.Context, *GetComputorListsForEpochRangeRequest) (*GetComputorListsForEpochRangeResponse, error)
+	// Get the computor lists an identity is part of and the index of the identity within each list.
+	GetComputorMembership(context.Context, *GetComputorMembershipRequest) (*GetComputorMembershipResponse, error)
+	// Get the number of produced and empty ticks per computor for the processed ticks of one epoch.
+	//
+	// The produced ticks are the ticks with tick data created by the computor. The empty ticks are the ticks without
+	// tick data the computor was tick leader for (the tick leader index is the tick number modulo the number of
+	// computors).
+	GetComputorTickStats(context.Context, *GetComputorTickStatsRequest) (*GetComputorTickStatsResponse, error)
+	// Get a summary of one epoch.
+	//
+	// Contains the processed tick intervals, information about the first and last tick, transaction and event log
+	// statistics, the burned amount and the latest computor list of the epoch. The summary of the current epoch is
+	// based on the data processed so far. Once the epoch is closed (`closed` is true) the summary does not change
+	// anymore.
+	GetEpochSummary(context.Context, *GetEpochSummaryRequest) (*GetEpochSummaryResponse, error)
 	// Get the last processed tick and other processing information from the archive.
 	// All data queried from the archive is only fully processed up to this tick.
 	// Before calling the service you should check the last processed tick to be sure to get
@@ -584,6 +875,30 @@ type ArchiveQueryServiceServer interface {
 	// | offset | uint32 | optional  | The offset of the first record to return. Defaults to zero (first record). Maximum offset is 10000. |
 	// | size   | uint32 | optional  | Defaults to 10. Maximum size is 1000. Zero value is ignored (uses default). |
 	GetEventLogs(context.Context, *GetEventLogsRequest) (*GetEventLogsResponse, error)
+	// Get a single event log by its epoch and log id. The log id is unique within one epoch.
+	//
+	// Please note: beta version – may be subject to incompatible changes.
+	//
+	// If the event log is not found a NotFound error is returned that contains the last processed tick
+	// for event logs in the details. The event log might not be processed yet.
+	GetEventLog(context.Context, *GetEventLogRequest) (*GetEventLogResponse, error)
+	// Get all event logs of one transaction in execution order (log id ascending).
+	//
+	// Please note: beta version – may be subject to incompatible changes.
+	//
+	// The results are paged by log id and not by offset, so there is no limit on the number of event logs that can be
+	// retrieved. Use the log id of the last returned event log as `afterLogId` to get the next page. If the tick of the
+	// transaction is not processed yet for event logs a NotFound error is returned that contains the last processed
+	// tick for event logs in the details.
+	//
+	// ###  Request structure
+	//
+	// | Name            | Type   | Necessity | Description                                                          |
+	// |-----------------|--------|-----------|----------------------------------------------------------------------|
+	// | transactionHash | string | required  | 60 characters lowercase transaction hash.                            |
+	// | afterLogId      | uint64 | optional  | Only return event logs with a log id greater than this value.        |
+	// | size            | uint32 | optional  | Defaults to 10. Maximum size is 1000. Zero value is ignored.         |
+	GetEventLogsForTransaction(context.Context, *GetEventLogsForTransactionRequest) (*GetEventLogsForTransactionResponse, error)
 	GetHealth(context.Context, *emptypb.Empty) (*HealthResponse, error)
 	mustEmbedUnimplementedArchiveQueryServiceServer()
 }
@@ -598,18 +913,51 @@ type UnimplementedArchiveQueryServiceServer struct{}
 func (UnimplementedArchiveQueryServiceServer) GetTransactionByHash(context.Context, *GetTransactionByHashRequest) (*GetTransactionByHashResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetTransactionByHash not implemented")
 }
+func (UnimplementedArchiveQueryServiceServer) GetTransactionDetails(context.Context, *GetTransactionDetailsRequest) (*GetTransactionDetailsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTransactionDetails not implemented")
+}
+func (UnimplementedArchiveQueryServiceServer) VerifyTransaction(context.Context, *VerifyTransactionRequest) (*VerifyTransactionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method VerifyTransaction not implemented")
+}
 func (UnimplementedArchiveQueryServiceServer) GetTransactionsForTick(context.Context, *GetTransactionsForTickRequest) (*GetTransactionsForTickResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetTransactionsForTick not implemented")
 }
 func (UnimplementedArchiveQueryServiceServer) GetTransactionsForIdentity(context.Context, *GetTransactionsForIdentityRequest) (*GetTransactionsForIdentityResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetTransactionsForIdentity not implemented")
 }
+func (UnimplementedArchiveQueryServiceServer) GetIdentityOverview(context.Context, *GetIdentityOverviewRequest) (*GetIdentityOverviewResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetIdentityOverview not implemented")
+}
 func (UnimplementedArchiveQueryServiceServer) GetTickData(context.Context, *GetTickDataRequest) (*GetTickDataResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetTickData not implemented")
 }
+func (UnimplementedArchiveQueryServiceServer) GetTickDataRange(context.Context, *GetTickDataRangeRequest) (*GetTickDataRangeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTickDataRange not implemented")
+}
+func (UnimplementedArchiveQueryServiceServer) VerifyTick(context.Context, *VerifyTickRequest) (*VerifyTickResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method VerifyTick not implemented")
+}
+func (UnimplementedArchiveQueryServiceServer) GetTicksForEpoch(context.Context, *GetTicksForEpochRequest) (*GetTicksForEpochResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTicksForEpoch not implemented")
+}
+func (UnimplementedArchiveQueryServiceServer) GetEmptyTicksForEpoch(context.Context, *GetEmptyTicksForEpochRequest) (*GetEmptyTicksForEpochResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetEmptyTicksForEpoch not implemented")
+}
 func (UnimplementedArchiveQueryServiceServer) GetComputorsListsForEpoch(context.Context, *GetComputorListsForEpochRequest) (*GetComputorListsForEpochResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetComputorsListsForEpoch not implemented")
 }
+func (UnimplementedArchiveQueryServiceServer) GetComputorListsForEpochRange(context.Context, *GetComputorListsForEpochRangeRequest) (*GetComputorListsForEpochRangeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetComputorListsForEpochRange not implemented")
+}
+func (UnimplementedArchiveQueryServiceServer) GetComputorMembership(context.Context, *GetComputorMembershipRequest) (*GetComputorMembershipResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetComputorMembership not implemented")
+}
+func (UnimplementedArchiveQueryServiceServer) GetComputorTickStats(context.Context, *GetComputorTickStatsRequest) (*GetComputorTickStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetComputorTickStats not implemented")
+}
+func (UnimplementedArchiveQueryServiceServer) GetEpochSummary(context.Context, *GetEpochSummaryRequest) (*GetEpochSummaryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetEpochSummary not implemented")
+}
 func (UnimplementedArchiveQueryServiceServer) GetLastProcessedTick(context.Context, *emptypb.Empty) (*GetLastProcessedTickResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetLastProcessedTick not implemented")
 }
@@ -619,6 +967,12 @@ func (UnimplementedArchiveQueryServiceServer) GetProcessedTickIntervals(context.
 func (UnimplementedArchiveQueryServiceServer) GetEventLogs(context.Context, *GetEventLogsRequest) (*GetEventLogsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetEventLogs not implemented")
 }
+func (UnimplementedArchiveQueryServiceServer) GetEventLog(context.Context, *GetEventLogRequest) (*GetEventLogResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetEventLog not implemented")
+}
+func (UnimplementedArchiveQueryServiceServer) GetEventLogsForTransaction(context.Context, *GetEventLogsForTransactionRequest) (*GetEventLogsForTransactionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetEventLogsForTransaction not implemented")
+}
 func (UnimplementedArchiveQueryServiceServer) GetHealth(context.Context, *emptypb.Empty) (*HealthResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetHealth not implemented")
 }
@@ -661,6 +1015,42 @@ func _ArchiveQueryService_GetTransactionByHash_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArchiveQueryService_GetTransactionDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchiveQueryServiceServer).GetTransactionDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArchiveQueryService_GetTransactionDetails_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchiveQueryServiceServer).GetTransactionDetails(ctx, req.(*GetTransactionDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArchiveQueryService_VerifyTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchiveQueryServiceServer).VerifyTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArchiveQueryService_VerifyTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchiveQueryServiceServer).VerifyTransaction(ctx, req.(*VerifyTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArchiveQueryService_GetTransactionsForTick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTransactionsForTickRequest)
 	if err := dec(in); err != nil {
@@ -697,6 +1087,24 @@ func _ArchiveQueryService_GetTransactionsForIdentity_Handler(srv interface{}, ct
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArchiveQueryService_GetIdentityOverview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIdentityOverviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchiveQueryServiceServer).GetIdentityOverview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArchiveQueryService_GetIdentityOverview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchiveQueryServiceServer).GetIdentityOverview(ctx, req.(*GetIdentityOverviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArchiveQueryService_GetTickData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTickDataRequest)
 	if err := dec(in); err != nil {
@@ -715,6 +1123,78 @@ func _ArchiveQueryService_GetTickData_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArchiveQueryService_GetTickDataRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTickDataRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchiveQueryServiceServer).GetTickDataRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArchiveQueryService_GetTickDataRange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchiveQueryServiceServer).GetTickDataRange(ctx, req.(*GetTickDataRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArchiveQueryService_VerifyTick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyTickRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchiveQueryServiceServer).VerifyTick(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArchiveQueryService_VerifyTick_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchiveQueryServiceServer).VerifyTick(ctx, req.(*VerifyTickRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArchiveQueryService_GetTicksForEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTicksForEpochRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchiveQueryServiceServer).GetTicksForEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArchiveQueryService_GetTicksForEpoch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchiveQueryServiceServer).GetTicksForEpoch(ctx, req.(*GetTicksForEpochRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArchiveQueryService_GetEmptyTicksForEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEmptyTicksForEpochRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchiveQueryServiceServer).GetEmptyTicksForEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArchiveQueryService_GetEmptyTicksForEpoch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchiveQueryServiceServer).GetEmptyTicksForEpoch(ctx, req.(*GetEmptyTicksForEpochRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArchiveQueryService_GetComputorsListsForEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetComputorListsForEpochRequest)
 	if err := dec(in); err != nil {
@@ -733,6 +1213,78 @@ func _ArchiveQueryService_GetComputorsListsForEpoch_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArchiveQueryService_GetComputorListsForEpochRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetComputorListsForEpochRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchiveQueryServiceServer).GetComputorListsForEpochRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArchiveQueryService_GetComputorListsForEpochRange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchiveQueryServiceServer).GetComputorListsForEpochRange(ctx, req.(*GetComputorListsForEpochRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArchiveQueryService_GetComputorMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetComputorMembershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchiveQueryServiceServer).GetComputorMembership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArchiveQueryService_GetComputorMembership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchiveQueryServiceServer).GetComputorMembership(ctx, req.(*GetComputorMembershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArchiveQueryService_GetComputorTickStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetComputorTickStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchiveQueryServiceServer).GetComputorTickStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArchiveQueryService_GetComputorTickStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchiveQueryServiceServer).GetComputorTickStats(ctx, req.(*GetComputorTickStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArchiveQueryService_GetEpochSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEpochSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchiveQueryServiceServer).GetEpochSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArchiveQueryService_GetEpochSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchiveQueryServiceServer).GetEpochSummary(ctx, req.(*GetEpochSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArchiveQueryService_GetLastProcessedTick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -787,6 +1339,42 @@ func _ArchiveQueryService_GetEventLogs_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArchiveQueryService_GetEventLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchiveQueryServiceServer).GetEventLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArchiveQueryService_GetEventLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchiveQueryServiceServer).GetEventLog(ctx, req.(*GetEventLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArchiveQueryService_GetEventLogsForTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventLogsForTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchiveQueryServiceServer).GetEventLogsForTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArchiveQueryService_GetEventLogsForTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchiveQueryServiceServer).GetEventLogsForTransaction(ctx, req.(*GetEventLogsForTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArchiveQueryService_GetHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -816,6 +1404,14 @@ var ArchiveQueryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTransactionByHash",
 			Handler:    _ArchiveQueryService_GetTransactionByHash_Handler,
 		},
+		{
+			MethodName: "GetTransactionDetails",
+			Handler:    _ArchiveQueryService_GetTransactionDetails_Handler,
+		},
+		{
+			MethodName: "VerifyTransaction",
+			Handler:    _ArchiveQueryService_VerifyTransaction_Handler,
+		},
 		{
 			MethodName: "GetTransactionsForTick",
 			Handler:    _ArchiveQueryService_GetTransactionsForTick_Handler,
@@ -824,14 +1420,50 @@ var ArchiveQueryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTransactionsForIdentity",
 			Handler:    _ArchiveQueryService_GetTransactionsForIdentity_Handler,
 		},
+		{
+			MethodName: "GetIdentityOverview",
+			Handler:    _ArchiveQueryService_GetIdentityOverview_Handler,
+		},
 		{
 			MethodName: "GetTickData",
 			Handler:    _ArchiveQueryService_GetTickData_Handler,
 		},
+		{
+			MethodName: "GetTickDataRange",
+			Handler:    _ArchiveQueryService_GetTickDataRange_Handler,
+		},
+		{
+			MethodName: "VerifyTick",
+			Handler:    _ArchiveQueryService_VerifyTick_Handler,
+		},
+		{
+			MethodName: "GetTicksForEpoch",
+			Handler:    _ArchiveQueryService_GetTicksForEpoch_Handler,
+		},
+		{
+			MethodName: "GetEmptyTicksForEpoch",
+			Handler:    _ArchiveQueryService_GetEmptyTicksForEpoch_Handler,
+		},
 		{
 			MethodName: "GetComputorsListsForEpoch",
 			Handler:    _ArchiveQueryService_GetComputorsListsForEpoch_Handler,
 		},
+		{
+			MethodName: "GetComputorListsForEpochRange",
+			Handler:    _ArchiveQueryService_GetComputorListsForEpochRange_Handler,
+		},
+		{
+			MethodName: "GetComputorMembership",
+			Handler:    _ArchiveQueryService_GetComputorMembership_Handler,
+		},
+		{
+			MethodName: "GetComputorTickStats",
+			Handler:    _ArchiveQueryService_GetComputorTickStats_Handler,
+		},
+		{
+			MethodName: "GetEpochSummary",
+			Handler:    _ArchiveQueryService_GetEpochSummary_Handler,
+		},
 		{
 			MethodName: "GetLastProcessedTick",
 			Handler:    _ArchiveQueryService_GetLastProcessedTick_Handler,
@@ -844,6 +1476,14 @@ var ArchiveQueryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetEventLogs",
 			Handler:    _ArchiveQueryService_GetEventLogs_Handler,
 		},
+		{
+			MethodName: "GetEventLog",
+			Handler:    _ArchiveQueryService_GetEventLog_Handler,
+		},
+		{
+			MethodName: "GetEventLogsForTransaction",
+			Handler:    _ArchiveQueryService_GetEventLogsForTransaction_Handler,
+		},
 		{
 			MethodName: "GetHealth",
 			Handler:    _ArchiveQueryService_GetHealth_Handler,
@@ -0,0 +1,623 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.1
+// source: webhooks.proto
+
+package api
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// RegisterWebhookRequest
+type RegisterWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`                              // http(s) url the notifications are posted to
+	Identity      string                 `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`                    // notify about new transactions of this identity
+	EventLogs     *GetEventLogsRequest   `protobuf:"bytes,3,opt,name=event_logs,json=eventLogs,proto3" json:"event_logs,omitempty"` // notify about new event logs matching this filter. Tick number filters and pagination are not supported.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterWebhookRequest) Reset() {
+	*x = RegisterWebhookRequest{}
+	mi := &file_webhooks_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterWebhookRequest) ProtoMessage() {}
+
+func (x *RegisterWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_webhooks_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterWebhookRequest.ProtoReflect.Descriptor instead.
+func (*RegisterWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_webhooks_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RegisterWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *RegisterWebhookRequest) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *RegisterWebhookRequest) GetEventLogs() *GetEventLogsRequest {
+	if x != nil {
+		return x.EventLogs
+	}
+	return nil
+}
+
+// RegisterWebhookResponse
+type RegisterWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhook       *Webhook               `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	Secret        string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"` // hex encoded HMAC-SHA256 key for verifying the X-Webhook-Signature header
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterWebhookResponse) Reset() {
+	*x = RegisterWebhookResponse{}
+	mi := &file_webhooks_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterWebhookResponse) ProtoMessage() {}
+
+func (x *RegisterWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_webhooks_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterWebhookResponse.ProtoReflect.Descriptor instead.
+func (*RegisterWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_webhooks_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RegisterWebhookResponse) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+func (x *RegisterWebhookResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+// GetWebhookRequest
+type GetWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWebhookRequest) Reset() {
+	*x = GetWebhookRequest{}
+	mi := &file_webhooks_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhookRequest) ProtoMessage() {}
+
+func (x *GetWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_webhooks_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhookRequest.ProtoReflect.Descriptor instead.
+func (*GetWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_webhooks_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetWebhookRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetWebhookResponse
+type GetWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhook       *Webhook               `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWebhookResponse) Reset() {
+	*x = GetWebhookResponse{}
+	mi := &file_webhooks_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhookResponse) ProtoMessage() {}
+
+func (x *GetWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_webhooks_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhookResponse.ProtoReflect.Descriptor instead.
+func (*GetWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_webhooks_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetWebhookResponse) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+// ListWebhooksResponse
+type ListWebhooksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhooks      []*Webhook             `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksResponse) Reset() {
+	*x = ListWebhooksResponse{}
+	mi := &file_webhooks_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksResponse) ProtoMessage() {}
+
+func (x *ListWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_webhooks_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_webhooks_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListWebhooksResponse) GetWebhooks() []*Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+// DeleteWebhookRequest
+type DeleteWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookRequest) Reset() {
+	*x = DeleteWebhookRequest{}
+	mi := &file_webhooks_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_webhooks_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_webhooks_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteWebhookRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Webhook
+type Webhook struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url              string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Identity         string                 `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
+	EventLogs        *GetEventLogsRequest   `protobuf:"bytes,4,opt,name=event_logs,json=eventLogs,proto3" json:"event_logs,omitempty"`
+	CreatedAt        uint64                 `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                        // unix timestamp in milliseconds
+	LastNotifiedTick uint32                 `protobuf:"varint,6,opt,name=last_notified_tick,json=lastNotifiedTick,proto3" json:"last_notified_tick,omitempty"` // all data up to this tick was notified (or failed to be delivered)
+	DeliveryStatus   *WebhookDeliveryStatus `protobuf:"bytes,7,opt,name=delivery_status,json=deliveryStatus,proto3" json:"delivery_status,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	mi := &file_webhooks_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_webhooks_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_webhooks_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Webhook) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *Webhook) GetEventLogs() *GetEventLogsRequest {
+	if x != nil {
+		return x.EventLogs
+	}
+	return nil
+}
+
+func (x *Webhook) GetCreatedAt() uint64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Webhook) GetLastNotifiedTick() uint32 {
+	if x != nil {
+		return x.LastNotifiedTick
+	}
+	return 0
+}
+
+func (x *Webhook) GetDeliveryStatus() *WebhookDeliveryStatus {
+	if x != nil {
+		return x.DeliveryStatus
+	}
+	return nil
+}
+
+// WebhookDeliveryStatus
+type WebhookDeliveryStatus struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Delivered           uint64                 `protobuf:"varint,1,opt,name=delivered,proto3" json:"delivered,omitempty"` // number of successful deliveries
+	Failed              uint64                 `protobuf:"varint,2,opt,name=failed,proto3" json:"failed,omitempty"`       // number of deliveries that failed after all retries
+	ConsecutiveFailures uint32                 `protobuf:"varint,3,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	LastAttempt         uint64                 `protobuf:"varint,4,opt,name=last_attempt,json=lastAttempt,proto3" json:"last_attempt,omitempty"`            // unix timestamp in milliseconds
+	LastSuccess         uint64                 `protobuf:"varint,5,opt,name=last_success,json=lastSuccess,proto3" json:"last_success,omitempty"`            // unix timestamp in milliseconds
+	LastStatusCode      int32                  `protobuf:"varint,6,opt,name=last_status_code,json=lastStatusCode,proto3" json:"last_status_code,omitempty"` // http status code of the last attempt. Zero, if there was no response.
+	LastError           string                 `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *WebhookDeliveryStatus) Reset() {
+	*x = WebhookDeliveryStatus{}
+	mi := &file_webhooks_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDeliveryStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDeliveryStatus) ProtoMessage() {}
+
+func (x *WebhookDeliveryStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_webhooks_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDeliveryStatus.ProtoReflect.Descriptor instead.
+func (*WebhookDeliveryStatus) Descriptor() ([]byte, []int) {
+	return file_webhooks_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *WebhookDeliveryStatus) GetDelivered() uint64 {
+	if x != nil {
+		return x.Delivered
+	}
+	return 0
+}
+
+func (x *WebhookDeliveryStatus) GetFailed() uint64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *WebhookDeliveryStatus) GetConsecutiveFailures() uint32 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (x *WebhookDeliveryStatus) GetLastAttempt() uint64 {
+	if x != nil {
+		return x.LastAttempt
+	}
+	return 0
+}
+
+func (x *WebhookDeliveryStatus) GetLastSuccess() uint64 {
+	if x != nil {
+		return x.LastSuccess
+	}
+	return 0
+}
+
+func (x *WebhookDeliveryStatus) GetLastStatusCode() int32 {
+	if x != nil {
+		return x.LastStatusCode
+	}
+	return 0
+}
+
+func (x *WebhookDeliveryStatus) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+var File_webhooks_proto protoreflect.FileDescriptor
+
+const file_webhooks_proto_rawDesc = "" +
+	"\n" +
+	"\x0ewebhooks.proto\x12\x13qubic.v2.archive.pb\x1a\x0emessages.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\"\x8f\x01\n" +
+	"\x16RegisterWebhookRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x1a\n" +
+	"\bidentity\x18\x02 \x01(\tR\bidentity\x12G\n" +
+	"\n" +
+	"event_logs\x18\x03 \x01(\v2(.qubic.v2.archive.pb.GetEventLogsRequestR\teventLogs\"i\n" +
+	"\x17RegisterWebhookResponse\x126\n" +
+	"\awebhook\x18\x01 \x01(\v2\x1c.qubic.v2.archive.pb.WebhookR\awebhook\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\"#\n" +
+	"\x11GetWebhookRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"L\n" +
+	"\x12GetWebhookResponse\x126\n" +
+	"\awebhook\x18\x01 \x01(\v2\x1c.qubic.v2.archive.pb.WebhookR\awebhook\"P\n" +
+	"\x14ListWebhooksResponse\x128\n" +
+	"\bwebhooks\x18\x01 \x03(\v2\x1c.qubic.v2.archive.pb.WebhookR\bwebhooks\"&\n" +
+	"\x14DeleteWebhookRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xb2\x02\n" +
+	"\aWebhook\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1a\n" +
+	"\bidentity\x18\x03 \x01(\tR\bidentity\x12G\n" +
+	"\n" +
+	"event_logs\x18\x04 \x01(\v2(.qubic.v2.archive.pb.GetEventLogsRequestR\teventLogs\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x04R\tcreatedAt\x12,\n" +
+	"\x12last_notified_tick\x18\x06 \x01(\rR\x10lastNotifiedTick\x12S\n" +
+	"\x0fdelivery_status\x18\a \x01(\v2*.qubic.v2.archive.pb.WebhookDeliveryStatusR\x0edeliveryStatus\"\x8f\x02\n" +
+	"\x15WebhookDeliveryStatus\x12\x1c\n" +
+	"\tdelivered\x18\x01 \x01(\x04R\tdelivered\x12\x16\n" +
+	"\x06failed\x18\x02 \x01(\x04R\x06failed\x121\n" +
+	"\x14consecutive_failures\x18\x03 \x01(\rR\x13consecutiveFailures\x12!\n" +
+	"\flast_attempt\x18\x04 \x01(\x04R\vlastAttempt\x12!\n" +
+	"\flast_success\x18\x05 \x01(\x04R\vlastSuccess\x12(\n" +
+	"\x10last_status_code\x18\x06 \x01(\x05R\x0elastStatusCode\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\a \x01(\tR\tlastError2\xee\x03\n" +
+	"\x0eWebhookService\x12\x8b\x01\n" +
+	"\x0fRegisterWebhook\x12+.qubic.v2.archive.pb.RegisterWebhookRequest\x1a,.qubic.v2.archive.pb.RegisterWebhookResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/webhooks/register\x12w\n" +
+	"\n" +
+	"GetWebhook\x12&.qubic.v2.archive.pb.GetWebhookRequest\x1a'.qubic.v2.archive.pb.GetWebhookResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/webhooks/get\x12d\n" +
+	"\fListWebhooks\x12\x16.google.protobuf.Empty\x1a).qubic.v2.archive.pb.ListWebhooksResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/webhooks\x12o\n" +
+	"\rDeleteWebhook\x12).qubic.v2.archive.pb.DeleteWebhookRequest\x1a\x16.google.protobuf.Empty\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/webhooks/deleteB,Z*github.com/qubic/archive-query-service/apib\x06proto3"
+
+var (
+	file_webhooks_proto_rawDescOnce sync.Once
+	file_webhooks_proto_rawDescData []byte
+)
+
+func file_webhooks_proto_rawDescGZIP() []byte {
+	file_webhooks_proto_rawDescOnce.Do(func() {
+		file_webhooks_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_webhooks_proto_rawDesc), len(file_webhooks_proto_rawDesc)))
+	})
+	return file_webhooks_proto_rawDescData
+}
+
+var file_webhooks_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_webhooks_proto_goTypes = []any{
+	(*RegisterWebhookRequest)(nil),  // 0: qubic.v2.archive.pb.RegisterWebhookRequest
+	(*RegisterWebhookResponse)(nil), // 1: qubic.v2.archive.pb.RegisterWebhookResponse
+	(*GetWebhookRequest)(nil),       // 2: qubic.v2.archive.pb.GetWebhookRequest
+	(*GetWebhookResponse)(nil),      // 3: qubic.v2.archive.pb.GetWebhookResponse
+	(*ListWebhooksResponse)(nil),    // 4: qubic.v2.archive.pb.ListWebhooksResponse
+	(*DeleteWebhookRequest)(nil),    // 5: qubic.v2.archive.pb.DeleteWebhookRequest
+	(*Webhook)(nil),                 // 6: qubic.v2.archive.pb.Webhook
+	(*WebhookDeliveryStatus)(nil),   // 7: qubic.v2.archive.pb.WebhookDeliveryStatus
+	(*GetEventLogsRequest)(nil),     // 8: qubic.v2.archive.pb.GetEventLogsRequest
+	(*emptypb.Empty)(nil),           // 9: google.protobuf.Empty
+}
+var file_webhooks_proto_depIdxs = []int32{
+	8,  // 0: qubic.v2.archive.pb.RegisterWebhookRequest.event_logs:type_name -> qubic.v2.archive.pb.GetEventLogsRequest
+	6,  // 1: qubic.v2.archive.pb.RegisterWebhookResponse.webhook:type_name -> qubic.v2.archive.pb.Webhook
+	6,  // 2: qubic.v2.archive.pb.GetWebhookResponse.webhook:type_name -> qubic.v2.archive.pb.Webhook
+	6,  // 3: qubic.v2.archive.pb.ListWebhooksResponse.webhooks:type_name -> qubic.v2.archive.pb.Webhook
+	8,  // 4: qubic.v2.archive.pb.Webhook.event_logs:type_name -> qubic.v2.archive.pb.GetEventLogsRequest
+	7,  // 5: qubic.v2.archive.pb.Webhook.delivery_status:type_name -> qubic.v2.archive.pb.WebhookDeliveryStatus
+	0,  // 6: qubic.v2.archive.pb.WebhookService.RegisterWebhook:input_type -> qubic.v2.archive.pb.RegisterWebhookRequest
+	2,  // 7: qubic.v2.archive.pb.WebhookService.GetWebhook:input_type -> qubic.v2.archive.pb.GetWebhookRequest
+	9,  // 8: qubic.v2.archive.pb.WebhookService.ListWebhooks:input_type -> google.protobuf.Empty
+	5,  // 9: qubic.v2.archive.pb.WebhookService.DeleteWebhook:input_type -> qubic.v2.archive.pb.DeleteWebhookRequest
+	1,  // 10: qubic.v2.archive.pb.WebhookService.RegisterWebhook:output_type -> qubic.v2.archive.pb.RegisterWebhookResponse
+	3,  // 11: qubic.v2.archive.pb.WebhookService.GetWebhook:output_type -> qubic.v2.archive.pb.GetWebhookResponse
+	4,  // 12: qubic.v2.archive.pb.WebhookService.ListWebhooks:output_type -> qubic.v2.archive.pb.ListWebhooksResponse
+	9,  // 13: qubic.v2.archive.pb.WebhookService.DeleteWebhook:output_type -> google.protobuf.Empty
+	10, // [10:14] is the sub-list for method output_type
+	6,  // [6:10] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_webhooks_proto_init() }
+func file_webhooks_proto_init() {
+	if File_webhooks_proto != nil {
+		return
+	}
+	file_messages_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_webhooks_proto_rawDesc), len(file_webhooks_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_webhooks_proto_goTypes,
+		DependencyIndexes: file_webhooks_proto_depIdxs,
+		MessageInfos:      file_webhooks_proto_msgTypes,
+	}.Build()
+	File_webhooks_proto = out.File
+	file_webhooks_proto_goTypes = nil
+	file_webhooks_proto_depIdxs = nil
+}
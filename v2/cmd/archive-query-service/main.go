@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
@@ -18,15 +19,40 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/qubic/archive-query-service/v2/domain"
 	"github.com/qubic/archive-query-service/v2/domain/repository/elastic"
+	"github.com/qubic/archive-query-service/v2/domain/repository/file"
+	"github.com/qubic/archive-query-service/v2/domain/repository/memory"
 	rpc "github.com/qubic/archive-query-service/v2/grpc"
+	"github.com/qubic/archive-query-service/v2/grpc/graphql"
+	"github.com/qubic/archive-query-service/v2/grpc/legacy"
+	"github.com/qubic/archive-query-service/v2/grpc/subscriptions"
+	"github.com/qubic/archive-query-service/v2/grpc/tlsconfig"
 	statusPb "github.com/qubic/go-data-publisher/status-service/protobuf"
 	"github.com/redis/go-redis/v9"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
 const prefix = "QUBIC_LTS_QUERY_SERVICE_V2"
 
+// archiveRepository is implemented by the elastic and the in-memory archive repository.
+type archiveRepository interface {
+	domain.TransactionRepository
+	domain.TickDataRepository
+	domain.ComputorsListRepository
+	domain.EpochStatsRepository
+	domain.IdentityTransactionsRepository
+	domain.TransactionsExportRepository
+}
+
+// eventsRepository is implemented by the elastic and the in-memory events repository.
+type eventsRepository interface {
+	domain.EventsRepository
+	domain.EventStatsRepository
+	domain.IdentityEventsRepository
+	domain.EventsExportRepository
+}
+
 func main() {
 	log.SetOutput(os.Stdout)
 	if err := run(); err != nil {
@@ -36,6 +62,10 @@ func main() {
 
 func run() error {
 	var cfg struct {
+		// Dev serves the data of the fixtures file from memory. Elasticsearch and the status service are not needed.
+		Dev             bool   `conf:"default:false"`
+		DevFixturesFile string `conf:"default:dev/fixtures.json"`
+
 		Server struct {
 			ReadTimeout           time.Duration `conf:"default:5s"`
 			WriteTimeout          time.Duration `conf:"default:5s"`
@@ -47,8 +77,57 @@ func run() error {
 			StatusDataCacheTTL    time.Duration `conf:"default:1s"`
 			CacheEnabled          bool          `conf:"default:false"`
 			CacheTTLFile          string        `conf:"default:cache_ttl.json"`
+			RequestTimeout        time.Duration `conf:"default:30s"`
+			RequestTimeoutsFile   string        `conf:"optional"`
 			MaxRecvSizeInMb       int           `conf:"default:1"`
 			MaxSendSizeInMb       int           `conf:"default:10"`
+			LegacyServiceEnabled  bool          `conf:"default:false"`
+			CompressionEnabled    bool          `conf:"default:true"`
+			CompactJSON           bool          `conf:"default:false"`
+			GrpcWebEnabled        bool          `conf:"default:false"`
+			GrpcWebAllowedOrigins []string      `conf:"optional"` // no cross-origin requests if empty
+		}
+		TLS struct {
+			CertFile          string `conf:"optional"` // enables tls for the grpc and http listeners
+			KeyFile           string `conf:"optional"`
+			GrpcClientCAFile  string `conf:"optional"` // requires client certificates for the grpc listener (mTLS)
+			GatewayCAFile     string `conf:"optional"` // verifies the grpc server certificate, system certificates if empty
+			GatewayCertFile   string `conf:"optional"` // client certificate of the http gateway, server certificate if empty
+			GatewayKeyFile    string `conf:"optional"`
+			GatewayServerName string `conf:"default:localhost"`
+		}
+		StatusServiceTLS struct {
+			Enabled    bool   `conf:"default:false"`
+			CAFile     string `conf:"optional"` // system certificates if empty
+			CertFile   string `conf:"optional"` // client certificate (mTLS)
+			KeyFile    string `conf:"optional"`
+			ServerName string `conf:"optional"`
+		}
+		Export struct {
+			APIKeys []string `conf:"mask,optional"`
+			MaxRows uint32   `conf:"default:100000"`
+		}
+		GraphQL struct {
+			Enabled       bool `conf:"default:false"`
+			MaxDepth      int  `conf:"default:8"`
+			MaxComplexity int  `conf:"default:10000"`
+		}
+		Webhooks struct {
+			APIKeys        []string      `conf:"mask,optional,help:Enables webhooks. Only supported on a single instance."`
+			StoreFile      string        `conf:"default:webhooks.json,help:Local registrations file. Not shared between instances."`
+			MaxWebhooks    int           `conf:"default:10"`
+			MaxAttempts    int           `conf:"default:5"`
+			InitialBackoff time.Duration `conf:"default:1s"`
+			MaxBackoff     time.Duration `conf:"default:1m"`
+			Timeout        time.Duration `conf:"default:10s"`
+			// only for testing and internal deployments. allows webhooks to reach internal services.
+			AllowPrivateAddresses bool `conf:"default:false"`
+		}
+		Subscriptions struct {
+			Enabled          bool `conf:"default:false"`
+			MaxConnections   int  `conf:"default:1000"`
+			MaxSubscriptions int  `conf:"default:10"`
+			MaxIdentities    int  `conf:"default:10"`
 		}
 		Pagination struct {
 			MaxPageSize     uint32 `conf:"default:1000"`
@@ -64,6 +143,11 @@ func run() error {
 			TransactionsIndex  string        `conf:"default:qubic-transactions-alias"`
 			TickDataIndex      string        `conf:"default:qubic-tick-data-alias"`
 			ComputorsListIndex string        `conf:"default:qubic-computors-alias"`
+			// Clusters is an ordered list of clusters (name=address1,address2). Overrides the address.
+			Clusters            []string      `conf:"optional"`
+			MaxTickLag          uint32        `conf:"default:100"`
+			FailureBackoff      time.Duration `conf:"default:30s"`
+			HealthCheckInterval time.Duration `conf:"default:10s"`
 		}
 		EventsElasticSearch struct {
 			Address         []string      `conf:"default:https://localhost:9200"`
@@ -73,6 +157,11 @@ func run() error {
 			MaxRetries      int           `conf:"default:3"`
 			ReadTimeout     time.Duration `conf:"default:10s"`
 			EventsIndex     string        `conf:"default:qubic-event-logs-read"`
+			// Clusters is an ordered list of clusters (name=address1,address2). Overrides the address.
+			Clusters            []string      `conf:"optional"`
+			MaxTickLag          uint32        `conf:"default:100"`
+			FailureBackoff      time.Duration `conf:"default:30s"`
+			HealthCheckInterval time.Duration `conf:"default:10s"`
 		}
 		Metrics struct {
 			Namespace string `conf:"default:query_service_v2"`
@@ -116,75 +205,164 @@ func run() error {
 	}
 	log.Printf("main: Config :\n%v\n", out)
 
-	cert, err := os.ReadFile(cfg.ElasticSearch.CertificatePath)
-	if err != nil {
-		log.Printf("warn: Failed to load Elastic certificate file: %v\n", err)
-	}
-
-	elsCfg := elasticsearch.Config{
-		Addresses:     cfg.ElasticSearch.Address,
-		Username:      cfg.ElasticSearch.Username,
-		Password:      cfg.ElasticSearch.Password,
-		CACert:        cert,
-		RetryOnStatus: []int{502, 503, 504, 429},
-		MaxRetries:    cfg.ElasticSearch.MaxRetries,
-		Transport: &http.Transport{
-			MaxIdleConnsPerHost:   10,
-			ResponseHeaderTimeout: cfg.ElasticSearch.ReadTimeout,
-		},
-	}
-
-	esClient, err := elasticsearch.NewClient(elsCfg)
-	if err != nil {
-		return fmt.Errorf("creating elasticsearch client: %w", err)
-	}
-
 	srvMetrics := grpcProm.NewServerMetrics(
 		grpcProm.WithServerCounterOptions(grpcProm.WithConstLabels(prometheus.Labels{"namespace": cfg.Metrics.Namespace})),
 	)
 	reg := prometheus.DefaultRegisterer
 	reg.MustRegister(srvMetrics)
+	var repo archiveRepository
+	var eventsRepo eventsRepository
+	var statusServiceClient statusPb.StatusServiceClient
+	if cfg.Dev {
+		log.Printf("main: dev mode, serving the fixtures of [%s] from memory", cfg.DevFixturesFile)
+		fixtures, err := memory.LoadFixtures(cfg.DevFixturesFile)
+		if err != nil {
+			return fmt.Errorf("loading fixtures: %w", err)
+		}
+		repo = memory.NewArchiveRepository(fixtures.Transactions, fixtures.TickData, fixtures.ComputorsLists)
+		eventsRepo = memory.NewEventsRepository(fixtures.Events)
+		statusServiceClient = memory.NewStatusServiceClient(fixtures.Status, fixtures.TickIntervals)
+	} else {
+		clusterMetrics := elastic.NewClusterMetrics(cfg.Metrics.Namespace, reg)
 
-	statusServiceGrpcConn, err := grpc.NewClient(cfg.Server.StatusServiceGrpcHost, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		return fmt.Errorf("creating archiver api connection: %w", err)
+		archiveClusters, err := createESClusters("archive", cfg.ElasticSearch.Clusters, cfg.ElasticSearch.Address,
+			func(addresses []string) (*elasticsearch.Client, error) {
+				return createESClient(addresses, cfg.ElasticSearch.Username, cfg.ElasticSearch.Password,
+					cfg.ElasticSearch.CertificatePath, cfg.ElasticSearch.MaxRetries, cfg.ElasticSearch.ReadTimeout)
+			}, elastic.ClusterOptions{
+				TickIndex:           cfg.ElasticSearch.TickDataIndex,
+				MaxTickLag:          cfg.ElasticSearch.MaxTickLag,
+				FailureBackoff:      cfg.ElasticSearch.FailureBackoff,
+				HealthCheckInterval: cfg.ElasticSearch.HealthCheckInterval,
+			}, clusterMetrics)
+		if err != nil {
+			return fmt.Errorf("creating elasticsearch clusters: %w", err)
+		}
+		archiveClusters.Start()
+		defer closeESClusters(archiveClusters)
+
+		statusServiceCredentials := insecure.NewCredentials()
+		if cfg.StatusServiceTLS.Enabled {
+			tlsCfg, err := tlsconfig.ClientConfig(cfg.StatusServiceTLS.CAFile, cfg.StatusServiceTLS.CertFile, cfg.StatusServiceTLS.KeyFile, cfg.StatusServiceTLS.ServerName)
+			if err != nil {
+				return fmt.Errorf("creating status service tls config: %w", err)
+			}
+			statusServiceCredentials = credentials.NewTLS(tlsCfg)
+		}
+		statusServiceGrpcConn, err := grpc.NewClient(cfg.Server.StatusServiceGrpcHost, grpc.WithTransportCredentials(statusServiceCredentials))
+		if err != nil {
+			return fmt.Errorf("creating archiver api connection: %w", err)
+		}
+		defer statusServiceGrpcConn.Close() //nolint:errcheck
+		statusServiceClient = statusPb.NewStatusServiceClient(statusServiceGrpcConn)
+
+		repo = elastic.NewClusteredArchiveRepository(cfg.ElasticSearch.TransactionsIndex, cfg.ElasticSearch.TickDataIndex, cfg.ElasticSearch.ComputorsListIndex, archiveClusters)
+
+		eventsClusters, err := createESClusters("events", cfg.EventsElasticSearch.Clusters, cfg.EventsElasticSearch.Address,
+			func(addresses []string) (*elasticsearch.Client, error) {
+				return createESClient(addresses, cfg.EventsElasticSearch.Username, cfg.EventsElasticSearch.Password,
+					cfg.EventsElasticSearch.CertificatePath, cfg.EventsElasticSearch.MaxRetries, cfg.EventsElasticSearch.ReadTimeout)
+			}, elastic.ClusterOptions{
+				TickIndex:           cfg.EventsElasticSearch.EventsIndex,
+				MaxTickLag:          cfg.EventsElasticSearch.MaxTickLag,
+				FailureBackoff:      cfg.EventsElasticSearch.FailureBackoff,
+				HealthCheckInterval: cfg.EventsElasticSearch.HealthCheckInterval,
+			}, clusterMetrics)
+		if err != nil {
+			return fmt.Errorf("creating events elasticsearch clusters: %w", err)
+		}
+		eventsClusters.Start()
+		defer closeESClusters(eventsClusters)
+
+		eventsRepo = elastic.NewClusteredEventsRepository(cfg.EventsElasticSearch.EventsIndex, eventsClusters)
 	}
-	statusServiceClient := statusPb.NewStatusServiceClient(statusServiceGrpcConn)
 
 	cache := domain.NewStatusGetter(statusServiceClient, cfg.Server.StatusDataCacheTTL)
 
 	go cache.Start()
 	defer cache.Stop()
 
-	repo := elastic.NewArchiveRepository(cfg.ElasticSearch.TransactionsIndex, cfg.ElasticSearch.TickDataIndex, cfg.ElasticSearch.ComputorsListIndex, esClient)
-
-	eventsEsClient, err := createEventsESClient(
-		cfg.EventsElasticSearch.Address,
-		cfg.EventsElasticSearch.Username,
-		cfg.EventsElasticSearch.Password,
-		cfg.EventsElasticSearch.CertificatePath,
-		cfg.EventsElasticSearch.MaxRetries,
-		cfg.EventsElasticSearch.ReadTimeout,
-	)
-	if err != nil {
-		return fmt.Errorf("creating events elasticsearch client: %w", err)
-	}
-
-	eventsRepo := elastic.NewEventsRepository(cfg.EventsElasticSearch.EventsIndex, eventsEsClient)
 	eventsService := domain.NewEventsService(eventsRepo)
 
 	txService := domain.NewTransactionService(repo, cache.GetStatus)
-	tdService := domain.NewTickDataService(repo)
+	tdService := domain.NewTickDataService(repo, cache.GetTickIntervals)
 	statusService := domain.NewStatusService(cache)
 	clService := domain.NewComputorsListService(repo)
 	pageSizeLimits := rpc.NewPageSizeLimits(cfg.Pagination.MaxPageSize, cfg.Pagination.DefaultPageSize)
+
 	rpcServer := rpc.NewArchiveQueryService(txService, tdService, statusService, clService, eventsService, pageSizeLimits)
+	rpcServer.SetEpochSummaryService(domain.NewEpochSummaryService(repo, eventsRepo, cache.GetStatus, cache.GetTickIntervals))
+	rpcServer.SetIdentityOverviewService(domain.NewIdentityOverviewService(repo, eventsRepo, cache.GetStatus))
+	if cfg.Server.LegacyServiceEnabled {
+		log.Println("main: legacy transactions service is enabled")
+		rpcServer.SetLegacyService(legacy.NewTransactionsService(txService, tdService, statusService, clService, statusServiceClient))
+	}
+	if len(cfg.Export.APIKeys) > 0 {
+		log.Println("main: export is enabled")
+		exportService := domain.NewExportService(repo, eventsRepo, cache.GetStatus)
+		rpcServer.SetExportService(exportService, rpc.ExportConfig{APIKeys: cfg.Export.APIKeys, MaxRows: cfg.Export.MaxRows})
+	}
+	if cfg.GraphQL.Enabled {
+		log.Println("main: graphql is enabled")
+		graphqlHandler, err := graphql.NewHandler(txService, tdService, eventsService, clService, statusService, pageSizeLimits,
+			graphql.Config{MaxDepth: cfg.GraphQL.MaxDepth, MaxComplexity: cfg.GraphQL.MaxComplexity})
+		if err != nil {
+			return fmt.Errorf("creating graphql handler: %w", err)
+		}
+		rpcServer.SetGraphQLHandler(graphqlHandler)
+	}
+	var tickWatcher *domain.TickWatcher
+	if cfg.Subscriptions.Enabled || len(cfg.Webhooks.APIKeys) > 0 {
+		tickWatcher = domain.NewTickWatcher(cache.GetStatus, cfg.Server.StatusDataCacheTTL)
+		go tickWatcher.Start()
+		defer tickWatcher.Stop()
+	}
+	if cfg.Subscriptions.Enabled {
+		log.Println("main: subscriptions are enabled")
+		rpcServer.SetSubscriptionsHandler(subscriptions.NewHandler(tickWatcher, txService, eventsService, subscriptions.Config{
+			MaxConnections:   cfg.Subscriptions.MaxConnections,
+			MaxSubscriptions: cfg.Subscriptions.MaxSubscriptions,
+			MaxIdentities:    cfg.Subscriptions.MaxIdentities,
+		}))
+	}
+	if len(cfg.Webhooks.APIKeys) > 0 {
+		log.Printf("main: webhooks are enabled. registrations are stored in [%s]. webhooks must only be enabled on a single instance", cfg.Webhooks.StoreFile)
+		webhookRepo, err := file.NewWebhookRepository(cfg.Webhooks.StoreFile)
+		if err != nil {
+			return fmt.Errorf("creating webhook repository: %w", err)
+		}
+		webhookService, err := domain.NewWebhookService(context.Background(), webhookRepo, repo, eventsRepo, domain.WebhookConfig{
+			MaxWebhooks:           cfg.Webhooks.MaxWebhooks,
+			MaxAttempts:           cfg.Webhooks.MaxAttempts,
+			InitialBackoff:        cfg.Webhooks.InitialBackoff,
+			MaxBackoff:            cfg.Webhooks.MaxBackoff,
+			Timeout:               cfg.Webhooks.Timeout,
+			AllowPrivateAddresses: cfg.Webhooks.AllowPrivateAddresses,
+		})
+		if err != nil {
+			return fmt.Errorf("creating webhook service: %w", err)
+		}
+		updates, unsubscribe := tickWatcher.Subscribe()
+		defer unsubscribe()
+		go webhookService.Start(updates)
+		defer webhookService.Stop()
+		rpcServer.SetWebhookServer(rpc.NewWebhookServer(webhookService, cfg.Webhooks.APIKeys))
+	}
 	tickInBoundsInterceptor := rpc.NewTickWithinBoundsInterceptor(statusService)
 	var identitiesValidatorInterceptor rpc.IdentitiesValidatorInterceptor
 	var logTechnicalErrorInterceptor rpc.LogTechnicalErrorInterceptor
+	var requestTimeouts map[string]time.Duration
+	if cfg.Server.RequestTimeoutsFile != "" {
+		requestTimeouts, err = rpc.CreateTimeoutMapFromJSONFile(cfg.Server.RequestTimeoutsFile)
+		if err != nil {
+			return fmt.Errorf("creating timeout map from json file: %w", err)
+		}
+	}
+	deadlineInterceptor := rpc.NewDeadlineInterceptor(cfg.Server.RequestTimeout, requestTimeouts)
 
 	var interceptors = []grpc.UnaryServerInterceptor{
 		srvMetrics.UnaryServerInterceptor(),
+		deadlineInterceptor.GetInterceptor,
 		logTechnicalErrorInterceptor.GetInterceptor,
 		tickInBoundsInterceptor.GetInterceptor,
 		identitiesValidatorInterceptor.GetInterceptor,
@@ -206,6 +384,7 @@ func run() error {
 			ReadTimeout:  cfg.Redis.ReadTimeout,
 			WriteTimeout: cfg.Redis.WriteTimeout,
 		})
+		defer redisClient.Close() //nolint:errcheck
 		// check if redis client is reachable
 		if err := redisClient.Ping(context.Background()).Err(); err != nil {
 			return fmt.Errorf("connecting to redis: %w", err)
@@ -216,10 +395,32 @@ func run() error {
 	}
 
 	startCfg := rpc.StartConfig{
-		ListenAddrGRPC: cfg.Server.GrpcHost,
-		ListenAddrHTTP: cfg.Server.HttpHost,
-		MaxRecvMsgSize: cfg.Server.MaxRecvSizeInMb * 1024 * 1024,
-		MaxSendMsgSize: cfg.Server.MaxSendSizeInMb * 1024 * 1024,
+		ListenAddrGRPC:        cfg.Server.GrpcHost,
+		ListenAddrHTTP:        cfg.Server.HttpHost,
+		MaxRecvMsgSize:        cfg.Server.MaxRecvSizeInMb * 1024 * 1024,
+		MaxSendMsgSize:        cfg.Server.MaxSendSizeInMb * 1024 * 1024,
+		ReadTimeout:           cfg.Server.ReadTimeout,
+		WriteTimeout:          cfg.Server.WriteTimeout,
+		Compression:           cfg.Server.CompressionEnabled,
+		CompactJSON:           cfg.Server.CompactJSON,
+		GrpcWeb:               cfg.Server.GrpcWebEnabled,
+		GrpcWebAllowedOrigins: cfg.Server.GrpcWebAllowedOrigins,
+	}
+
+	if cfg.TLS.CertFile != "" || cfg.TLS.KeyFile != "" {
+		if startCfg.GrpcTLS, err = tlsconfig.ServerConfig(cfg.TLS.CertFile, cfg.TLS.KeyFile, cfg.TLS.GrpcClientCAFile); err != nil {
+			return fmt.Errorf("creating grpc tls config: %w", err)
+		}
+		if startCfg.HTTPTLS, err = tlsconfig.ServerConfig(cfg.TLS.CertFile, cfg.TLS.KeyFile, ""); err != nil {
+			return fmt.Errorf("creating http tls config: %w", err)
+		}
+		gatewayCertFile, gatewayKeyFile := cfg.TLS.GatewayCertFile, cfg.TLS.GatewayKeyFile
+		if gatewayCertFile == "" && gatewayKeyFile == "" {
+			gatewayCertFile, gatewayKeyFile = cfg.TLS.CertFile, cfg.TLS.KeyFile
+		}
+		if startCfg.GatewayTLS, err = tlsconfig.ClientConfig(cfg.TLS.GatewayCAFile, gatewayCertFile, gatewayKeyFile, cfg.TLS.GatewayServerName); err != nil {
+			return fmt.Errorf("creating gateway tls config: %w", err)
+		}
 	}
 
 	srvErrorsChan := make(chan error, 1)
@@ -231,39 +432,88 @@ func run() error {
 	shutdown := make(chan os.Signal, 1)
 	signal.Notify(shutdown, os.Interrupt, syscall.SIGTERM)
 
+	pprofServer := &http.Server{Addr: cfg.Server.ProfilingHost, ReadHeaderTimeout: cfg.Server.ReadTimeout}
 	pprofErrors := make(chan error, 1)
-
 	go func() {
-		pprofErrors <- http.ListenAndServe(cfg.Server.ProfilingHost, nil) //nolint:gosec
+		pprofErrors <- pprofServer.ListenAndServe()
 	}()
 
+	http.Handle("/metrics", promhttp.HandlerFor(prometheus.DefaultGatherer, promhttp.HandlerOpts{EnableOpenMetrics: true}))
+	metricsServer := &http.Server{Addr: fmt.Sprintf(":%d", cfg.Metrics.Port), ReadHeaderTimeout: cfg.Server.ReadTimeout}
 	webServerErr := make(chan error, 1)
 	go func() {
 		log.Printf("main: Starting status and metrics endpoints on port [%d]\n", cfg.Metrics.Port)
-		http.Handle("/metrics", promhttp.HandlerFor(prometheus.DefaultGatherer, promhttp.HandlerOpts{EnableOpenMetrics: true}))
-		webServerErr <- http.ListenAndServe(fmt.Sprintf(":%d", cfg.Metrics.Port), nil) //nolint:gosec
+		webServerErr <- metricsServer.ListenAndServe()
 	}()
 
-	for {
-		select {
-		case <-shutdown:
-			return errors.New("shutting down")
-		case err := <-pprofErrors:
-			return fmt.Errorf("pprof error: %w", err)
-		case err := <-webServerErr:
-			return fmt.Errorf("web server error: %w", err)
-		case err := <-srvErrorsChan:
-			return fmt.Errorf("grpc server error: %w", err)
+	select {
+	case <-shutdown:
+		log.Println("main: shutting down")
+	case err := <-pprofErrors:
+		rpcServer.Stop()
+		return fmt.Errorf("pprof error: %w", err)
+	case err := <-webServerErr:
+		rpcServer.Stop()
+		return fmt.Errorf("web server error: %w", err)
+	case err := <-srvErrorsChan:
+		rpcServer.Stop()
+		return fmt.Errorf("grpc server error: %w", err)
+	}
+
+	// the remaining resources (status cache, webhooks, clients) are released by the deferred calls afterward
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
+	defer cancel()
+	err = rpcServer.Shutdown(ctx)
+	if shutdownErr := pprofServer.Shutdown(ctx); shutdownErr != nil {
+		err = errors.Join(err, fmt.Errorf("shutting down pprof server: %w", shutdownErr))
+	}
+	if shutdownErr := metricsServer.Shutdown(ctx); shutdownErr != nil {
+		err = errors.Join(err, fmt.Errorf("shutting down metrics server: %w", shutdownErr))
+	}
+	if err != nil {
+		return fmt.Errorf("shutting down: %w", err)
+	}
+	log.Println("main: shutdown complete")
+	return nil
+}
+
+func closeESClusters(clusters *elastic.Clusters) {
+	if err := clusters.Close(context.Background()); err != nil {
+		log.Printf("closing elasticsearch clusters: %v", err)
+	}
+}
+
+// createESClusters creates the ordered list of clusters from the cluster configs (name=address1,address2). Without
+// cluster configs the addresses are used as single cluster.
+func createESClusters(repository string, clusterConfigs, addresses []string, createClient func([]string) (*elasticsearch.Client, error),
+	options elastic.ClusterOptions, metrics *elastic.ClusterMetrics) (*elastic.Clusters, error) {
+	if len(clusterConfigs) == 0 {
+		clusterConfigs = []string{"default=" + strings.Join(addresses, ",")}
+	}
+	clusters := make([]elastic.Cluster, 0, len(clusterConfigs))
+	for _, clusterConfig := range clusterConfigs {
+		name, clusterAddresses, ok := strings.Cut(clusterConfig, "=")
+		if !ok || strings.TrimSpace(name) == "" || strings.TrimSpace(clusterAddresses) == "" {
+			return nil, fmt.Errorf("invalid cluster [%s], expected name=address1,address2", clusterConfig)
+		}
+		client, err := createClient(strings.Split(clusterAddresses, ","))
+		if err != nil {
+			return nil, fmt.Errorf("creating client of cluster [%s]: %w", name, err)
 		}
+		clusters = append(clusters, elastic.Cluster{Name: strings.TrimSpace(name), Client: client})
 	}
+	if len(clusters) > 1 {
+		log.Printf("main: %s elasticsearch clusters: %d", repository, len(clusters))
+	}
+	return elastic.NewClusters(repository, clusters, options, metrics)
 }
 
-func createEventsESClient(
+func createESClient(
 	addresses []string, username, password, certPath string, maxRetries int, readTimeout time.Duration,
 ) (*elasticsearch.Client, error) {
 	cert, err := os.ReadFile(certPath)
 	if err != nil {
-		log.Printf("warn: Failed to load Events Elastic certificate file: %v\n", err)
+		log.Printf("warn: Failed to load Elastic certificate file: %v\n", err)
 	}
 
 	esCfg := elasticsearch.Config{
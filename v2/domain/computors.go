@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"slices"
 
 	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
 )
@@ -9,6 +10,8 @@ import (
 //go:generate go tool go.uber.org/mock/mockgen -destination=mock/computors.mock.go -package=mock -source computors.go
 type ComputorsListRepository interface {
 	GetComputorsListsForEpoch(ctx context.Context, epoch uint32) ([]*api.ComputorList, error)
+	GetComputorsListsForIdentity(ctx context.Context, identity string) ([]*api.ComputorList, error)
+	GetComputorsListsForEpochRange(ctx context.Context, fromEpoch, toEpoch uint32) ([]*api.ComputorList, error)
 }
 
 type ComputorsListService struct {
@@ -24,3 +27,32 @@ func NewComputorsListService(repo ComputorsListRepository) *ComputorsListService
 func (s *ComputorsListService) GetComputorsListsForEpoch(ctx context.Context, epoch uint32) ([]*api.ComputorList, error) {
 	return s.repo.GetComputorsListsForEpoch(ctx, epoch)
 }
+
+// GetComputorsListsForEpochRange Returns the computor lists of the epoch range (inclusive) sorted by tick number
+// ascending.
+func (s *ComputorsListService) GetComputorsListsForEpochRange(ctx context.Context, fromEpoch, toEpoch uint32) ([]*api.ComputorList, error) {
+	return s.repo.GetComputorsListsForEpochRange(ctx, fromEpoch, toEpoch)
+}
+
+// GetComputorMembership Returns the computor lists the identity is part of together with the index of the identity in
+// the list sorted by tick number descending.
+func (s *ComputorsListService) GetComputorMembership(ctx context.Context, identity string) ([]*api.ComputorMembership, error) {
+	computorLists, err := s.repo.GetComputorsListsForIdentity(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	memberships := make([]*api.ComputorMembership, 0, len(computorLists))
+	for _, computorList := range computorLists {
+		index := slices.Index(computorList.GetIdentities(), identity)
+		if index < 0 {
+			continue // should not happen
+		}
+		memberships = append(memberships, &api.ComputorMembership{
+			Epoch:         computorList.GetEpoch(),
+			TickNumber:    computorList.GetTickNumber(),
+			ComputorIndex: uint32(index), //nolint: gosec
+		})
+	}
+	return memberships, nil
+}
@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"context"
+	"testing"
+
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	"github.com/qubic/archive-query-service/v2/domain/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestComputorsListService_GetComputorMembership(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	repo := mock.NewMockComputorsListRepository(ctrl)
+	service := NewComputorsListService(repo)
+	repo.EXPECT().GetComputorsListsForIdentity(gomock.Any(), "C").Return([]*api.ComputorList{
+		{Epoch: 101, TickNumber: 2000, Identities: []string{"A", "B", "C"}},
+		{Epoch: 100, TickNumber: 1000, Identities: []string{"C", "D"}},
+	}, nil)
+
+	memberships, err := service.GetComputorMembership(context.Background(), "C")
+	require.NoError(t, err)
+	assert.Equal(t, []*api.ComputorMembership{
+		{Epoch: 101, TickNumber: 2000, ComputorIndex: 2},
+		{Epoch: 100, TickNumber: 1000, ComputorIndex: 0},
+	}, memberships)
+}
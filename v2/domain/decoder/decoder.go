@@ -0,0 +1,167 @@
+package decoder
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	"github.com/qubic/go-node-connector/types"
+)
+
+const (
+	QxIssueAssetInputType         = 1
+	QxAddToAskOrderInputType      = 5
+	QxAddToBidOrderInputType      = 6
+	QxRemoveFromAskOrderInputType = 7
+	QxRemoveFromBidOrderInputType = 8
+	qxIssueAssetMinInputSize      = 8 + 8 + 8 + 1
+	qxOrderInputSize              = 32 + 8 + 8 + 8
+)
+
+type procedure struct {
+	contract  string
+	inputType uint32
+}
+
+type decodeFunc func(input []byte) (*api.DecodedInput, error)
+
+// decoders maps smart contract procedures to the functions that decode their input. Procedures that are not
+// registered here are not decoded.
+var decoders = map[procedure]decodeFunc{
+	{types.QutilAddress, types.QutilSendManyInputType}: decodeSendMany,
+	{types.QxAddress, QxIssueAssetInputType}:           decodeQxIssueAsset,
+	{types.QxAddress, types.QxTransferInputType}:       decodeQxTransferShare,
+	{types.QxAddress, QxAddToAskOrderInputType}:        decodeQxOrder,
+	{types.QxAddress, QxAddToBidOrderInputType}:        decodeQxOrder,
+	{types.QxAddress, QxRemoveFromAskOrderInputType}:   decodeQxOrder,
+	{types.QxAddress, QxRemoveFromBidOrderInputType}:   decodeQxOrder,
+}
+
+// DecodeInput decodes the input data of the transaction, if the destination and input type belong to a known smart
+// contract procedure. Returns nil, if the procedure is unknown.
+func DecodeInput(tx *api.Transaction) (*api.DecodedInput, error) {
+	decode, ok := decoders[procedure{contract: tx.GetDestination(), inputType: tx.GetInputType()}]
+	if !ok {
+		return nil, nil
+	}
+
+	input, err := base64.StdEncoding.DecodeString(tx.GetInputData())
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64 input data: %w", err)
+	}
+
+	decoded, err := decode(input)
+	if err != nil {
+		return nil, fmt.Errorf("decoding input of procedure [%d] of contract [%s]: %w", tx.GetInputType(), tx.GetDestination(), err)
+	}
+	return decoded, nil
+}
+
+// DecodeInputs sets the decoded input on all transactions with known procedures. Inputs that cannot be decoded are
+// left untouched.
+func DecodeInputs(txs []*api.Transaction) {
+	for _, tx := range txs {
+		decoded, err := DecodeInput(tx)
+		if err == nil && decoded != nil {
+			tx.DecodedInput = decoded
+		}
+	}
+}
+
+func decodeSendMany(input []byte) (*api.DecodedInput, error) {
+	if len(input) < types.QutilSendManyInputSize {
+		return nil, fmt.Errorf("invalid input size [%d]", len(input))
+	}
+
+	var payload types.SendManyTransferPayload
+	err := payload.UnmarshallBinary(input)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshalling send many payload: %w", err)
+	}
+	// zero addresses are unused transfer slots and are skipped
+	sendManyTransfers, err := payload.GetTransfers()
+	if err != nil {
+		return nil, fmt.Errorf("getting send many transfers: %w", err)
+	}
+
+	transfers := make([]*api.SendManyTransfer, 0, len(sendManyTransfers))
+	for _, transfer := range sendManyTransfers {
+		transfers = append(transfers, &api.SendManyTransfer{Destination: transfer.AddressID.String(), Amount: transfer.Amount})
+	}
+
+	return &api.DecodedInput{Input: &api.DecodedInput_SendMany{SendMany: &api.SendManyInput{
+		Transfers:   transfers,
+		TotalAmount: payload.GetTotalAmount(),
+	}}}, nil
+}
+
+func decodeQxIssueAsset(input []byte) (*api.DecodedInput, error) {
+	if len(input) < qxIssueAssetMinInputSize {
+		return nil, fmt.Errorf("invalid input size [%d]", len(input))
+	}
+
+	return &api.DecodedInput{Input: &api.DecodedInput_QxIssueAsset{QxIssueAsset: &api.QxIssueAssetInput{
+		AssetName:             assetName(input[0:8]),
+		NumberOfShares:        int64(binary.LittleEndian.Uint64(input[8:16])), //nolint: gosec
+		UnitOfMeasurement:     binary.LittleEndian.Uint64(input[16:24]),
+		NumberOfDecimalPlaces: int32(int8(input[24])),
+	}}}, nil
+}
+
+func decodeQxTransferShare(input []byte) (*api.DecodedInput, error) {
+	if len(input) < types.QxTransferInputSize {
+		return nil, fmt.Errorf("invalid input size [%d]", len(input))
+	}
+
+	issuer, err := identityFromPublicKey(input[0:32])
+	if err != nil {
+		return nil, fmt.Errorf("converting issuer: %w", err)
+	}
+	newOwner, err := identityFromPublicKey(input[32:64])
+	if err != nil {
+		return nil, fmt.Errorf("converting new owner and possessor: %w", err)
+	}
+
+	return &api.DecodedInput{Input: &api.DecodedInput_QxTransferShare{QxTransferShare: &api.QxTransferShareInput{
+		Issuer:               issuer,
+		NewOwnerAndPossessor: newOwner,
+		AssetName:            assetName(input[64:72]),
+		NumberOfShares:       int64(binary.LittleEndian.Uint64(input[72:80])), //nolint: gosec
+	}}}, nil
+}
+
+func decodeQxOrder(input []byte) (*api.DecodedInput, error) {
+	if len(input) < qxOrderInputSize {
+		return nil, fmt.Errorf("invalid input size [%d]", len(input))
+	}
+
+	issuer, err := identityFromPublicKey(input[0:32])
+	if err != nil {
+		return nil, fmt.Errorf("converting issuer: %w", err)
+	}
+
+	return &api.DecodedInput{Input: &api.DecodedInput_QxOrder{QxOrder: &api.QxOrderInput{
+		Issuer:         issuer,
+		AssetName:      assetName(input[32:40]),
+		Price:          int64(binary.LittleEndian.Uint64(input[40:48])), //nolint: gosec
+		NumberOfShares: int64(binary.LittleEndian.Uint64(input[48:56])), //nolint: gosec
+	}}}, nil
+}
+
+func identityFromPublicKey(publicKey []byte) (string, error) {
+	var pubKey [32]byte
+	copy(pubKey[:], publicKey)
+	var id types.Identity
+	id, err := id.FromPubKey(pubKey, false)
+	if err != nil {
+		return "", err
+	}
+	return id.String(), nil
+}
+
+// assetName converts the zero padded asset name into a string.
+func assetName(name []byte) string {
+	return string(bytes.TrimRight(name, "\x00"))
+}
@@ -0,0 +1,143 @@
+package decoder
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"testing"
+
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	"github.com/qubic/go-node-connector/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testIdentity1 = "CAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAACNKL"
+	testIdentity2 = "DAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAANMIG"
+	zeroIdentity  = "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFXIB"
+)
+
+func TestDecodeInput_SendMany(t *testing.T) {
+	var payload types.SendManyTransferPayload
+	err := payload.AddTransfers([]types.SendManyTransfer{
+		{AddressID: testIdentity1, Amount: 100},
+		{AddressID: testIdentity2, Amount: 200},
+	})
+	require.NoError(t, err)
+	input, err := payload.MarshallBinary()
+	require.NoError(t, err)
+
+	decoded, err := DecodeInput(&api.Transaction{
+		Destination: types.QutilAddress,
+		InputType:   types.QutilSendManyInputType,
+		InputSize:   uint32(len(input)),
+		InputData:   base64.StdEncoding.EncodeToString(input),
+	})
+	require.NoError(t, err)
+
+	sendMany := decoded.GetSendMany()
+	require.NotNil(t, sendMany)
+	assert.Equal(t, int64(310), sendMany.TotalAmount)
+	require.Len(t, sendMany.Transfers, 2)
+	assert.Equal(t, testIdentity1, sendMany.Transfers[0].Destination)
+	assert.Equal(t, int64(100), sendMany.Transfers[0].Amount)
+	assert.Equal(t, testIdentity2, sendMany.Transfers[1].Destination)
+	assert.Equal(t, int64(200), sendMany.Transfers[1].Amount)
+}
+
+func TestDecodeInput_QxTransferShare(t *testing.T) {
+	payload, err := types.NewAssetTransferPayload("QX", testIdentity1, testIdentity2, 42)
+	require.NoError(t, err)
+	input, err := payload.MarshallBinary()
+	require.NoError(t, err)
+
+	decoded, err := DecodeInput(&api.Transaction{
+		Destination: types.QxAddress,
+		InputType:   types.QxTransferInputType,
+		InputData:   base64.StdEncoding.EncodeToString(input),
+	})
+	require.NoError(t, err)
+
+	transfer := decoded.GetQxTransferShare()
+	require.NotNil(t, transfer)
+	assert.Equal(t, testIdentity1, transfer.Issuer)
+	assert.Equal(t, testIdentity2, transfer.NewOwnerAndPossessor)
+	assert.Equal(t, "QX", transfer.AssetName)
+	assert.Equal(t, int64(42), transfer.NumberOfShares)
+}
+
+func TestDecodeInput_QxIssueAsset(t *testing.T) {
+	input := make([]byte, 32)
+	copy(input[0:8], "CFB")
+	binary.LittleEndian.PutUint64(input[8:16], 1000000)
+	binary.LittleEndian.PutUint64(input[16:24], 0)
+	input[24] = 2
+
+	decoded, err := DecodeInput(&api.Transaction{
+		Destination: types.QxAddress,
+		InputType:   QxIssueAssetInputType,
+		InputData:   base64.StdEncoding.EncodeToString(input),
+	})
+	require.NoError(t, err)
+
+	issue := decoded.GetQxIssueAsset()
+	require.NotNil(t, issue)
+	assert.Equal(t, "CFB", issue.AssetName)
+	assert.Equal(t, int64(1000000), issue.NumberOfShares)
+	assert.Equal(t, int32(2), issue.NumberOfDecimalPlaces)
+}
+
+func TestDecodeInput_QxOrder(t *testing.T) {
+	id := types.Identity(testIdentity1)
+	issuer, err := id.ToPubKey(false)
+	require.NoError(t, err)
+	input := make([]byte, 56)
+	copy(input[0:32], issuer[:])
+	copy(input[32:40], "QX")
+	binary.LittleEndian.PutUint64(input[40:48], 150)
+	binary.LittleEndian.PutUint64(input[48:56], 3)
+
+	for _, inputType := range []uint32{QxAddToAskOrderInputType, QxAddToBidOrderInputType, QxRemoveFromAskOrderInputType, QxRemoveFromBidOrderInputType} {
+		decoded, err := DecodeInput(&api.Transaction{
+			Destination: types.QxAddress,
+			InputType:   inputType,
+			InputData:   base64.StdEncoding.EncodeToString(input),
+		})
+		require.NoError(t, err)
+
+		order := decoded.GetQxOrder()
+		require.NotNil(t, order)
+		assert.Equal(t, testIdentity1, order.Issuer)
+		assert.Equal(t, "QX", order.AssetName)
+		assert.Equal(t, int64(150), order.Price)
+		assert.Equal(t, int64(3), order.NumberOfShares)
+	}
+}
+
+func TestDecodeInput_GivenUnknownProcedure_ThenNil(t *testing.T) {
+	decoded, err := DecodeInput(&api.Transaction{Destination: zeroIdentity, InputType: 1, InputData: "AAAA"})
+	require.NoError(t, err)
+	assert.Nil(t, decoded)
+
+	decoded, err = DecodeInput(&api.Transaction{Destination: types.QxAddress, InputType: 99, InputData: "AAAA"})
+	require.NoError(t, err)
+	assert.Nil(t, decoded)
+}
+
+func TestDecodeInput_GivenInvalidInput_ThenError(t *testing.T) {
+	_, err := DecodeInput(&api.Transaction{Destination: types.QxAddress, InputType: types.QxTransferInputType, InputData: "AAAA"})
+	require.ErrorContains(t, err, "invalid input size")
+
+	_, err = DecodeInput(&api.Transaction{Destination: types.QxAddress, InputType: types.QxTransferInputType, InputData: "not base64!"})
+	require.ErrorContains(t, err, "base64")
+}
+
+func TestDecodeInputs_GivenInvalidInput_ThenUntouched(t *testing.T) {
+	txs := []*api.Transaction{
+		{Destination: types.QxAddress, InputType: types.QxTransferInputType, InputData: "AAAA"},
+		{Destination: zeroIdentity, InputType: 0},
+	}
+	DecodeInputs(txs)
+	assert.Nil(t, txs[0].DecodedInput)
+	assert.Nil(t, txs[1].DecodedInput)
+}
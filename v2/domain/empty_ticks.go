@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"math/bits"
+	"sync"
+)
+
+// emptyTicks stores the empty ticks of one epoch in a bitmap. The bit index is the offset of the tick to the start
+// tick of the epoch. The bitmap is extended incrementally when new ticks are processed, so that already loaded ticks
+// do not need to be queried again.
+type emptyTicks struct {
+	mutex     sync.Mutex
+	startTick uint32
+	endTick   uint32 // last tick that was loaded. zero, if nothing was loaded yet.
+	bitmap    []uint64
+}
+
+func newEmptyTicks(startTick uint32) *emptyTicks {
+	return &emptyTicks{startTick: startTick}
+}
+
+func (e *emptyTicks) set(tickNumber uint32) {
+	index := tickNumber - e.startTick
+	word := int(index / 64)
+	if word >= len(e.bitmap) {
+		e.bitmap = append(e.bitmap, make([]uint64, word-len(e.bitmap)+1)...)
+	}
+	e.bitmap[word] |= 1 << (index % 64)
+}
+
+func (e *emptyTicks) isEmpty(tickNumber uint32) bool {
+	if tickNumber < e.startTick {
+		return false
+	}
+	index := tickNumber - e.startTick
+	word := int(index / 64)
+	if word >= len(e.bitmap) {
+		return false
+	}
+	return e.bitmap[word]&(1<<(index%64)) != 0
+}
+
+func (e *emptyTicks) count() uint32 {
+	var count int
+	for _, word := range e.bitmap {
+		count += bits.OnesCount64(word)
+	}
+	return uint32(count) //nolint: gosec
+}
+
+// tickNumbers returns the empty tick numbers starting at the given offset (number of empty ticks to skip).
+func (e *emptyTicks) tickNumbers(offset, size uint32) []uint32 {
+	tickNumbers := make([]uint32, 0, size)
+	for i, word := range e.bitmap {
+		ones := uint32(bits.OnesCount64(word)) //nolint: gosec
+		// skip the whole word, if all empty ticks are within the offset
+		if offset >= ones {
+			offset -= ones
+			continue
+		}
+		for word != 0 && uint32(len(tickNumbers)) < size {
+			bit := bits.TrailingZeros64(word)
+			word &= word - 1 // clear lowest bit
+			if offset > 0 {
+				offset--
+				continue
+			}
+			tickNumbers = append(tickNumbers, e.startTick+uint32(i*64+bit)) //nolint: gosec
+		}
+		if uint32(len(tickNumbers)) >= size {
+			break
+		}
+	}
+	return tickNumbers
+}
+
+// forEach calls the function for every empty tick in ascending order.
+func (e *emptyTicks) forEach(f func(tickNumber uint32)) {
+	for i, word := range e.bitmap {
+		for word != 0 {
+			bit := bits.TrailingZeros64(word)
+			word &= word - 1                  // clear lowest bit
+			f(e.startTick + uint32(i*64+bit)) //nolint: gosec
+		}
+	}
+}
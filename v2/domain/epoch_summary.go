@@ -0,0 +1,138 @@
+package domain
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/jellydator/ttlcache/v3"
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	"github.com/qubic/archive-query-service/v2/entities"
+	statusPb "github.com/qubic/go-data-publisher/status-service/protobuf"
+	"golang.org/x/sync/errgroup"
+)
+
+//go:generate go tool go.uber.org/mock/mockgen -destination=mock/epoch_summary.mock.go -package=mock -source epoch_summary.go
+type EpochStatsRepository interface {
+	GetTickDataStats(ctx context.Context, epoch uint32) (*entities.TickDataStats, error)
+	GetTransactionStats(ctx context.Context, fromTick, toTick uint32) (*entities.TransactionStats, error)
+	GetComputorsListsForEpoch(ctx context.Context, epoch uint32) ([]*api.ComputorList, error)
+}
+
+type EventStatsRepository interface {
+	GetEventStats(ctx context.Context, epoch, maxTick uint32) (*entities.EventStats, error)
+}
+
+// maximum number of cached closed epoch summaries. The least recently used summary is evicted first.
+const maxCachedEpochSummaries = 100
+
+type EpochSummaryService struct {
+	repo             EpochStatsRepository
+	eventsRepo       EventStatsRepository
+	statusFetcher    StatusFetcherFunc
+	intervalsFetcher TickIntervalsFetcherFunc
+	closedEpochs     *ttlcache.Cache[uint32, *api.EpochSummary] // summaries of closed epochs do not change anymore
+}
+
+func NewEpochSummaryService(repo EpochStatsRepository, eventsRepo EventStatsRepository, statusFetcher StatusFetcherFunc, intervalsFetcher TickIntervalsFetcherFunc) *EpochSummaryService {
+	return &EpochSummaryService{
+		repo:             repo,
+		eventsRepo:       eventsRepo,
+		statusFetcher:    statusFetcher,
+		intervalsFetcher: intervalsFetcher,
+		closedEpochs: ttlcache.New[uint32, *api.EpochSummary](
+			ttlcache.WithCapacity[uint32, *api.EpochSummary](maxCachedEpochSummaries),
+		),
+	}
+}
+
+// GetEpochSummary Returns the summary of the epoch or nil, if there are no processed ticks for the epoch. The summary
+// of a closed epoch (a later epoch is processed and all events of the epoch are processed) is cached. The number of
+// cached summaries is limited.
+func (s *EpochSummaryService) GetEpochSummary(ctx context.Context, epoch uint32) (*api.EpochSummary, error) {
+	if item := s.closedEpochs.Get(epoch); item != nil {
+		return item.Value(), nil
+	}
+
+	status, err := s.statusFetcher(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting status: %w", err)
+	}
+	intervals, err := s.intervalsFetcher(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting tick intervals: %w", err)
+	}
+
+	epochIntervals := make([]*statusPb.TickInterval, 0)
+	for _, interval := range intervals {
+		if interval.GetEpoch() == epoch && interval.GetLastTick() >= interval.GetFirstTick() {
+			epochIntervals = append(epochIntervals, interval)
+		}
+	}
+	if len(epochIntervals) == 0 {
+		return nil, nil
+	}
+	slices.SortFunc(epochIntervals, func(a, b *statusPb.TickInterval) int {
+		return cmp.Compare(a.FirstTick, b.FirstTick)
+	})
+	firstTick, lastTick := epochIntervals[0].GetFirstTick(), epochIntervals[len(epochIntervals)-1].GetLastTick()
+	eventsMaxTick := min(status.GetLastProcessedLogTick(), lastTick)
+
+	var tickDataStats *entities.TickDataStats
+	var txStats *entities.TransactionStats
+	var eventStats *entities.EventStats
+	var computorLists []*api.ComputorList
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.Go(func() (err error) {
+		tickDataStats, err = s.repo.GetTickDataStats(groupCtx, epoch)
+		return err
+	})
+	group.Go(func() (err error) {
+		txStats, err = s.repo.GetTransactionStats(groupCtx, firstTick, lastTick)
+		return err
+	})
+	group.Go(func() (err error) {
+		eventStats, err = s.eventsRepo.GetEventStats(groupCtx, epoch, eventsMaxTick)
+		return err
+	})
+	group.Go(func() (err error) {
+		computorLists, err = s.repo.GetComputorsListsForEpoch(groupCtx, epoch)
+		return err
+	})
+	if err = group.Wait(); err != nil {
+		return nil, fmt.Errorf("getting statistics for epoch [%d]: %w", epoch, err)
+	}
+
+	eventCounts := make([]*api.EventTypeCount, 0, len(eventStats.CountsByLogType))
+	for logType, count := range eventStats.CountsByLogType {
+		eventCounts = append(eventCounts, &api.EventTypeCount{LogType: logType, Count: count})
+	}
+	slices.SortFunc(eventCounts, func(a, b *api.EventTypeCount) int {
+		return cmp.Compare(a.LogType, b.LogType)
+	})
+
+	summary := &api.EpochSummary{
+		Epoch:              epoch,
+		Closed:             epoch < status.GetProcessingEpoch() && status.GetLastProcessedLogTick() >= lastTick,
+		TickIntervals:      toAPIProcessedTickIntervals(epochIntervals),
+		FirstTick:          tickDataStats.FirstTick,
+		FirstTickTimestamp: tickDataStats.FirstTimestamp,
+		LastTick:           tickDataStats.LastTick,
+		LastTickTimestamp:  tickDataStats.LastTimestamp,
+		TickDataCount:      tickDataStats.Count,
+		TransactionCount:   txStats.Count,
+		TransactionVolume:  txStats.Volume,
+		EventCounts:        eventCounts,
+		BurnedAmount:       eventStats.BurnedAmount,
+		EventsValidForTick: eventsMaxTick,
+	}
+	if len(computorLists) > 0 { // sorted by tick number descending
+		summary.ComputorList = computorLists[0]
+	}
+
+	if summary.Closed {
+		s.closedEpochs.Set(epoch, summary, ttlcache.NoTTL)
+	}
+	return summary, nil
+}
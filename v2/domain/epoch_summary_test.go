@@ -0,0 +1,134 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jellydator/ttlcache/v3"
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	"github.com/qubic/archive-query-service/v2/domain/mock"
+	"github.com/qubic/archive-query-service/v2/entities"
+	statusPb "github.com/qubic/go-data-publisher/status-service/protobuf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func fixedStatusFetcher(status *statusPb.GetStatusResponse) StatusFetcherFunc {
+	return func(context.Context) (*statusPb.GetStatusResponse, error) {
+		return status, nil
+	}
+}
+
+func TestEpochSummaryService_GetEpochSummary(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	repo := mock.NewMockEpochStatsRepository(ctrl)
+	eventsRepo := mock.NewMockEventStatsRepository(ctrl)
+	service := NewEpochSummaryService(repo, eventsRepo,
+		fixedStatusFetcher(&statusPb.GetStatusResponse{ProcessingEpoch: 100, LastProcessedTick: 2100, LastProcessedLogTick: 2050}),
+		intervalsFetcher(
+			&statusPb.TickInterval{Epoch: 99, FirstTick: 1, LastTick: 999},
+			&statusPb.TickInterval{Epoch: 100, FirstTick: 2000, LastTick: 2100},
+			&statusPb.TickInterval{Epoch: 100, FirstTick: 1000, LastTick: 1500},
+		))
+	computorList := &api.ComputorList{Epoch: 100, TickNumber: 1200}
+	repo.EXPECT().GetTickDataStats(gomock.Any(), uint32(100)).Return(&entities.TickDataStats{
+		Count: 500, FirstTick: 1001, FirstTimestamp: 111, LastTick: 2100, LastTimestamp: 222,
+	}, nil)
+	repo.EXPECT().GetTransactionStats(gomock.Any(), uint32(1000), uint32(2100)).Return(&entities.TransactionStats{Count: 42, Volume: 1000000}, nil)
+	repo.EXPECT().GetComputorsListsForEpoch(gomock.Any(), uint32(100)).Return([]*api.ComputorList{computorList, {Epoch: 100, TickNumber: 1000}}, nil)
+	eventsRepo.EXPECT().GetEventStats(gomock.Any(), uint32(100), uint32(2050)).Return(&entities.EventStats{
+		CountsByLogType: map[uint32]uint64{8: 3, 0: 100, 1: 2},
+		BurnedAmount:    12345,
+	}, nil)
+
+	summary, err := service.GetEpochSummary(context.Background(), 100)
+	require.NoError(t, err)
+	assert.Equal(t, &api.EpochSummary{
+		Epoch:  100,
+		Closed: false,
+		TickIntervals: []*api.ProcessedTickInterval{
+			{Epoch: 100, FirstTick: 1000, LastTick: 1500},
+			{Epoch: 100, FirstTick: 2000, LastTick: 2100},
+		},
+		FirstTick:          1001,
+		FirstTickTimestamp: 111,
+		LastTick:           2100,
+		LastTickTimestamp:  222,
+		TickDataCount:      500,
+		TransactionCount:   42,
+		TransactionVolume:  1000000,
+		EventCounts:        []*api.EventTypeCount{{LogType: 0, Count: 100}, {LogType: 1, Count: 2}, {LogType: 8, Count: 3}},
+		BurnedAmount:       12345,
+		ComputorList:       computorList,
+		EventsValidForTick: 2050,
+	}, summary)
+}
+
+func TestEpochSummaryService_GetEpochSummary_GivenClosedEpoch_ThenCache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	repo := mock.NewMockEpochStatsRepository(ctrl)
+	eventsRepo := mock.NewMockEventStatsRepository(ctrl)
+	service := NewEpochSummaryService(repo, eventsRepo,
+		fixedStatusFetcher(&statusPb.GetStatusResponse{ProcessingEpoch: 100, LastProcessedTick: 2100, LastProcessedLogTick: 2050}),
+		intervalsFetcher(&statusPb.TickInterval{Epoch: 99, FirstTick: 1, LastTick: 999}))
+	// expected only once
+	repo.EXPECT().GetTickDataStats(gomock.Any(), uint32(99)).Return(&entities.TickDataStats{}, nil)
+	repo.EXPECT().GetTransactionStats(gomock.Any(), uint32(1), uint32(999)).Return(&entities.TransactionStats{}, nil)
+	repo.EXPECT().GetComputorsListsForEpoch(gomock.Any(), uint32(99)).Return(nil, nil)
+	eventsRepo.EXPECT().GetEventStats(gomock.Any(), uint32(99), uint32(999)).Return(&entities.EventStats{}, nil)
+
+	summary, err := service.GetEpochSummary(context.Background(), 99)
+	require.NoError(t, err)
+	assert.True(t, summary.Closed)
+	assert.Equal(t, uint32(999), summary.EventsValidForTick)
+
+	cached, err := service.GetEpochSummary(context.Background(), 99)
+	require.NoError(t, err)
+	assert.Same(t, summary, cached)
+}
+
+func TestEpochSummaryService_GivenManyClosedEpochs_ThenCacheLimited(t *testing.T) {
+	service := NewEpochSummaryService(nil, nil, nil, nil)
+	for epoch := range uint32(maxCachedEpochSummaries + 1) {
+		service.closedEpochs.Set(epoch, &api.EpochSummary{Epoch: epoch, Closed: true}, ttlcache.NoTTL)
+	}
+	assert.Equal(t, maxCachedEpochSummaries, service.closedEpochs.Len())
+	assert.False(t, service.closedEpochs.Has(0), "least recently used summary evicted")
+
+	summary, err := service.GetEpochSummary(context.Background(), maxCachedEpochSummaries)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(maxCachedEpochSummaries), summary.GetEpoch())
+}
+
+func TestEpochSummaryService_GetEpochSummary_GivenUnknownEpoch_ThenNil(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service := NewEpochSummaryService(mock.NewMockEpochStatsRepository(ctrl), mock.NewMockEventStatsRepository(ctrl),
+		fixedStatusFetcher(&statusPb.GetStatusResponse{ProcessingEpoch: 100}),
+		intervalsFetcher(&statusPb.TickInterval{Epoch: 100, FirstTick: 1, LastTick: 2}))
+
+	summary, err := service.GetEpochSummary(context.Background(), 101)
+	require.NoError(t, err)
+	assert.Nil(t, summary)
+}
+
+func TestEpochSummaryService_GetEpochSummary_GivenRepositoryError_ThenError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	repo := mock.NewMockEpochStatsRepository(ctrl)
+	eventsRepo := mock.NewMockEventStatsRepository(ctrl)
+	service := NewEpochSummaryService(repo, eventsRepo,
+		fixedStatusFetcher(&statusPb.GetStatusResponse{ProcessingEpoch: 100, LastProcessedLogTick: 2}),
+		intervalsFetcher(&statusPb.TickInterval{Epoch: 100, FirstTick: 1, LastTick: 2}))
+	repo.EXPECT().GetTickDataStats(gomock.Any(), uint32(100)).Return(&entities.TickDataStats{}, nil).AnyTimes()
+	repo.EXPECT().GetTransactionStats(gomock.Any(), uint32(1), uint32(2)).Return(nil, errors.New("test")).AnyTimes()
+	repo.EXPECT().GetComputorsListsForEpoch(gomock.Any(), uint32(100)).Return(nil, nil).AnyTimes()
+	eventsRepo.EXPECT().GetEventStats(gomock.Any(), uint32(100), uint32(2)).Return(&entities.EventStats{}, nil).AnyTimes()
+
+	_, err := service.GetEpochSummary(context.Background(), 100)
+	require.ErrorContains(t, err, "getting statistics for epoch [100]")
+}
@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"errors"
 
 	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
 	"github.com/qubic/archive-query-service/v2/entities"
@@ -11,6 +12,8 @@ import (
 
 type EventsRepository interface {
 	GetEvents(ctx context.Context, filters entities.Filters, from, size, maxTick uint32) ([]*api.Event, *entities.Hits, error)
+	GetEvent(ctx context.Context, epoch uint32, logID uint64, maxTick uint32) (*api.Event, error)
+	GetEventsForTransaction(ctx context.Context, hash string, afterLogID *uint64, size, maxTick uint32) ([]*api.Event, error)
 }
 
 type EventsService struct {
@@ -28,3 +31,26 @@ func (s *EventsService) GetEvents(ctx context.Context, filters entities.Filters,
 	}
 	return &entities.EventsResult{Hits: hits, Events: events}, nil
 }
+
+// GetEvent returns the event or nil, if no event is found.
+func (s *EventsService) GetEvent(ctx context.Context, epoch uint32, logID uint64, maxTick uint32) (*api.Event, error) {
+	event, err := s.repo.GetEvent(ctx, epoch, logID, maxTick)
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	}
+	return event, err
+}
+
+// GetEventsForTransaction returns the events of one transaction in execution order. To find out if there are more
+// events available one more event than requested is queried. The additional event is not returned.
+func (s *EventsService) GetEventsForTransaction(ctx context.Context, hash string, afterLogID *uint64, size, maxTick uint32) (*entities.TransactionEventsResult, error) {
+	events, err := s.repo.GetEventsForTransaction(ctx, hash, afterLogID, size+1, maxTick)
+	if err != nil {
+		return nil, err
+	}
+	hasMore := len(events) > int(size)
+	if hasMore {
+		events = events[:size]
+	}
+	return &entities.TransactionEventsResult{Events: events, HasMore: hasMore}, nil
+}
@@ -71,3 +71,47 @@ func TestEventsService_GetEvents_EmptyResult(t *testing.T) {
 	assert.Empty(t, result.Events)
 	assert.Equal(t, 0, result.Hits.Total)
 }
+
+func TestEventsService_GetEvent_GivenNotFound_ThenNil(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mock.NewMockEventsRepository(ctrl)
+	service := NewEventsService(mockRepo)
+
+	mockRepo.EXPECT().GetEvent(gomock.Any(), uint32(100), uint64(42), uint32(50000)).Return(nil, ErrNotFound)
+
+	event, err := service.GetEvent(context.Background(), 100, 42, 50000)
+	require.NoError(t, err)
+	assert.Nil(t, event)
+}
+
+func TestEventsService_GetEventsForTransaction_GivenMoreEvents_ThenHasMore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mock.NewMockEventsRepository(ctrl)
+	service := NewEventsService(mockRepo)
+
+	events := []*api.Event{{LogId: 1}, {LogId: 2}, {LogId: 3}}
+	mockRepo.EXPECT().GetEventsForTransaction(gomock.Any(), "hash", nil, uint32(3), uint32(50000)).Return(events, nil)
+
+	result, err := service.GetEventsForTransaction(context.Background(), "hash", nil, 2, 50000)
+	require.NoError(t, err)
+	assert.True(t, result.HasMore)
+	assert.Equal(t, events[:2], result.Events)
+}
+
+func TestEventsService_GetEventsForTransaction_GivenLastPage_ThenNoMore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mock.NewMockEventsRepository(ctrl)
+	service := NewEventsService(mockRepo)
+
+	afterLogID := uint64(2)
+	events := []*api.Event{{LogId: 3}}
+	mockRepo.EXPECT().GetEventsForTransaction(gomock.Any(), "hash", &afterLogID, uint32(3), uint32(50000)).Return(events, nil)
+
+	result, err := service.GetEventsForTransaction(context.Background(), "hash", &afterLogID, 2, 50000)
+	require.NoError(t, err)
+	assert.False(t, result.HasMore)
+	assert.Equal(t, events, result.Events)
+}
@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	"github.com/qubic/archive-query-service/v2/entities"
+)
+
+//go:generate go tool go.uber.org/mock/mockgen -destination=mock/export.mock.go -package=mock -source export.go
+type TransactionsExportRepository interface {
+	ExportTransactionsForIdentity(ctx context.Context, identity string, maxTick uint32, filters entities.Filters, limit uint32, fn func([]*api.Transaction) error) error
+}
+
+type EventsExportRepository interface {
+	ExportEvents(ctx context.Context, filters entities.Filters, maxTick, limit uint32, fn func([]*api.Event) error) error
+}
+
+type ExportService struct {
+	repo          TransactionsExportRepository
+	eventsRepo    EventsExportRepository
+	statusFetcher StatusFetcherFunc
+}
+
+func NewExportService(repo TransactionsExportRepository, eventsRepo EventsExportRepository, statusFetcher StatusFetcherFunc) *ExportService {
+	return &ExportService{
+		repo:          repo,
+		eventsRepo:    eventsRepo,
+		statusFetcher: statusFetcher,
+	}
+}
+
+// ExportTransactionsForIdentity passes all transactions of the identity up to the last processed tick batch wise to the
+// callback. Stops after limit transactions.
+func (s *ExportService) ExportTransactionsForIdentity(ctx context.Context, identity string, filters entities.Filters, limit uint32, fn func([]*api.Transaction) error) error {
+	status, err := s.statusFetcher(ctx)
+	if err != nil {
+		return fmt.Errorf("getting status: %w", err)
+	}
+	return s.repo.ExportTransactionsForIdentity(ctx, identity, status.GetLastProcessedTick(), filters, limit, func(txs []*api.Transaction) error {
+		setExecutionStatuses(txs)
+		return fn(txs)
+	})
+}
+
+// ExportEvents passes all filtered events up to the last processed log tick batch wise to the callback. Stops after
+// limit events.
+func (s *ExportService) ExportEvents(ctx context.Context, filters entities.Filters, limit uint32, fn func([]*api.Event) error) error {
+	status, err := s.statusFetcher(ctx)
+	if err != nil {
+		return fmt.Errorf("getting status: %w", err)
+	}
+	return s.eventsRepo.ExportEvents(ctx, filters, status.GetLastProcessedLogTick(), limit, fn)
+}
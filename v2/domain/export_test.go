@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"context"
+	"testing"
+
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	"github.com/qubic/archive-query-service/v2/domain/mock"
+	"github.com/qubic/archive-query-service/v2/entities"
+	statusPb "github.com/qubic/go-data-publisher/status-service/protobuf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestExportService_ExportTransactionsForIdentity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	repo := mock.NewMockTransactionsExportRepository(ctrl)
+	service := NewExportService(repo, mock.NewMockEventsExportRepository(ctrl),
+		fixedStatusFetcher(&statusPb.GetStatusResponse{LastProcessedTick: 2000, LastProcessedLogTick: 1990}))
+	repo.EXPECT().ExportTransactionsForIdentity(gomock.Any(), "ID", uint32(2000), entities.Filters{}, uint32(10), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, _ uint32, _ entities.Filters, _ uint32, fn func([]*api.Transaction) error) error {
+			return fn([]*api.Transaction{{Hash: "a", MoneyFlew: true}})
+		})
+
+	var exported []*api.Transaction
+	err := service.ExportTransactionsForIdentity(context.Background(), "ID", entities.Filters{}, 10, func(txs []*api.Transaction) error {
+		exported = append(exported, txs...)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, exported, 1)
+	assert.Equal(t, api.ExecutionStatus_EXECUTION_STATUS_EXECUTED, exported[0].ExecutionStatus)
+}
+
+func TestExportService_ExportEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	eventsRepo := mock.NewMockEventsExportRepository(ctrl)
+	service := NewExportService(mock.NewMockTransactionsExportRepository(ctrl), eventsRepo,
+		fixedStatusFetcher(&statusPb.GetStatusResponse{LastProcessedTick: 2000, LastProcessedLogTick: 1990}))
+	eventsRepo.EXPECT().ExportEvents(gomock.Any(), entities.Filters{}, uint32(1990), uint32(10), gomock.Any()).Return(nil)
+
+	err := service.ExportEvents(context.Background(), entities.Filters{}, 10, func([]*api.Event) error { return nil })
+	require.NoError(t, err)
+}
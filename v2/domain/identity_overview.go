@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	"github.com/qubic/archive-query-service/v2/entities"
+	"golang.org/x/sync/errgroup"
+)
+
+//go:generate go tool go.uber.org/mock/mockgen -destination=mock/identity_overview.mock.go -package=mock -source identity_overview.go
+type IdentityTransactionsRepository interface {
+	GetIdentityTransactionStats(ctx context.Context, identity string, maxTick, topCounterparties uint32) (*entities.IdentityTransactionStats, error)
+	CountIdentityTransactions(ctx context.Context, identity string, maxTick uint32) (uint64, uint64, error)
+}
+
+type IdentityEventsRepository interface {
+	GetIdentityEventStats(ctx context.Context, identity string, maxTick uint32) (*entities.IdentityEventStats, error)
+}
+
+type IdentityOverviewService struct {
+	repo          IdentityTransactionsRepository
+	eventsRepo    IdentityEventsRepository
+	statusFetcher StatusFetcherFunc
+}
+
+func NewIdentityOverviewService(repo IdentityTransactionsRepository, eventsRepo IdentityEventsRepository, statusFetcher StatusFetcherFunc) *IdentityOverviewService {
+	return &IdentityOverviewService{
+		repo:          repo,
+		eventsRepo:    eventsRepo,
+		statusFetcher: statusFetcher,
+	}
+}
+
+// GetIdentityOverview Returns the transaction and event statistics of the identity. All statistics are pinned to the
+// last tick that is processed for transactions and events.
+func (s *IdentityOverviewService) GetIdentityOverview(ctx context.Context, identity string, topCounterparties uint32) (*api.IdentityOverview, error) {
+	status, err := s.statusFetcher(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting status: %w", err)
+	}
+	validForTick := min(status.GetLastProcessedTick(), status.GetLastProcessedLogTick())
+
+	var txStats *entities.IdentityTransactionStats
+	var eventStats *entities.IdentityEventStats
+	var incoming, outgoing uint64
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.Go(func() (err error) {
+		txStats, err = s.repo.GetIdentityTransactionStats(groupCtx, identity, validForTick, topCounterparties)
+		return err
+	})
+	group.Go(func() (err error) {
+		incoming, outgoing, err = s.repo.CountIdentityTransactions(groupCtx, identity, validForTick)
+		return err
+	})
+	group.Go(func() (err error) {
+		eventStats, err = s.eventsRepo.GetIdentityEventStats(groupCtx, identity, validForTick)
+		return err
+	})
+	if err = group.Wait(); err != nil {
+		return nil, fmt.Errorf("getting statistics for identity [%s]: %w", identity, err)
+	}
+
+	return &api.IdentityOverview{
+		Identity:                  identity,
+		ValidForTick:              validForTick,
+		FirstSeenTick:             txStats.FirstSeenTick,
+		FirstSeenTimestamp:        txStats.FirstSeenTimestamp,
+		LastSeenTick:              txStats.LastSeenTick,
+		LastSeenTimestamp:         txStats.LastSeenTimestamp,
+		IncomingTransactionCount:  incoming,
+		OutgoingTransactionCount:  outgoing,
+		TopIncomingCounterparties: txStats.TopIncoming,
+		TopOutgoingCounterparties: txStats.TopOutgoing,
+		QuTransfersReceivedCount:  eventStats.ReceivedCount,
+		QuTransfersReceivedAmount: eventStats.ReceivedAmount,
+		QuTransfersSentCount:      eventStats.SentCount,
+		QuTransfersSentAmount:     eventStats.SentAmount,
+		Assets:                    eventStats.Assets,
+	}, nil
+}
@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	"github.com/qubic/archive-query-service/v2/domain/mock"
+	"github.com/qubic/archive-query-service/v2/entities"
+	statusPb "github.com/qubic/go-data-publisher/status-service/protobuf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const overviewIdentity = "BZBQFLLBNCXEMGLOBHUVFTLUPLVCPQUASSILFABOFFBCADQSSUPNWLZBQEXK"
+
+func TestIdentityOverviewService_GetIdentityOverview(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	repo := mock.NewMockIdentityTransactionsRepository(ctrl)
+	eventsRepo := mock.NewMockIdentityEventsRepository(ctrl)
+	service := NewIdentityOverviewService(repo, eventsRepo,
+		fixedStatusFetcher(&statusPb.GetStatusResponse{LastProcessedTick: 2000, LastProcessedLogTick: 1990}))
+
+	incoming := []*api.Counterparty{{Identity: "A", TransactionCount: 2, Volume: 100}}
+	outgoing := []*api.Counterparty{{Identity: "B", TransactionCount: 1, Volume: 50}}
+	assets := []*api.IdentityAsset{{AssetIssuer: "C", AssetName: "QX"}}
+	repo.EXPECT().GetIdentityTransactionStats(gomock.Any(), overviewIdentity, uint32(1990), uint32(10)).Return(&entities.IdentityTransactionStats{
+		FirstSeenTick: 100, FirstSeenTimestamp: 111, LastSeenTick: 1900, LastSeenTimestamp: 222,
+		TopIncoming: incoming, TopOutgoing: outgoing,
+	}, nil)
+	repo.EXPECT().CountIdentityTransactions(gomock.Any(), overviewIdentity, uint32(1990)).Return(uint64(12345), uint64(67), nil)
+	eventsRepo.EXPECT().GetIdentityEventStats(gomock.Any(), overviewIdentity, uint32(1990)).Return(&entities.IdentityEventStats{
+		ReceivedCount: 3, ReceivedAmount: 300, SentCount: 4, SentAmount: 400, Assets: assets,
+	}, nil)
+
+	overview, err := service.GetIdentityOverview(context.Background(), overviewIdentity, 10)
+	require.NoError(t, err)
+	assert.Equal(t, &api.IdentityOverview{
+		Identity:                  overviewIdentity,
+		ValidForTick:              1990,
+		FirstSeenTick:             100,
+		FirstSeenTimestamp:        111,
+		LastSeenTick:              1900,
+		LastSeenTimestamp:         222,
+		IncomingTransactionCount:  12345,
+		OutgoingTransactionCount:  67,
+		TopIncomingCounterparties: incoming,
+		TopOutgoingCounterparties: outgoing,
+		QuTransfersReceivedCount:  3,
+		QuTransfersReceivedAmount: 300,
+		QuTransfersSentCount:      4,
+		QuTransfersSentAmount:     400,
+		Assets:                    assets,
+	}, overview)
+}
+
+func TestIdentityOverviewService_GetIdentityOverview_GivenRepositoryError_ThenError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	repo := mock.NewMockIdentityTransactionsRepository(ctrl)
+	eventsRepo := mock.NewMockIdentityEventsRepository(ctrl)
+	service := NewIdentityOverviewService(repo, eventsRepo,
+		fixedStatusFetcher(&statusPb.GetStatusResponse{LastProcessedTick: 2000, LastProcessedLogTick: 2000}))
+	repo.EXPECT().GetIdentityTransactionStats(gomock.Any(), overviewIdentity, uint32(2000), uint32(10)).Return(&entities.IdentityTransactionStats{}, nil).AnyTimes()
+	repo.EXPECT().CountIdentityTransactions(gomock.Any(), overviewIdentity, uint32(2000)).Return(uint64(0), uint64(0), errors.New("test")).AnyTimes()
+	eventsRepo.EXPECT().GetIdentityEventStats(gomock.Any(), overviewIdentity, uint32(2000)).Return(&entities.IdentityEventStats{}, nil).AnyTimes()
+
+	_, err := service.GetIdentityOverview(context.Background(), overviewIdentity, 10)
+	require.ErrorContains(t, err, "getting statistics for identity")
+}
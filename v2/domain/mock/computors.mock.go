@@ -55,3 +55,33 @@ func (mr *MockComputorsListRepositoryMockRecorder) GetComputorsListsForEpoch(ctx
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComputorsListsForEpoch", reflect.TypeOf((*MockComputorsListRepository)(nil).GetComputorsListsForEpoch), ctx, epoch)
 }
+
+// GetComputorsListsForEpochRange mocks base method.
+func (m *MockComputorsListRepository) GetComputorsListsForEpochRange(ctx context.Context, fromEpoch, toEpoch uint32) ([]*api.ComputorList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComputorsListsForEpochRange", ctx, fromEpoch, toEpoch)
+	ret0, _ := ret[0].([]*api.ComputorList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComputorsListsForEpochRange indicates an expected call of GetComputorsListsForEpochRange.
+func (mr *MockComputorsListRepositoryMockRecorder) GetComputorsListsForEpochRange(ctx, fromEpoch, toEpoch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComputorsListsForEpochRange", reflect.TypeOf((*MockComputorsListRepository)(nil).GetComputorsListsForEpochRange), ctx, fromEpoch, toEpoch)
+}
+
+// GetComputorsListsForIdentity mocks base method.
+func (m *MockComputorsListRepository) GetComputorsListsForIdentity(ctx context.Context, identity string) ([]*api.ComputorList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComputorsListsForIdentity", ctx, identity)
+	ret0, _ := ret[0].([]*api.ComputorList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComputorsListsForIdentity indicates an expected call of GetComputorsListsForIdentity.
+func (mr *MockComputorsListRepositoryMockRecorder) GetComputorsListsForIdentity(ctx, identity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComputorsListsForIdentity", reflect.TypeOf((*MockComputorsListRepository)(nil).GetComputorsListsForIdentity), ctx, identity)
+}
@@ -0,0 +1,127 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: epoch_summary.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=mock/epoch_summary.mock.go -package=mock -source epoch_summary.go
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	entities "github.com/qubic/archive-query-service/v2/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEpochStatsRepository is a mock of EpochStatsRepository interface.
+type MockEpochStatsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockEpochStatsRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockEpochStatsRepositoryMockRecorder is the mock recorder for MockEpochStatsRepository.
+type MockEpochStatsRepositoryMockRecorder struct {
+	mock *MockEpochStatsRepository
+}
+
+// NewMockEpochStatsRepository creates a new mock instance.
+func NewMockEpochStatsRepository(ctrl *gomock.Controller) *MockEpochStatsRepository {
+	mock := &MockEpochStatsRepository{ctrl: ctrl}
+	mock.recorder = &MockEpochStatsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEpochStatsRepository) EXPECT() *MockEpochStatsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetComputorsListsForEpoch mocks base method.
+func (m *MockEpochStatsRepository) GetComputorsListsForEpoch(ctx context.Context, epoch uint32) ([]*api.ComputorList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComputorsListsForEpoch", ctx, epoch)
+	ret0, _ := ret[0].([]*api.ComputorList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComputorsListsForEpoch indicates an expected call of GetComputorsListsForEpoch.
+func (mr *MockEpochStatsRepositoryMockRecorder) GetComputorsListsForEpoch(ctx, epoch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComputorsListsForEpoch", reflect.TypeOf((*MockEpochStatsRepository)(nil).GetComputorsListsForEpoch), ctx, epoch)
+}
+
+// GetTickDataStats mocks base method.
+func (m *MockEpochStatsRepository) GetTickDataStats(ctx context.Context, epoch uint32) (*entities.TickDataStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTickDataStats", ctx, epoch)
+	ret0, _ := ret[0].(*entities.TickDataStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTickDataStats indicates an expected call of GetTickDataStats.
+func (mr *MockEpochStatsRepositoryMockRecorder) GetTickDataStats(ctx, epoch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTickDataStats", reflect.TypeOf((*MockEpochStatsRepository)(nil).GetTickDataStats), ctx, epoch)
+}
+
+// GetTransactionStats mocks base method.
+func (m *MockEpochStatsRepository) GetTransactionStats(ctx context.Context, fromTick, toTick uint32) (*entities.TransactionStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionStats", ctx, fromTick, toTick)
+	ret0, _ := ret[0].(*entities.TransactionStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactionStats indicates an expected call of GetTransactionStats.
+func (mr *MockEpochStatsRepositoryMockRecorder) GetTransactionStats(ctx, fromTick, toTick any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionStats", reflect.TypeOf((*MockEpochStatsRepository)(nil).GetTransactionStats), ctx, fromTick, toTick)
+}
+
+// MockEventStatsRepository is a mock of EventStatsRepository interface.
+type MockEventStatsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventStatsRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockEventStatsRepositoryMockRecorder is the mock recorder for MockEventStatsRepository.
+type MockEventStatsRepositoryMockRecorder struct {
+	mock *MockEventStatsRepository
+}
+
+// NewMockEventStatsRepository creates a new mock instance.
+func NewMockEventStatsRepository(ctrl *gomock.Controller) *MockEventStatsRepository {
+	mock := &MockEventStatsRepository{ctrl: ctrl}
+	mock.recorder = &MockEventStatsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventStatsRepository) EXPECT() *MockEventStatsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetEventStats mocks base method.
+func (m *MockEventStatsRepository) GetEventStats(ctx context.Context, epoch, maxTick uint32) (*entities.EventStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventStats", ctx, epoch, maxTick)
+	ret0, _ := ret[0].(*entities.EventStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventStats indicates an expected call of GetEventStats.
+func (mr *MockEventStatsRepositoryMockRecorder) GetEventStats(ctx, epoch, maxTick any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventStats", reflect.TypeOf((*MockEventStatsRepository)(nil).GetEventStats), ctx, epoch, maxTick)
+}
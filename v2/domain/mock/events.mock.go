@@ -42,6 +42,21 @@ func (m *MockEventsRepository) EXPECT() *MockEventsRepositoryMockRecorder {
 	return m.recorder
 }
 
+// GetEvent mocks base method.
+func (m *MockEventsRepository) GetEvent(ctx context.Context, epoch uint32, logID uint64, maxTick uint32) (*api.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEvent", ctx, epoch, logID, maxTick)
+	ret0, _ := ret[0].(*api.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEvent indicates an expected call of GetEvent.
+func (mr *MockEventsRepositoryMockRecorder) GetEvent(ctx, epoch, logID, maxTick any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvent", reflect.TypeOf((*MockEventsRepository)(nil).GetEvent), ctx, epoch, logID, maxTick)
+}
+
 // GetEvents mocks base method.
 func (m *MockEventsRepository) GetEvents(ctx context.Context, filters entities.Filters, from, size, maxTick uint32) ([]*api.Event, *entities.Hits, error) {
 	m.ctrl.T.Helper()
@@ -57,3 +72,18 @@ func (mr *MockEventsRepositoryMockRecorder) GetEvents(ctx, filters, from, size,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvents", reflect.TypeOf((*MockEventsRepository)(nil).GetEvents), ctx, filters, from, size, maxTick)
 }
+
+// GetEventsForTransaction mocks base method.
+func (m *MockEventsRepository) GetEventsForTransaction(ctx context.Context, hash string, afterLogID *uint64, size, maxTick uint32) ([]*api.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventsForTransaction", ctx, hash, afterLogID, size, maxTick)
+	ret0, _ := ret[0].([]*api.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventsForTransaction indicates an expected call of GetEventsForTransaction.
+func (mr *MockEventsRepositoryMockRecorder) GetEventsForTransaction(ctx, hash, afterLogID, size, maxTick any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventsForTransaction", reflect.TypeOf((*MockEventsRepository)(nil).GetEventsForTransaction), ctx, hash, afterLogID, size, maxTick)
+}
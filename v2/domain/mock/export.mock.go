@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: export.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=mock/export.mock.go -package=mock -source export.go
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	entities "github.com/qubic/archive-query-service/v2/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTransactionsExportRepository is a mock of TransactionsExportRepository interface.
+type MockTransactionsExportRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionsExportRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTransactionsExportRepositoryMockRecorder is the mock recorder for MockTransactionsExportRepository.
+type MockTransactionsExportRepositoryMockRecorder struct {
+	mock *MockTransactionsExportRepository
+}
+
+// NewMockTransactionsExportRepository creates a new mock instance.
+func NewMockTransactionsExportRepository(ctrl *gomock.Controller) *MockTransactionsExportRepository {
+	mock := &MockTransactionsExportRepository{ctrl: ctrl}
+	mock.recorder = &MockTransactionsExportRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransactionsExportRepository) EXPECT() *MockTransactionsExportRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ExportTransactionsForIdentity mocks base method.
+func (m *MockTransactionsExportRepository) ExportTransactionsForIdentity(ctx context.Context, identity string, maxTick uint32, filters entities.Filters, limit uint32, fn func([]*api.Transaction) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportTransactionsForIdentity", ctx, identity, maxTick, filters, limit, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportTransactionsForIdentity indicates an expected call of ExportTransactionsForIdentity.
+func (mr *MockTransactionsExportRepositoryMockRecorder) ExportTransactionsForIdentity(ctx, identity, maxTick, filters, limit, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportTransactionsForIdentity", reflect.TypeOf((*MockTransactionsExportRepository)(nil).ExportTransactionsForIdentity), ctx, identity, maxTick, filters, limit, fn)
+}
+
+// MockEventsExportRepository is a mock of EventsExportRepository interface.
+type MockEventsExportRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventsExportRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockEventsExportRepositoryMockRecorder is the mock recorder for MockEventsExportRepository.
+type MockEventsExportRepositoryMockRecorder struct {
+	mock *MockEventsExportRepository
+}
+
+// NewMockEventsExportRepository creates a new mock instance.
+func NewMockEventsExportRepository(ctrl *gomock.Controller) *MockEventsExportRepository {
+	mock := &MockEventsExportRepository{ctrl: ctrl}
+	mock.recorder = &MockEventsExportRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventsExportRepository) EXPECT() *MockEventsExportRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ExportEvents mocks base method.
+func (m *MockEventsExportRepository) ExportEvents(ctx context.Context, filters entities.Filters, maxTick, limit uint32, fn func([]*api.Event) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportEvents", ctx, filters, maxTick, limit, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportEvents indicates an expected call of ExportEvents.
+func (mr *MockEventsExportRepositoryMockRecorder) ExportEvents(ctx, filters, maxTick, limit, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportEvents", reflect.TypeOf((*MockEventsExportRepository)(nil).ExportEvents), ctx, filters, maxTick, limit, fn)
+}
@@ -0,0 +1,112 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: identity_overview.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=mock/identity_overview.mock.go -package=mock -source identity_overview.go
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/qubic/archive-query-service/v2/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIdentityTransactionsRepository is a mock of IdentityTransactionsRepository interface.
+type MockIdentityTransactionsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdentityTransactionsRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIdentityTransactionsRepositoryMockRecorder is the mock recorder for MockIdentityTransactionsRepository.
+type MockIdentityTransactionsRepositoryMockRecorder struct {
+	mock *MockIdentityTransactionsRepository
+}
+
+// NewMockIdentityTransactionsRepository creates a new mock instance.
+func NewMockIdentityTransactionsRepository(ctrl *gomock.Controller) *MockIdentityTransactionsRepository {
+	mock := &MockIdentityTransactionsRepository{ctrl: ctrl}
+	mock.recorder = &MockIdentityTransactionsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdentityTransactionsRepository) EXPECT() *MockIdentityTransactionsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountIdentityTransactions mocks base method.
+func (m *MockIdentityTransactionsRepository) CountIdentityTransactions(ctx context.Context, identity string, maxTick uint32) (uint64, uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountIdentityTransactions", ctx, identity, maxTick)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(uint64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CountIdentityTransactions indicates an expected call of CountIdentityTransactions.
+func (mr *MockIdentityTransactionsRepositoryMockRecorder) CountIdentityTransactions(ctx, identity, maxTick any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountIdentityTransactions", reflect.TypeOf((*MockIdentityTransactionsRepository)(nil).CountIdentityTransactions), ctx, identity, maxTick)
+}
+
+// GetIdentityTransactionStats mocks base method.
+func (m *MockIdentityTransactionsRepository) GetIdentityTransactionStats(ctx context.Context, identity string, maxTick, topCounterparties uint32) (*entities.IdentityTransactionStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIdentityTransactionStats", ctx, identity, maxTick, topCounterparties)
+	ret0, _ := ret[0].(*entities.IdentityTransactionStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIdentityTransactionStats indicates an expected call of GetIdentityTransactionStats.
+func (mr *MockIdentityTransactionsRepositoryMockRecorder) GetIdentityTransactionStats(ctx, identity, maxTick, topCounterparties any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIdentityTransactionStats", reflect.TypeOf((*MockIdentityTransactionsRepository)(nil).GetIdentityTransactionStats), ctx, identity, maxTick, topCounterparties)
+}
+
+// MockIdentityEventsRepository is a mock of IdentityEventsRepository interface.
+type MockIdentityEventsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdentityEventsRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIdentityEventsRepositoryMockRecorder is the mock recorder for MockIdentityEventsRepository.
+type MockIdentityEventsRepositoryMockRecorder struct {
+	mock *MockIdentityEventsRepository
+}
+
+// NewMockIdentityEventsRepository creates a new mock instance.
+func NewMockIdentityEventsRepository(ctrl *gomock.Controller) *MockIdentityEventsRepository {
+	mock := &MockIdentityEventsRepository{ctrl: ctrl}
+	mock.recorder = &MockIdentityEventsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdentityEventsRepository) EXPECT() *MockIdentityEventsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetIdentityEventStats mocks base method.
+func (m *MockIdentityEventsRepository) GetIdentityEventStats(ctx context.Context, identity string, maxTick uint32) (*entities.IdentityEventStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIdentityEventStats", ctx, identity, maxTick)
+	ret0, _ := ret[0].(*entities.IdentityEventStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIdentityEventStats indicates an expected call of GetIdentityEventStats.
+func (mr *MockIdentityEventsRepositoryMockRecorder) GetIdentityEventStats(ctx, identity, maxTick any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIdentityEventStats", reflect.TypeOf((*MockIdentityEventsRepository)(nil).GetIdentityEventStats), ctx, identity, maxTick)
+}
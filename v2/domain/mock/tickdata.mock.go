@@ -41,6 +41,21 @@ func (m *MockTickDataRepository) EXPECT() *MockTickDataRepositoryMockRecorder {
 	return m.recorder
 }
 
+// GetComputorTickCounts mocks base method.
+func (m *MockTickDataRepository) GetComputorTickCounts(ctx context.Context, epoch uint32) (map[uint32]uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComputorTickCounts", ctx, epoch)
+	ret0, _ := ret[0].(map[uint32]uint32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComputorTickCounts indicates an expected call of GetComputorTickCounts.
+func (mr *MockTickDataRepositoryMockRecorder) GetComputorTickCounts(ctx, epoch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComputorTickCounts", reflect.TypeOf((*MockTickDataRepository)(nil).GetComputorTickCounts), ctx, epoch)
+}
+
 // GetTickData mocks base method.
 func (m *MockTickDataRepository) GetTickData(ctx context.Context, tickNumber uint32) (*api.TickData, error) {
 	m.ctrl.T.Helper()
@@ -55,3 +70,33 @@ func (mr *MockTickDataRepositoryMockRecorder) GetTickData(ctx, tickNumber any) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTickData", reflect.TypeOf((*MockTickDataRepository)(nil).GetTickData), ctx, tickNumber)
 }
+
+// GetTickDataRange mocks base method.
+func (m *MockTickDataRepository) GetTickDataRange(ctx context.Context, fromTick, toTick uint32) ([]*api.TickData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTickDataRange", ctx, fromTick, toTick)
+	ret0, _ := ret[0].([]*api.TickData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTickDataRange indicates an expected call of GetTickDataRange.
+func (mr *MockTickDataRepositoryMockRecorder) GetTickDataRange(ctx, fromTick, toTick any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTickDataRange", reflect.TypeOf((*MockTickDataRepository)(nil).GetTickDataRange), ctx, fromTick, toTick)
+}
+
+// GetTickNumbers mocks base method.
+func (m *MockTickDataRepository) GetTickNumbers(ctx context.Context, epoch, fromTick, toTick uint32) ([]uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTickNumbers", ctx, epoch, fromTick, toTick)
+	ret0, _ := ret[0].([]uint32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTickNumbers indicates an expected call of GetTickNumbers.
+func (mr *MockTickDataRepositoryMockRecorder) GetTickNumbers(ctx, epoch, fromTick, toTick any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTickNumbers", reflect.TypeOf((*MockTickDataRepository)(nil).GetTickNumbers), ctx, epoch, fromTick, toTick)
+}
@@ -0,0 +1,166 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: webhooks.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=mock/webhooks.mock.go -package=mock -source webhooks.go
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	entities "github.com/qubic/archive-query-service/v2/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWebhookRepository is a mock of WebhookRepository interface.
+type MockWebhookRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockWebhookRepositoryMockRecorder is the mock recorder for MockWebhookRepository.
+type MockWebhookRepositoryMockRecorder struct {
+	mock *MockWebhookRepository
+}
+
+// NewMockWebhookRepository creates a new mock instance.
+func NewMockWebhookRepository(ctrl *gomock.Controller) *MockWebhookRepository {
+	mock := &MockWebhookRepository{ctrl: ctrl}
+	mock.recorder = &MockWebhookRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookRepository) EXPECT() *MockWebhookRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteWebhook mocks base method.
+func (m *MockWebhookRepository) DeleteWebhook(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhook", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhook indicates an expected call of DeleteWebhook.
+func (mr *MockWebhookRepositoryMockRecorder) DeleteWebhook(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*MockWebhookRepository)(nil).DeleteWebhook), ctx, id)
+}
+
+// GetWebhooks mocks base method.
+func (m *MockWebhookRepository) GetWebhooks(ctx context.Context) ([]*entities.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhooks", ctx)
+	ret0, _ := ret[0].([]*entities.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhooks indicates an expected call of GetWebhooks.
+func (mr *MockWebhookRepositoryMockRecorder) GetWebhooks(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhooks", reflect.TypeOf((*MockWebhookRepository)(nil).GetWebhooks), ctx)
+}
+
+// SaveWebhook mocks base method.
+func (m *MockWebhookRepository) SaveWebhook(ctx context.Context, webhook *entities.Webhook) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveWebhook", ctx, webhook)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveWebhook indicates an expected call of SaveWebhook.
+func (mr *MockWebhookRepositoryMockRecorder) SaveWebhook(ctx, webhook any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveWebhook", reflect.TypeOf((*MockWebhookRepository)(nil).SaveWebhook), ctx, webhook)
+}
+
+// MockWebhookTransactionsRepository is a mock of WebhookTransactionsRepository interface.
+type MockWebhookTransactionsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookTransactionsRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockWebhookTransactionsRepositoryMockRecorder is the mock recorder for MockWebhookTransactionsRepository.
+type MockWebhookTransactionsRepositoryMockRecorder struct {
+	mock *MockWebhookTransactionsRepository
+}
+
+// NewMockWebhookTransactionsRepository creates a new mock instance.
+func NewMockWebhookTransactionsRepository(ctrl *gomock.Controller) *MockWebhookTransactionsRepository {
+	mock := &MockWebhookTransactionsRepository{ctrl: ctrl}
+	mock.recorder = &MockWebhookTransactionsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookTransactionsRepository) EXPECT() *MockWebhookTransactionsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetTransactionsForIdentity mocks base method.
+func (m *MockWebhookTransactionsRepository) GetTransactionsForIdentity(ctx context.Context, identity string, maxTick uint32, filters entities.Filters, from, size uint32) ([]*api.Transaction, *entities.Hits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionsForIdentity", ctx, identity, maxTick, filters, from, size)
+	ret0, _ := ret[0].([]*api.Transaction)
+	ret1, _ := ret[1].(*entities.Hits)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTransactionsForIdentity indicates an expected call of GetTransactionsForIdentity.
+func (mr *MockWebhookTransactionsRepositoryMockRecorder) GetTransactionsForIdentity(ctx, identity, maxTick, filters, from, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionsForIdentity", reflect.TypeOf((*MockWebhookTransactionsRepository)(nil).GetTransactionsForIdentity), ctx, identity, maxTick, filters, from, size)
+}
+
+// MockWebhookEventsRepository is a mock of WebhookEventsRepository interface.
+type MockWebhookEventsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookEventsRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockWebhookEventsRepositoryMockRecorder is the mock recorder for MockWebhookEventsRepository.
+type MockWebhookEventsRepositoryMockRecorder struct {
+	mock *MockWebhookEventsRepository
+}
+
+// NewMockWebhookEventsRepository creates a new mock instance.
+func NewMockWebhookEventsRepository(ctrl *gomock.Controller) *MockWebhookEventsRepository {
+	mock := &MockWebhookEventsRepository{ctrl: ctrl}
+	mock.recorder = &MockWebhookEventsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookEventsRepository) EXPECT() *MockWebhookEventsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetEvents mocks base method.
+func (m *MockWebhookEventsRepository) GetEvents(ctx context.Context, filters entities.Filters, from, size, maxTick uint32) ([]*api.Event, *entities.Hits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEvents", ctx, filters, from, size, maxTick)
+	ret0, _ := ret[0].([]*api.Event)
+	ret1, _ := ret[1].(*entities.Hits)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetEvents indicates an expected call of GetEvents.
+func (mr *MockWebhookEventsRepositoryMockRecorder) GetEvents(ctx, filters, from, size, maxTick any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvents", reflect.TypeOf((*MockWebhookEventsRepository)(nil).GetEvents), ctx, filters, from, size, maxTick)
+}
@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+
+	"github.com/qubic/archive-query-service/v2/entities"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// MaxNotificationItems is the maximum number of transactions or event logs per subscription or webhook
+	// notification and the page size of the queries.
+	MaxNotificationItems = 1000
+	// MaxNotificationResultWindow is the maximum number of results that can be paged through for one tick range. If
+	// there are more results, the remaining ticks are notified in the next round. If a single tick has more results,
+	// the notification is marked as truncated.
+	MaxNotificationResultWindow = 10000
+)
+
+// TickRange returns a copy of the ranges with the tick number restricted to the given ticks. Used to query the new
+// ticks of subscriptions and webhooks.
+func TickRange(ranges map[string][]entities.Range, fromTick, toTick uint32) map[string][]entities.Range {
+	result := maps.Clone(ranges)
+	if result == nil {
+		result = map[string][]entities.Range{}
+	}
+	result["tickNumber"] = []entities.Range{
+		{Operation: "gte", Value: strconv.FormatUint(uint64(fromTick), 10)},
+		{Operation: "lte", Value: strconv.FormatUint(uint64(toTick), 10)},
+	}
+	return result
+}
+
+var notificationMarshaler = protojson.MarshalOptions{EmitDefaultValues: true, EmitUnpopulated: true}
+
+// MarshalNotificationItems marshals the transactions or event logs of a notification in the json format of the api
+// responses.
+func MarshalNotificationItems[T proto.Message](items []T) ([]json.RawMessage, error) {
+	result := make([]json.RawMessage, 0, len(items))
+	for _, item := range items {
+		data, err := notificationMarshaler.Marshal(item)
+		if err != nil {
+			return nil, fmt.Errorf("marshalling %T: %w", item, err)
+		}
+		result = append(result, data)
+	}
+	return result, nil
+}
+
+// CollectTickPages queries the pages of the results (sorted by tick number ascending) until all results are collected
+// or the result window is exhausted. Returns the results and the last tick that is completely included. If the
+// window is exhausted, the results of the last included tick are removed, unless it is the first tick. In this case
+// the results are truncated.
+func CollectTickPages[T any](fetch func(from uint32) ([]T, *entities.Hits, error), tickNumber func(T) uint32, fromTick, toTick uint32) ([]T, uint32, bool, error) {
+	var results []T
+	for from := uint32(0); from < MaxNotificationResultWindow; from += MaxNotificationItems {
+		page, hits, err := fetch(from)
+		if err != nil {
+			return nil, 0, false, err
+		}
+		results = append(results, page...)
+		if len(page) < MaxNotificationItems || (hits.GetTotal() <= len(results) && hits.GetRelation() != "gte") {
+			return results, toTick, false, nil
+		}
+	}
+
+	lastTick := tickNumber(results[len(results)-1])
+	if lastTick == fromTick {
+		return results, fromTick, true, nil
+	}
+	complete := slices.IndexFunc(results, func(result T) bool { return tickNumber(result) == lastTick })
+	return results[:complete], lastTick - 1, false, nil
+}
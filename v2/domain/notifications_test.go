@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"testing"
+
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	"github.com/qubic/archive-query-service/v2/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testTickEvents creates count events for each of the ticks.
+func testTickEvents(count int, ticks ...uint32) []*api.Event {
+	events := make([]*api.Event, 0, count*len(ticks))
+	for _, tick := range ticks {
+		for range count {
+			events = append(events, &api.Event{TickNumber: tick, LogId: uint64(len(events))})
+		}
+	}
+	return events
+}
+
+func TestCollectTickPages_GivenResultWindowExhausted_ThenOnlyCompleteTicks(t *testing.T) {
+	events := testTickEvents(4000, 11, 12, 13)
+	fetch := func(from uint32) ([]*api.Event, *entities.Hits, error) {
+		return events[from : from+MaxNotificationItems], &entities.Hits{Total: MaxNotificationResultWindow, Relation: "gte"}, nil
+	}
+
+	results, notifiedTick, truncated, err := CollectTickPages(fetch, (*api.Event).GetTickNumber, 11, 13)
+	require.NoError(t, err)
+	assert.Len(t, results, 8000, "events of tick 13 are notified later")
+	assert.Equal(t, uint32(12), notifiedTick)
+	assert.False(t, truncated)
+}
+
+func TestCollectTickPages_GivenResultWindowExhaustedByFirstTick_ThenTruncated(t *testing.T) {
+	events := testTickEvents(MaxNotificationResultWindow+1, 11)
+	fetch := func(from uint32) ([]*api.Event, *entities.Hits, error) {
+		return events[from : from+MaxNotificationItems], &entities.Hits{Total: MaxNotificationResultWindow, Relation: "gte"}, nil
+	}
+
+	results, notifiedTick, truncated, err := CollectTickPages(fetch, (*api.Event).GetTickNumber, 11, 13)
+	require.NoError(t, err)
+	assert.Len(t, results, MaxNotificationResultWindow)
+	assert.Equal(t, uint32(11), notifiedTick)
+	assert.True(t, truncated)
+}
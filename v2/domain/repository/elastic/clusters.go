@@ -0,0 +1,346 @@
+package elastic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/qubic/archive-query-service/v2/domain"
+)
+
+const defaultClusterName = "default"
+
+const (
+	resultSuccess = "success"
+	resultError   = "error"  // the request failed, but not because of the cluster (for example an invalid query)
+	resultFailed  = "failed" // the cluster failed and the request was passed to the next cluster, if available
+)
+
+// Cluster is an elasticsearch cluster. The client can be configured with several nodes of the cluster.
+type Cluster struct {
+	Name   string
+	Client *elasticsearch.Client
+}
+
+type ClusterOptions struct {
+	// TickIndex is the index that is queried for the highest indexed tick of the clusters.
+	TickIndex string
+	// MaxTickLag is the number of ticks a cluster may lag behind the most recent cluster. 0 disables the check.
+	MaxTickLag uint32
+	// FailureBackoff is the time a cluster is skipped after a failed request.
+	FailureBackoff time.Duration
+	// HealthCheckInterval is the interval, in which the indexed ticks are checked.
+	HealthCheckInterval time.Duration
+}
+
+type clusterState struct {
+	Cluster
+	indexedTick atomic.Uint32
+	lagging     atomic.Bool
+	failedUntil atomic.Int64 // unix nanos
+}
+
+func (cs *clusterState) available(now time.Time) bool {
+	return !cs.lagging.Load() && now.UnixNano() >= cs.failedUntil.Load()
+}
+
+// Clusters routes the reads of a repository to an ordered list of clusters with replicas of the same data. Requests are
+// sent to the first available cluster. A cluster is unavailable after a failed request (for the failure backoff) and
+// while its indexed tick lags behind the most recent cluster. If a request fails because of the cluster, it is retried
+// with the next cluster. Unavailable clusters are used as last resort.
+type Clusters struct {
+	repository string
+	clusters   []*clusterState
+	options    ClusterOptions
+	metrics    *ClusterMetrics
+	stop       chan struct{}
+	stopOnce   sync.Once
+	wg         sync.WaitGroup
+}
+
+// NewClusters creates the clusters of the repository (used for metrics and logs) in order of preference.
+func NewClusters(repository string, clusters []Cluster, options ClusterOptions, metrics *ClusterMetrics) (*Clusters, error) {
+	if len(clusters) == 0 {
+		return nil, errors.New("no clusters")
+	}
+	states := make([]*clusterState, 0, len(clusters))
+	names := make(map[string]bool, len(clusters))
+	for _, cluster := range clusters {
+		if cluster.Name == "" || cluster.Client == nil {
+			return nil, errors.New("cluster name and client are required")
+		}
+		if names[cluster.Name] {
+			return nil, fmt.Errorf("duplicate cluster [%s]", cluster.Name)
+		}
+		names[cluster.Name] = true
+		states = append(states, &clusterState{Cluster: cluster})
+		metrics.setAvailable(repository, cluster.Name, true)
+	}
+	return &Clusters{
+		repository: repository,
+		clusters:   states,
+		options:    options,
+		metrics:    metrics,
+		stop:       make(chan struct{}),
+	}, nil
+}
+
+func newSingleCluster(repository string, esClient *elasticsearch.Client) *Clusters {
+	return &Clusters{
+		repository: repository,
+		clusters:   []*clusterState{{Cluster: Cluster{Name: defaultClusterName, Client: esClient}}},
+		stop:       make(chan struct{}),
+	}
+}
+
+// Start checks the indexed ticks of the clusters periodically. Not needed for a single cluster.
+func (c *Clusters) Start() {
+	if len(c.clusters) < 2 || c.options.HealthCheckInterval <= 0 {
+		return
+	}
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		ticker := time.NewTicker(c.options.HealthCheckInterval)
+		defer ticker.Stop()
+		for {
+			ctx, cancel := context.WithTimeout(context.Background(), c.options.HealthCheckInterval)
+			c.checkHealth(ctx)
+			cancel()
+			select {
+			case <-c.stop:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (c *Clusters) Stop() {
+	c.stopOnce.Do(func() { close(c.stop) })
+	c.wg.Wait()
+}
+
+// Close stops the health checks and closes the clients.
+func (c *Clusters) Close(ctx context.Context) error {
+	c.Stop()
+	var errs []error
+	for _, cluster := range c.clusters {
+		if err := cluster.Client.Close(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("closing cluster [%s]: %w", cluster.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+type maxTickSearchResponse struct {
+	searchResult
+	Aggregations struct {
+		MaxTick aggregationValue `json:"max_tick"`
+	} `json:"aggregations"`
+}
+
+const maxTickQuery = `{"size":0,"track_total_hits":false,"aggs":{"max_tick":{"max":{"field":"tickNumber"}}}}`
+
+// checkHealth queries the highest indexed tick of all clusters and marks the clusters that lag behind.
+func (c *Clusters) checkHealth(ctx context.Context) {
+	reachable := make([]*clusterState, 0, len(c.clusters))
+	var mostRecent uint32
+	for _, cluster := range c.clusters {
+		var result maxTickSearchResponse
+		err := performElasticSearch(ctx, cluster.Client, c.options.TickIndex, strings.NewReader(maxTickQuery), &result)
+		if err != nil {
+			log.Printf("[WARN] %s: checking cluster [%s]: %v", c.repository, cluster.Name, err)
+			c.markFailed(cluster)
+			continue
+		}
+		tick := uint32(result.Aggregations.MaxTick.Value)
+		cluster.indexedTick.Store(tick)
+		c.metrics.setIndexedTick(c.repository, cluster.Name, tick)
+		mostRecent = max(mostRecent, tick)
+		reachable = append(reachable, cluster)
+	}
+
+	for _, cluster := range reachable {
+		cluster.failedUntil.Store(0)
+		tick := cluster.indexedTick.Load()
+		lagging := c.options.MaxTickLag > 0 && mostRecent-tick > c.options.MaxTickLag
+		if cluster.lagging.Swap(lagging) != lagging {
+			log.Printf("[INFO] %s: cluster [%s] at tick [%d], most recent tick [%d], lagging: %t",
+				c.repository, cluster.Name, tick, mostRecent, lagging)
+		}
+		c.metrics.setAvailable(c.repository, cluster.Name, cluster.available(time.Now()))
+	}
+}
+
+func (c *Clusters) markFailed(cluster *clusterState) {
+	cluster.failedUntil.Store(time.Now().Add(c.options.FailureBackoff).UnixNano())
+	c.metrics.setAvailable(c.repository, cluster.Name, false)
+}
+
+// ordered returns the available clusters in order of preference followed by the unavailable clusters.
+func (c *Clusters) ordered() []*clusterState {
+	if len(c.clusters) == 1 {
+		return c.clusters
+	}
+	now := time.Now()
+	available := make([]*clusterState, 0, len(c.clusters))
+	var unavailable []*clusterState
+	for _, cluster := range c.clusters {
+		if cluster.available(now) {
+			available = append(available, cluster)
+		} else {
+			unavailable = append(unavailable, cluster)
+		}
+	}
+	return append(available, unavailable...)
+}
+
+// do calls the function with the client of the preferred cluster and fails over to the next cluster, if the cluster
+// failed. The function must be repeatable.
+func (c *Clusters) do(ctx context.Context, fn func(*elasticsearch.Client) error) error {
+	var err error
+	for i, cluster := range c.ordered() {
+		if i > 0 {
+			log.Printf("[WARN] %s: failing over to cluster [%s]: %v", c.repository, cluster.Name, err)
+		}
+		err = fn(cluster.Client)
+		failed := isClusterFailure(ctx, err)
+		c.metrics.countRequest(c.repository, cluster.Name, requestResult(err, failed))
+		if !failed {
+			return err
+		}
+		c.markFailed(cluster)
+	}
+	return err
+}
+
+// preferred calls the function with the client of the preferred cluster without failover. Used for requests that
+// cannot be repeated (for example streamed exports).
+func (c *Clusters) preferred(ctx context.Context, fn func(*elasticsearch.Client) error) error {
+	cluster := c.ordered()[0]
+	err := fn(cluster.Client)
+	failed := isClusterFailure(ctx, err)
+	c.metrics.countRequest(c.repository, cluster.Name, requestResult(err, failed))
+	if failed {
+		c.markFailed(cluster)
+	}
+	return err
+}
+
+func (c *Clusters) search(ctx context.Context, index, query string, result searchResponse) error {
+	return c.do(ctx, func(esClient *elasticsearch.Client) error {
+		return performElasticSearch(ctx, esClient, index, strings.NewReader(query), result)
+	})
+}
+
+func (c *Clusters) count(ctx context.Context, index, query string) (uint64, error) {
+	var count uint64
+	err := c.do(ctx, func(esClient *elasticsearch.Client) error {
+		var err error
+		count, err = performElasticCount(ctx, esClient, index, strings.NewReader(query))
+		return err
+	})
+	return count, err
+}
+
+// isClusterFailure returns true, if the error is caused by the cluster and another cluster might succeed.
+func isClusterFailure(ctx context.Context, err error) bool {
+	if err == nil || ctx.Err() != nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, domain.ErrNotFound) || isCallbackError(err) {
+		return false
+	}
+	var resErr *responseError
+	if errors.As(err, &resErr) {
+		return resErr.statusCode >= http.StatusInternalServerError || resErr.statusCode == http.StatusTooManyRequests
+	}
+	return true
+}
+
+// callbackError is an error of a callback that processes the results (for example writing an export to a client that
+// disconnected). The data store requests succeeded, so it does not count against the cluster.
+type callbackError struct {
+	err error
+}
+
+func (e *callbackError) Error() string {
+	return e.err.Error()
+}
+
+func (e *callbackError) Unwrap() error {
+	return e.err
+}
+
+func isCallbackError(err error) bool {
+	var cbErr *callbackError
+	return errors.As(err, &cbErr)
+}
+
+func requestResult(err error, clusterFailure bool) string {
+	switch {
+	case clusterFailure:
+		return resultFailed
+	case err == nil || errors.Is(err, domain.ErrNotFound) || isCallbackError(err):
+		return resultSuccess
+	default:
+		return resultError
+	}
+}
+
+// ClusterMetrics reports the cluster that served the requests and the state of the clusters. Nil metrics are ignored.
+type ClusterMetrics struct {
+	requests    *prometheus.CounterVec
+	indexedTick *prometheus.GaugeVec
+	available   *prometheus.GaugeVec
+}
+
+func NewClusterMetrics(namespace string, registerer prometheus.Registerer) *ClusterMetrics {
+	m := &ClusterMetrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name:        "elastic_cluster_requests_total",
+			ConstLabels: prometheus.Labels{"namespace": namespace},
+			Help:        "Number of elasticsearch requests by repository, cluster and result (success, error, failed).",
+		}, []string{"repository", "cluster", "result"}),
+		indexedTick: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name:        "elastic_cluster_indexed_tick",
+			ConstLabels: prometheus.Labels{"namespace": namespace},
+			Help:        "Highest indexed tick of the elasticsearch cluster.",
+		}, []string{"repository", "cluster"}),
+		available: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name:        "elastic_cluster_available",
+			ConstLabels: prometheus.Labels{"namespace": namespace},
+			Help:        "Availability of the elasticsearch cluster for reads (1 available, 0 failed or lagging).",
+		}, []string{"repository", "cluster"}),
+	}
+	registerer.MustRegister(m.requests, m.indexedTick, m.available)
+	return m
+}
+
+func (m *ClusterMetrics) countRequest(repository, cluster, result string) {
+	if m == nil {
+		return
+	}
+	m.requests.WithLabelValues(repository, cluster, result).Inc()
+}
+
+func (m *ClusterMetrics) setIndexedTick(repository, cluster string, tick uint32) {
+	if m == nil {
+		return
+	}
+	m.indexedTick.WithLabelValues(repository, cluster).Set(float64(tick))
+}
+
+func (m *ClusterMetrics) setAvailable(repository, cluster string, available bool) {
+	if m == nil {
+		return
+	}
+	m.available.WithLabelValues(repository, cluster).Set(If(available, 1.0, 0.0))
+}
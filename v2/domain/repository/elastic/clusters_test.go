@@ -0,0 +1,164 @@
+package elastic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testCluster is a fake cluster that answers searches with the configured status and max tick.
+type testCluster struct {
+	status   atomic.Int32
+	maxTick  atomic.Uint32
+	searches atomic.Int32
+}
+
+func newTestCluster(t *testing.T, name string, maxTick uint32) (*testCluster, Cluster) {
+	tc := &testCluster{}
+	tc.status.Store(http.StatusOK)
+	tc.maxTick.Store(maxTick)
+	client := newTestESClient(t, func(w http.ResponseWriter, r *http.Request) {
+		status := int(tc.status.Load())
+		w.WriteHeader(status)
+		if status != http.StatusOK {
+			_, _ = w.Write([]byte(`{"error":"failed"}`))
+			return
+		}
+		if strings.Contains(r.URL.Path, "tick-data") {
+			_, _ = fmt.Fprintf(w, `{"hits":{"hits":[]},"aggregations":{"max_tick":{"value":%d}}}`, tc.maxTick.Load())
+			return
+		}
+		tc.searches.Add(1)
+		_, _ = fmt.Fprintf(w, `{"hits":{"total":{"value":1,"relation":"eq"},"hits":[{"_source":{"computors":{"epoch":%d}}}]}}`, tc.maxTick.Load())
+	})
+	return tc, Cluster{Name: name, Client: client}
+}
+
+func newTestClusters(t *testing.T, maxTickLag uint32) (*Clusters, *testCluster, *testCluster, *ClusterMetrics) {
+	first, firstCluster := newTestCluster(t, "first", 100)
+	second, secondCluster := newTestCluster(t, "second", 100)
+	metrics := NewClusterMetrics("test", prometheus.NewRegistry())
+	clusters, err := NewClusters("archive", []Cluster{firstCluster, secondCluster}, ClusterOptions{
+		TickIndex:      "tick-data",
+		MaxTickLag:     maxTickLag,
+		FailureBackoff: time.Minute,
+	}, metrics)
+	require.NoError(t, err)
+	return clusters, first, second, metrics
+}
+
+func requestCount(metrics *ClusterMetrics, cluster, result string) int {
+	return int(testutil.ToFloat64(metrics.requests.WithLabelValues("archive", cluster, result)))
+}
+
+func TestClusters_GivenAvailableClusters_ThenUsePreferred(t *testing.T) {
+	clusters, first, second, metrics := newTestClusters(t, 0)
+
+	var result computorsListSearchResponse
+	require.NoError(t, clusters.search(context.Background(), "computors", "{}", &result))
+	assert.Equal(t, int32(1), first.searches.Load())
+	assert.Equal(t, int32(0), second.searches.Load())
+	assert.Equal(t, 1, requestCount(metrics, "first", resultSuccess))
+}
+
+func TestClusters_GivenFailingCluster_ThenFailOver(t *testing.T) {
+	clusters, first, second, metrics := newTestClusters(t, 0)
+	first.status.Store(http.StatusInternalServerError)
+
+	var result computorsListSearchResponse
+	require.NoError(t, clusters.search(context.Background(), "computors", "{}", &result))
+	assert.Equal(t, 1, result.Hits.Total.Value)
+	assert.Equal(t, int32(1), second.searches.Load())
+	assert.Equal(t, 1, requestCount(metrics, "first", resultFailed))
+	assert.Equal(t, 1, requestCount(metrics, "second", resultSuccess))
+
+	// the failed cluster is skipped during the backoff
+	first.status.Store(http.StatusOK)
+	require.NoError(t, clusters.search(context.Background(), "computors", "{}", &result))
+	assert.Equal(t, int32(0), first.searches.Load())
+	assert.Equal(t, int32(2), second.searches.Load())
+	assert.InDelta(t, 0, testutil.ToFloat64(metrics.available.WithLabelValues("archive", "first")), 0)
+
+	// a successful health check makes it available again
+	clusters.checkHealth(context.Background())
+	require.NoError(t, clusters.search(context.Background(), "computors", "{}", &result))
+	assert.Equal(t, int32(1), first.searches.Load())
+}
+
+func TestClusters_GivenClientError_ThenNoFailOver(t *testing.T) {
+	clusters, first, second, metrics := newTestClusters(t, 0)
+	first.status.Store(http.StatusBadRequest)
+
+	var result computorsListSearchResponse
+	err := clusters.search(context.Background(), "computors", "{}", &result)
+	require.ErrorContains(t, err, "error response from data store")
+	assert.Equal(t, int32(0), second.searches.Load())
+	assert.Equal(t, 1, requestCount(metrics, "first", resultError))
+}
+
+func TestClusters_GivenCallbackError_ThenNoClusterFailure(t *testing.T) {
+	clusters, first, second, metrics := newTestClusters(t, 0)
+
+	writeErr := errors.New("broken pipe")
+	err := clusters.preferred(context.Background(), func(*elasticsearch.Client) error {
+		return fmt.Errorf("exporting: %w", &callbackError{err: writeErr})
+	})
+	require.ErrorIs(t, err, writeErr)
+	assert.Equal(t, 1, requestCount(metrics, "first", resultSuccess))
+	assert.Equal(t, 0, requestCount(metrics, "first", resultFailed))
+
+	var result computorsListSearchResponse
+	require.NoError(t, clusters.search(context.Background(), "computors", "{}", &result))
+	assert.Equal(t, int32(1), first.searches.Load(), "cluster is still preferred")
+	assert.Equal(t, int32(0), second.searches.Load())
+}
+
+func TestClusters_GivenAllClustersFail_ThenError(t *testing.T) {
+	clusters, first, second, _ := newTestClusters(t, 0)
+	first.status.Store(http.StatusServiceUnavailable)
+	second.status.Store(http.StatusInternalServerError)
+
+	var result computorsListSearchResponse
+	err := clusters.search(context.Background(), "computors", "{}", &result)
+	require.ErrorContains(t, err, "500")
+
+	// unavailable clusters are used as last resort
+	second.status.Store(http.StatusOK)
+	require.NoError(t, clusters.search(context.Background(), "computors", "{}", &result))
+}
+
+func TestClusters_GivenLaggingCluster_ThenUseMostRecent(t *testing.T) {
+	clusters, first, second, metrics := newTestClusters(t, 10)
+	first.maxTick.Store(89)
+
+	clusters.checkHealth(context.Background())
+	var result computorsListSearchResponse
+	require.NoError(t, clusters.search(context.Background(), "computors", "{}", &result))
+	assert.Equal(t, int32(0), first.searches.Load())
+	assert.Equal(t, int32(1), second.searches.Load())
+	assert.InDelta(t, 89, testutil.ToFloat64(metrics.indexedTick.WithLabelValues("archive", "first")), 0)
+
+	first.maxTick.Store(90)
+	clusters.checkHealth(context.Background())
+	require.NoError(t, clusters.search(context.Background(), "computors", "{}", &result))
+	assert.Equal(t, int32(1), first.searches.Load())
+}
+
+func TestNewClusters_GivenInvalidClusters_ThenError(t *testing.T) {
+	_, cluster := newTestCluster(t, "first", 1)
+	_, err := NewClusters("archive", nil, ClusterOptions{}, nil)
+	require.Error(t, err)
+	_, err = NewClusters("archive", []Cluster{cluster, cluster}, ClusterOptions{}, nil)
+	require.ErrorContains(t, err, "duplicate cluster [first]")
+}
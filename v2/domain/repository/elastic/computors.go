@@ -21,6 +21,7 @@ type computorsListHit struct {
 }
 
 type computorsListSearchResponse struct {
+	searchResult
 	Hits struct {
 		Total struct {
 			Value    int    `json:"value"`
@@ -37,7 +38,7 @@ func (r *ArchiveRepository) GetComputorsListsForEpoch(ctx context.Context, epoch
 	}
 
 	var result computorsListSearchResponse
-	err = performElasticSearch(ctx, r.esClient, r.clIndex, &query, &result)
+	err = r.clusters.search(ctx, r.clIndex, query.String(), &result)
 	if err != nil {
 		return nil, fmt.Errorf("performing elasting search: %w", err)
 	}
@@ -65,3 +66,82 @@ func createComputorsListQuery(epoch uint32) (bytes.Buffer, error) {
 	}
 	return buf, nil
 }
+
+// maximum number of computor lists returned by one query. There are only a few lists per epoch.
+const maxComputorsLists = 1000
+
+// GetComputorsListsForIdentity Returns all computor lists that contain the identity sorted by tick number descending.
+func (r *ArchiveRepository) GetComputorsListsForIdentity(ctx context.Context, identity string) ([]*api.ComputorList, error) {
+	query, err := createComputorsListForIdentityQuery(identity)
+	if err != nil {
+		return nil, fmt.Errorf("creating query %w", err)
+	}
+
+	var result computorsListSearchResponse
+	err = r.clusters.search(ctx, r.clIndex, query.String(), &result)
+	if err != nil {
+		return nil, fmt.Errorf("performing elasting search: %w", err)
+	}
+
+	return computorsListHitsToAPIObjects(result.Hits.Hits), nil
+}
+
+// GetComputorsListsForEpochRange Returns all computor lists of the epoch range (inclusive) sorted by tick number
+// ascending.
+func (r *ArchiveRepository) GetComputorsListsForEpochRange(ctx context.Context, fromEpoch, toEpoch uint32) ([]*api.ComputorList, error) {
+	query, err := createComputorsListForEpochRangeQuery(fromEpoch, toEpoch)
+	if err != nil {
+		return nil, fmt.Errorf("creating query %w", err)
+	}
+
+	var result computorsListSearchResponse
+	err = r.clusters.search(ctx, r.clIndex, query.String(), &result)
+	if err != nil {
+		return nil, fmt.Errorf("performing elasting search: %w", err)
+	}
+
+	return computorsListHitsToAPIObjects(result.Hits.Hits), nil
+}
+
+func createComputorsListForIdentityQuery(identity string) (bytes.Buffer, error) {
+	query := map[string]interface{}{
+		"query": map[string]interface{}{
+			"term": map[string]interface{}{
+				"identities": identity,
+			},
+		},
+		"sort": map[string]interface{}{
+			"tickNumber": "desc",
+		},
+		"size": maxComputorsLists,
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(query); err != nil {
+		return bytes.Buffer{}, fmt.Errorf("encoding query: %w", err)
+	}
+	return buf, nil
+}
+
+func createComputorsListForEpochRangeQuery(fromEpoch, toEpoch uint32) (bytes.Buffer, error) {
+	query := map[string]interface{}{
+		"query": map[string]interface{}{
+			"range": map[string]interface{}{
+				"epoch": map[string]interface{}{
+					"gte": fromEpoch,
+					"lte": toEpoch,
+				},
+			},
+		},
+		"sort": map[string]interface{}{
+			"tickNumber": "asc",
+		},
+		"size": maxComputorsLists,
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(query); err != nil {
+		return bytes.Buffer{}, fmt.Errorf("encoding query: %w", err)
+	}
+	return buf, nil
+}
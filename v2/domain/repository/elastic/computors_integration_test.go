@@ -140,3 +140,19 @@ func (s *computorsSuite) Test_GetEpochComputorsList() {
 	assert.Equal(s.T(), []*api.ComputorList{expected}, cl, cmpopts.IgnoreUnexported(api.ComputorList{}))
 
 }
+
+func (s *computorsSuite) Test_GetComputorsListsForIdentity() {
+	cl, err := s.repo.GetComputorsListsForIdentity(s.ctx, testComputorList1.Identities[1])
+	require.NoError(s.T(), err, "getting computors lists for identity")
+	assert.Equal(s.T(), []*api.ComputorList{computorsListToAPIObject(testComputorList1)}, cl)
+
+	cl, err = s.repo.GetComputorsListsForIdentity(s.ctx, "BZBQFLLBNCXEMGLOBHUVFTLUPLVCPQUASSILFABOFFBCADQSSUPNWLZBQEXK")
+	require.NoError(s.T(), err, "getting computors lists for unknown identity")
+	assert.Empty(s.T(), cl)
+}
+
+func (s *computorsSuite) Test_GetComputorsListsForEpochRange() {
+	cl, err := s.repo.GetComputorsListsForEpochRange(s.ctx, testComputorList1.Epoch-1, testComputorList1.Epoch)
+	require.NoError(s.T(), err, "getting computors lists for epoch range")
+	assert.Equal(s.T(), []*api.ComputorList{computorsListToAPIObject(testComputorList1)}, cl)
+}
@@ -28,3 +28,39 @@ func TestComputorsListElasticRepository_createComputorsListQuery(t *testing.T) {
 
 	require.JSONEq(t, expectedQuery, query.String())
 }
+
+func TestComputorsListElasticRepository_createComputorsListForIdentityQuery(t *testing.T) {
+	expectedQuery := `{
+		"query": {
+			"term": {
+				"identities": "BZBQFLLBNCXEMGLOBHUVFTLUPLVCPQUASSILFABOFFBCADQSSUPNWLZBQEXK"
+			}
+		},
+		"sort": {
+			"tickNumber": "desc"
+		},
+		"size": 1000
+	}`
+
+	query, err := createComputorsListForIdentityQuery("BZBQFLLBNCXEMGLOBHUVFTLUPLVCPQUASSILFABOFFBCADQSSUPNWLZBQEXK")
+	require.NoError(t, err)
+	require.JSONEq(t, expectedQuery, query.String())
+}
+
+func TestComputorsListElasticRepository_createComputorsListForEpochRangeQuery(t *testing.T) {
+	expectedQuery := `{
+		"query": {
+			"range": {
+				"epoch": { "gte": 100, "lte": 105 }
+			}
+		},
+		"sort": {
+			"tickNumber": "asc"
+		},
+		"size": 1000
+	}`
+
+	query, err := createComputorsListForEpochRangeQuery(100, 105)
+	require.NoError(t, err)
+	require.JSONEq(t, expectedQuery, query.String())
+}
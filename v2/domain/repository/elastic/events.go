@@ -7,18 +7,27 @@ import (
 
 	"github.com/elastic/go-elasticsearch/v8"
 	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	"github.com/qubic/archive-query-service/v2/domain"
 	"github.com/qubic/archive-query-service/v2/entities"
 )
 
+const burningEventLogType = 8
+
 type EventsRepository struct {
-	esClient   *elasticsearch.Client
+	clusters   *Clusters
 	eventIndex string
 }
 
 func NewEventsRepository(eventIndex string, esClient *elasticsearch.Client) *EventsRepository {
+	return NewClusteredEventsRepository(eventIndex, newSingleCluster("events", esClient))
+}
+
+// NewClusteredEventsRepository creates a repository that reads from the preferred cluster and fails over to the other
+// clusters.
+func NewClusteredEventsRepository(eventIndex string, clusters *Clusters) *EventsRepository {
 	return &EventsRepository{
 		eventIndex: eventIndex,
-		esClient:   esClient,
+		clusters:   clusters,
 	}
 }
 
@@ -57,6 +66,7 @@ type eventHit struct {
 }
 
 type eventsSearchResponse struct {
+	searchResult
 	Hits struct {
 		Total struct {
 			Value    int    `json:"value"`
@@ -73,7 +83,7 @@ func (r *EventsRepository) GetEvents(ctx context.Context, filters entities.Filte
 	}
 
 	var result eventsSearchResponse
-	err = performElasticSearch(ctx, r.esClient, r.eventIndex, strings.NewReader(query), &result)
+	err = r.clusters.search(ctx, r.eventIndex, query, &result)
 	if err != nil {
 		return nil, nil, fmt.Errorf("performing elastic search: %w", err)
 	}
@@ -87,6 +97,24 @@ func (r *EventsRepository) GetEvents(ctx context.Context, filters entities.Filte
 }
 
 func createEventsQuery(filters entities.Filters, from, size, maxTick uint32) (string, error) {
+	boolQuery, err := createEventsBoolQuery(filters, maxTick)
+	if err != nil {
+		return "", err
+	}
+
+	query := fmt.Sprintf(`{
+		"query": %s,
+		"sort": [{"tickNumber":{"order":"%s"}},{"logId":{"order":"asc"}}],
+		"from": %d,
+		"size": %d,
+		"track_total_hits": %d
+	}`, boolQuery, If(filters.Ascending, "asc", "desc"), from, size, maxTrackTotalHits)
+	// log.Printf("[DEBUG] %s", query)
+	return query, nil
+}
+
+// createEventsBoolQuery creates the bool query that matches the filtered events up to max tick.
+func createEventsBoolQuery(filters entities.Filters, maxTick uint32) (string, error) {
 	// Clamp upper bound tickNumber range to maxTick (reuses transaction logic)
 	hasUpperBoundTickFilter, err := modifyUpperBoundTickNumberFilterIfNecessary(filters.Ranges, maxTick)
 	if err != nil {
@@ -137,15 +165,227 @@ func createEventsQuery(filters entities.Filters, from, size, maxTick uint32) (st
 		boolClause = append(boolClause, mustNotClause)
 	}
 
-	query := fmt.Sprintf(`{
+	return fmt.Sprintf(`{"bool": {%s}}`, strings.Join(boolClause, ",")), nil
+}
+
+// GetEvent returns the event with the given log id in the given epoch or domain.ErrNotFound if there is none up to maxTick.
+func (r *EventsRepository) GetEvent(ctx context.Context, epoch uint32, logID uint64, maxTick uint32) (*api.Event, error) {
+	query := createEventQuery(epoch, logID, maxTick)
+
+	var result eventsSearchResponse
+	err := r.clusters.search(ctx, r.eventIndex, query, &result)
+	if err != nil {
+		return nil, fmt.Errorf("performing elastic search: %w", err)
+	}
+
+	if len(result.Hits.Hits) == 0 {
+		return nil, domain.ErrNotFound
+	}
+
+	return eventToAPIEvent(result.Hits.Hits[0].Source), nil
+}
+
+// GetEventsForTransaction returns up to size events of one transaction ordered by log id. Only events with a log id
+// greater than afterLogID are returned, if afterLogID is set.
+func (r *EventsRepository) GetEventsForTransaction(ctx context.Context, hash string, afterLogID *uint64, size, maxTick uint32) ([]*api.Event, error) {
+	query := createTransactionEventsQuery(hash, afterLogID, size, maxTick)
+
+	var result eventsSearchResponse
+	err := r.clusters.search(ctx, r.eventIndex, query, &result)
+	if err != nil {
+		return nil, fmt.Errorf("performing elastic search: %w", err)
+	}
+
+	return eventHitsToAPIEvents(result.Hits.Hits), nil
+}
+
+func createEventQuery(epoch uint32, logID uint64, maxTick uint32) string {
+	return fmt.Sprintf(`{
 		"query": {
-			"bool": {%s}
+			"bool": {
+				"filter": [
+					{"term":{"epoch":"%d"}},
+					{"term":{"logId":"%d"}},
+					{"range":{"tickNumber":{"lte":"%d"}}}
+				]
+			}
 		},
-		"sort": [{"tickNumber":{"order":"desc"}},{"logId":{"order":"asc"}}],
-		"from": %d,
+		"size": 1
+	}`, epoch, logID, maxTick)
+}
+
+func createTransactionEventsQuery(hash string, afterLogID *uint64, size, maxTick uint32) string {
+	// search after allows paging without the max result window limitation
+	searchAfter := ""
+	if afterLogID != nil {
+		searchAfter = fmt.Sprintf(`"search_after": [%d],`, *afterLogID)
+	}
+
+	return fmt.Sprintf(`{
+		"query": {
+			"bool": {
+				"filter": [
+					{"term":{"transactionHash":"%s"}},
+					{"range":{"tickNumber":{"lte":"%d"}}}
+				]
+			}
+		},
+		"sort": [{"logId":{"order":"asc"}}],
+		%s
 		"size": %d,
-		"track_total_hits": %d
-	}`, strings.Join(boolClause, ","), from, size, maxTrackTotalHits)
-	// log.Printf("[DEBUG] %s", query)
-	return query, nil
+		"track_total_hits": false
+	}`, hash, maxTick, searchAfter, size)
+}
+
+type eventStatsSearchResponse struct {
+	searchResult
+	Aggregations struct {
+		LogTypes struct {
+			Buckets []struct {
+				Key      uint32 `json:"key"`
+				DocCount uint64 `json:"doc_count"`
+			} `json:"buckets"`
+		} `json:"log_types"`
+		Burning struct {
+			Amount aggregationValue `json:"amount"`
+		} `json:"burning"`
+	} `json:"aggregations"`
+}
+
+// GetEventStats Returns the number of events per log type and the sum of the burned amounts of the epoch up to the
+// max tick (inclusive).
+func (r *EventsRepository) GetEventStats(ctx context.Context, epoch, maxTick uint32) (*entities.EventStats, error) {
+	query := createEventStatsQuery(epoch, maxTick)
+
+	var result eventStatsSearchResponse
+	err := r.clusters.search(ctx, r.eventIndex, query, &result)
+	if err != nil {
+		return nil, fmt.Errorf("performing elastic search: %w", err)
+	}
+
+	counts := make(map[uint32]uint64, len(result.Aggregations.LogTypes.Buckets))
+	for _, bucket := range result.Aggregations.LogTypes.Buckets {
+		counts[bucket.Key] = bucket.DocCount
+	}
+	return &entities.EventStats{
+		CountsByLogType: counts,
+		BurnedAmount:    uint64(result.Aggregations.Burning.Amount.Value),
+	}, nil
+}
+
+func createEventStatsQuery(epoch, maxTick uint32) string {
+	return fmt.Sprintf(`{
+		"query": {
+			"bool": {
+				"filter": [
+					{"term":{"epoch":"%d"}},
+					{"range":{"tickNumber":{"lte":"%d"}}}
+				]
+			}
+		},
+		"aggs": {
+			"log_types": { "terms": { "field": "logType", "size": 256 } },
+			"burning": {
+				"filter": { "term": { "logType": "%d" } },
+				"aggs": { "amount": { "sum": { "field": "amount" } } }
+			}
+		},
+		"size": 0,
+		"track_total_hits": false
+	}`, epoch, maxTick, burningEventLogType)
+}
+
+// maximum number of assets returned in the identity event stats
+const maxIdentityAssets = 100
+
+type identityEventStatsSearchResponse struct {
+	searchResult
+	Aggregations struct {
+		Received struct {
+			DocCount uint64        `json:"doc_count"`
+			Amount   exactSumValue `json:"amount"`
+		} `json:"received"`
+		Sent struct {
+			DocCount uint64        `json:"doc_count"`
+			Amount   exactSumValue `json:"amount"`
+		} `json:"sent"`
+		Assets struct {
+			Assets struct {
+				Buckets []struct {
+					Key []string `json:"key"` // issuer and name
+				} `json:"buckets"`
+			} `json:"assets"`
+		} `json:"assets"`
+	} `json:"aggregations"`
+}
+
+// GetIdentityEventStats Returns the QU transfer totals and the assets of the asset events of the identity up to the
+// max tick (inclusive).
+func (r *EventsRepository) GetIdentityEventStats(ctx context.Context, identity string, maxTick uint32) (*entities.IdentityEventStats, error) {
+	query := createIdentityEventStatsQuery(identity, maxTick)
+
+	var result identityEventStatsSearchResponse
+	err := r.clusters.search(ctx, r.eventIndex, query, &result)
+	if err != nil {
+		return nil, fmt.Errorf("performing elastic search: %w", err)
+	}
+
+	aggs := result.Aggregations
+	assets := make([]*api.IdentityAsset, 0, len(aggs.Assets.Assets.Buckets))
+	for _, bucket := range aggs.Assets.Assets.Buckets {
+		if len(bucket.Key) == 2 {
+			assets = append(assets, &api.IdentityAsset{AssetIssuer: bucket.Key[0], AssetName: bucket.Key[1]})
+		}
+	}
+	return &entities.IdentityEventStats{
+		ReceivedCount:  aggs.Received.DocCount,
+		ReceivedAmount: aggs.Received.Amount.Value,
+		SentCount:      aggs.Sent.DocCount,
+		SentAmount:     aggs.Sent.Amount.Value,
+		Assets:         assets,
+	}, nil
+}
+
+func createIdentityEventStatsQuery(identity string, maxTick uint32) string {
+	// asset issuance, ownership change, possession change and managing contract change events
+	return fmt.Sprintf(`{
+		"query": {
+			"bool": {
+				"should": [
+					{"term":{"source":"%[1]s"}},
+					{"term":{"destination":"%[1]s"}},
+					{"term":{"assetIssuer":"%[1]s"}},
+					{"term":{"owner":"%[1]s"}},
+					{"term":{"possessor":"%[1]s"}}
+				],
+				"minimum_should_match": 1,
+				"filter": [
+					{"range":{"tickNumber":{"lte":"%[2]d"}}}
+				]
+			}
+		},
+		"aggs": {
+			"received": {
+				"filter": { "bool": { "filter": [ {"term":{"logType":"0"}}, {"term":{"destination":"%[1]s"}} ] } },
+				"aggs": { "amount": %[4]s }
+			},
+			"sent": {
+				"filter": { "bool": { "filter": [ {"term":{"logType":"0"}}, {"term":{"source":"%[1]s"}} ] } },
+				"aggs": { "amount": %[4]s }
+			},
+			"assets": {
+				"filter": { "terms": { "logType": ["1", "2", "3", "11", "12"] } },
+				"aggs": {
+					"assets": {
+						"multi_terms": {
+							"terms": [ { "field": "assetIssuer" }, { "field": "assetName" } ],
+							"size": %[3]d
+						}
+					}
+				}
+			}
+		},
+		"size": 0,
+		"track_total_hits": false
+	}`, identity, maxTick, maxIdentityAssets, exactSumAggregation("amount"))
 }
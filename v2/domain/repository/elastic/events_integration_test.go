@@ -10,6 +10,7 @@ import (
 
 	"github.com/elastic/go-elasticsearch/v8"
 	"github.com/google/go-cmp/cmp"
+	"github.com/qubic/archive-query-service/v2/domain"
 	"github.com/qubic/archive-query-service/v2/entities"
 	"github.com/qubic/archive-query-service/v2/test"
 	"github.com/stretchr/testify/assert"
@@ -364,3 +365,36 @@ func (s *eventsSuite) Test_GetEvents_WithShouldFilter() {
 	assert.Equal(s.T(), 1, int(events[1].GetLogId()))
 	assert.Equal(s.T(), 4, int(events[0].GetLogId()))
 }
+
+func (s *eventsSuite) Test_GetEvent() {
+	event, err := s.repo.GetEvent(s.ctx, 101, 4, 999999)
+	require.NoError(s.T(), err, "getting event")
+
+	expected := eventToAPIEvent(testEvent4)
+	diff := cmp.Diff(expected, event, protocmp.Transform())
+	assert.Empty(s.T(), diff, "event should match. diff: %s", diff)
+}
+
+func (s *eventsSuite) Test_GetEvent_GivenOtherEpoch_ThenNotFound() {
+	_, err := s.repo.GetEvent(s.ctx, 100, 4, 999999)
+	require.ErrorIs(s.T(), err, domain.ErrNotFound)
+}
+
+func (s *eventsSuite) Test_GetEvent_GivenTickNotProcessed_ThenNotFound() {
+	_, err := s.repo.GetEvent(s.ctx, 101, 4, 15999)
+	require.ErrorIs(s.T(), err, domain.ErrNotFound)
+}
+
+func (s *eventsSuite) Test_GetEventsForTransaction() {
+	events, err := s.repo.GetEventsForTransaction(s.ctx, "txhash1", nil, 10, 999999)
+	require.NoError(s.T(), err, "getting events for transaction")
+	require.Len(s.T(), events, 2)
+	assert.Equal(s.T(), 1, int(events[0].GetLogId()))
+	assert.Equal(s.T(), 3, int(events[1].GetLogId()))
+
+	afterLogID := uint64(1)
+	events, err = s.repo.GetEventsForTransaction(s.ctx, "txhash1", &afterLogID, 10, 999999)
+	require.NoError(s.T(), err, "getting events for transaction after log id")
+	require.Len(s.T(), events, 1)
+	assert.Equal(s.T(), 3, int(events[0].GetLogId()))
+}
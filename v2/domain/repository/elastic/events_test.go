@@ -45,6 +45,19 @@ func Test_createEventsQuery_noFilters(t *testing.T) {
 	require.Contains(t, logIdOrder, "asc")
 }
 
+func Test_createEventsQuery_GivenAscending_ThenSortedByTickNumberAscending(t *testing.T) {
+	query, err := createEventsQuery(entities.Filters{Ascending: true}, 0, 10, 999999)
+	require.NoError(t, err)
+
+	var parsed map[string]any
+	err = json.Unmarshal([]byte(query), &parsed)
+	require.NoError(t, err, "query should be valid JSON")
+
+	sort := parsed["sort"].([]any)
+	assert.Equal(t, "asc", sort[0].(map[string]any)["tickNumber"].(map[string]any)["order"])
+	assert.Equal(t, "asc", sort[1].(map[string]any)["logId"].(map[string]any)["order"])
+}
+
 func Test_createEventsQuery_withTransactionHash(t *testing.T) {
 	filters := map[string][]string{
 		"transactionHash": {"abc123"},
@@ -395,3 +408,95 @@ func Test_createEventsQuery_tickCap_onlyLowerBound(t *testing.T) {
 	tickRange := rangeFilter["tickNumber"].(map[string]any)
 	assert.Equal(t, "1000", tickRange["gte"])
 }
+
+func Test_createEventQuery(t *testing.T) {
+	query := createEventQuery(100, 42, 999999)
+
+	var parsed map[string]any
+	err := json.Unmarshal([]byte(query), &parsed)
+	require.NoError(t, err, "query should be valid JSON")
+
+	filterArr := parsed["query"].(map[string]any)["bool"].(map[string]any)["filter"].([]any)
+	require.Len(t, filterArr, 3)
+	assert.Equal(t, "100", filterArr[0].(map[string]any)["term"].(map[string]any)["epoch"])
+	assert.Equal(t, "42", filterArr[1].(map[string]any)["term"].(map[string]any)["logId"])
+	tickRange := filterArr[2].(map[string]any)["range"].(map[string]any)["tickNumber"].(map[string]any)
+	assert.Equal(t, "999999", tickRange["lte"])
+	assert.Equal(t, float64(1), parsed["size"])
+}
+
+func Test_createTransactionEventsQuery(t *testing.T) {
+	query := createTransactionEventsQuery("abc123", nil, 11, 999999)
+
+	var parsed map[string]any
+	err := json.Unmarshal([]byte(query), &parsed)
+	require.NoError(t, err, "query should be valid JSON")
+
+	filterArr := parsed["query"].(map[string]any)["bool"].(map[string]any)["filter"].([]any)
+	require.Len(t, filterArr, 2)
+	assert.Equal(t, "abc123", filterArr[0].(map[string]any)["term"].(map[string]any)["transactionHash"])
+	tickRange := filterArr[1].(map[string]any)["range"].(map[string]any)["tickNumber"].(map[string]any)
+	assert.Equal(t, "999999", tickRange["lte"])
+
+	sort := parsed["sort"].([]any)
+	require.Len(t, sort, 1)
+	assert.Equal(t, "asc", sort[0].(map[string]any)["logId"].(map[string]any)["order"])
+	assert.Equal(t, float64(11), parsed["size"])
+	assert.NotContains(t, parsed, "search_after")
+	assert.NotContains(t, parsed, "from")
+}
+
+func Test_createTransactionEventsQuery_withSearchAfter(t *testing.T) {
+	afterLogID := uint64(12345)
+	query := createTransactionEventsQuery("abc123", &afterLogID, 11, 999999)
+
+	var parsed map[string]any
+	err := json.Unmarshal([]byte(query), &parsed)
+	require.NoError(t, err, "query should be valid JSON")
+
+	searchAfter := parsed["search_after"].([]any)
+	require.Len(t, searchAfter, 1)
+	assert.Equal(t, float64(12345), searchAfter[0])
+}
+
+func Test_createEventStatsQuery(t *testing.T) {
+	query := createEventStatsQuery(190, 12345)
+
+	var parsed map[string]any
+	err := json.Unmarshal([]byte(query), &parsed)
+	require.NoError(t, err, "query should be valid JSON")
+
+	assert.Equal(t, float64(0), parsed["size"])
+	filterArr := parsed["query"].(map[string]any)["bool"].(map[string]any)["filter"].([]any)
+	require.Len(t, filterArr, 2)
+	assert.Equal(t, "190", filterArr[0].(map[string]any)["term"].(map[string]any)["epoch"])
+	assert.Equal(t, "12345", filterArr[1].(map[string]any)["range"].(map[string]any)["tickNumber"].(map[string]any)["lte"])
+
+	aggs := parsed["aggs"].(map[string]any)
+	assert.Equal(t, "logType", aggs["log_types"].(map[string]any)["terms"].(map[string]any)["field"])
+	burning := aggs["burning"].(map[string]any)
+	assert.Equal(t, "8", burning["filter"].(map[string]any)["term"].(map[string]any)["logType"])
+	assert.Equal(t, "amount", burning["aggs"].(map[string]any)["amount"].(map[string]any)["sum"].(map[string]any)["field"])
+}
+
+func Test_createIdentityEventStatsQuery(t *testing.T) {
+	query := createIdentityEventStatsQuery("BZBQFLLBNCXEMGLOBHUVFTLUPLVCPQUASSILFABOFFBCADQSSUPNWLZBQEXK", 12345)
+
+	var parsed map[string]any
+	err := json.Unmarshal([]byte(query), &parsed)
+	require.NoError(t, err, "query should be valid JSON")
+
+	boolQuery := parsed["query"].(map[string]any)["bool"].(map[string]any)
+	assert.Len(t, boolQuery["should"], 5)
+	assert.Equal(t, "12345", boolQuery["filter"].([]any)[0].(map[string]any)["range"].(map[string]any)["tickNumber"].(map[string]any)["lte"])
+
+	aggs := parsed["aggs"].(map[string]any)
+	receivedFilter := aggs["received"].(map[string]any)["filter"].(map[string]any)["bool"].(map[string]any)["filter"].([]any)
+	assert.Equal(t, "BZBQFLLBNCXEMGLOBHUVFTLUPLVCPQUASSILFABOFFBCADQSSUPNWLZBQEXK", receivedFilter[1].(map[string]any)["term"].(map[string]any)["destination"])
+	sentFilter := aggs["sent"].(map[string]any)["filter"].(map[string]any)["bool"].(map[string]any)["filter"].([]any)
+	assert.Equal(t, "BZBQFLLBNCXEMGLOBHUVFTLUPLVCPQUASSILFABOFFBCADQSSUPNWLZBQEXK", sentFilter[1].(map[string]any)["term"].(map[string]any)["source"])
+	assert.Contains(t, aggs["received"].(map[string]any)["aggs"].(map[string]any)["amount"], "scripted_metric", "exact sum")
+	assert.Contains(t, aggs["sent"].(map[string]any)["aggs"].(map[string]any)["amount"], "scripted_metric", "exact sum")
+	multiTerms := aggs["assets"].(map[string]any)["aggs"].(map[string]any)["assets"].(map[string]any)["multi_terms"].(map[string]any)
+	assert.Equal(t, float64(100), multiTerms["size"])
+}
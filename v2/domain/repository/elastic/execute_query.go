@@ -3,31 +3,135 @@ package elastic
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
+	"time"
 
 	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/elastic/go-elasticsearch/v8/esapi"
 )
 
-func performElasticSearch(ctx context.Context, esClient *elasticsearch.Client, index string, query io.Reader, result any) error {
-	res, err := esClient.Search(
+// responseError is an error response of the data store.
+type responseError struct {
+	statusCode int
+	message    string
+}
+
+func newResponseError(res *esapi.Response) *responseError {
+	return &responseError{statusCode: res.StatusCode, message: fmt.Sprintf("error response from data store: %s", res.String())}
+}
+
+func (e *responseError) Error() string {
+	return e.message
+}
+
+// searchResult contains the common fields of the search responses. It is embedded in all search response types.
+type searchResult struct {
+	TimedOut bool `json:"timed_out"`
+}
+
+func (r *searchResult) searchTimedOut() bool {
+	return r.TimedOut
+}
+
+// searchResponse is a search response type that embeds searchResult.
+type searchResponse interface {
+	searchTimedOut() bool
+}
+
+// performElasticSearch executes the search and decodes the response into the result. If the context has a deadline,
+// the remaining time is passed as search timeout, so that elastic stops searching when the request is abandoned.
+// Partial results of timed out searches are not returned.
+func performElasticSearch(ctx context.Context, esClient *elasticsearch.Client, index string, query io.Reader, result searchResponse) error {
+	opts := []func(*esapi.SearchRequest){
 		esClient.Search.WithContext(ctx),
 		esClient.Search.WithIndex(index),
 		esClient.Search.WithBody(query),
-	)
+	}
+	var searchTimeout time.Duration
+	if deadline, ok := ctx.Deadline(); ok {
+		searchTimeout = time.Until(deadline)
+		if searchTimeout <= 0 {
+			return fmt.Errorf("request deadline exceeded before search: %w", context.DeadlineExceeded)
+		}
+		opts = append(opts, esClient.Search.WithTimeout(searchTimeout))
+	}
+	res, err := esClient.Search(opts...)
 	if err != nil {
 		log.Printf("[DEBUG] calling es client search with query: %s", query)
+		if errors.Is(err, context.DeadlineExceeded) {
+			return fmt.Errorf("performing search: request deadline exceeded: %w", err)
+		}
 		return fmt.Errorf("performing search: %w", err)
 	}
 	defer res.Body.Close()
 	if res.IsError() {
-		return fmt.Errorf("error response from data store: %s", res.String())
+		return newResponseError(res)
 	}
 
-	if err = json.NewDecoder(res.Body).Decode(&result); err != nil {
+	if err = json.NewDecoder(res.Body).Decode(result); err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return fmt.Errorf("reading response: request deadline exceeded: %w", ctx.Err())
+		}
 		return fmt.Errorf("decoding response: %w", err)
 	}
+	if result.searchTimedOut() {
+		if searchTimeout > 0 {
+			return fmt.Errorf("elasticsearch search timeout [%s] exceeded: %w", searchTimeout.Round(time.Millisecond), context.DeadlineExceeded)
+		}
+		return fmt.Errorf("elasticsearch search timed out: %w", context.DeadlineExceeded)
+	}
 
 	return nil
 }
+
+// aggregationValue is the result of a single value metrics aggregation (min, max, sum, ...). The value is null, if
+// there are no documents.
+type aggregationValue struct {
+	Value float64 `json:"value"`
+}
+
+// exactSumValue is the result of an exact sum aggregation (see exactSumAggregation).
+type exactSumValue struct {
+	Value uint64 `json:"value"`
+}
+
+// exactSumAggregation creates a scripted metric aggregation that sums up the field as long values. The sum aggregation
+// of elastic uses double values and loses precision above 2^53.
+func exactSumAggregation(field string) string {
+	return fmt.Sprintf(`{ "scripted_metric": {
+		"init_script": "state.sum = 0L",
+		"map_script": "if (doc['%s'].size() > 0) { state.sum += doc['%s'].value }",
+		"combine_script": "return state.sum",
+		"reduce_script": "long sum = 0L; for (s in states) { if (s != null) { sum += s } } return sum"
+	} }`, field, field)
+}
+
+type countResponse struct {
+	Count uint64 `json:"count"`
+}
+
+// performElasticCount returns the exact number of documents matching the query. In contrast to the total hits of a
+// search the count is not limited.
+func performElasticCount(ctx context.Context, esClient *elasticsearch.Client, index string, query io.Reader) (uint64, error) {
+	res, err := esClient.Count(
+		esClient.Count.WithContext(ctx),
+		esClient.Count.WithIndex(index),
+		esClient.Count.WithBody(query),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("performing count: %w", err)
+	}
+	defer res.Body.Close()
+	if res.IsError() {
+		return 0, newResponseError(res)
+	}
+
+	var result countResponse
+	if err = json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return 0, fmt.Errorf("decoding response: %w", err)
+	}
+	return result.Count, nil
+}
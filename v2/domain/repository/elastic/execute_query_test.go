@@ -0,0 +1,83 @@
+package elastic
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestESClient(t *testing.T, handler http.HandlerFunc) *elasticsearch.Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Elastic-Product", "Elasticsearch")
+		w.Header().Set("Content-Type", "application/json")
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+	client, err := elasticsearch.NewClient(elasticsearch.Config{Addresses: []string{server.URL}})
+	require.NoError(t, err)
+	return client
+}
+
+func TestPerformElasticSearch_GivenDeadline_ThenSearchTimeout(t *testing.T) {
+	var timeout string
+	client := newTestESClient(t, func(w http.ResponseWriter, r *http.Request) {
+		timeout = r.URL.Query().Get("timeout")
+		_, _ = w.Write([]byte(`{"took":1,"timed_out":false,"hits":{"total":{"value":1,"relation":"eq"}}}`))
+	})
+
+	var result computorsListSearchResponse
+	require.NoError(t, performElasticSearch(context.Background(), client, "index", strings.NewReader("{}"), &result))
+	assert.Empty(t, timeout, "no deadline")
+	assert.Equal(t, 1, result.Hits.Total.Value)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	require.NoError(t, performElasticSearch(ctx, client, "index", strings.NewReader("{}"), &result))
+	assert.Regexp(t, `^[0-9]+ms$`, timeout)
+}
+
+func TestPerformElasticSearch_GivenTimedOutSearch_ThenDeadlineExceeded(t *testing.T) {
+	client := newTestESClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"took":1000,"timed_out":true,"hits":{"total":{"value":1,"relation":"eq"}}}`))
+	})
+
+	var result computorsListSearchResponse
+	err := performElasticSearch(context.Background(), client, "index", strings.NewReader("{}"), &result)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, "elasticsearch search timed out: context deadline exceeded", err.Error())
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	err = performElasticSearch(ctx, client, "index", strings.NewReader("{}"), &result)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Contains(t, err.Error(), "elasticsearch search timeout [", "server-side timeout")
+
+	ctx, cancel = context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+	err = performElasticSearch(ctx, client, "index", strings.NewReader("{}"), &result)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Contains(t, err.Error(), "request deadline exceeded", "client deadline")
+}
+
+func TestPerformElasticSearch_GivenSlowResponse_ThenRequestDeadlineExceeded(t *testing.T) {
+	client := newTestESClient(t, func(_ http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body) // the request context is only canceled after the body is read
+		<-r.Context().Done()
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	var result computorsListSearchResponse
+	err := performElasticSearch(ctx, client, "index", strings.NewReader("{}"), &result)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Contains(t, err.Error(), "request deadline exceeded")
+	assert.NotContains(t, err.Error(), "elasticsearch search timeout")
+}
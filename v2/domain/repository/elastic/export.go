@@ -0,0 +1,185 @@
+package elastic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v8"
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	"github.com/qubic/archive-query-service/v2/entities"
+)
+
+const (
+	exportBatchSize      = 1000
+	pointInTimeKeepAlive = "1m"
+)
+
+type pointInTimeHit[T any] struct {
+	Source T               `json:"_source"`
+	Sort   json.RawMessage `json:"sort"`
+}
+
+type pointInTimeSearchResponse[T any] struct {
+	PitID string `json:"pit_id"`
+	Hits  struct {
+		Hits []pointInTimeHit[T] `json:"hits"`
+	} `json:"hits"`
+}
+
+type openPointInTimeResponse struct {
+	ID string `json:"id"`
+}
+
+// ExportTransactionsForIdentity walks all transactions of the identity up to max tick (but not more than limit) and
+// passes them batch wise to the callback. The order is the same as for GetTransactionsForIdentity.
+func (r *ArchiveRepository) ExportTransactionsForIdentity(ctx context.Context, identity string, maxTick uint32, filters entities.Filters,
+	limit uint32, fn func([]*api.Transaction) error) error {
+
+	boolQuery, err := createIdentitiesBoolQuery(identity, filters, maxTick)
+	if err != nil {
+		return fmt.Errorf("creating transactions for identity query: %w", err)
+	}
+	sort := fmt.Sprintf(`{"tickNumber":{"order":"%s"}}`, If(filters.Ascending, "asc", "desc"))
+	return r.clusters.preferred(ctx, func(esClient *elasticsearch.Client) error {
+		return walkPointInTime(ctx, esClient, r.txIndex, boolQuery, sort, limit, transactionToAPITransaction, fn)
+	})
+}
+
+// ExportEvents walks all filtered events up to max tick (but not more than limit) and passes them batch wise to the
+// callback. The order is the same as for GetEvents.
+func (r *EventsRepository) ExportEvents(ctx context.Context, filters entities.Filters, maxTick, limit uint32, fn func([]*api.Event) error) error {
+	boolQuery, err := createEventsBoolQuery(filters, maxTick)
+	if err != nil {
+		return fmt.Errorf("creating events query: %w", err)
+	}
+	sort := `{"tickNumber":{"order":"desc"}},{"logId":{"order":"asc"}}`
+	return r.clusters.preferred(ctx, func(esClient *elasticsearch.Client) error {
+		return walkPointInTime(ctx, esClient, r.eventIndex, boolQuery, sort, limit, eventToAPIEvent, fn)
+	})
+}
+
+// walkPointInTime pages through all documents matching the query with a point in time and search after. Other than
+// from/size paging this is not limited to the first 10000 hits and the results are consistent across pages.
+func walkPointInTime[T, R any](ctx context.Context, esClient *elasticsearch.Client, index, query, sort string, limit uint32,
+	convert func(T) R, fn func([]R) error) error {
+
+	pitID, err := openPointInTime(ctx, esClient, index)
+	if err != nil {
+		return fmt.Errorf("opening point in time: %w", err)
+	}
+	defer func() {
+		// the request context might be cancelled already
+		if err := closePointInTime(context.Background(), esClient, pitID); err != nil {
+			log.Printf("[WARN] closing point in time: %v", err)
+		}
+	}()
+
+	var searchAfter json.RawMessage
+	for remaining := limit; remaining > 0; {
+		size := min(remaining, exportBatchSize)
+		var result pointInTimeSearchResponse[T]
+		err = performPointInTimeSearch(ctx, esClient, strings.NewReader(createPointInTimeQuery(query, sort, pitID, searchAfter, size)), &result)
+		if err != nil {
+			return fmt.Errorf("performing elastic search: %w", err)
+		}
+		if result.PitID != "" {
+			pitID = result.PitID // the id can change between requests
+		}
+
+		hits := result.Hits.Hits
+		if len(hits) == 0 {
+			return nil
+		}
+		converted := make([]R, 0, len(hits))
+		for _, hit := range hits {
+			converted = append(converted, convert(hit.Source))
+		}
+		if err = fn(converted); err != nil {
+			return &callbackError{err: err}
+		}
+
+		remaining -= uint32(len(hits)) //nolint: gosec
+		if len(hits) < int(size) {
+			return nil
+		}
+		searchAfter = hits[len(hits)-1].Sort
+	}
+	return nil
+}
+
+// createPointInTimeQuery creates a search request for one page. The implicit _shard_doc tiebreaker makes the sort
+// values unique, so that search after does not skip documents.
+func createPointInTimeQuery(query, sort, pitID string, searchAfter json.RawMessage, size uint32) string {
+	var searchAfterString string
+	if len(searchAfter) > 0 {
+		searchAfterString = fmt.Sprintf(`, "search_after": %s`, searchAfter)
+	}
+	return fmt.Sprintf(`{
+		"query": %s,
+		"sort": [%s,{"_shard_doc":"asc"}],
+		"size": %d,
+		"track_total_hits": false,
+		"pit": {"id": "%s", "keep_alive": "%s"}%s
+	}`, query, sort, size, pitID, pointInTimeKeepAlive, searchAfterString)
+}
+
+func openPointInTime(ctx context.Context, esClient *elasticsearch.Client, index string) (string, error) {
+	res, err := esClient.OpenPointInTime([]string{index}, pointInTimeKeepAlive,
+		esClient.OpenPointInTime.WithContext(ctx),
+	)
+	if err != nil {
+		return "", fmt.Errorf("calling es client open point in time: %w", err)
+	}
+	defer res.Body.Close()
+	if res.IsError() {
+		return "", newResponseError(res)
+	}
+
+	var result openPointInTimeResponse
+	if err = json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("decoding response: %w", err)
+	}
+	return result.ID, nil
+}
+
+func closePointInTime(ctx context.Context, esClient *elasticsearch.Client, pitID string) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	res, err := esClient.ClosePointInTime(
+		esClient.ClosePointInTime.WithContext(ctx),
+		esClient.ClosePointInTime.WithBody(strings.NewReader(fmt.Sprintf(`{"id":"%s"}`, pitID))),
+	)
+	if err != nil {
+		return fmt.Errorf("calling es client close point in time: %w", err)
+	}
+	defer res.Body.Close()
+	if res.IsError() {
+		return newResponseError(res)
+	}
+	return nil
+}
+
+// performPointInTimeSearch performs a search without index. The index is defined by the point in time.
+func performPointInTimeSearch(ctx context.Context, esClient *elasticsearch.Client, query io.Reader, result any) error {
+	res, err := esClient.Search(
+		esClient.Search.WithContext(ctx),
+		esClient.Search.WithBody(query),
+	)
+	if err != nil {
+		return fmt.Errorf("performing search: %w", err)
+	}
+	defer res.Body.Close()
+	if res.IsError() {
+		return newResponseError(res)
+	}
+
+	if err = json.NewDecoder(res.Body).Decode(result); err != nil {
+		return fmt.Errorf("decoding response: %w", err)
+	}
+	return nil
+}
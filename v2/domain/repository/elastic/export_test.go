@@ -0,0 +1,45 @@
+package elastic
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/qubic/archive-query-service/v2/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_createPointInTimeQuery(t *testing.T) {
+	query := createPointInTimeQuery(`{"bool":{"filter":[{"term":{"logType":0}}]}}`, `{"tickNumber":{"order":"desc"}}`, "pit-id", nil, 1000)
+
+	var parsed map[string]any
+	require.NoError(t, json.Unmarshal([]byte(query), &parsed), "query should be valid JSON")
+	assert.Equal(t, float64(1000), parsed["size"])
+	assert.Equal(t, false, parsed["track_total_hits"])
+	assert.Equal(t, map[string]any{"id": "pit-id", "keep_alive": pointInTimeKeepAlive}, parsed["pit"])
+	assert.Equal(t, []any{
+		map[string]any{"tickNumber": map[string]any{"order": "desc"}},
+		map[string]any{"_shard_doc": "asc"},
+	}, parsed["sort"])
+	assert.NotContains(t, parsed, "search_after")
+	assert.NotContains(t, parsed, "from")
+}
+
+func Test_createPointInTimeQuery_withSearchAfter(t *testing.T) {
+	query := createPointInTimeQuery(`{"bool":{}}`, `{"tickNumber":{"order":"asc"}}`, "pit-id", json.RawMessage(`[42,17]`), 10)
+
+	var parsed map[string]any
+	require.NoError(t, json.Unmarshal([]byte(query), &parsed), "query should be valid JSON")
+	assert.Equal(t, []any{float64(42), float64(17)}, parsed["search_after"])
+}
+
+func Test_createIdentitiesBoolQuery(t *testing.T) {
+	query, err := createIdentitiesBoolQuery(testIdentity, entities.Filters{Include: map[string][]string{"inputType": {"0"}}}, 100)
+	require.NoError(t, err)
+
+	var parsed map[string]any
+	require.NoError(t, json.Unmarshal([]byte(query), &parsed), "query should be valid JSON")
+	boolQuery := parsed["bool"].(map[string]any)
+	assert.Len(t, boolQuery["should"], 2)
+	assert.Len(t, boolQuery["filter"], 2)
+}
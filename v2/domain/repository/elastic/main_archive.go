@@ -7,7 +7,7 @@ import (
 )
 
 type ArchiveRepository struct {
-	esClient                     *elasticsearch.Client
+	clusters                     *Clusters
 	ConsecutiveElasticErrorCount atomic.Int32
 	TotalElasticErrorCount       atomic.Int32
 	txIndex                      string
@@ -16,10 +16,16 @@ type ArchiveRepository struct {
 }
 
 func NewArchiveRepository(txIndex, tickDataIndex, clIndex string, esClient *elasticsearch.Client) *ArchiveRepository {
+	return NewClusteredArchiveRepository(txIndex, tickDataIndex, clIndex, newSingleCluster("archive", esClient))
+}
+
+// NewClusteredArchiveRepository creates a repository that reads from the preferred cluster and fails over to the other
+// clusters.
+func NewClusteredArchiveRepository(txIndex, tickDataIndex, clIndex string, clusters *Clusters) *ArchiveRepository {
 	return &ArchiveRepository{
 		txIndex:       txIndex,
 		tickDataIndex: tickDataIndex,
-		esClient:      esClient,
+		clusters:      clusters,
 		clIndex:       clIndex,
 	}
 }
@@ -5,9 +5,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 
+	"github.com/elastic/go-elasticsearch/v8"
 	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
 	"github.com/qubic/archive-query-service/v2/domain"
+	"github.com/qubic/archive-query-service/v2/entities"
+	"github.com/qubic/go-node-connector/types"
 )
 
 type tickDataGetResponse struct {
@@ -33,25 +37,247 @@ type tickData struct {
 }
 
 // GetTickData Returns the tick data or domain.ErrNotFound if there is not tick data for this tick number.
-func (r *ArchiveRepository) GetTickData(_ context.Context, tickNumber uint32) (*api.TickData, error) {
-	res, err := r.esClient.Get(r.tickDataIndex, strconv.FormatUint(uint64(tickNumber), 10))
+func (r *ArchiveRepository) GetTickData(ctx context.Context, tickNumber uint32) (*api.TickData, error) {
+	var result tickDataGetResponse
+	err := r.clusters.do(ctx, func(esClient *elasticsearch.Client) error {
+		res, err := esClient.Get(r.tickDataIndex, strconv.FormatUint(uint64(tickNumber), 10))
+		if err != nil {
+			return fmt.Errorf("calling es client get: %w", err)
+		}
+		defer res.Body.Close()
+
+		if res.StatusCode == 404 {
+			return domain.ErrNotFound
+		}
+
+		if res.IsError() {
+			return newResponseError(res)
+		}
+
+		if err = json.NewDecoder(res.Body).Decode(&result); err != nil {
+			return fmt.Errorf("decoding response: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("calling es client get: %w", err)
+		return nil, err
 	}
-	defer res.Body.Close()
 
-	if res.StatusCode == 404 {
-		return nil, domain.ErrNotFound
+	return tickDataToAPITickData(result.Source), nil
+}
+
+type tickDataMultiGetResponse struct {
+	Docs []struct {
+		ID     string   `json:"_id"`
+		Found  bool     `json:"found"`
+		Source tickData `json:"_source"`
+	} `json:"docs"`
+}
+
+// GetTickDataRange Returns the tick data of all ticks with tick data in the given tick range (inclusive) in ascending
+// order. Ticks without tick data are empty and not contained in the result.
+func (r *ArchiveRepository) GetTickDataRange(ctx context.Context, fromTick, toTick uint32) ([]*api.TickData, error) {
+	query := createTickDataRangeQuery(fromTick, toTick)
+	var result tickDataMultiGetResponse
+	err := r.clusters.do(ctx, func(esClient *elasticsearch.Client) error {
+		res, err := esClient.Mget(
+			strings.NewReader(query),
+			esClient.Mget.WithContext(ctx),
+			esClient.Mget.WithIndex(r.tickDataIndex),
+		)
+		if err != nil {
+			return fmt.Errorf("calling es client mget: %w", err)
+		}
+		defer res.Body.Close()
+
+		if res.IsError() {
+			return newResponseError(res)
+		}
+
+		if err = json.NewDecoder(res.Body).Decode(&result); err != nil {
+			return fmt.Errorf("decoding response: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	if res.IsError() {
-		return nil, fmt.Errorf("got error response from Elasticsearch: %s", res.String())
+	tickData := make([]*api.TickData, 0, len(result.Docs))
+	for _, doc := range result.Docs {
+		if doc.Found {
+			tickData = append(tickData, tickDataToAPITickData(doc.Source))
+		}
 	}
+	return tickData, nil
+}
 
-	var result tickDataGetResponse
-	if err = json.NewDecoder(res.Body).Decode(&result); err != nil {
-		return nil, fmt.Errorf("decoding response: %w", err)
+// createTickDataRangeQuery creates a multi get query. The document id is the tick number.
+func createTickDataRangeQuery(fromTick, toTick uint32) string {
+	ids := make([]string, 0, toTick-fromTick+1)
+	for tick := fromTick; tick <= toTick; tick++ {
+		ids = append(ids, fmt.Sprintf(`"%d"`, tick))
 	}
+	return fmt.Sprintf(`{ "ids": [ %s ] }`, strings.Join(ids, ", "))
+}
 
-	return tickDataToAPITickData(result.Source), nil
+const tickNumbersPageSize = 10000
+
+type tickNumbersSearchResponse struct {
+	searchResult
+	Hits struct {
+		Hits []struct {
+			ID string `json:"_id"`
+		} `json:"hits"`
+	} `json:"hits"`
+}
+
+// GetTickNumbers Returns the tick numbers of all ticks with tick data in the given epoch and tick range (inclusive) in
+// ascending order. Ticks without tick data are empty.
+func (r *ArchiveRepository) GetTickNumbers(ctx context.Context, epoch, fromTick, toTick uint32) ([]uint32, error) {
+	tickNumbers := make([]uint32, 0, toTick-fromTick+1)
+	var searchAfter *uint32
+	for {
+		query := createTickNumbersQuery(epoch, fromTick, toTick, searchAfter, tickNumbersPageSize)
+
+		var result tickNumbersSearchResponse
+		err := r.clusters.search(ctx, r.tickDataIndex, query, &result)
+		if err != nil {
+			return nil, fmt.Errorf("performing elastic search: %w", err)
+		}
+
+		for _, hit := range result.Hits.Hits {
+			tickNumber, err := strconv.ParseUint(hit.ID, 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("parsing tick number [%s]: %w", hit.ID, err)
+			}
+			tickNumbers = append(tickNumbers, uint32(tickNumber))
+		}
+
+		if len(result.Hits.Hits) < tickNumbersPageSize {
+			return tickNumbers, nil
+		}
+		searchAfter = &tickNumbers[len(tickNumbers)-1]
+	}
+}
+
+func createTickNumbersQuery(epoch, fromTick, toTick uint32, searchAfter *uint32, size int) string {
+	searchAfterString := ""
+	if searchAfter != nil {
+		searchAfterString = fmt.Sprintf(`"search_after": [%d],`, *searchAfter)
+	}
+
+	query := `{
+		"_source": false,
+		"query": {
+			"bool": {
+				"filter": [
+					{ "term": { "epoch": "%d" } },
+					{ "range": { "tickNumber": { "gte": "%d", "lte": "%d" } } }
+				]
+			}
+		},
+		"sort": [ { "tickNumber": { "order": "asc" } } ],
+		%s
+		"size": %d,
+		"track_total_hits": false
+	}`
+	return fmt.Sprintf(query, epoch, fromTick, toTick, searchAfterString, size)
+}
+
+type computorTickCountsSearchResponse struct {
+	searchResult
+	Aggregations struct {
+		Computors struct {
+			Buckets []struct {
+				Key      uint32 `json:"key"`
+				DocCount uint32 `json:"doc_count"`
+			} `json:"buckets"`
+		} `json:"computors"`
+	} `json:"aggregations"`
+}
+
+// GetComputorTickCounts Returns the number of ticks with tick data per computor index in the given epoch. Computors
+// without tick data are not contained in the result.
+func (r *ArchiveRepository) GetComputorTickCounts(ctx context.Context, epoch uint32) (map[uint32]uint32, error) {
+	query := createComputorTickCountsQuery(epoch)
+
+	var result computorTickCountsSearchResponse
+	err := r.clusters.search(ctx, r.tickDataIndex, query, &result)
+	if err != nil {
+		return nil, fmt.Errorf("performing elastic search: %w", err)
+	}
+
+	counts := make(map[uint32]uint32, len(result.Aggregations.Computors.Buckets))
+	for _, bucket := range result.Aggregations.Computors.Buckets {
+		counts[bucket.Key] = bucket.DocCount
+	}
+	return counts, nil
+}
+
+func createComputorTickCountsQuery(epoch uint32) string {
+	query := `{
+		"query": {
+			"term": { "epoch": "%d" }
+		},
+		"aggs": {
+			"computors": {
+				"terms": { "field": "computorIndex", "size": %d }
+			}
+		},
+		"size": 0,
+		"track_total_hits": false
+	}`
+	return fmt.Sprintf(query, epoch, types.NumberOfComputors)
+}
+
+type tickDataStatsSearchResponse struct {
+	searchResult
+	Hits struct {
+		Total struct {
+			Value uint64 `json:"value"`
+		} `json:"total"`
+	} `json:"hits"`
+	Aggregations struct {
+		MinTick      aggregationValue `json:"min_tick"`
+		MaxTick      aggregationValue `json:"max_tick"`
+		MinTimestamp aggregationValue `json:"min_timestamp"`
+		MaxTimestamp aggregationValue `json:"max_timestamp"`
+	} `json:"aggregations"`
+}
+
+// GetTickDataStats Returns the number of ticks with tick data and the first and last tick with tick data of the epoch.
+func (r *ArchiveRepository) GetTickDataStats(ctx context.Context, epoch uint32) (*entities.TickDataStats, error) {
+	query := createTickDataStatsQuery(epoch)
+
+	var result tickDataStatsSearchResponse
+	err := r.clusters.search(ctx, r.tickDataIndex, query, &result)
+	if err != nil {
+		return nil, fmt.Errorf("performing elastic search: %w", err)
+	}
+
+	return &entities.TickDataStats{
+		Count:          result.Hits.Total.Value,
+		FirstTick:      uint32(result.Aggregations.MinTick.Value),
+		FirstTimestamp: uint64(result.Aggregations.MinTimestamp.Value),
+		LastTick:       uint32(result.Aggregations.MaxTick.Value),
+		LastTimestamp:  uint64(result.Aggregations.MaxTimestamp.Value),
+	}, nil
+}
+
+func createTickDataStatsQuery(epoch uint32) string {
+	query := `{
+		"query": {
+			"term": { "epoch": "%d" }
+		},
+		"aggs": {
+			"min_tick": { "min": { "field": "tickNumber" } },
+			"max_tick": { "max": { "field": "tickNumber" } },
+			"min_timestamp": { "min": { "field": "timestamp" } },
+			"max_timestamp": { "max": { "field": "timestamp" } }
+		},
+		"size": 0,
+		"track_total_hits": true
+	}`
+	return fmt.Sprintf(query, epoch)
 }
@@ -0,0 +1,79 @@
+package elastic
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_createTickNumbersQuery(t *testing.T) {
+	query := createTickNumbersQuery(100, 1000, 2000, nil, 10000)
+
+	var parsed map[string]any
+	err := json.Unmarshal([]byte(query), &parsed)
+	require.NoError(t, err, "query should be valid JSON")
+
+	assert.Equal(t, false, parsed["_source"])
+	filterArr := parsed["query"].(map[string]any)["bool"].(map[string]any)["filter"].([]any)
+	require.Len(t, filterArr, 2)
+	assert.Equal(t, "100", filterArr[0].(map[string]any)["term"].(map[string]any)["epoch"])
+	tickRange := filterArr[1].(map[string]any)["range"].(map[string]any)["tickNumber"].(map[string]any)
+	assert.Equal(t, "1000", tickRange["gte"])
+	assert.Equal(t, "2000", tickRange["lte"])
+	assert.Equal(t, float64(10000), parsed["size"])
+	assert.NotContains(t, parsed, "search_after")
+}
+
+func Test_createTickNumbersQuery_withSearchAfter(t *testing.T) {
+	searchAfter := uint32(1500)
+	query := createTickNumbersQuery(100, 1000, 2000, &searchAfter, 10000)
+
+	var parsed map[string]any
+	err := json.Unmarshal([]byte(query), &parsed)
+	require.NoError(t, err, "query should be valid JSON")
+
+	assert.Equal(t, []any{float64(1500)}, parsed["search_after"])
+}
+
+func Test_createTickDataRangeQuery(t *testing.T) {
+	query := createTickDataRangeQuery(1000, 1002)
+
+	var parsed map[string]any
+	err := json.Unmarshal([]byte(query), &parsed)
+	require.NoError(t, err, "query should be valid JSON")
+
+	assert.Equal(t, []any{"1000", "1001", "1002"}, parsed["ids"])
+}
+
+func Test_createComputorTickCountsQuery(t *testing.T) {
+	query := createComputorTickCountsQuery(100)
+
+	var parsed map[string]any
+	err := json.Unmarshal([]byte(query), &parsed)
+	require.NoError(t, err, "query should be valid JSON")
+
+	assert.Equal(t, float64(0), parsed["size"])
+	assert.Equal(t, "100", parsed["query"].(map[string]any)["term"].(map[string]any)["epoch"])
+	terms := parsed["aggs"].(map[string]any)["computors"].(map[string]any)["terms"].(map[string]any)
+	assert.Equal(t, "computorIndex", terms["field"])
+	assert.Equal(t, float64(676), terms["size"])
+}
+
+func Test_createTickDataStatsQuery(t *testing.T) {
+	query := createTickDataStatsQuery(190)
+
+	var parsed map[string]any
+	err := json.Unmarshal([]byte(query), &parsed)
+	require.NoError(t, err, "query should be valid JSON")
+
+	assert.Equal(t, float64(0), parsed["size"])
+	assert.Equal(t, true, parsed["track_total_hits"])
+	assert.Equal(t, "190", parsed["query"].(map[string]any)["term"].(map[string]any)["epoch"])
+	aggs := parsed["aggs"].(map[string]any)
+	assert.Equal(t, "tickNumber", aggs["min_tick"].(map[string]any)["min"].(map[string]any)["field"])
+	assert.Equal(t, "tickNumber", aggs["max_tick"].(map[string]any)["max"].(map[string]any)["field"])
+	assert.Equal(t, "timestamp", aggs["min_timestamp"].(map[string]any)["min"].(map[string]any)["field"])
+	assert.Equal(t, "timestamp", aggs["max_timestamp"].(map[string]any)["max"].(map[string]any)["field"])
+}
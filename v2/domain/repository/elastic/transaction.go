@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/elastic/go-elasticsearch/v8"
 	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
 	"github.com/qubic/archive-query-service/v2/domain"
 	"github.com/qubic/archive-query-service/v2/entities"
@@ -28,6 +29,7 @@ type transactionHit struct {
 }
 
 type transactionsSearchResponse struct {
+	searchResult
 	Hits struct {
 		Total struct {
 			Value    int    `json:"value"`
@@ -53,24 +55,30 @@ type transaction struct {
 
 const maxTrackTotalHits int = 10000 // limit for better performance
 
-func (r *ArchiveRepository) GetTransactionByHash(_ context.Context, hash string) (*api.Transaction, error) {
-	res, err := r.esClient.Get(r.txIndex, hash)
-	if err != nil {
-		return nil, fmt.Errorf("calling es client get with: %w", err)
-	}
-	defer res.Body.Close()
+func (r *ArchiveRepository) GetTransactionByHash(ctx context.Context, hash string) (*api.Transaction, error) {
+	var result transactionGetResponse
+	err := r.clusters.do(ctx, func(esClient *elasticsearch.Client) error {
+		res, err := esClient.Get(r.txIndex, hash)
+		if err != nil {
+			return fmt.Errorf("calling es client get with: %w", err)
+		}
+		defer res.Body.Close()
 
-	if res.StatusCode == 404 {
-		return nil, domain.ErrNotFound
-	}
+		if res.StatusCode == 404 {
+			return domain.ErrNotFound
+		}
 
-	if res.IsError() {
-		return nil, fmt.Errorf("got error response from data store: %s", res.String())
-	}
+		if res.IsError() {
+			return newResponseError(res)
+		}
 
-	var result transactionGetResponse
-	if err = json.NewDecoder(res.Body).Decode(&result); err != nil {
-		return nil, fmt.Errorf("decoding json response: %w", err)
+		if err = json.NewDecoder(res.Body).Decode(&result); err != nil {
+			return fmt.Errorf("decoding json response: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return transactionToAPITransaction(result.Source), nil
@@ -83,7 +91,7 @@ func (r *ArchiveRepository) GetTransactionsForTickNumber(ctx context.Context, ti
 	}
 
 	var result transactionsSearchResponse
-	err = performElasticSearch(ctx, r.esClient, r.txIndex, &query, &result)
+	err = r.clusters.search(ctx, r.txIndex, query.String(), &result)
 	if err != nil {
 		return nil, fmt.Errorf("performing elastic search: %w", err)
 	}
@@ -132,7 +140,7 @@ func (r *ArchiveRepository) GetTransactionsForIdentity(ctx context.Context, iden
 	}
 
 	var result transactionsSearchResponse
-	err = performElasticSearch(ctx, r.esClient, r.txIndex, strings.NewReader(query), &result)
+	err = r.clusters.search(ctx, r.txIndex, query, &result)
 	if err != nil {
 		return nil, nil, fmt.Errorf("performing elastic search: %w", err)
 	}
@@ -146,9 +154,27 @@ func (r *ArchiveRepository) GetTransactionsForIdentity(ctx context.Context, iden
 }
 
 func createIdentitiesQuery(identity string, filters entities.Filters, from, size, maxTick uint32) (string, error) {
+	boolQuery, err := createIdentitiesBoolQuery(identity, filters, maxTick)
+	if err != nil {
+		return "", err
+	}
 
-	var query string
+	query := `{
+	  "query": %s,
+	  "sort": [ {"tickNumber":{"order":"%s"}} ],
+	  "from": %d,
+	  "size": %d,
+	  "track_total_hits": %d
+	}`
+
+	query = fmt.Sprintf(query, boolQuery,
+		If(filters.Ascending, "asc", "desc"),
+		from, size, maxTrackTotalHits)
+	return query, nil
+}
 
+// createIdentitiesBoolQuery creates the bool query that matches the transactions of the identity up to max tick.
+func createIdentitiesBoolQuery(identity string, filters entities.Filters, maxTick uint32) (string, error) {
 	// Check if there's an upper bound tickNumber range filter (lt/lte) and adjust if needed
 	hasUpperBoundTickFilter, err := modifyUpperBoundTickNumberFilterIfNecessary(filters.Ranges, maxTick)
 	if err != nil {
@@ -182,8 +208,7 @@ func createIdentitiesQuery(identity string, filters entities.Filters, from, size
 	}
 
 	// in case we have a source or destination filter, the should clause still works
-	query = `{ 
-      "query": {
+	query := `{
 		"bool": {
 		  "should": [
 			{ "term":{"source":"%s"} },
@@ -192,17 +217,9 @@ func createIdentitiesQuery(identity string, filters entities.Filters, from, size
 		  "minimum_should_match": 1,
 		  "filter": [ %s ] %s
 		}
-	  },
-	  "sort": [ {"tickNumber":{"order":"desc"}} ],
-	  "from": %d,
-	  "size": %d,
-	  "track_total_hits": %d
-	}`
+	  }`
 
-	query = fmt.Sprintf(query, identity, identity,
-		filterQueryString, mustNotQueryString,
-		from, size, maxTrackTotalHits)
-	return query, nil
+	return fmt.Sprintf(query, identity, identity, filterQueryString, mustNotQueryString), nil
 }
 
 func modifyUpperBoundTickNumberFilterIfNecessary(ranges map[string][]entities.Range, maxTick uint32) (bool, error) {
@@ -235,3 +252,186 @@ func If[T any](cond bool, vTrue, vFalse T) T {
 	}
 	return vFalse
 }
+
+type transactionStatsSearchResponse struct {
+	searchResult
+	Hits struct {
+		Total struct {
+			Value uint64 `json:"value"`
+		} `json:"total"`
+	} `json:"hits"`
+	Aggregations struct {
+		Volume exactSumValue `json:"volume"`
+	} `json:"aggregations"`
+}
+
+// GetTransactionStats Returns the number of transactions and the sum of the transaction amounts in the tick range
+// (inclusive).
+func (r *ArchiveRepository) GetTransactionStats(ctx context.Context, fromTick, toTick uint32) (*entities.TransactionStats, error) {
+	query := createTransactionStatsQuery(fromTick, toTick)
+
+	var result transactionStatsSearchResponse
+	err := r.clusters.search(ctx, r.txIndex, query, &result)
+	if err != nil {
+		return nil, fmt.Errorf("performing elastic search: %w", err)
+	}
+
+	return &entities.TransactionStats{
+		Count:  result.Hits.Total.Value,
+		Volume: result.Aggregations.Volume.Value,
+	}, nil
+}
+
+func createTransactionStatsQuery(fromTick, toTick uint32) string {
+	query := `{
+		"query": {
+			"range": { "tickNumber": { "gte": "%d", "lte": "%d" } }
+		},
+		"aggs": {
+			"volume": %s
+		},
+		"size": 0,
+		"track_total_hits": true
+	}`
+	return fmt.Sprintf(query, fromTick, toTick, exactSumAggregation("amount"))
+}
+
+// counterpartyBucket is a counterparty with its transaction volume. The buckets are ordered by the (approximate) sum
+// aggregation, the exact volume is returned.
+type counterpartyBucket struct {
+	Key         string        `json:"key"`
+	DocCount    uint64        `json:"doc_count"`
+	ExactVolume exactSumValue `json:"exact_volume"`
+}
+
+type identityTransactionStatsSearchResponse struct {
+	searchResult
+	Aggregations struct {
+		MinTick      aggregationValue `json:"min_tick"`
+		MaxTick      aggregationValue `json:"max_tick"`
+		MinTimestamp aggregationValue `json:"min_timestamp"`
+		MaxTimestamp aggregationValue `json:"max_timestamp"`
+		Incoming     struct {
+			Counterparties struct {
+				Buckets []counterpartyBucket `json:"buckets"`
+			} `json:"counterparties"`
+		} `json:"incoming"`
+		Outgoing struct {
+			Counterparties struct {
+				Buckets []counterpartyBucket `json:"buckets"`
+			} `json:"counterparties"`
+		} `json:"outgoing"`
+	} `json:"aggregations"`
+}
+
+// GetIdentityTransactionStats Returns the first and last seen tick and the top counterparties by volume of the
+// identity's transactions up to the max tick (inclusive).
+func (r *ArchiveRepository) GetIdentityTransactionStats(ctx context.Context, identity string, maxTick, topCounterparties uint32) (*entities.IdentityTransactionStats, error) {
+	query := createIdentityTransactionStatsQuery(identity, maxTick, topCounterparties)
+
+	var result identityTransactionStatsSearchResponse
+	err := r.clusters.search(ctx, r.txIndex, query, &result)
+	if err != nil {
+		return nil, fmt.Errorf("performing elastic search: %w", err)
+	}
+
+	aggs := result.Aggregations
+	return &entities.IdentityTransactionStats{
+		FirstSeenTick:      uint32(aggs.MinTick.Value),
+		FirstSeenTimestamp: uint64(aggs.MinTimestamp.Value),
+		LastSeenTick:       uint32(aggs.MaxTick.Value),
+		LastSeenTimestamp:  uint64(aggs.MaxTimestamp.Value),
+		TopIncoming:        counterpartyBucketsToAPICounterparties(aggs.Incoming.Counterparties.Buckets),
+		TopOutgoing:        counterpartyBucketsToAPICounterparties(aggs.Outgoing.Counterparties.Buckets),
+	}, nil
+}
+
+// CountIdentityTransactions Returns the exact number of incoming and outgoing transactions of the identity up to the
+// max tick (inclusive).
+func (r *ArchiveRepository) CountIdentityTransactions(ctx context.Context, identity string, maxTick uint32) (uint64, uint64, error) {
+	incoming, err := r.clusters.count(ctx, r.txIndex, createIdentityTransactionCountQuery("destination", identity, maxTick))
+	if err != nil {
+		return 0, 0, fmt.Errorf("counting incoming transactions: %w", err)
+	}
+	outgoing, err := r.clusters.count(ctx, r.txIndex, createIdentityTransactionCountQuery("source", identity, maxTick))
+	if err != nil {
+		return 0, 0, fmt.Errorf("counting outgoing transactions: %w", err)
+	}
+	return incoming, outgoing, nil
+}
+
+func createIdentityTransactionStatsQuery(identity string, maxTick, topCounterparties uint32) string {
+	query := `{
+		"query": {
+			"bool": {
+				"should": [
+					{ "term": { "source": "%[1]s" } },
+					{ "term": { "destination": "%[1]s" } }
+				],
+				"minimum_should_match": 1,
+				"filter": [
+					{ "range": { "tickNumber": { "lte": "%[2]d" } } }
+				]
+			}
+		},
+		"aggs": {
+			"min_tick": { "min": { "field": "tickNumber" } },
+			"max_tick": { "max": { "field": "tickNumber" } },
+			"min_timestamp": { "min": { "field": "timestamp" } },
+			"max_timestamp": { "max": { "field": "timestamp" } },
+			"incoming": {
+				"filter": { "term": { "destination": "%[1]s" } },
+				"aggs": {
+					"counterparties": {
+						"terms": { "field": "source", "size": %[3]d, "order": { "volume": "desc" } },
+						"aggs": {
+							"volume": { "sum": { "field": "amount" } },
+							"exact_volume": %[4]s
+						}
+					}
+				}
+			},
+			"outgoing": {
+				"filter": { "term": { "source": "%[1]s" } },
+				"aggs": {
+					"counterparties": {
+						"terms": { "field": "destination", "size": %[3]d, "order": { "volume": "desc" } },
+						"aggs": {
+							"volume": { "sum": { "field": "amount" } },
+							"exact_volume": %[4]s
+						}
+					}
+				}
+			}
+		},
+		"size": 0,
+		"track_total_hits": false
+	}`
+	return fmt.Sprintf(query, identity, maxTick, topCounterparties, exactSumAggregation("amount"))
+}
+
+func createIdentityTransactionCountQuery(field, identity string, maxTick uint32) string {
+	query := `{
+		"query": {
+			"bool": {
+				"filter": [
+					{ "term": { "%s": "%s" } },
+					{ "range": { "tickNumber": { "lte": "%d" } } }
+				]
+			}
+		}
+	}`
+	return fmt.Sprintf(query, field, identity, maxTick)
+}
+
+func counterpartyBucketsToAPICounterparties(buckets []counterpartyBucket) []*api.Counterparty {
+	counterparties := make([]*api.Counterparty, len(buckets))
+	for i, bucket := range buckets {
+		counterparties[i] = &api.Counterparty{
+			Identity:         bucket.Key,
+			TransactionCount: bucket.DocCount,
+			Volume:           bucket.ExactVolume.Value,
+		}
+	}
+	return counterparties
+}
@@ -1,10 +1,12 @@
 package elastic
 
 import (
+	"encoding/json"
 	"log"
 	"testing"
 
 	"github.com/qubic/archive-query-service/v2/entities"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -35,6 +37,31 @@ func Test_createIdentitiesQuery_returnQuery(t *testing.T) {
 	require.JSONEq(t, expectedQuery, query)
 }
 
+func Test_createIdentitiesQuery_givenAscending_returnQuerySortedAscending(t *testing.T) {
+	expectedQuery := `{ 
+      "query": {
+		"bool": {
+		  "should": [
+			{ "term":{"source":"some-identity"} },
+			{ "term":{"destination":"some-identity"} }
+		  ],
+		  "minimum_should_match": 1,
+		  "filter": [{"range":{"tickNumber":{"lte":"12345"}}}]
+		}
+	  },
+	  "sort": [ {"tickNumber":{"order":"asc"}} ],
+	  "from": 0,
+	  "size": 10,
+	  "track_total_hits": 10000
+	}`
+
+	query, err := createIdentitiesQuery(testIdentity, entities.Filters{Ascending: true}, 0, 10, 12345)
+	require.NoError(t, err)
+	require.NotEmpty(t, query)
+
+	require.JSONEq(t, expectedQuery, query)
+}
+
 func Test_createIdentitiesQuery_givenFilters_returnQueryWithFilters(t *testing.T) {
 	expectedQuery := `{ 
       "query": {
@@ -380,3 +407,41 @@ func Test_createIdentitiesQuery_withTickNumberLowerBoundOnly_includesMaxTickFilt
 
 	require.JSONEq(t, expectedQuery, query)
 }
+
+func Test_createIdentityTransactionStatsQuery(t *testing.T) {
+	query := createIdentityTransactionStatsQuery("BZBQFLLBNCXEMGLOBHUVFTLUPLVCPQUASSILFABOFFBCADQSSUPNWLZBQEXK", 12345, 10)
+
+	var parsed map[string]any
+	err := json.Unmarshal([]byte(query), &parsed)
+	require.NoError(t, err, "query should be valid JSON")
+
+	boolQuery := parsed["query"].(map[string]any)["bool"].(map[string]any)
+	assert.Len(t, boolQuery["should"], 2)
+	assert.Equal(t, float64(1), boolQuery["minimum_should_match"])
+
+	aggs := parsed["aggs"].(map[string]any)
+	incoming := aggs["incoming"].(map[string]any)
+	assert.Equal(t, "BZBQFLLBNCXEMGLOBHUVFTLUPLVCPQUASSILFABOFFBCADQSSUPNWLZBQEXK", incoming["filter"].(map[string]any)["term"].(map[string]any)["destination"])
+	terms := incoming["aggs"].(map[string]any)["counterparties"].(map[string]any)["terms"].(map[string]any)
+	assert.Equal(t, "source", terms["field"])
+	assert.Equal(t, float64(10), terms["size"])
+	assert.Equal(t, "desc", terms["order"].(map[string]any)["volume"])
+	counterpartyAggs := incoming["aggs"].(map[string]any)["counterparties"].(map[string]any)["aggs"].(map[string]any)
+	assert.Contains(t, counterpartyAggs["exact_volume"], "scripted_metric")
+	outgoing := aggs["outgoing"].(map[string]any)
+	assert.Equal(t, "destination", outgoing["aggs"].(map[string]any)["counterparties"].(map[string]any)["terms"].(map[string]any)["field"])
+}
+
+func Test_createIdentityTransactionCountQuery(t *testing.T) {
+	query := createIdentityTransactionCountQuery("destination", "BZBQFLLBNCXEMGLOBHUVFTLUPLVCPQUASSILFABOFFBCADQSSUPNWLZBQEXK", 12345)
+
+	var parsed map[string]any
+	err := json.Unmarshal([]byte(query), &parsed)
+	require.NoError(t, err, "query should be valid JSON")
+
+	_, hasSize := parsed["size"]
+	assert.False(t, hasSize, "count query must not contain size")
+	filter := parsed["query"].(map[string]any)["bool"].(map[string]any)["filter"].([]any)
+	assert.Equal(t, "BZBQFLLBNCXEMGLOBHUVFTLUPLVCPQUASSILFABOFFBCADQSSUPNWLZBQEXK", filter[0].(map[string]any)["term"].(map[string]any)["destination"])
+	assert.Equal(t, "12345", filter[1].(map[string]any)["range"].(map[string]any)["tickNumber"].(map[string]any)["lte"])
+}
@@ -107,3 +107,26 @@ func Test_createTickTransactionsQuery_withFiltersAndRanges(t *testing.T) {
 	// Should have tickNumber + 1 filter + 1 range = 3 total
 	assert.Len(t, filterBlock, 3)
 }
+
+func Test_createTransactionStatsQuery(t *testing.T) {
+	query := createTransactionStatsQuery(1000, 2000)
+
+	var parsed map[string]any
+	err := json.Unmarshal([]byte(query), &parsed)
+	require.NoError(t, err, "query should be valid JSON")
+
+	assert.Equal(t, float64(0), parsed["size"])
+	assert.Equal(t, true, parsed["track_total_hits"])
+	tickRange := parsed["query"].(map[string]any)["range"].(map[string]any)["tickNumber"].(map[string]any)
+	assert.Equal(t, "1000", tickRange["gte"])
+	assert.Equal(t, "2000", tickRange["lte"])
+	scriptedMetric := parsed["aggs"].(map[string]any)["volume"].(map[string]any)["scripted_metric"].(map[string]any)
+	assert.Contains(t, scriptedMetric["map_script"], "doc['amount'].value")
+}
+
+func Test_transactionStatsSearchResponse_GivenVolumeAboveFloatPrecision_ThenExact(t *testing.T) {
+	var result transactionStatsSearchResponse
+	err := json.Unmarshal([]byte(`{"hits":{"total":{"value":2}},"aggregations":{"volume":{"value":9007199254740993}}}`), &result)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(9007199254740993), result.Aggregations.Volume.Value)
+}
@@ -0,0 +1,119 @@
+package file
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/qubic/archive-query-service/v2/entities"
+)
+
+// WebhookRepository persists the webhooks in one json file. The file is rewritten on every change. The file must
+// only be used by a single instance, changes of other processes are not detected.
+type WebhookRepository struct {
+	path     string
+	mutex    sync.Mutex
+	webhooks map[string]*entities.Webhook
+}
+
+// NewWebhookRepository loads the webhooks from the file. A missing file is treated as empty.
+func NewWebhookRepository(path string) (*WebhookRepository, error) {
+	repo := &WebhookRepository{path: path, webhooks: map[string]*entities.Webhook{}}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return repo, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading webhooks file: %w", err)
+	}
+	var webhooks []*entities.Webhook
+	if err = json.Unmarshal(data, &webhooks); err != nil {
+		return nil, fmt.Errorf("parsing webhooks file: %w", err)
+	}
+	for _, webhook := range webhooks {
+		repo.webhooks[webhook.ID] = webhook
+	}
+	return repo, nil
+}
+
+func (r *WebhookRepository) SaveWebhook(_ context.Context, webhook *entities.Webhook) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	previous, existed := r.webhooks[webhook.ID]
+	saved := *webhook
+	r.webhooks[webhook.ID] = &saved
+	if err := r.write(); err != nil {
+		if existed {
+			r.webhooks[webhook.ID] = previous
+		} else {
+			delete(r.webhooks, webhook.ID)
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *WebhookRepository) DeleteWebhook(_ context.Context, id string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	previous, existed := r.webhooks[id]
+	if !existed {
+		return nil
+	}
+	delete(r.webhooks, id)
+	if err := r.write(); err != nil {
+		r.webhooks[id] = previous
+		return err
+	}
+	return nil
+}
+
+func (r *WebhookRepository) GetWebhooks(_ context.Context) ([]*entities.Webhook, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	webhooks := r.sorted()
+	for i, webhook := range webhooks {
+		webhookCopy := *webhook
+		webhooks[i] = &webhookCopy
+	}
+	return webhooks, nil
+}
+
+// write replaces the file atomically, so that it is never left half written.
+func (r *WebhookRepository) write() error {
+	data, err := json.MarshalIndent(r.sorted(), "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshalling webhooks: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(r.path), filepath.Base(r.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("creating temporary webhooks file: %w", err)
+	}
+	defer os.Remove(tmp.Name()) //nolint:errcheck // fails after successful rename
+	if _, err = tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("writing webhooks file: %w", err)
+	}
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("closing webhooks file: %w", err)
+	}
+	if err = os.Rename(tmp.Name(), r.path); err != nil {
+		return fmt.Errorf("replacing webhooks file: %w", err)
+	}
+	return nil
+}
+
+// sorted returns the webhooks ordered by id.
+func (r *WebhookRepository) sorted() []*entities.Webhook {
+	webhooks := slices.Collect(maps.Values(r.webhooks))
+	slices.SortFunc(webhooks, func(a, b *entities.Webhook) int { return strings.Compare(a.ID, b.ID) })
+	return webhooks
+}
@@ -0,0 +1,56 @@
+package file
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/qubic/archive-query-service/v2/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookRepository(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "webhooks.json")
+	repo, err := NewWebhookRepository(path)
+	require.NoError(t, err)
+	webhooks, err := repo.GetWebhooks(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, webhooks)
+
+	first := &entities.Webhook{ID: "b", URL: "http://localhost/b", Identity: "ID"}
+	second := &entities.Webhook{ID: "a", URL: "http://localhost/a", EventFilters: &entities.Filters{
+		Include: map[string][]string{"logType": {"0"}},
+	}}
+	require.NoError(t, repo.SaveWebhook(ctx, first))
+	require.NoError(t, repo.SaveWebhook(ctx, second))
+	first.LastNotifiedTick = 42
+	require.NoError(t, repo.SaveWebhook(ctx, first))
+
+	reloaded, err := NewWebhookRepository(path)
+	require.NoError(t, err)
+	webhooks, err = reloaded.GetWebhooks(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []*entities.Webhook{second, first}, webhooks)
+
+	require.NoError(t, reloaded.DeleteWebhook(ctx, "a"))
+	require.NoError(t, reloaded.DeleteWebhook(ctx, "unknown"))
+	reloaded, err = NewWebhookRepository(path)
+	require.NoError(t, err)
+	webhooks, err = reloaded.GetWebhooks(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []*entities.Webhook{first}, webhooks)
+
+	files, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	assert.Len(t, files, 1, "no temporary files left")
+}
+
+func TestWebhookRepository_GivenInvalidFile_ThenError(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "webhooks.json")
+	require.NoError(t, os.WriteFile(path, []byte("{"), 0600))
+	_, err := NewWebhookRepository(path)
+	require.ErrorContains(t, err, "parsing webhooks file")
+}
@@ -0,0 +1,272 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	"github.com/qubic/archive-query-service/v2/domain"
+	"github.com/qubic/archive-query-service/v2/entities"
+)
+
+// maximum number of transactions per tick, same as the size of the elastic query
+const maxTickTransactions = 1024
+
+// ArchiveRepository serves transactions, tick data and computor lists from memory. It has the same semantics as the
+// elastic archive repository and is meant for development and tests. The data is read only.
+type ArchiveRepository struct {
+	transactions   []document[*api.Transaction] // sorted by tick number ascending
+	tickData       map[uint32]*api.TickData
+	tickNumbers    []uint32            // ticks with tick data in ascending order
+	computorsLists []*api.ComputorList // sorted by tick number ascending
+}
+
+func NewArchiveRepository(transactions []*api.Transaction, tickData []*api.TickData, computorsLists []*api.ComputorList) *ArchiveRepository {
+	r := &ArchiveRepository{
+		transactions:   make([]document[*api.Transaction], 0, len(transactions)),
+		tickData:       make(map[uint32]*api.TickData, len(tickData)),
+		computorsLists: slices.Clone(computorsLists),
+	}
+	for _, tx := range transactions {
+		r.transactions = append(r.transactions, document[*api.Transaction]{value: tx, fields: transactionFields(tx)})
+	}
+	slices.SortStableFunc(r.transactions, func(a, b document[*api.Transaction]) int {
+		return cmp.Compare(a.value.GetTickNumber(), b.value.GetTickNumber())
+	})
+	for _, td := range tickData {
+		r.tickData[td.GetTickNumber()] = td
+		r.tickNumbers = append(r.tickNumbers, td.GetTickNumber())
+	}
+	slices.Sort(r.tickNumbers)
+	r.tickNumbers = slices.Compact(r.tickNumbers)
+	slices.SortStableFunc(r.computorsLists, func(a, b *api.ComputorList) int {
+		return cmp.Compare(a.GetTickNumber(), b.GetTickNumber())
+	})
+	return r
+}
+
+func (r *ArchiveRepository) GetTransactionByHash(_ context.Context, hash string) (*api.Transaction, error) {
+	for _, doc := range r.transactions {
+		if doc.value.GetHash() == hash {
+			return clone(doc.value), nil
+		}
+	}
+	return nil, domain.ErrNotFound
+}
+
+func (r *ArchiveRepository) GetTransactionsForTickNumber(_ context.Context, tickNumber uint32, filters map[string][]string, ranges map[string][]entities.Range) ([]*api.Transaction, error) {
+	tickFilters := entities.Filters{Include: filters, Ranges: ranges}
+	var matching []document[*api.Transaction]
+	for _, doc := range r.transactions {
+		if doc.value.GetTickNumber() == tickNumber && doc.fields.matches(tickFilters, tickNumber) {
+			matching = append(matching, doc)
+		}
+	}
+	return values(matching[:min(len(matching), maxTickTransactions)]), nil
+}
+
+func (r *ArchiveRepository) GetTransactionsForIdentity(_ context.Context, identity string, maxTick uint32, filters entities.Filters,
+	from, size uint32) ([]*api.Transaction, *entities.Hits, error) {
+	transactions, hits := page(r.identityTransactions(identity, maxTick, filters), from, size)
+	return transactions, hits, nil
+}
+
+// ExportTransactionsForIdentity passes the transactions of the identity batch wise to the callback. The order is the
+// same as for GetTransactionsForIdentity.
+func (r *ArchiveRepository) ExportTransactionsForIdentity(_ context.Context, identity string, maxTick uint32, filters entities.Filters,
+	limit uint32, fn func([]*api.Transaction) error) error {
+	return export(r.identityTransactions(identity, maxTick, filters), limit, fn)
+}
+
+// identityTransactions returns the filtered transactions with the identity as source or destination sorted by tick
+// number.
+func (r *ArchiveRepository) identityTransactions(identity string, maxTick uint32, filters entities.Filters) []document[*api.Transaction] {
+	var matching []document[*api.Transaction]
+	for _, doc := range r.transactions {
+		if (doc.value.GetSource() == identity || doc.value.GetDestination() == identity) && doc.fields.matches(filters, maxTick) {
+			matching = append(matching, doc)
+		}
+	}
+	if !filters.Ascending {
+		slices.Reverse(matching)
+	}
+	return matching
+}
+
+// GetTransactionStats Returns the number of transactions and the sum of the transaction amounts in the tick range
+// (inclusive).
+func (r *ArchiveRepository) GetTransactionStats(_ context.Context, fromTick, toTick uint32) (*entities.TransactionStats, error) {
+	var stats entities.TransactionStats
+	for _, doc := range r.transactions {
+		if tick := doc.value.GetTickNumber(); tick >= fromTick && tick <= toTick {
+			stats.Count++
+			stats.Volume += doc.value.GetAmount()
+		}
+	}
+	return &stats, nil
+}
+
+// GetIdentityTransactionStats Returns the first and last seen tick and the top counterparties by volume of the
+// identity's transactions up to the max tick (inclusive).
+func (r *ArchiveRepository) GetIdentityTransactionStats(_ context.Context, identity string, maxTick, topCounterparties uint32) (*entities.IdentityTransactionStats, error) {
+	var stats entities.IdentityTransactionStats
+	incoming := map[string]*api.Counterparty{}
+	outgoing := map[string]*api.Counterparty{}
+	first := true
+	for _, doc := range r.identityTransactions(identity, maxTick, entities.Filters{Ascending: true}) {
+		tx := doc.value
+		if first {
+			stats.FirstSeenTick, stats.FirstSeenTimestamp = tx.GetTickNumber(), tx.GetTimestamp()
+			first = false
+		}
+		stats.FirstSeenTimestamp = min(stats.FirstSeenTimestamp, tx.GetTimestamp())
+		stats.LastSeenTick = tx.GetTickNumber()
+		stats.LastSeenTimestamp = max(stats.LastSeenTimestamp, tx.GetTimestamp())
+		if tx.GetDestination() == identity {
+			addCounterparty(incoming, tx.GetSource(), tx.GetAmount())
+		}
+		if tx.GetSource() == identity {
+			addCounterparty(outgoing, tx.GetDestination(), tx.GetAmount())
+		}
+	}
+	stats.TopIncoming = topCounterpartiesByVolume(incoming, topCounterparties)
+	stats.TopOutgoing = topCounterpartiesByVolume(outgoing, topCounterparties)
+	return &stats, nil
+}
+
+func addCounterparty(counterparties map[string]*api.Counterparty, identity string, amount uint64) {
+	counterparty, ok := counterparties[identity]
+	if !ok {
+		counterparty = &api.Counterparty{Identity: identity}
+		counterparties[identity] = counterparty
+	}
+	counterparty.TransactionCount++
+	counterparty.Volume += amount
+}
+
+func topCounterpartiesByVolume(counterparties map[string]*api.Counterparty, size uint32) []*api.Counterparty {
+	sorted := make([]*api.Counterparty, 0, len(counterparties))
+	for _, counterparty := range counterparties {
+		sorted = append(sorted, counterparty)
+	}
+	slices.SortFunc(sorted, func(a, b *api.Counterparty) int {
+		return cmp.Or(cmp.Compare(b.GetVolume(), a.GetVolume()), cmp.Compare(a.GetIdentity(), b.GetIdentity()))
+	})
+	return sorted[:min(len(sorted), int(size))]
+}
+
+// CountIdentityTransactions Returns the number of incoming and outgoing transactions of the identity up to the max
+// tick (inclusive).
+func (r *ArchiveRepository) CountIdentityTransactions(_ context.Context, identity string, maxTick uint32) (uint64, uint64, error) {
+	var incoming, outgoing uint64
+	for _, doc := range r.transactions {
+		if doc.value.GetTickNumber() > maxTick {
+			continue
+		}
+		if doc.value.GetDestination() == identity {
+			incoming++
+		}
+		if doc.value.GetSource() == identity {
+			outgoing++
+		}
+	}
+	return incoming, outgoing, nil
+}
+
+// GetTickData Returns the tick data or domain.ErrNotFound if there is not tick data for this tick number.
+func (r *ArchiveRepository) GetTickData(_ context.Context, tickNumber uint32) (*api.TickData, error) {
+	td, ok := r.tickData[tickNumber]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	return clone(td), nil
+}
+
+// GetTickDataRange Returns the tick data of all ticks with tick data in the given tick range (inclusive) in ascending
+// order.
+func (r *ArchiveRepository) GetTickDataRange(_ context.Context, fromTick, toTick uint32) ([]*api.TickData, error) {
+	var tickData []*api.TickData
+	for _, tick := range r.tickNumbers {
+		if tick >= fromTick && tick <= toTick {
+			tickData = append(tickData, clone(r.tickData[tick]))
+		}
+	}
+	return tickData, nil
+}
+
+// GetTickNumbers Returns the tick numbers of all ticks with tick data in the given epoch and tick range (inclusive) in
+// ascending order.
+func (r *ArchiveRepository) GetTickNumbers(_ context.Context, epoch, fromTick, toTick uint32) ([]uint32, error) {
+	tickNumbers := make([]uint32, 0)
+	for _, tick := range r.tickNumbers {
+		if tick >= fromTick && tick <= toTick && r.tickData[tick].GetEpoch() == epoch {
+			tickNumbers = append(tickNumbers, tick)
+		}
+	}
+	return tickNumbers, nil
+}
+
+// GetComputorTickCounts Returns the number of ticks with tick data per computor index in the given epoch.
+func (r *ArchiveRepository) GetComputorTickCounts(_ context.Context, epoch uint32) (map[uint32]uint32, error) {
+	counts := map[uint32]uint32{}
+	for _, td := range r.tickData {
+		if td.GetEpoch() == epoch {
+			counts[td.GetComputorIndex()]++
+		}
+	}
+	return counts, nil
+}
+
+// GetTickDataStats Returns the number of ticks with tick data and the first and last tick of the epoch.
+func (r *ArchiveRepository) GetTickDataStats(_ context.Context, epoch uint32) (*entities.TickDataStats, error) {
+	var stats entities.TickDataStats
+	for _, tick := range r.tickNumbers {
+		td := r.tickData[tick]
+		if td.GetEpoch() != epoch {
+			continue
+		}
+		if stats.Count == 0 {
+			stats.FirstTick, stats.FirstTimestamp = tick, td.GetTimestamp()
+		}
+		stats.Count++
+		stats.LastTick, stats.LastTimestamp = tick, td.GetTimestamp()
+	}
+	return &stats, nil
+}
+
+// GetComputorsListsForEpoch Returns the computor lists of the epoch sorted by tick number descending.
+func (r *ArchiveRepository) GetComputorsListsForEpoch(_ context.Context, epoch uint32) ([]*api.ComputorList, error) {
+	return r.computorsListsDescending(func(cl *api.ComputorList) bool {
+		return cl.GetEpoch() == epoch
+	}), nil
+}
+
+// GetComputorsListsForIdentity Returns all computor lists that contain the identity sorted by tick number descending.
+func (r *ArchiveRepository) GetComputorsListsForIdentity(_ context.Context, identity string) ([]*api.ComputorList, error) {
+	return r.computorsListsDescending(func(cl *api.ComputorList) bool {
+		return slices.Contains(cl.GetIdentities(), identity)
+	}), nil
+}
+
+// GetComputorsListsForEpochRange Returns all computor lists of the epoch range (inclusive) sorted by tick number
+// ascending.
+func (r *ArchiveRepository) GetComputorsListsForEpochRange(_ context.Context, fromEpoch, toEpoch uint32) ([]*api.ComputorList, error) {
+	var lists []*api.ComputorList
+	for _, cl := range r.computorsLists {
+		if cl.GetEpoch() >= fromEpoch && cl.GetEpoch() <= toEpoch {
+			lists = append(lists, clone(cl))
+		}
+	}
+	return lists, nil
+}
+
+func (r *ArchiveRepository) computorsListsDescending(match func(*api.ComputorList) bool) []*api.ComputorList {
+	var lists []*api.ComputorList
+	for _, cl := range slices.Backward(r.computorsLists) {
+		if match(cl) {
+			lists = append(lists, clone(cl))
+		}
+	}
+	return lists
+}
@@ -0,0 +1,198 @@
+package memory
+
+import (
+	"context"
+	"testing"
+
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	"github.com/qubic/archive-query-service/v2/domain"
+	"github.com/qubic/archive-query-service/v2/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testIdentity  = "NPCSWDPRJLRDCFGOSUQQWBUJNXXEGYYMFRQNMZEEICRXRNEKHRZUNBFEYSVH"
+	testIdentity2 = "RWNQIHQJVTCFJGTGJERCYOQIXVRAHFFWEBWXCFVRGCYTQFRAWAQQDJUGGLCF"
+	testIdentity3 = "SHVKSJXSXUMYPEOSXYAEWDKZGOHDAUQPSNAPKJFVKDDMTIMRBIWWVJDHVZQK"
+)
+
+func newTestArchiveRepository() *ArchiveRepository {
+	return NewArchiveRepository([]*api.Transaction{
+		{Hash: "a", Source: testIdentity, Destination: testIdentity2, Amount: 100, TickNumber: 10, Timestamp: 1000, InputType: 0},
+		{Hash: "b", Source: testIdentity2, Destination: testIdentity, Amount: 5, TickNumber: 12, Timestamp: 1200, InputType: 1},
+		{Hash: "c", Source: testIdentity, Destination: testIdentity3, Amount: 18446744073709551615, TickNumber: 11, Timestamp: 1100},
+		{Hash: "d", Source: testIdentity2, Destination: testIdentity3, Amount: 1, TickNumber: 11, Timestamp: 1100},
+		{Hash: "e", Source: testIdentity, Destination: testIdentity2, Amount: 7, TickNumber: 20, Timestamp: 2000},
+	}, []*api.TickData{
+		{TickNumber: 10, Epoch: 1, ComputorIndex: 3, Timestamp: 1000},
+		{TickNumber: 12, Epoch: 1, ComputorIndex: 3, Timestamp: 1200},
+		{TickNumber: 11, Epoch: 1, ComputorIndex: 4, Timestamp: 1100},
+		{TickNumber: 20, Epoch: 2, ComputorIndex: 3, Timestamp: 2000},
+	}, []*api.ComputorList{
+		{Epoch: 2, TickNumber: 19, Identities: []string{testIdentity}},
+		{Epoch: 1, TickNumber: 5, Identities: []string{testIdentity, testIdentity2}},
+		{Epoch: 1, TickNumber: 8, Identities: []string{testIdentity2}},
+	})
+}
+
+func hashes(transactions []*api.Transaction) []string {
+	result := make([]string, 0, len(transactions))
+	for _, tx := range transactions {
+		result = append(result, tx.GetHash())
+	}
+	return result
+}
+
+func TestArchiveRepository_GetTransactionsForIdentity_GivenNoFilters_ThenSortedDescendingUpToMaxTick(t *testing.T) {
+	repo := newTestArchiveRepository()
+	transactions, hits, err := repo.GetTransactionsForIdentity(context.Background(), testIdentity, 15, entities.Filters{}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b", "c", "a"}, hashes(transactions))
+	assert.Equal(t, &entities.Hits{Total: 3, Relation: "eq"}, hits)
+
+	transactions, _, err = repo.GetTransactionsForIdentity(context.Background(), testIdentity, 15, entities.Filters{Ascending: true}, 1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"c"}, hashes(transactions))
+}
+
+func TestArchiveRepository_GetTransactionsForIdentity_GivenFilters_ThenMatchingTransactions(t *testing.T) {
+	repo := newTestArchiveRepository()
+	tests := []struct {
+		name     string
+		filters  entities.Filters
+		expected []string
+	}{
+		{name: "include", filters: entities.Filters{Include: map[string][]string{"destination": {testIdentity2, testIdentity3}}}, expected: []string{"c", "a"}},
+		{name: "exclude", filters: entities.Filters{Exclude: map[string][]string{"destination": {testIdentity2}}}, expected: []string{"b", "c"}},
+		{name: "range", filters: entities.Filters{Ranges: map[string][]entities.Range{"amount": {{Operation: "gt", Value: "5"}, {Operation: "lte", Value: "100"}}}}, expected: []string{"a"}},
+		{name: "uint64 range", filters: entities.Filters{Ranges: map[string][]entities.Range{"amount": {{Operation: "gte", Value: "18446744073709551615"}}}}, expected: []string{"c"}},
+		{name: "tick number clamped", filters: entities.Filters{Ranges: map[string][]entities.Range{"tickNumber": {{Operation: "lt", Value: "100"}}}}, expected: []string{"b", "c", "a"}},
+		{name: "tick number range", filters: entities.Filters{Ranges: map[string][]entities.Range{"tickNumber": {{Operation: "gte", Value: "11"}}}}, expected: []string{"b", "c"}},
+		{name: "input type", filters: entities.Filters{Include: map[string][]string{"inputType": {"1"}}}, expected: []string{"b"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			transactions, hits, err := repo.GetTransactionsForIdentity(context.Background(), testIdentity, 15, tc.filters, 0, 10)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, hashes(transactions))
+			assert.Equal(t, len(tc.expected), hits.Total)
+		})
+	}
+}
+
+func TestArchiveRepository_GetTransactionsForIdentity_GivenMoreThanMaxTrackedHits_ThenRelationGte(t *testing.T) {
+	transactions := make([]*api.Transaction, 0, maxTrackTotalHits+1)
+	for i := range maxTrackTotalHits + 1 {
+		transactions = append(transactions, &api.Transaction{Source: testIdentity, TickNumber: uint32(i)})
+	}
+	repo := NewArchiveRepository(transactions, nil, nil)
+
+	result, hits, err := repo.GetTransactionsForIdentity(context.Background(), testIdentity, maxTrackTotalHits+1, entities.Filters{}, 0, 2)
+	require.NoError(t, err)
+	assert.Len(t, result, 2)
+	assert.Equal(t, &entities.Hits{Total: maxTrackTotalHits, Relation: "gte"}, hits)
+}
+
+func TestArchiveRepository_GetTransactionByHash(t *testing.T) {
+	repo := newTestArchiveRepository()
+	tx, err := repo.GetTransactionByHash(context.Background(), "c")
+	require.NoError(t, err)
+	assert.Equal(t, uint32(11), tx.GetTickNumber())
+
+	tx.TickNumber = 99 // the repository returns copies
+	tx, err = repo.GetTransactionByHash(context.Background(), "c")
+	require.NoError(t, err)
+	assert.Equal(t, uint32(11), tx.GetTickNumber())
+
+	_, err = repo.GetTransactionByHash(context.Background(), "x")
+	require.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestArchiveRepository_GetTransactionsForTickNumber(t *testing.T) {
+	repo := newTestArchiveRepository()
+	transactions, err := repo.GetTransactionsForTickNumber(context.Background(), 11, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"c", "d"}, hashes(transactions))
+
+	transactions, err = repo.GetTransactionsForTickNumber(context.Background(), 11, map[string][]string{"source": {testIdentity2}}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"d"}, hashes(transactions))
+}
+
+func TestArchiveRepository_ExportTransactionsForIdentity_ThenLimited(t *testing.T) {
+	repo := newTestArchiveRepository()
+	var exported []*api.Transaction
+	err := repo.ExportTransactionsForIdentity(context.Background(), testIdentity, 100, entities.Filters{}, 3, func(batch []*api.Transaction) error {
+		exported = append(exported, batch...)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"e", "b", "c"}, hashes(exported))
+}
+
+func TestArchiveRepository_Stats(t *testing.T) {
+	repo := newTestArchiveRepository()
+	txStats, err := repo.GetTransactionStats(context.Background(), 10, 10)
+	require.NoError(t, err)
+	assert.Equal(t, &entities.TransactionStats{Count: 1, Volume: 100}, txStats)
+
+	identityStats, err := repo.GetIdentityTransactionStats(context.Background(), testIdentity, 15, 1)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(10), identityStats.FirstSeenTick)
+	assert.Equal(t, uint32(12), identityStats.LastSeenTick)
+	assert.Equal(t, uint64(1200), identityStats.LastSeenTimestamp)
+	require.Len(t, identityStats.TopOutgoing, 1)
+	assert.Equal(t, testIdentity3, identityStats.TopOutgoing[0].GetIdentity())
+	require.Len(t, identityStats.TopIncoming, 1)
+	assert.Equal(t, uint64(5), identityStats.TopIncoming[0].GetVolume())
+
+	incoming, outgoing, err := repo.CountIdentityTransactions(context.Background(), testIdentity, 100)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), incoming)
+	assert.Equal(t, uint64(3), outgoing)
+
+	tickStats, err := repo.GetTickDataStats(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, &entities.TickDataStats{Count: 3, FirstTick: 10, FirstTimestamp: 1000, LastTick: 12, LastTimestamp: 1200}, tickStats)
+}
+
+func TestArchiveRepository_TickData(t *testing.T) {
+	repo := newTestArchiveRepository()
+	td, err := repo.GetTickData(context.Background(), 11)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(4), td.GetComputorIndex())
+	_, err = repo.GetTickData(context.Background(), 13)
+	require.ErrorIs(t, err, domain.ErrNotFound)
+
+	tickData, err := repo.GetTickDataRange(context.Background(), 11, 20)
+	require.NoError(t, err)
+	require.Len(t, tickData, 3)
+	assert.Equal(t, uint32(11), tickData[0].GetTickNumber())
+
+	tickNumbers, err := repo.GetTickNumbers(context.Background(), 1, 0, 100)
+	require.NoError(t, err)
+	assert.Equal(t, []uint32{10, 11, 12}, tickNumbers)
+
+	counts, err := repo.GetComputorTickCounts(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, map[uint32]uint32{3: 2, 4: 1}, counts)
+}
+
+func TestArchiveRepository_ComputorsLists(t *testing.T) {
+	repo := newTestArchiveRepository()
+	lists, err := repo.GetComputorsListsForEpoch(context.Background(), 1)
+	require.NoError(t, err)
+	require.Len(t, lists, 2)
+	assert.Equal(t, uint32(8), lists[0].GetTickNumber())
+
+	lists, err = repo.GetComputorsListsForIdentity(context.Background(), testIdentity)
+	require.NoError(t, err)
+	require.Len(t, lists, 2)
+	assert.Equal(t, uint32(19), lists[0].GetTickNumber())
+
+	lists, err = repo.GetComputorsListsForEpochRange(context.Background(), 1, 2)
+	require.NoError(t, err)
+	require.Len(t, lists, 3)
+	assert.Equal(t, uint32(5), lists[0].GetTickNumber())
+}
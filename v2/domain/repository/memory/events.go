@@ -0,0 +1,158 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	"github.com/qubic/archive-query-service/v2/domain"
+	"github.com/qubic/archive-query-service/v2/entities"
+)
+
+const (
+	quTransferEventLogType = 0
+	burningEventLogType    = 8
+	// maximum number of assets returned in the identity event stats
+	maxIdentityAssets = 100
+)
+
+// asset issuance, ownership change, possession change and managing contract change events
+var assetEventLogTypes = []uint32{1, 2, 3, 11, 12}
+
+// EventsRepository serves events from memory. It has the same semantics as the elastic events repository and is meant
+// for development and tests. The data is read only.
+type EventsRepository struct {
+	events []document[*api.Event] // sorted by tick number descending and log id ascending
+}
+
+func NewEventsRepository(events []*api.Event) *EventsRepository {
+	r := &EventsRepository{events: make([]document[*api.Event], 0, len(events))}
+	for _, e := range events {
+		r.events = append(r.events, document[*api.Event]{value: e, fields: eventFields(e)})
+	}
+	slices.SortStableFunc(r.events, func(a, b document[*api.Event]) int {
+		return cmp.Or(cmp.Compare(b.value.GetTickNumber(), a.value.GetTickNumber()), cmp.Compare(a.value.GetLogId(), b.value.GetLogId()))
+	})
+	return r
+}
+
+// GetEvents returns a page of the filtered events sorted by tick number (descending or ascending) and log id
+// ascending.
+func (r *EventsRepository) GetEvents(_ context.Context, filters entities.Filters, from, size, maxTick uint32) ([]*api.Event, *entities.Hits, error) {
+	matching := r.filtered(filters, maxTick)
+	if filters.Ascending {
+		slices.SortStableFunc(matching, func(a, b document[*api.Event]) int {
+			return cmp.Compare(a.value.GetTickNumber(), b.value.GetTickNumber())
+		})
+	}
+	events, hits := page(matching, from, size)
+	return events, hits, nil
+}
+
+// ExportEvents passes the filtered events batch wise to the callback. The order is the same as for GetEvents.
+func (r *EventsRepository) ExportEvents(_ context.Context, filters entities.Filters, maxTick, limit uint32, fn func([]*api.Event) error) error {
+	return export(r.filtered(filters, maxTick), limit, fn)
+}
+
+func (r *EventsRepository) filtered(filters entities.Filters, maxTick uint32) []document[*api.Event] {
+	var matching []document[*api.Event]
+	for _, doc := range r.events {
+		if doc.fields.matches(filters, maxTick) {
+			matching = append(matching, doc)
+		}
+	}
+	return matching
+}
+
+// GetEvent returns the event with the given log id in the given epoch or domain.ErrNotFound if there is none up to maxTick.
+func (r *EventsRepository) GetEvent(_ context.Context, epoch uint32, logID uint64, maxTick uint32) (*api.Event, error) {
+	for _, doc := range r.events {
+		e := doc.value
+		if e.GetEpoch() == epoch && e.GetLogId() == logID && e.GetTickNumber() <= maxTick {
+			return clone(e), nil
+		}
+	}
+	return nil, domain.ErrNotFound
+}
+
+// GetEventsForTransaction returns up to size events of one transaction ordered by log id. Only events with a log id
+// greater than afterLogID are returned, if afterLogID is set.
+func (r *EventsRepository) GetEventsForTransaction(_ context.Context, hash string, afterLogID *uint64, size, maxTick uint32) ([]*api.Event, error) {
+	var matching []*api.Event
+	for _, doc := range r.events {
+		e := doc.value
+		if e.TransactionHash != nil && e.GetTransactionHash() == hash && e.GetTickNumber() <= maxTick &&
+			(afterLogID == nil || e.GetLogId() > *afterLogID) {
+			matching = append(matching, e)
+		}
+	}
+	slices.SortStableFunc(matching, func(a, b *api.Event) int {
+		return cmp.Compare(a.GetLogId(), b.GetLogId())
+	})
+	events := make([]*api.Event, 0, min(len(matching), int(size)))
+	for _, e := range matching[:min(len(matching), int(size))] {
+		events = append(events, clone(e))
+	}
+	return events, nil
+}
+
+// GetEventStats Returns the number of events per log type and the sum of the burned amounts of the epoch up to the
+// max tick (inclusive).
+func (r *EventsRepository) GetEventStats(_ context.Context, epoch, maxTick uint32) (*entities.EventStats, error) {
+	stats := entities.EventStats{CountsByLogType: map[uint32]uint64{}}
+	for _, doc := range r.events {
+		e := doc.value
+		if e.GetEpoch() != epoch || e.GetTickNumber() > maxTick {
+			continue
+		}
+		stats.CountsByLogType[e.GetLogType()]++
+		if e.GetLogType() == burningEventLogType {
+			stats.BurnedAmount += doc.fields.uint("amount")
+		}
+	}
+	return &stats, nil
+}
+
+// GetIdentityEventStats Returns the QU transfer totals and the assets of the asset events of the identity up to the
+// max tick (inclusive).
+func (r *EventsRepository) GetIdentityEventStats(_ context.Context, identity string, maxTick uint32) (*entities.IdentityEventStats, error) {
+	var stats entities.IdentityEventStats
+	type asset struct{ issuer, name string }
+	assetCounts := map[asset]int{}
+	identityFields := []string{"source", "destination", "assetIssuer", "owner", "possessor"}
+	for _, doc := range r.events {
+		f := doc.fields
+		if doc.value.GetTickNumber() > maxTick || !slices.ContainsFunc(identityFields, func(name string) bool {
+			return f.matchesTerm(name, []string{identity})
+		}) {
+			continue
+		}
+		logType := doc.value.GetLogType()
+		if logType == quTransferEventLogType && f.first("destination") == identity {
+			stats.ReceivedCount++
+			stats.ReceivedAmount += f.uint("amount")
+		}
+		if logType == quTransferEventLogType && f.first("source") == identity {
+			stats.SentCount++
+			stats.SentAmount += f.uint("amount")
+		}
+		if slices.Contains(assetEventLogTypes, logType) && len(f["assetIssuer"]) > 0 && len(f["assetName"]) > 0 {
+			assetCounts[asset{issuer: f.first("assetIssuer"), name: f.first("assetName")}]++
+		}
+	}
+
+	// ordered by number of events like the multi terms aggregation
+	assets := make([]asset, 0, len(assetCounts))
+	for a := range assetCounts {
+		assets = append(assets, a)
+	}
+	slices.SortFunc(assets, func(a, b asset) int {
+		return cmp.Or(cmp.Compare(assetCounts[b], assetCounts[a]), cmp.Compare(a.issuer, b.issuer), cmp.Compare(a.name, b.name))
+	})
+	stats.Assets = make([]*api.IdentityAsset, 0, min(len(assets), maxIdentityAssets))
+	for _, a := range assets[:min(len(assets), maxIdentityAssets)] {
+		stats.Assets = append(stats.Assets, &api.IdentityAsset{AssetIssuer: a.issuer, AssetName: a.name})
+	}
+	return &stats, nil
+}
@@ -0,0 +1,125 @@
+package memory
+
+import (
+	"context"
+	"testing"
+
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	"github.com/qubic/archive-query-service/v2/domain"
+	"github.com/qubic/archive-query-service/v2/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func quTransfer(logID uint64, tick uint32, hash, source, destination string, amount uint64) *api.Event {
+	return &api.Event{
+		Epoch: 1, TickNumber: tick, LogId: logID, LogType: 0, TransactionHash: &hash,
+		EventData: &api.Event_QuTransfer{QuTransfer: &api.QuTransferData{Source: source, Destination: destination, Amount: amount}},
+	}
+}
+
+func newTestEventsRepository() *EventsRepository {
+	return NewEventsRepository([]*api.Event{
+		quTransfer(1, 10, "a", testIdentity, testIdentity2, 100),
+		quTransfer(3, 11, "b", testIdentity2, testIdentity, 5),
+		quTransfer(2, 11, "b", testIdentity2, testIdentity3, 50),
+		{Epoch: 1, TickNumber: 12, LogId: 4, LogType: 8, Categories: []int32{1, 2},
+			EventData: &api.Event_Burning{Burning: &api.BurningData{Source: testIdentity, Amount: 7}}},
+		{Epoch: 1, TickNumber: 12, LogId: 5, LogType: 1,
+			EventData: &api.Event_AssetIssuance{AssetIssuance: &api.AssetIssuanceData{AssetIssuer: testIdentity, AssetName: "TEST"}}},
+		quTransfer(6, 20, "c", testIdentity, testIdentity2, 1),
+	})
+}
+
+func logIDs(events []*api.Event) []uint64 {
+	result := make([]uint64, 0, len(events))
+	for _, e := range events {
+		result = append(result, e.GetLogId())
+	}
+	return result
+}
+
+func TestEventsRepository_GetEvents_GivenFilters_ThenMatchingEventsSorted(t *testing.T) {
+	repo := newTestEventsRepository()
+	tests := []struct {
+		name     string
+		filters  entities.Filters
+		expected []uint64
+	}{
+		{name: "no filters", expected: []uint64{4, 5, 2, 3, 1}},
+		{name: "include", filters: entities.Filters{Include: map[string][]string{"logType": {"0"}, "source": {testIdentity2}}}, expected: []uint64{2, 3}},
+		{name: "exclude", filters: entities.Filters{Exclude: map[string][]string{"logType": {"0"}}}, expected: []uint64{4, 5}},
+		{name: "categories", filters: entities.Filters{Include: map[string][]string{"categories": {"2"}}}, expected: []uint64{4}},
+		{name: "range", filters: entities.Filters{Ranges: map[string][]entities.Range{"amount": {{Operation: "gte", Value: "7"}, {Operation: "lt", Value: "100"}}}}, expected: []uint64{4, 2}},
+		{name: "should", filters: entities.Filters{Should: []entities.ShouldFilter{
+			{Terms: map[string][]string{"source": {testIdentity}, "destination": {testIdentity}}},
+			{Ranges: map[string][]entities.Range{"amount": {{Operation: "gt", Value: "5"}}}, Terms: map[string][]string{"logType": {"1"}}},
+		}}, expected: []uint64{4, 1}},
+		{name: "asset name", filters: entities.Filters{Include: map[string][]string{"assetName": {"TEST"}}}, expected: []uint64{5}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			events, hits, err := repo.GetEvents(context.Background(), tc.filters, 0, 10, 15)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, logIDs(events))
+			assert.Equal(t, &entities.Hits{Total: len(tc.expected), Relation: "eq"}, hits)
+		})
+	}
+}
+
+func TestEventsRepository_GetEvents_GivenPagination_ThenPage(t *testing.T) {
+	repo := newTestEventsRepository()
+	events, hits, err := repo.GetEvents(context.Background(), entities.Filters{}, 2, 2, 100)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{5, 2}, logIDs(events))
+	assert.Equal(t, 6, hits.Total)
+
+	events, _, err = repo.GetEvents(context.Background(), entities.Filters{}, 10, 2, 100)
+	require.NoError(t, err)
+	assert.Empty(t, events)
+}
+
+func TestEventsRepository_GetEvents_GivenAscending_ThenSortedByTickAscending(t *testing.T) {
+	repo := newTestEventsRepository()
+	events, _, err := repo.GetEvents(context.Background(), entities.Filters{Ascending: true}, 0, 10, 100)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{1, 2, 3, 4, 5, 6}, logIDs(events))
+}
+
+func TestEventsRepository_GetEvent(t *testing.T) {
+	repo := newTestEventsRepository()
+	event, err := repo.GetEvent(context.Background(), 1, 3, 15)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), event.GetQuTransfer().GetAmount())
+
+	_, err = repo.GetEvent(context.Background(), 1, 6, 15)
+	require.ErrorIs(t, err, domain.ErrNotFound, "after max tick")
+}
+
+func TestEventsRepository_GetEventsForTransaction(t *testing.T) {
+	repo := newTestEventsRepository()
+	events, err := repo.GetEventsForTransaction(context.Background(), "b", nil, 10, 15)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{2, 3}, logIDs(events))
+
+	after := uint64(2)
+	events, err = repo.GetEventsForTransaction(context.Background(), "b", &after, 10, 15)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{3}, logIDs(events))
+}
+
+func TestEventsRepository_Stats(t *testing.T) {
+	repo := newTestEventsRepository()
+	stats, err := repo.GetEventStats(context.Background(), 1, 15)
+	require.NoError(t, err)
+	assert.Equal(t, &entities.EventStats{CountsByLogType: map[uint32]uint64{0: 3, 1: 1, 8: 1}, BurnedAmount: 7}, stats)
+
+	identityStats, err := repo.GetIdentityEventStats(context.Background(), testIdentity, 15)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), identityStats.ReceivedCount)
+	assert.Equal(t, uint64(5), identityStats.ReceivedAmount)
+	assert.Equal(t, uint64(1), identityStats.SentCount)
+	assert.Equal(t, uint64(100), identityStats.SentAmount)
+	require.Len(t, identityStats.Assets, 1)
+	assert.Equal(t, "TEST", identityStats.Assets[0].GetAssetName())
+}
@@ -0,0 +1,273 @@
+package memory
+
+import (
+	"cmp"
+	"math/big"
+	"slices"
+	"strconv"
+
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	"github.com/qubic/archive-query-service/v2/entities"
+	"google.golang.org/protobuf/proto"
+)
+
+// same limit as the elastic repository
+const maxTrackTotalHits = 10000
+
+// fields are the values of a document by field name as they would be indexed in elasticsearch. Filters are matched
+// against these values.
+type fields map[string][]string
+
+func (f fields) set(name string, values ...string) {
+	f[name] = values
+}
+
+func (f fields) setUint(name string, value uint64) {
+	f[name] = []string{strconv.FormatUint(value, 10)}
+}
+
+func (f fields) setInt(name string, value int64) {
+	f[name] = []string{strconv.FormatInt(value, 10)}
+}
+
+func (f fields) uint(name string) uint64 {
+	if values := f[name]; len(values) > 0 {
+		value, _ := strconv.ParseUint(values[0], 10, 64)
+		return value
+	}
+	return 0
+}
+
+func (f fields) first(name string) string {
+	if values := f[name]; len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// document is an api object with its indexed fields.
+type document[T proto.Message] struct {
+	value  T
+	fields fields
+}
+
+func transactionFields(tx *api.Transaction) fields {
+	f := fields{}
+	f.set("hash", tx.GetHash())
+	f.set("source", tx.GetSource())
+	f.set("destination", tx.GetDestination())
+	f.setUint("amount", tx.GetAmount())
+	f.setUint("tickNumber", uint64(tx.GetTickNumber()))
+	f.setUint("timestamp", tx.GetTimestamp())
+	f.setUint("inputType", uint64(tx.GetInputType()))
+	f.setUint("inputSize", uint64(tx.GetInputSize()))
+	f.set("moneyFlew", strconv.FormatBool(tx.GetMoneyFlew()))
+	return f
+}
+
+// eventFields flattens the event data like the documents in the event index.
+func eventFields(e *api.Event) fields {
+	f := fields{}
+	f.setUint("epoch", uint64(e.GetEpoch()))
+	f.setUint("tickNumber", uint64(e.GetTickNumber()))
+	f.setUint("timestamp", e.GetTimestamp())
+	if e.TransactionHash != nil {
+		f.set("transactionHash", e.GetTransactionHash())
+	}
+	f.setUint("logId", e.GetLogId())
+	f.set("logDigest", e.GetLogDigest())
+	f.setUint("logType", uint64(e.GetLogType()))
+	categories := make([]string, 0, len(e.GetCategories()))
+	for _, category := range e.GetCategories() {
+		categories = append(categories, strconv.FormatInt(int64(category), 10))
+	}
+	f.set("categories", categories...)
+
+	switch data := e.GetEventData().(type) {
+	case *api.Event_QuTransfer:
+		f.set("source", data.QuTransfer.GetSource())
+		f.set("destination", data.QuTransfer.GetDestination())
+		f.setUint("amount", data.QuTransfer.GetAmount())
+	case *api.Event_AssetIssuance:
+		f.set("assetIssuer", data.AssetIssuance.GetAssetIssuer())
+		f.setUint("numberOfShares", data.AssetIssuance.GetNumberOfShares())
+		f.setUint("managingContractIndex", data.AssetIssuance.GetManagingContractIndex())
+		f.set("assetName", data.AssetIssuance.GetAssetName())
+		f.setUint("numberOfDecimalPlaces", uint64(data.AssetIssuance.GetNumberOfDecimalPlaces()))
+		f.set("unitOfMeasurement", data.AssetIssuance.GetUnitOfMeasurement())
+	case *api.Event_AssetOwnershipChange:
+		f.set("source", data.AssetOwnershipChange.GetSource())
+		f.set("destination", data.AssetOwnershipChange.GetDestination())
+		f.set("assetIssuer", data.AssetOwnershipChange.GetAssetIssuer())
+		f.set("assetName", data.AssetOwnershipChange.GetAssetName())
+		f.setUint("numberOfShares", data.AssetOwnershipChange.GetNumberOfShares())
+	case *api.Event_AssetPossessionChange:
+		f.set("source", data.AssetPossessionChange.GetSource())
+		f.set("destination", data.AssetPossessionChange.GetDestination())
+		f.set("assetIssuer", data.AssetPossessionChange.GetAssetIssuer())
+		f.set("assetName", data.AssetPossessionChange.GetAssetName())
+		f.setUint("numberOfShares", data.AssetPossessionChange.GetNumberOfShares())
+	case *api.Event_Burning:
+		f.set("source", data.Burning.GetSource())
+		f.setUint("amount", data.Burning.GetAmount())
+		f.setUint("contractIndex", data.Burning.GetContractIndex())
+	case *api.Event_ContractReserveDeduction:
+		f.setUint("deductedAmount", data.ContractReserveDeduction.GetDeductedAmount())
+		f.setInt("remainingAmount", data.ContractReserveDeduction.GetRemainingAmount())
+		f.setUint("contractIndex", data.ContractReserveDeduction.GetContractIndex())
+	case *api.Event_SmartContractMessage:
+		f.setUint("contractIndex", data.SmartContractMessage.GetContractIndex())
+		f.setUint("contractMessageType", data.SmartContractMessage.GetContractMessageType())
+	case *api.Event_CustomMessage:
+		f.setUint("customMessage", data.CustomMessage.GetValue())
+	case *api.Event_AssetOwnershipManagingContractChange:
+		f.set("assetName", data.AssetOwnershipManagingContractChange.GetAssetName())
+		f.set("assetIssuer", data.AssetOwnershipManagingContractChange.GetAssetIssuer())
+		f.set("owner", data.AssetOwnershipManagingContractChange.GetOwner())
+		f.setUint("numberOfShares", data.AssetOwnershipManagingContractChange.GetNumberOfShares())
+		f.setUint("sourceContractIndex", data.AssetOwnershipManagingContractChange.GetSourceContractIndex())
+		f.setUint("destinationContractIndex", data.AssetOwnershipManagingContractChange.GetDestinationContractIndex())
+	case *api.Event_AssetPossessionManagingContractChange:
+		f.set("assetName", data.AssetPossessionManagingContractChange.GetAssetName())
+		f.set("assetIssuer", data.AssetPossessionManagingContractChange.GetAssetIssuer())
+		f.set("owner", data.AssetPossessionManagingContractChange.GetOwner())
+		f.set("possessor", data.AssetPossessionManagingContractChange.GetPossessor())
+		f.setUint("numberOfShares", data.AssetPossessionManagingContractChange.GetNumberOfShares())
+		f.setUint("sourceContractIndex", data.AssetPossessionManagingContractChange.GetSourceContractIndex())
+		f.setUint("destinationContractIndex", data.AssetPossessionManagingContractChange.GetDestinationContractIndex())
+	}
+	return f
+}
+
+// matchesTerm returns true, if one of the field values equals one of the filter values (term and terms query).
+func (f fields) matchesTerm(name string, values []string) bool {
+	for _, value := range f[name] {
+		if slices.Contains(values, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesRange returns true, if one of the field values is within all bounds of the range (range query).
+func (f fields) matchesRange(name string, ranges []entities.Range) bool {
+	for _, value := range f[name] {
+		if inRange(value, ranges) {
+			return true
+		}
+	}
+	return false
+}
+
+func inRange(value string, ranges []entities.Range) bool {
+	for _, r := range ranges {
+		c := compareValues(value, r.Value)
+		var ok bool
+		switch r.Operation {
+		case "gt":
+			ok = c > 0
+		case "gte":
+			ok = c >= 0
+		case "lt":
+			ok = c < 0
+		case "lte":
+			ok = c <= 0
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// compareValues compares integers numerically (without precision loss) and other values lexicographically.
+func compareValues(a, b string) int {
+	x, okX := new(big.Int).SetString(a, 10)
+	y, okY := new(big.Int).SetString(b, 10)
+	if okX && okY {
+		return x.Cmp(y)
+	}
+	return cmp.Compare(a, b)
+}
+
+// matches evaluates the filters like the bool query of the elastic repository. The upper tick number bound is
+// clamped to max tick, which is the same as restricting all results to max tick.
+func (f fields) matches(filters entities.Filters, maxTick uint32) bool {
+	if f.uint("tickNumber") > uint64(maxTick) {
+		return false
+	}
+	for name, values := range filters.Include {
+		if !f.matchesTerm(name, values) {
+			return false
+		}
+	}
+	for name, values := range filters.Exclude {
+		if f.matchesTerm(name, values) {
+			return false
+		}
+	}
+	for name, ranges := range filters.Ranges {
+		if !f.matchesRange(name, ranges) {
+			return false
+		}
+	}
+	for _, should := range filters.Should {
+		if !f.matchesShould(should) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesShould returns true, if one of the terms or ranges matches (minimum should match 1).
+func (f fields) matchesShould(should entities.ShouldFilter) bool {
+	for name, values := range should.Terms {
+		if f.matchesTerm(name, values) {
+			return true
+		}
+	}
+	for name, ranges := range should.Ranges {
+		if f.matchesRange(name, ranges) {
+			return true
+		}
+	}
+	return len(should.Terms) == 0 && len(should.Ranges) == 0
+}
+
+// page returns the page of the sorted documents and the hits limited like the tracked total hits of elasticsearch.
+func page[T proto.Message](docs []document[T], from, size uint32) ([]T, *entities.Hits) {
+	hits := &entities.Hits{Total: len(docs), Relation: "eq"}
+	if hits.Total > maxTrackTotalHits {
+		hits.Total = maxTrackTotalHits
+		hits.Relation = "gte"
+	}
+	start := min(int(from), len(docs))
+	end := min(start+int(size), len(docs))
+	return values(docs[start:end]), hits
+}
+
+// values returns copies of the api objects. The services modify the returned objects.
+func values[T proto.Message](docs []document[T]) []T {
+	result := make([]T, 0, len(docs))
+	for _, doc := range docs {
+		result = append(result, clone(doc.value))
+	}
+	return result
+}
+
+func clone[T proto.Message](value T) T {
+	return proto.Clone(value).(T)
+}
+
+// export passes the documents in batches to the callback, but not more than limit.
+func export[T proto.Message](docs []document[T], limit uint32, fn func([]T) error) error {
+	docs = docs[:min(int(limit), len(docs))]
+	for start := 0; start < len(docs); start += exportBatchSize {
+		if err := fn(values(docs[start:min(start+exportBatchSize, len(docs))])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+const exportBatchSize = 1000
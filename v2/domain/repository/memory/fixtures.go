@@ -0,0 +1,141 @@
+package memory
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	statusPb "github.com/qubic/go-data-publisher/status-service/protobuf"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Fixtures is the data of the in-memory repositories and the status service stub.
+type Fixtures struct {
+	Status         *statusPb.GetStatusResponse
+	TickIntervals  []*statusPb.TickInterval
+	Transactions   []*api.Transaction
+	TickData       []*api.TickData
+	ComputorsLists []*api.ComputorList
+	Events         []*api.Event
+}
+
+// fixturesFile contains the objects in the json format of the api (the status in the format of the status service).
+type fixturesFile struct {
+	Status         json.RawMessage   `json:"status"`
+	TickIntervals  []json.RawMessage `json:"tickIntervals"`
+	Transactions   []json.RawMessage `json:"transactions"`
+	TickData       []json.RawMessage `json:"tickData"`
+	ComputorsLists []json.RawMessage `json:"computorsLists"`
+	Events         []json.RawMessage `json:"events"`
+}
+
+// LoadFixtures reads the fixtures from the json file. The status and the tick intervals are optional and derived from
+// the tick data and events, if missing.
+func LoadFixtures(path string) (*Fixtures, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading fixtures file: %w", err)
+	}
+	var file fixturesFile
+	if err = json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parsing fixtures file: %w", err)
+	}
+
+	var fixtures Fixtures
+	if len(file.Status) > 0 {
+		fixtures.Status = &statusPb.GetStatusResponse{}
+		if err = protojson.Unmarshal(file.Status, fixtures.Status); err != nil {
+			return nil, fmt.Errorf("parsing status: %w", err)
+		}
+	}
+	if fixtures.TickIntervals, err = unmarshalAll[statusPb.TickInterval]("tick interval", file.TickIntervals); err != nil {
+		return nil, err
+	}
+	if fixtures.Transactions, err = unmarshalAll[api.Transaction]("transaction", file.Transactions); err != nil {
+		return nil, err
+	}
+	if fixtures.TickData, err = unmarshalAll[api.TickData]("tick data", file.TickData); err != nil {
+		return nil, err
+	}
+	if fixtures.ComputorsLists, err = unmarshalAll[api.ComputorList]("computors list", file.ComputorsLists); err != nil {
+		return nil, err
+	}
+	if fixtures.Events, err = unmarshalAll[api.Event]("event", file.Events); err != nil {
+		return nil, err
+	}
+
+	if len(fixtures.TickIntervals) == 0 {
+		fixtures.TickIntervals = fixtures.deriveTickIntervals()
+	}
+	if fixtures.Status == nil {
+		fixtures.Status = fixtures.deriveStatus()
+	}
+	return &fixtures, nil
+}
+
+func unmarshalAll[T any, M interface {
+	*T
+	proto.Message
+}](name string, raw []json.RawMessage) ([]M, error) {
+	messages := make([]M, 0, len(raw))
+	for i, r := range raw {
+		message := M(new(T))
+		if err := protojson.Unmarshal(r, message); err != nil {
+			return nil, fmt.Errorf("parsing %s [%d]: %w", name, i, err)
+		}
+		messages = append(messages, message)
+	}
+	return messages, nil
+}
+
+// deriveTickIntervals creates one interval per epoch from the first to the last tick of the tick data and events.
+func (f *Fixtures) deriveTickIntervals() []*statusPb.TickInterval {
+	intervals := map[uint32]*statusPb.TickInterval{}
+	add := func(epoch, tick uint32) {
+		interval, ok := intervals[epoch]
+		if !ok {
+			intervals[epoch] = &statusPb.TickInterval{Epoch: epoch, FirstTick: tick, LastTick: tick}
+			return
+		}
+		interval.FirstTick = min(interval.FirstTick, tick)
+		interval.LastTick = max(interval.LastTick, tick)
+	}
+	for _, td := range f.TickData {
+		add(td.GetEpoch(), td.GetTickNumber())
+	}
+	for _, e := range f.Events {
+		add(e.GetEpoch(), e.GetTickNumber())
+	}
+
+	sorted := make([]*statusPb.TickInterval, 0, len(intervals))
+	for _, interval := range intervals {
+		sorted = append(sorted, interval)
+	}
+	slices.SortFunc(sorted, func(a, b *statusPb.TickInterval) int {
+		return cmp.Compare(a.GetEpoch(), b.GetEpoch())
+	})
+	return sorted
+}
+
+// deriveStatus processes all data up to the last tick of the fixtures. The processing epoch is the last epoch of the
+// tick intervals.
+func (f *Fixtures) deriveStatus() *statusPb.GetStatusResponse {
+	var lastTick uint32
+	for _, interval := range f.TickIntervals {
+		lastTick = max(lastTick, interval.GetLastTick())
+	}
+	for _, tx := range f.Transactions {
+		lastTick = max(lastTick, tx.GetTickNumber())
+	}
+	status := &statusPb.GetStatusResponse{LastProcessedTick: lastTick, LastProcessedLogTick: lastTick}
+	if len(f.TickIntervals) > 0 {
+		last := f.TickIntervals[len(f.TickIntervals)-1]
+		status.ProcessingEpoch = last.GetEpoch()
+		status.IntervalInitialTick = last.GetFirstTick()
+	}
+	return status
+}
@@ -0,0 +1,77 @@
+package memory
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadFixtures_GivenDevFixtures_ThenDerivedStatus(t *testing.T) {
+	fixtures, err := LoadFixtures("../../../dev/fixtures.json")
+	require.NoError(t, err)
+	assert.NotEmpty(t, fixtures.Transactions)
+	assert.NotEmpty(t, fixtures.TickData)
+	assert.NotEmpty(t, fixtures.ComputorsLists)
+	assert.NotEmpty(t, fixtures.Events)
+
+	require.Len(t, fixtures.TickIntervals, 1)
+	assert.Equal(t, uint32(180), fixtures.TickIntervals[0].GetEpoch())
+	assert.Equal(t, uint32(30000000), fixtures.TickIntervals[0].GetFirstTick())
+	assert.Equal(t, uint32(30000006), fixtures.TickIntervals[0].GetLastTick())
+	assert.Equal(t, uint32(30000006), fixtures.Status.GetLastProcessedTick())
+	assert.Equal(t, uint32(30000006), fixtures.Status.GetLastProcessedLogTick())
+	assert.Equal(t, uint32(180), fixtures.Status.GetProcessingEpoch())
+	assert.Equal(t, uint32(30000000), fixtures.Status.GetIntervalInitialTick())
+}
+
+func TestLoadFixtures_GivenStatus_ThenNotDerived(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fixtures.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{
+		"status": {"lastProcessedTick": 15, "processingEpoch": 2, "lastProcessedLogTick": 14},
+		"tickIntervals": [{"epoch": 2, "firstTick": 10, "lastTick": 15}],
+		"transactions": [{"hash": "a", "amount": "1", "tickNumber": 12}]
+	}`), 0o600))
+
+	fixtures, err := LoadFixtures(path)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(14), fixtures.Status.GetLastProcessedLogTick())
+	require.Len(t, fixtures.TickIntervals, 1)
+	assert.Equal(t, uint32(10), fixtures.TickIntervals[0].GetFirstTick())
+	require.Len(t, fixtures.Transactions, 1)
+	assert.Equal(t, uint64(1), fixtures.Transactions[0].GetAmount())
+}
+
+func TestLoadFixtures_GivenInvalidObject_ThenError(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fixtures.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"events": [{"epoch": 1}, {"unknown": 1}]}`), 0o600))
+
+	_, err := LoadFixtures(path)
+	require.ErrorContains(t, err, "parsing event [1]")
+
+	_, err = LoadFixtures(filepath.Join(t.TempDir(), "missing.json"))
+	require.ErrorContains(t, err, "reading fixtures file")
+}
+
+func TestStatusServiceClient(t *testing.T) {
+	fixtures, err := LoadFixtures("../../../dev/fixtures.json")
+	require.NoError(t, err)
+	client := NewStatusServiceClient(fixtures.Status, fixtures.TickIntervals)
+
+	status, err := client.GetStatus(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(30000006), status.GetLastProcessedTick())
+
+	intervals, err := client.GetTickIntervals(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Len(t, intervals.GetIntervals(), 1)
+
+	archiverStatus, err := client.GetArchiverStatus(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(30000006), archiverStatus.GetLastProcessedTicksPerEpoch()[180])
+	require.Len(t, archiverStatus.GetProcessedTickIntervalsPerEpoch(), 1)
+	assert.Equal(t, uint32(30000000), archiverStatus.GetProcessedTickIntervalsPerEpoch()[0].GetIntervals()[0].GetInitialProcessedTick())
+}
@@ -0,0 +1,65 @@
+package memory
+
+import (
+	"context"
+
+	statusPb "github.com/qubic/go-data-publisher/status-service/protobuf"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var _ statusPb.StatusServiceClient = &StatusServiceClient{}
+
+// StatusServiceClient is a status service stub that returns a fixed status. Replaces the status service connection in
+// development mode.
+type StatusServiceClient struct {
+	status    *statusPb.GetStatusResponse
+	intervals []*statusPb.TickInterval
+}
+
+func NewStatusServiceClient(status *statusPb.GetStatusResponse, intervals []*statusPb.TickInterval) *StatusServiceClient {
+	return &StatusServiceClient{status: status, intervals: intervals}
+}
+
+func (c *StatusServiceClient) GetStatus(_ context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*statusPb.GetStatusResponse, error) {
+	return clone(c.status), nil
+}
+
+func (c *StatusServiceClient) GetTickIntervals(_ context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*statusPb.GetTickIntervalsResponse, error) {
+	intervals := make([]*statusPb.TickInterval, 0, len(c.intervals))
+	for _, interval := range c.intervals {
+		intervals = append(intervals, clone(interval))
+	}
+	return &statusPb.GetTickIntervalsResponse{Intervals: intervals}, nil
+}
+
+// GetArchiverStatus returns the tick intervals in the format of the archiver status. There are no skipped ticks.
+func (c *StatusServiceClient) GetArchiverStatus(_ context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*statusPb.GetArchiverStatusResponse, error) {
+	response := &statusPb.GetArchiverStatusResponse{
+		LastProcessedTick: &statusPb.ProcessedTick{
+			TickNumber: c.status.GetLastProcessedTick(),
+			Epoch:      c.status.GetProcessingEpoch(),
+		},
+		LastProcessedTicksPerEpoch: map[uint32]uint32{},
+	}
+	for _, interval := range c.intervals {
+		lastTick := min(interval.GetLastTick(), c.status.GetLastProcessedTick())
+		response.LastProcessedTicksPerEpoch[interval.GetEpoch()] = lastTick
+		response.ProcessedTickIntervalsPerEpoch = append(response.ProcessedTickIntervalsPerEpoch, &statusPb.ProcessedTickIntervalsPerEpoch{
+			Epoch: interval.GetEpoch(),
+			Intervals: []*statusPb.ProcessedTickInterval{{
+				InitialProcessedTick: interval.GetFirstTick(),
+				LastProcessedTick:    lastTick,
+			}},
+		})
+	}
+	return response, nil
+}
+
+func (c *StatusServiceClient) GetErroneousSkippedTicks(_ context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*statusPb.GetSkippedTicksResponse, error) {
+	return &statusPb.GetSkippedTicksResponse{}, nil
+}
+
+func (c *StatusServiceClient) GetHealthCheck(_ context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*statusPb.GetHealthCheckResponse, error) {
+	return &statusPb.GetHealthCheckResponse{Status: "UP"}, nil
+}
@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	statusPb "github.com/qubic/go-data-publisher/status-service/protobuf"
+)
+
+// TickWatcher polls the status and notifies all subscribers, if new ticks or event logs are processed. There is only
+// one watcher per service instance, so that the number of subscribers does not influence the number of status requests.
+type TickWatcher struct {
+	statusFetcher StatusFetcherFunc
+	interval      time.Duration
+	mutex         sync.Mutex
+	subscribers   map[chan *statusPb.GetStatusResponse]struct{}
+	status        *statusPb.GetStatusResponse
+	stop          chan struct{}
+	stopOnce      sync.Once
+}
+
+func NewTickWatcher(statusFetcher StatusFetcherFunc, interval time.Duration) *TickWatcher {
+	return &TickWatcher{
+		statusFetcher: statusFetcher,
+		interval:      interval,
+		subscribers:   map[chan *statusPb.GetStatusResponse]struct{}{},
+		stop:          make(chan struct{}),
+	}
+}
+
+// Start polls the status until the watcher is stopped.
+func (w *TickWatcher) Start() {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		w.poll()
+		select {
+		case <-w.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *TickWatcher) Stop() {
+	w.stopOnce.Do(func() { close(w.stop) })
+}
+
+// Subscribe returns a channel that receives the status whenever the processed tick or log tick changes. The current
+// status is sent immediately, if it is known already. The channel only holds the latest status. Subscribers that are
+// too slow miss intermediate updates but never block the watcher. The returned function needs to be called to
+// unsubscribe.
+func (w *TickWatcher) Subscribe() (<-chan *statusPb.GetStatusResponse, func()) {
+	updates := make(chan *statusPb.GetStatusResponse, 1)
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	w.subscribers[updates] = struct{}{}
+	if w.status != nil {
+		updates <- w.status
+	}
+	return updates, func() {
+		w.mutex.Lock()
+		defer w.mutex.Unlock()
+		delete(w.subscribers, updates)
+	}
+}
+
+func (w *TickWatcher) poll() {
+	ctx, cancel := context.WithTimeout(context.Background(), w.interval)
+	defer cancel()
+	status, err := w.statusFetcher(ctx)
+	if err != nil || status == nil {
+		log.Printf("[WARN] tick watcher: getting status: %v", err)
+		return
+	}
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	if w.status != nil && w.status.GetLastProcessedTick() == status.GetLastProcessedTick() &&
+		w.status.GetLastProcessedLogTick() == status.GetLastProcessedLogTick() {
+		return
+	}
+	w.status = status
+	for updates := range w.subscribers {
+		select { // replace the pending status, if the subscriber did not read it yet
+		case <-updates:
+		default:
+		}
+		updates <- status
+	}
+}
@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	statusPb "github.com/qubic/go-data-publisher/status-service/protobuf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTickWatcher_Subscribe(t *testing.T) {
+	var current *statusPb.GetStatusResponse
+	watcher := NewTickWatcher(func(context.Context) (*statusPb.GetStatusResponse, error) {
+		return current, nil
+	}, time.Second)
+
+	updates, unsubscribe := watcher.Subscribe()
+	assert.Empty(t, updates, "status not known yet")
+
+	current = &statusPb.GetStatusResponse{LastProcessedTick: 10, LastProcessedLogTick: 9}
+	watcher.poll()
+	require.Len(t, updates, 1)
+	assert.Equal(t, current, <-updates)
+
+	watcher.poll()
+	assert.Empty(t, updates, "no new ticks")
+
+	// slow subscribers only get the latest status
+	current = &statusPb.GetStatusResponse{LastProcessedTick: 11, LastProcessedLogTick: 9}
+	watcher.poll()
+	current = &statusPb.GetStatusResponse{LastProcessedTick: 11, LastProcessedLogTick: 11}
+	watcher.poll()
+	require.Len(t, updates, 1)
+	assert.Equal(t, current, <-updates)
+
+	// new subscribers get the current status immediately
+	other, unsubscribeOther := watcher.Subscribe()
+	defer unsubscribeOther()
+	require.Len(t, other, 1)
+	assert.Equal(t, current, <-other)
+
+	unsubscribe()
+	current = &statusPb.GetStatusResponse{LastProcessedTick: 12, LastProcessedLogTick: 12}
+	watcher.poll()
+	assert.Empty(t, updates)
+	require.Len(t, other, 1)
+	assert.Equal(t, current, <-other)
+}
+
+func TestTickWatcher_StartStop(t *testing.T) {
+	watcher := NewTickWatcher(fixedStatusFetcher(&statusPb.GetStatusResponse{LastProcessedTick: 10}), time.Millisecond)
+	updates, unsubscribe := watcher.Subscribe()
+	defer unsubscribe()
+
+	done := make(chan struct{})
+	go func() {
+		watcher.Start()
+		close(done)
+	}()
+	assert.Equal(t, uint32(10), (<-updates).GetLastProcessedTick())
+
+	watcher.Stop()
+	watcher.Stop() // stopping twice is fine
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("watcher did not stop")
+	}
+}
@@ -1,24 +1,41 @@
 package domain
 
 import (
+	"cmp"
 	"context"
 	"errors"
+	"fmt"
+	"slices"
+	"sync"
 
 	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	"github.com/qubic/archive-query-service/v2/entities"
+	statusPb "github.com/qubic/go-data-publisher/status-service/protobuf"
+	"github.com/qubic/go-node-connector/types"
 )
 
 //go:generate go tool go.uber.org/mock/mockgen -destination=mock/tickdata.mock.go -package=mock -source tickdata.go
 type TickDataRepository interface {
 	GetTickData(ctx context.Context, tickNumber uint32) (*api.TickData, error)
+	GetTickDataRange(ctx context.Context, fromTick, toTick uint32) ([]*api.TickData, error)
+	GetTickNumbers(ctx context.Context, epoch, fromTick, toTick uint32) ([]uint32, error)
+	GetComputorTickCounts(ctx context.Context, epoch uint32) (map[uint32]uint32, error)
 }
 
+type TickIntervalsFetcherFunc func(ctx context.Context) ([]*statusPb.TickInterval, error)
+
 type TickDataService struct {
-	repo TickDataRepository
+	repo             TickDataRepository
+	intervalsFetcher TickIntervalsFetcherFunc
+	emptyTicksMutex  sync.Mutex
+	emptyTicks       map[uint32]*emptyTicks // key is the epoch
 }
 
-func NewTickDataService(repo TickDataRepository) *TickDataService {
+func NewTickDataService(repo TickDataRepository, intervalsFetcher TickIntervalsFetcherFunc) *TickDataService {
 	return &TickDataService{
-		repo: repo,
+		repo:             repo,
+		intervalsFetcher: intervalsFetcher,
+		emptyTicks:       make(map[uint32]*emptyTicks),
 	}
 }
 
@@ -30,3 +47,145 @@ func (s *TickDataService) GetTickData(ctx context.Context, tickNumber uint32) (*
 	} // empty tick
 	return tickData, err
 }
+
+// GetTickDataRange Returns the tick data of the non-empty ticks within the tick range (inclusive) in ascending order.
+func (s *TickDataService) GetTickDataRange(ctx context.Context, fromTick, toTick uint32) ([]*api.TickData, error) {
+	return s.repo.GetTickDataRange(ctx, fromTick, toTick)
+}
+
+// GetTicksForEpoch Returns the processed ticks of the epoch or nil, if there are no processed ticks for the epoch.
+func (s *TickDataService) GetTicksForEpoch(ctx context.Context, epoch, from, size uint32, desc bool) (*entities.EpochTicksResult, error) {
+	intervals, et, err := s.getEmptyTicks(ctx, epoch)
+	if err != nil || et == nil {
+		return nil, err
+	}
+
+	var total uint32
+	for _, interval := range intervals {
+		total += interval.LastTick - interval.FirstTick + 1
+	}
+	if desc {
+		slices.Reverse(intervals)
+	}
+
+	et.mutex.Lock()
+	defer et.mutex.Unlock()
+
+	ticks := make([]*api.EpochTick, 0, min(size, total))
+	skip := from
+	for _, interval := range intervals {
+		length := interval.LastTick - interval.FirstTick + 1
+		if skip >= length {
+			skip -= length
+			continue
+		}
+		for i := skip; i < length && uint32(len(ticks)) < size; i++ {
+			tickNumber := interval.FirstTick + i
+			if desc {
+				tickNumber = interval.LastTick - i
+			}
+			ticks = append(ticks, &api.EpochTick{TickNumber: tickNumber, IsEmpty: et.isEmpty(tickNumber)})
+		}
+		skip = 0
+		if uint32(len(ticks)) >= size {
+			break
+		}
+	}
+
+	return &entities.EpochTicksResult{Total: total, Ticks: ticks}, nil
+}
+
+// GetEmptyTicksForEpoch Returns the empty ticks of the epoch in ascending order or nil, if there are no processed
+// ticks for the epoch.
+func (s *TickDataService) GetEmptyTicksForEpoch(ctx context.Context, epoch, from, size uint32) (*entities.EmptyTicksResult, error) {
+	_, et, err := s.getEmptyTicks(ctx, epoch)
+	if err != nil || et == nil {
+		return nil, err
+	}
+
+	et.mutex.Lock()
+	defer et.mutex.Unlock()
+	return &entities.EmptyTicksResult{Total: et.count(), TickNumbers: et.tickNumbers(from, size)}, nil
+}
+
+// GetComputorTickStats Returns the number of produced and empty ticks per computor index (ordered by computor index)
+// or nil, if there are no processed ticks for the epoch. Empty ticks are counted for the tick leader, that is the
+// computor with the index tick number modulo number of computors.
+func (s *TickDataService) GetComputorTickStats(ctx context.Context, epoch uint32) ([]*api.ComputorTickStats, error) {
+	_, et, err := s.getEmptyTicks(ctx, epoch)
+	if err != nil || et == nil {
+		return nil, err
+	}
+
+	producedTicks, err := s.repo.GetComputorTickCounts(ctx, epoch)
+	if err != nil {
+		return nil, fmt.Errorf("getting computor tick counts for epoch [%d]: %w", epoch, err)
+	}
+
+	stats := make([]*api.ComputorTickStats, types.NumberOfComputors)
+	for i := range stats {
+		index := uint32(i) //nolint: gosec
+		stats[i] = &api.ComputorTickStats{ComputorIndex: index, ProducedTicks: producedTicks[index]}
+	}
+
+	et.mutex.Lock()
+	defer et.mutex.Unlock()
+	et.forEach(func(tickNumber uint32) {
+		stats[tickNumber%types.NumberOfComputors].EmptyTicks++
+	})
+	return stats, nil
+}
+
+// getEmptyTicks returns the sorted tick intervals of the epoch and the empty ticks within them. The empty ticks are
+// loaded on first access and then only updated with the ticks that got processed since the last access.
+func (s *TickDataService) getEmptyTicks(ctx context.Context, epoch uint32) ([]*statusPb.TickInterval, *emptyTicks, error) {
+	intervals, err := s.intervalsFetcher(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("getting tick intervals: %w", err)
+	}
+
+	epochIntervals := make([]*statusPb.TickInterval, 0)
+	for _, interval := range intervals {
+		if interval.GetEpoch() == epoch && interval.GetLastTick() >= interval.GetFirstTick() {
+			epochIntervals = append(epochIntervals, interval)
+		}
+	}
+	if len(epochIntervals) == 0 {
+		return nil, nil, nil
+	}
+	slices.SortFunc(epochIntervals, func(a, b *statusPb.TickInterval) int {
+		return cmp.Compare(a.FirstTick, b.FirstTick)
+	})
+
+	s.emptyTicksMutex.Lock()
+	et, ok := s.emptyTicks[epoch]
+	if !ok || et.startTick != epochIntervals[0].FirstTick { // create or reset, if the intervals changed
+		et = newEmptyTicks(epochIntervals[0].FirstTick)
+		s.emptyTicks[epoch] = et
+	}
+	s.emptyTicksMutex.Unlock()
+
+	et.mutex.Lock() // loading is costly. don't load the same epoch in parallel.
+	defer et.mutex.Unlock()
+	for _, interval := range epochIntervals {
+		if interval.LastTick <= et.endTick {
+			continue
+		}
+		fromTick := max(et.endTick+1, interval.FirstTick) // do not reload ticks we already have
+		tickNumbers, err := s.repo.GetTickNumbers(ctx, epoch, fromTick, interval.LastTick)
+		if err != nil {
+			return nil, nil, fmt.Errorf("getting tick numbers from [%d] to [%d] in epoch [%d]: %w", fromTick, interval.LastTick, epoch, err)
+		}
+		// only the gaps are empty
+		next := fromTick
+		for _, tickNumber := range append(tickNumbers, interval.LastTick+1) {
+			for tick := next; tick < tickNumber; tick++ {
+				et.set(tick)
+			}
+			next = tickNumber + 1
+		}
+		et.endTick = interval.LastTick
+	}
+
+	return epochIntervals, et, nil
+}
@@ -0,0 +1,178 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	"github.com/qubic/archive-query-service/v2/domain/mock"
+	statusPb "github.com/qubic/go-data-publisher/status-service/protobuf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func intervalsFetcher(intervals ...*statusPb.TickInterval) TickIntervalsFetcherFunc {
+	return func(context.Context) ([]*statusPb.TickInterval, error) {
+		return intervals, nil
+	}
+}
+
+func TestTickDataService_GetTickData_GivenNotFound_ThenNil(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	repo := mock.NewMockTickDataRepository(ctrl)
+	service := NewTickDataService(repo, intervalsFetcher())
+	repo.EXPECT().GetTickData(gomock.Any(), uint32(42)).Return(nil, ErrNotFound)
+
+	td, err := service.GetTickData(context.Background(), 42)
+	require.NoError(t, err)
+	assert.Nil(t, td)
+}
+
+func TestTickDataService_GetTicksForEpoch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	repo := mock.NewMockTickDataRepository(ctrl)
+	service := NewTickDataService(repo, intervalsFetcher(
+		&statusPb.TickInterval{Epoch: 99, FirstTick: 1, LastTick: 99},
+		&statusPb.TickInterval{Epoch: 100, FirstTick: 100, LastTick: 104},
+		&statusPb.TickInterval{Epoch: 100, FirstTick: 200, LastTick: 202},
+	))
+	repo.EXPECT().GetTickNumbers(gomock.Any(), uint32(100), uint32(100), uint32(104)).Return([]uint32{100, 103}, nil)
+	repo.EXPECT().GetTickNumbers(gomock.Any(), uint32(100), uint32(200), uint32(202)).Return([]uint32{201}, nil)
+
+	result, err := service.GetTicksForEpoch(context.Background(), 100, 0, 100, false)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(8), result.Total)
+	assert.Equal(t, []*api.EpochTick{
+		{TickNumber: 100},
+		{TickNumber: 101, IsEmpty: true},
+		{TickNumber: 102, IsEmpty: true},
+		{TickNumber: 103},
+		{TickNumber: 104, IsEmpty: true},
+		{TickNumber: 200, IsEmpty: true},
+		{TickNumber: 201},
+		{TickNumber: 202, IsEmpty: true},
+	}, result.Ticks)
+
+	// paged and descending. empty ticks are cached.
+	result, err = service.GetTicksForEpoch(context.Background(), 100, 2, 3, true)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(8), result.Total)
+	assert.Equal(t, []*api.EpochTick{
+		{TickNumber: 200, IsEmpty: true},
+		{TickNumber: 104, IsEmpty: true},
+		{TickNumber: 103},
+	}, result.Ticks)
+}
+
+func TestTickDataService_GetEmptyTicksForEpoch_GivenNewTicks_ThenOnlyLoadNewTicks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	repo := mock.NewMockTickDataRepository(ctrl)
+	interval := &statusPb.TickInterval{Epoch: 100, FirstTick: 1000, LastTick: 1100}
+	service := NewTickDataService(repo, intervalsFetcher(interval))
+	repo.EXPECT().GetTickNumbers(gomock.Any(), uint32(100), uint32(1000), uint32(1100)).Return([]uint32{1000, 1050, 1100}, nil)
+
+	result, err := service.GetEmptyTicksForEpoch(context.Background(), 100, 0, 1000)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(98), result.Total)
+	assert.Len(t, result.TickNumbers, 98)
+	assert.Equal(t, uint32(1001), result.TickNumbers[0])
+	assert.NotContains(t, result.TickNumbers, uint32(1050))
+
+	interval.LastTick = 1200
+	repo.EXPECT().GetTickNumbers(gomock.Any(), uint32(100), uint32(1101), uint32(1200)).Return([]uint32{}, nil)
+
+	result, err = service.GetEmptyTicksForEpoch(context.Background(), 100, 97, 3)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(198), result.Total)
+	assert.Equal(t, []uint32{1099, 1101, 1102}, result.TickNumbers)
+}
+
+func TestTickDataService_GetTicksForEpoch_GivenUnknownEpoch_ThenNil(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	repo := mock.NewMockTickDataRepository(ctrl)
+	service := NewTickDataService(repo, intervalsFetcher(&statusPb.TickInterval{Epoch: 100, FirstTick: 1, LastTick: 2}))
+
+	result, err := service.GetTicksForEpoch(context.Background(), 101, 0, 10, false)
+	require.NoError(t, err)
+	assert.Nil(t, result)
+
+	emptyResult, err := service.GetEmptyTicksForEpoch(context.Background(), 101, 0, 10)
+	require.NoError(t, err)
+	assert.Nil(t, emptyResult)
+}
+
+func TestTickDataService_GetTicksForEpoch_GivenRepositoryError_ThenError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	repo := mock.NewMockTickDataRepository(ctrl)
+	service := NewTickDataService(repo, intervalsFetcher(&statusPb.TickInterval{Epoch: 100, FirstTick: 1, LastTick: 2}))
+	repo.EXPECT().GetTickNumbers(gomock.Any(), uint32(100), uint32(1), uint32(2)).Return(nil, errors.New("test"))
+
+	_, err := service.GetTicksForEpoch(context.Background(), 100, 0, 10, false)
+	require.ErrorContains(t, err, "getting tick numbers")
+}
+
+func TestTickDataService_GetComputorTickStats(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	repo := mock.NewMockTickDataRepository(ctrl)
+	service := NewTickDataService(repo, intervalsFetcher(&statusPb.TickInterval{Epoch: 100, FirstTick: 674, LastTick: 1353}))
+	// empty ticks: 675, 677, 1352 (1352 % 676 = 0)
+	tickNumbers := make([]uint32, 0)
+	for tick := uint32(674); tick <= 1353; tick++ {
+		if tick != 675 && tick != 677 && tick != 1352 {
+			tickNumbers = append(tickNumbers, tick)
+		}
+	}
+	repo.EXPECT().GetTickNumbers(gomock.Any(), uint32(100), uint32(674), uint32(1353)).Return(tickNumbers, nil)
+	repo.EXPECT().GetComputorTickCounts(gomock.Any(), uint32(100)).Return(map[uint32]uint32{0: 1, 1: 2, 675: 1}, nil)
+
+	stats, err := service.GetComputorTickStats(context.Background(), 100)
+	require.NoError(t, err)
+	require.Len(t, stats, 676)
+	assert.Equal(t, &api.ComputorTickStats{ComputorIndex: 0, ProducedTicks: 1, EmptyTicks: 1}, stats[0])
+	assert.Equal(t, &api.ComputorTickStats{ComputorIndex: 1, ProducedTicks: 2, EmptyTicks: 1}, stats[1])
+	assert.Equal(t, &api.ComputorTickStats{ComputorIndex: 2, ProducedTicks: 0, EmptyTicks: 0}, stats[2])
+	assert.Equal(t, &api.ComputorTickStats{ComputorIndex: 675, ProducedTicks: 1, EmptyTicks: 1}, stats[675])
+}
+
+func TestTickDataService_GetComputorTickStats_GivenUnknownEpoch_ThenNil(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	repo := mock.NewMockTickDataRepository(ctrl)
+	service := NewTickDataService(repo, intervalsFetcher(&statusPb.TickInterval{Epoch: 100, FirstTick: 1, LastTick: 2}))
+
+	stats, err := service.GetComputorTickStats(context.Background(), 101)
+	require.NoError(t, err)
+	assert.Nil(t, stats)
+}
+
+func TestEmptyTicks(t *testing.T) {
+	et := newEmptyTicks(1000)
+	for _, tick := range []uint32{1000, 1063, 1064, 1200} {
+		et.set(tick)
+	}
+
+	assert.True(t, et.isEmpty(1000))
+	assert.True(t, et.isEmpty(1063))
+	assert.True(t, et.isEmpty(1064))
+	assert.True(t, et.isEmpty(1200))
+	assert.False(t, et.isEmpty(999))
+	assert.False(t, et.isEmpty(1001))
+	assert.False(t, et.isEmpty(5000))
+	assert.Equal(t, uint32(4), et.count())
+	assert.Equal(t, []uint32{1000, 1063, 1064, 1200}, et.tickNumbers(0, 10))
+	assert.Equal(t, []uint32{1064, 1200}, et.tickNumbers(2, 10))
+	assert.Equal(t, []uint32{1063}, et.tickNumbers(1, 1))
+	assert.Empty(t, et.tickNumbers(4, 10))
+
+	var all []uint32
+	et.forEach(func(tickNumber uint32) { all = append(all, tickNumber) })
+	assert.Equal(t, []uint32{1000, 1063, 1064, 1200}, all)
+}
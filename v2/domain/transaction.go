@@ -3,10 +3,13 @@ package domain
 import (
 	"context"
 	"errors"
+	"log"
 
 	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	"github.com/qubic/archive-query-service/v2/domain/decoder"
 	"github.com/qubic/archive-query-service/v2/entities"
 	statusPb "github.com/qubic/go-data-publisher/status-service/protobuf"
+	"github.com/qubic/go-node-connector/types"
 )
 
 //go:generate go tool go.uber.org/mock/mockgen -destination=mock/transactions.mock.go -package=mock -source transaction.go
@@ -41,11 +44,20 @@ func (s *TransactionService) GetTransactionByHash(ctx context.Context, hash stri
 	if errors.Is(err, ErrNotFound) {
 		return nil, nil
 	}
-	return tx, err
+	if err != nil {
+		return nil, err
+	}
+	setExecutionStatus(tx)
+	return tx, nil
 }
 
 func (s *TransactionService) GetTransactionsForTickNumber(ctx context.Context, tickNumber uint32, filters map[string][]string, ranges map[string][]entities.Range) ([]*api.Transaction, error) {
-	return s.repo.GetTransactionsForTickNumber(ctx, tickNumber, filters, ranges)
+	txs, err := s.repo.GetTransactionsForTickNumber(ctx, tickNumber, filters, ranges)
+	if err != nil {
+		return nil, err
+	}
+	setExecutionStatuses(txs)
+	return txs, nil
 }
 
 func (s *TransactionService) GetTransactionsForIdentity(ctx context.Context, identity string, filters entities.Filters, from, size uint32) (*entities.TransactionsResult, error) {
@@ -55,6 +67,43 @@ func (s *TransactionService) GetTransactionsForIdentity(ctx context.Context, ide
 		return nil, err
 	}
 	txs, hits, err := s.repo.GetTransactionsForIdentity(ctx, identity, status.LastProcessedTick, filters, from, size)
+	setExecutionStatuses(txs)
 	return &entities.TransactionsResult{LastProcessedTick: status.LastProcessedTick, Hits: hits, Transactions: txs}, err
 
 }
+
+func setExecutionStatuses(txs []*api.Transaction) {
+	for _, tx := range txs {
+		setExecutionStatus(tx)
+	}
+}
+
+// setExecutionStatus derives the execution status from money flew. The money flew information of send many
+// transactions is not reliable and needs to be corrected: the transfers are only executed, if money flew and the
+// amount covers the total amount of all transfers plus the fee.
+func setExecutionStatus(tx *api.Transaction) {
+	if isSendMany(tx) {
+		decoded, err := decoder.DecodeInput(tx)
+		if err != nil || decoded.GetSendMany() == nil {
+			log.Printf("[WARN] failed to recompute money flew for send many transaction [%s]: %v", tx.GetHash(), err)
+			tx.ExecutionStatus = api.ExecutionStatus_EXECUTION_STATUS_UNKNOWN
+			return
+		}
+		tx.MoneyFlew = tx.GetMoneyFlew() && int64(tx.GetAmount()) >= decoded.GetSendMany().GetTotalAmount() //nolint: gosec
+	}
+
+	switch {
+	case tx.GetMoneyFlew():
+		tx.ExecutionStatus = api.ExecutionStatus_EXECUTION_STATUS_EXECUTED
+	case tx.GetAmount() > 0:
+		tx.ExecutionStatus = api.ExecutionStatus_EXECUTION_STATUS_NOT_EXECUTED
+	default: // no money, no information
+		tx.ExecutionStatus = api.ExecutionStatus_EXECUTION_STATUS_UNKNOWN
+	}
+}
+
+func isSendMany(tx *api.Transaction) bool {
+	return tx.GetDestination() == types.QutilAddress &&
+		tx.GetInputType() == types.QutilSendManyInputType &&
+		tx.GetInputSize() == types.QutilSendManyInputSize
+}
@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"encoding/base64"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -10,6 +11,7 @@ import (
 	"github.com/qubic/archive-query-service/v2/domain/mock"
 	"github.com/qubic/archive-query-service/v2/entities"
 	statusPb "github.com/qubic/go-data-publisher/status-service/protobuf"
+	"github.com/qubic/go-node-connector/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -57,3 +59,117 @@ func TestTransactionService_GetTransactionByIdentity(t *testing.T) {
 	assert.Equal(t, apiTransactions, result.GetTransactions())
 	assert.Equal(t, entityHits, result.GetHits())
 }
+
+func sendManyTransaction(t *testing.T, amount uint64, transferAmounts ...int64) *api.Transaction {
+	var payload types.SendManyTransferPayload
+	for _, transferAmount := range transferAmounts {
+		err := payload.AddTransfer(types.SendManyTransfer{AddressID: "CAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAACNKL", Amount: transferAmount})
+		require.NoError(t, err)
+	}
+	input, err := payload.MarshallBinary()
+	require.NoError(t, err)
+
+	return &api.Transaction{
+		Hash:        "send-many-hash",
+		Destination: types.QutilAddress,
+		Amount:      amount,
+		InputType:   types.QutilSendManyInputType,
+		InputSize:   types.QutilSendManyInputSize,
+		InputData:   base64.StdEncoding.EncodeToString(input),
+		MoneyFlew:   true,
+	}
+}
+
+func TestTransactionService_GetTransactionByHash_ExecutionStatus(t *testing.T) {
+	tests := []struct {
+		name              string
+		tx                *api.Transaction
+		expectedMoneyFlew bool
+		expectedStatus    api.ExecutionStatus
+	}{
+		{
+			name:              "transfer executed",
+			tx:                &api.Transaction{Amount: 100, MoneyFlew: true},
+			expectedMoneyFlew: true,
+			expectedStatus:    api.ExecutionStatus_EXECUTION_STATUS_EXECUTED,
+		},
+		{
+			name:              "transfer not executed",
+			tx:                &api.Transaction{Amount: 100, MoneyFlew: false},
+			expectedMoneyFlew: false,
+			expectedStatus:    api.ExecutionStatus_EXECUTION_STATUS_NOT_EXECUTED,
+		},
+		{
+			name:              "zero amount",
+			tx:                &api.Transaction{Amount: 0, MoneyFlew: false},
+			expectedMoneyFlew: false,
+			expectedStatus:    api.ExecutionStatus_EXECUTION_STATUS_UNKNOWN,
+		},
+		{
+			name:              "send many with sufficient amount",
+			tx:                sendManyTransaction(t, 310, 100, 200),
+			expectedMoneyFlew: true,
+			expectedStatus:    api.ExecutionStatus_EXECUTION_STATUS_EXECUTED,
+		},
+		{
+			name:              "send many with insufficient amount",
+			tx:                sendManyTransaction(t, 300, 100, 200),
+			expectedMoneyFlew: false,
+			expectedStatus:    api.ExecutionStatus_EXECUTION_STATUS_NOT_EXECUTED,
+		},
+		{
+			name: "send many with sufficient amount and no money flew",
+			tx: func() *api.Transaction {
+				tx := sendManyTransaction(t, 310, 100, 200)
+				tx.MoneyFlew = false
+				return tx
+			}(),
+			expectedMoneyFlew: false,
+			expectedStatus:    api.ExecutionStatus_EXECUTION_STATUS_NOT_EXECUTED,
+		},
+		{
+			name: "send many with invalid payload",
+			tx: &api.Transaction{
+				Amount:      100,
+				Destination: types.QutilAddress,
+				InputType:   types.QutilSendManyInputType,
+				InputSize:   types.QutilSendManyInputSize,
+				InputData:   "AAAA",
+				MoneyFlew:   true,
+			},
+			expectedMoneyFlew: true,
+			expectedStatus:    api.ExecutionStatus_EXECUTION_STATUS_UNKNOWN,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			repo := mock.NewMockTransactionRepository(ctrl)
+			service := NewTransactionService(repo, statusFetcherFunc)
+			repo.EXPECT().GetTransactionByHash(gomock.Any(), "test-hash").Return(tc.tx, nil)
+
+			tx, err := service.GetTransactionByHash(context.Background(), "test-hash")
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedMoneyFlew, tx.MoneyFlew)
+			assert.Equal(t, tc.expectedStatus, tx.ExecutionStatus)
+		})
+	}
+}
+
+func TestTransactionService_GetTransactionsForTickNumber_RecomputesSendMany(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	repo := mock.NewMockTransactionRepository(ctrl)
+	service := NewTransactionService(repo, statusFetcherFunc)
+	repo.EXPECT().GetTransactionsForTickNumber(gomock.Any(), uint32(42), nil, nil).
+		Return([]*api.Transaction{sendManyTransaction(t, 10, 100), {Amount: 1, MoneyFlew: true}}, nil)
+
+	txs, err := service.GetTransactionsForTickNumber(context.Background(), 42, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, txs, 2)
+	assert.False(t, txs[0].MoneyFlew)
+	assert.Equal(t, api.ExecutionStatus_EXECUTION_STATUS_NOT_EXECUTED, txs[0].ExecutionStatus)
+	assert.Equal(t, api.ExecutionStatus_EXECUTION_STATUS_EXECUTED, txs[1].ExecutionStatus)
+}
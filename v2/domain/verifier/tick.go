@@ -0,0 +1,172 @@
+package verifier
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cloudflare/circl/xof/k12"
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	"github.com/qubic/go-node-connector/types"
+	"github.com/qubic/go-schnorrq"
+)
+
+// the message type of broadcasted tick data. The computor index is xor-ed with it before signing.
+const broadcastFutureTickDataType = 8
+
+// VerifyComputorList verifies that the computor list is signed by the arbitrator. Returns nil, if the signature is valid.
+func VerifyComputorList(computorList *api.ComputorList) error {
+	computors, err := toQubicComputors(computorList)
+	if err != nil {
+		return fmt.Errorf("converting computor list: %w", err)
+	}
+
+	arbitrator := types.Identity(types.ArbitratorIdentity)
+	arbitratorPubKey, err := arbitrator.ToPubKey(false)
+	if err != nil {
+		return fmt.Errorf("getting arbitrator public key: %w", err)
+	}
+
+	digest, err := signedDigest(computors)
+	if err != nil {
+		return fmt.Errorf("calculating computor list digest: %w", err)
+	}
+
+	return schnorrq.Verify(arbitratorPubKey, digest, computors.Signature)
+}
+
+// VerifyTickData verifies that the tick data is signed by the tick leader (the computor with the computor index of the
+// tick data) of the given computor list. Returns nil, if the signature is valid.
+func VerifyTickData(tickData *api.TickData, computorList *api.ComputorList) error {
+	identities := computorList.GetIdentities()
+	if int(tickData.GetComputorIndex()) >= len(identities) {
+		return fmt.Errorf("invalid computor index [%d]", tickData.GetComputorIndex())
+	}
+
+	computor := types.Identity(identities[tickData.GetComputorIndex()])
+	computorPubKey, err := computor.ToPubKey(false)
+	if err != nil {
+		return fmt.Errorf("getting public key of computor [%s]: %w", computor, err)
+	}
+
+	data, err := toQubicTickData(tickData)
+	if err != nil {
+		return fmt.Errorf("converting tick data: %w", err)
+	}
+
+	data.ComputorIndex ^= broadcastFutureTickDataType
+	digest, err := signedDigest(data)
+	if err != nil {
+		return fmt.Errorf("calculating tick data digest: %w", err)
+	}
+
+	return schnorrq.Verify(computorPubKey, digest, data.Signature)
+}
+
+func toQubicComputors(computorList *api.ComputorList) (types.Computors, error) {
+	if len(computorList.GetIdentities()) > types.NumberOfComputors {
+		return types.Computors{}, fmt.Errorf("too many computors [%d]", len(computorList.GetIdentities()))
+	}
+
+	computors := types.Computors{Epoch: uint16(computorList.GetEpoch())} //nolint: gosec
+	for i, identity := range computorList.GetIdentities() {
+		id := types.Identity(identity)
+		pubKey, err := id.ToPubKey(false)
+		if err != nil {
+			return types.Computors{}, fmt.Errorf("getting public key of computor [%s]: %w", identity, err)
+		}
+		computors.PubKeys[i] = pubKey
+	}
+
+	err := decodeBase64(computorList.GetSignature(), computors.Signature[:])
+	if err != nil {
+		return types.Computors{}, fmt.Errorf("decoding signature: %w", err)
+	}
+	return computors, nil
+}
+
+// toQubicTickData converts the tick data into the node format. The archive does not store the positions of the
+// transaction digests and contract fees. They are expected to be stored without gaps.
+func toQubicTickData(tickData *api.TickData) (types.TickData, error) {
+	if len(tickData.GetTransactionHashes()) > types.NumberOfTransactionsPerTick {
+		return types.TickData{}, fmt.Errorf("too many transactions [%d]", len(tickData.GetTransactionHashes()))
+	}
+	if len(tickData.GetContractFees()) > len(types.TickData{}.ContractFees) {
+		return types.TickData{}, fmt.Errorf("too many contract fees [%d]", len(tickData.GetContractFees()))
+	}
+
+	date := time.UnixMilli(int64(tickData.GetTimestamp())).UTC() //nolint: gosec
+	data := types.TickData{
+		ComputorIndex: uint16(tickData.GetComputorIndex()), //nolint: gosec
+		Epoch:         uint16(tickData.GetEpoch()),         //nolint: gosec
+		Tick:          tickData.GetTickNumber(),
+		Millisecond:   uint16(date.Nanosecond() / int(time.Millisecond)), //nolint: gosec
+		Second:        uint8(date.Second()),                              //nolint: gosec
+		Minute:        uint8(date.Minute()),                              //nolint: gosec
+		Hour:          uint8(date.Hour()),                                //nolint: gosec
+		Day:           uint8(date.Day()),                                 //nolint: gosec
+		Month:         uint8(date.Month()),                               //nolint: gosec
+		Year:          uint8(date.Year() - 2000),                         //nolint: gosec
+	}
+
+	for i, hash := range tickData.GetTransactionHashes() {
+		id := types.Identity(hash)
+		digest, err := id.ToPubKey(true)
+		if err != nil {
+			return types.TickData{}, fmt.Errorf("getting digest of transaction [%s]: %w", hash, err)
+		}
+		data.TransactionDigests[i] = digest
+	}
+	copy(data.ContractFees[:], tickData.GetContractFees())
+
+	err := decodeBase64(tickData.GetTimeLock(), data.Timelock[:])
+	if err != nil {
+		return types.TickData{}, fmt.Errorf("decoding time lock: %w", err)
+	}
+	err = decodeBase64(tickData.GetSignature(), data.Signature[:])
+	if err != nil {
+		return types.TickData{}, fmt.Errorf("decoding signature: %w", err)
+	}
+	return data, nil
+}
+
+// signedDigest calculates the digest of the binary representation without the trailing signature.
+func signedDigest(data any) ([32]byte, error) {
+	var buf bytes.Buffer
+	err := binary.Write(&buf, binary.LittleEndian, data)
+	if err != nil {
+		return [32]byte{}, fmt.Errorf("serializing data: %w", err)
+	}
+	serialized := buf.Bytes()
+	return k12Hash(serialized[:len(serialized)-types.SignatureSize])
+}
+
+func k12Hash(data []byte) ([32]byte, error) {
+	h := k12.NewDraft10([]byte{})
+	_, err := h.Write(data)
+	if err != nil {
+		return [32]byte{}, fmt.Errorf("hashing: %w", err)
+	}
+
+	var digest [32]byte
+	_, err = h.Read(digest[:])
+	if err != nil {
+		return [32]byte{}, fmt.Errorf("reading digest: %w", err)
+	}
+	return digest, nil
+}
+
+func decodeBase64(value string, target []byte) error {
+	decoded, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return err
+	}
+	if len(decoded) != len(target) {
+		return errors.New("invalid length")
+	}
+	copy(target, decoded)
+	return nil
+}
@@ -0,0 +1,106 @@
+package verifier
+
+import (
+	"encoding/base64"
+	"testing"
+
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	"github.com/qubic/go-node-connector/types"
+	"github.com/qubic/go-schnorrq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSeed = "testseedtestseedtestseedtestseedtestseedtestseedtestsee"
+
+func signedTestTickData(t *testing.T) (*api.TickData, *api.ComputorList) {
+	t.Helper()
+	wallet, err := types.NewWallet(testSeed)
+	require.NoError(t, err)
+	subSeed, err := types.GetSubSeed(testSeed)
+	require.NoError(t, err)
+
+	tickData := &api.TickData{
+		TickNumber:        12345,
+		Epoch:             100,
+		ComputorIndex:     1,
+		Timestamp:         1751345877123,
+		TimeLock:          base64.StdEncoding.EncodeToString(make([]byte, 32)),
+		TransactionHashes: []string{"zvqhhixcxmbyxlxgomvwzvlkfvwgjvjemaxkmdkmvofcdkfhsfcofjrbqvba"},
+		ContractFees:      []int64{1, 2},
+		Signature:         base64.StdEncoding.EncodeToString(make([]byte, 64)),
+	}
+	computorList := &api.ComputorList{
+		Epoch:      100,
+		Identities: []string{"BZBQFLLBNCXEMGLOBHUVFTLUPLVCPQUASSILFABOFFBCADQSSUPNWLZBQEXK", wallet.Identity.String()},
+	}
+
+	data, err := toQubicTickData(tickData)
+	require.NoError(t, err)
+	data.ComputorIndex ^= broadcastFutureTickDataType
+	digest, err := signedDigest(data)
+	require.NoError(t, err)
+	signature, err := schnorrq.Sign(subSeed, wallet.PubKey, digest)
+	require.NoError(t, err)
+	tickData.Signature = base64.StdEncoding.EncodeToString(signature[:])
+
+	return tickData, computorList
+}
+
+func TestVerifyTickData(t *testing.T) {
+	tickData, computorList := signedTestTickData(t)
+
+	err := VerifyTickData(tickData, computorList)
+	require.NoError(t, err)
+}
+
+func TestVerifyTickData_GivenModifiedTickData_ThenError(t *testing.T) {
+	tickData, computorList := signedTestTickData(t)
+	tickData.ContractFees = []int64{1, 3}
+
+	err := VerifyTickData(tickData, computorList)
+	require.Error(t, err)
+}
+
+func TestVerifyTickData_GivenOtherComputor_ThenError(t *testing.T) {
+	tickData, computorList := signedTestTickData(t)
+	tickData.ComputorIndex = 0
+
+	err := VerifyTickData(tickData, computorList)
+	require.Error(t, err)
+
+	tickData.ComputorIndex = 2
+	err = VerifyTickData(tickData, computorList)
+	require.ErrorContains(t, err, "invalid computor index [2]")
+}
+
+func TestVerifyComputorList_GivenInvalidSignature_ThenError(t *testing.T) {
+	computorList := &api.ComputorList{
+		Epoch:      100,
+		Identities: []string{"BZBQFLLBNCXEMGLOBHUVFTLUPLVCPQUASSILFABOFFBCADQSSUPNWLZBQEXK"},
+		Signature:  base64.StdEncoding.EncodeToString(make([]byte, 64)),
+	}
+
+	err := VerifyComputorList(computorList)
+	require.Error(t, err)
+
+	computorList.Signature = "invalid"
+	err = VerifyComputorList(computorList)
+	require.ErrorContains(t, err, "decoding signature")
+}
+
+func Test_toQubicTickData(t *testing.T) {
+	tickData, _ := signedTestTickData(t)
+
+	data, err := toQubicTickData(tickData)
+	require.NoError(t, err)
+	assert.Equal(t, uint16(1), data.ComputorIndex)
+	assert.Equal(t, uint16(100), data.Epoch)
+	assert.Equal(t, uint32(12345), data.Tick)
+	// 2025-07-01T04:57:57.123Z
+	assert.Equal(t, []uint16{25, 7, 1, 4, 57, 57, 123}, []uint16{uint16(data.Year), uint16(data.Month), uint16(data.Day),
+		uint16(data.Hour), uint16(data.Minute), uint16(data.Second), data.Millisecond})
+	assert.NotEqual(t, [32]byte{}, data.TransactionDigests[0])
+	assert.Equal(t, [32]byte{}, data.TransactionDigests[1])
+	assert.Equal(t, int64(2), data.ContractFees[1])
+}
@@ -0,0 +1,87 @@
+package verifier
+
+import (
+	"encoding/base64"
+	"fmt"
+	"math"
+
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	"github.com/qubic/go-node-connector/types"
+	"github.com/qubic/go-schnorrq"
+)
+
+// TransactionVerification contains the results of the transaction checks.
+type TransactionVerification struct {
+	Hash           string // the hash calculated from the transaction data
+	HashValid      bool   // true, if the calculated hash matches the hash of the transaction
+	SignatureValid bool   // true, if the transaction is signed by the source identity
+}
+
+// VerifyTransaction reconstructs the transaction digest from the transaction data, checks it against the hash of the
+// transaction and verifies the signature against the public key of the source identity. Returns an error, if the
+// transaction data cannot be converted into the node format.
+func VerifyTransaction(tx *api.Transaction) (*TransactionVerification, error) {
+	transaction, err := toQubicTransaction(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := transaction.ID()
+	if err != nil {
+		return nil, fmt.Errorf("calculating transaction hash: %w", err)
+	}
+
+	unsignedDigest, err := transaction.GetUnsignedDigest()
+	if err != nil {
+		return nil, fmt.Errorf("calculating unsigned transaction digest: %w", err)
+	}
+
+	return &TransactionVerification{
+		Hash:           hash,
+		HashValid:      hash == tx.GetHash(),
+		SignatureValid: schnorrq.Verify(transaction.SourcePublicKey, unsignedDigest, transaction.Signature) == nil,
+	}, nil
+}
+
+func toQubicTransaction(tx *api.Transaction) (types.Transaction, error) {
+	if tx.GetAmount() > math.MaxInt64 {
+		return types.Transaction{}, fmt.Errorf("invalid amount [%d]", tx.GetAmount())
+	}
+	if tx.GetInputType() > math.MaxUint16 {
+		return types.Transaction{}, fmt.Errorf("invalid input type [%d]", tx.GetInputType())
+	}
+	if tx.GetInputSize() > math.MaxUint16 {
+		return types.Transaction{}, fmt.Errorf("invalid input size [%d]", tx.GetInputSize())
+	}
+
+	source := types.Identity(tx.GetSource())
+	sourcePubKey, err := source.ToPubKey(false)
+	if err != nil {
+		return types.Transaction{}, fmt.Errorf("getting public key of source [%s]: %w", tx.GetSource(), err)
+	}
+	destination := types.Identity(tx.GetDestination())
+	destinationPubKey, err := destination.ToPubKey(false)
+	if err != nil {
+		return types.Transaction{}, fmt.Errorf("getting public key of destination [%s]: %w", tx.GetDestination(), err)
+	}
+
+	input, err := base64.StdEncoding.DecodeString(tx.GetInputData())
+	if err != nil {
+		return types.Transaction{}, fmt.Errorf("decoding input data: %w", err)
+	}
+
+	transaction := types.Transaction{
+		SourcePublicKey:      sourcePubKey,
+		DestinationPublicKey: destinationPubKey,
+		Amount:               int64(tx.GetAmount()), //nolint: gosec
+		Tick:                 tx.GetTickNumber(),
+		InputType:            uint16(tx.GetInputType()), //nolint: gosec
+		InputSize:            uint16(tx.GetInputSize()), //nolint: gosec
+		Input:                input,
+	}
+	err = decodeBase64(tx.GetSignature(), transaction.Signature[:])
+	if err != nil {
+		return types.Transaction{}, fmt.Errorf("decoding signature: %w", err)
+	}
+	return transaction, nil
+}
@@ -0,0 +1,102 @@
+package verifier
+
+import (
+	"encoding/base64"
+	"testing"
+
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	"github.com/qubic/go-node-connector/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func signedTestTransaction(t *testing.T) *api.Transaction {
+	t.Helper()
+	wallet, err := types.NewWallet(testSeed)
+	require.NoError(t, err)
+	destination := types.Identity("BZBQFLLBNCXEMGLOBHUVFTLUPLVCPQUASSILFABOFFBCADQSSUPNWLZBQEXK")
+	destinationPubKey, err := destination.ToPubKey(false)
+	require.NoError(t, err)
+
+	tx := types.Transaction{
+		SourcePublicKey:      wallet.PubKey,
+		DestinationPublicKey: destinationPubKey,
+		Amount:               1000,
+		Tick:                 12345,
+		InputType:            1,
+		InputSize:            3,
+		Input:                []byte{1, 2, 3},
+	}
+	require.NoError(t, tx.Sign(testSeed))
+	hash, err := tx.ID()
+	require.NoError(t, err)
+
+	return &api.Transaction{
+		Hash:        hash,
+		Amount:      1000,
+		Source:      wallet.Identity.String(),
+		Destination: destination.String(),
+		TickNumber:  12345,
+		Timestamp:   1751345877123,
+		InputType:   1,
+		InputSize:   3,
+		InputData:   base64.StdEncoding.EncodeToString([]byte{1, 2, 3}),
+		Signature:   base64.StdEncoding.EncodeToString(tx.Signature[:]),
+	}
+}
+
+func TestVerifyTransaction(t *testing.T) {
+	tx := signedTestTransaction(t)
+
+	verification, err := VerifyTransaction(tx)
+	require.NoError(t, err)
+	assert.Equal(t, tx.Hash, verification.Hash)
+	assert.True(t, verification.HashValid)
+	assert.True(t, verification.SignatureValid)
+}
+
+func TestVerifyTransaction_GivenModifiedTransaction_ThenInvalid(t *testing.T) {
+	tx := signedTestTransaction(t)
+	originalHash := tx.Hash
+	tx.Amount = 1001
+
+	verification, err := VerifyTransaction(tx)
+	require.NoError(t, err)
+	assert.NotEqual(t, originalHash, verification.Hash)
+	assert.False(t, verification.HashValid)
+	assert.False(t, verification.SignatureValid)
+}
+
+func TestVerifyTransaction_GivenOtherHash_ThenHashInvalid(t *testing.T) {
+	tx := signedTestTransaction(t)
+	originalHash := tx.Hash
+	tx.Hash = "zvqhhixcxmbyxlxgomvwzvlkfvwgjvjemaxkmdkmvofcdkfhsfcofjrbqvba"
+
+	verification, err := VerifyTransaction(tx)
+	require.NoError(t, err)
+	assert.Equal(t, originalHash, verification.Hash)
+	assert.False(t, verification.HashValid)
+	assert.True(t, verification.SignatureValid)
+}
+
+func TestVerifyTransaction_GivenInvalidData_ThenError(t *testing.T) {
+	tx := signedTestTransaction(t)
+	tx.Source = "invalid"
+	_, err := VerifyTransaction(tx)
+	require.ErrorContains(t, err, "getting public key of source")
+
+	tx = signedTestTransaction(t)
+	tx.InputData = "%%%"
+	_, err = VerifyTransaction(tx)
+	require.ErrorContains(t, err, "decoding input data")
+
+	tx = signedTestTransaction(t)
+	tx.Signature = ""
+	_, err = VerifyTransaction(tx)
+	require.ErrorContains(t, err, "decoding signature")
+
+	tx = signedTestTransaction(t)
+	tx.InputSize = 70000
+	_, err = VerifyTransaction(tx)
+	require.ErrorContains(t, err, "invalid input size [70000]")
+}
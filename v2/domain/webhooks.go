@@ -0,0 +1,514 @@
+package domain
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	"github.com/qubic/archive-query-service/v2/entities"
+	statusPb "github.com/qubic/go-data-publisher/status-service/protobuf"
+	"google.golang.org/protobuf/proto"
+)
+
+//go:generate go tool go.uber.org/mock/mockgen -destination=mock/webhooks.mock.go -package=mock -source webhooks.go
+
+const (
+	// maxWebhookItems is the maximum number of transactions or event logs per payload. If there are more results for
+	// the new ticks, they are sent in several payloads.
+	maxWebhookItems = MaxNotificationItems
+
+	WebhookIDHeader        = "X-Webhook-Id"
+	WebhookTimestampHeader = "X-Webhook-Timestamp"
+	WebhookSignatureHeader = "X-Webhook-Signature"
+)
+
+var (
+	ErrTooManyWebhooks          = errors.New("too many webhooks")
+	ErrWebhookAddressNotAllowed = errors.New("webhook address not allowed")
+)
+
+type WebhookRepository interface {
+	SaveWebhook(ctx context.Context, webhook *entities.Webhook) error
+	DeleteWebhook(ctx context.Context, id string) error
+	GetWebhooks(ctx context.Context) ([]*entities.Webhook, error)
+}
+
+type WebhookTransactionsRepository interface {
+	GetTransactionsForIdentity(ctx context.Context, identity string, maxTick uint32, filters entities.Filters, from, size uint32) ([]*api.Transaction, *entities.Hits, error)
+}
+
+type WebhookEventsRepository interface {
+	GetEvents(ctx context.Context, filters entities.Filters, from, size, maxTick uint32) ([]*api.Event, *entities.Hits, error)
+}
+
+type WebhookConfig struct {
+	MaxWebhooks    int           // maximum number of webhooks per owner
+	MaxAttempts    int           // delivery attempts per notification
+	InitialBackoff time.Duration // wait time before the first retry. Doubled for every further retry.
+	MaxBackoff     time.Duration // maximum wait time between retries
+	Timeout        time.Duration // http request timeout per attempt
+	// AllowPrivateAddresses allows webhook urls with loopback, private, link-local and unspecified addresses. By
+	// default, only public addresses are allowed, so that webhooks cannot reach internal services.
+	AllowPrivateAddresses bool
+}
+
+// WebhookPayload is posted to the webhook url. Transactions and event logs have the same json format as in the api
+// responses.
+type WebhookPayload struct {
+	WebhookID    string            `json:"webhookId"`
+	Epoch        uint32            `json:"epoch"`
+	FromTick     uint32            `json:"fromTick"`
+	ToTick       uint32            `json:"toTick"`
+	Transactions []json.RawMessage `json:"transactions,omitempty"`
+	EventLogs    []json.RawMessage `json:"eventLogs,omitempty"`
+	Truncated    bool              `json:"truncated,omitempty"`
+}
+
+// WebhookService manages the webhook registrations and notifies the webhooks about new transactions and event logs.
+// Every webhook is notified sequentially. If a notification is still being delivered, the next notification contains
+// the data of all ticks processed in the meantime.
+type WebhookService struct {
+	repo       WebhookRepository
+	txRepo     WebhookTransactionsRepository
+	eventsRepo WebhookEventsRepository
+	client     *http.Client
+	cfg        WebhookConfig
+
+	mutex    sync.Mutex
+	webhooks map[string]*entities.Webhook
+	inFlight map[string]bool
+	status   *statusPb.GetStatusResponse // latest status received from the watcher
+
+	stopCtx context.Context
+	stop    context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+// NewWebhookService loads the persisted webhooks.
+func NewWebhookService(ctx context.Context, repo WebhookRepository, txRepo WebhookTransactionsRepository, eventsRepo WebhookEventsRepository, cfg WebhookConfig) (*WebhookService, error) {
+	webhooks, err := repo.GetWebhooks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("loading webhooks: %w", err)
+	}
+	stopCtx, stop := context.WithCancel(context.Background())
+	s := &WebhookService{
+		repo:       repo,
+		txRepo:     txRepo,
+		eventsRepo: eventsRepo,
+		client: &http.Client{
+			Transport:     newWebhookTransport(cfg.AllowPrivateAddresses),
+			Timeout:       cfg.Timeout,
+			CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+		},
+		cfg:      cfg,
+		webhooks: make(map[string]*entities.Webhook, len(webhooks)),
+		inFlight: map[string]bool{},
+		stopCtx:  stopCtx,
+		stop:     stop,
+	}
+	for _, webhook := range webhooks {
+		s.webhooks[webhook.ID] = webhook
+	}
+	return s, nil
+}
+
+// RegisterWebhook stores the webhook. The id, secret and creation time are generated. Only ticks that are processed
+// after the registration are notified. Returns ErrWebhookAddressNotAllowed, if the url host does not resolve to public
+// addresses only.
+func (s *WebhookService) RegisterWebhook(ctx context.Context, owner string, webhook *entities.Webhook) (*entities.Webhook, error) {
+	if !s.cfg.AllowPrivateAddresses {
+		if err := checkWebhookHost(ctx, webhook.URL); err != nil {
+			return nil, err
+		}
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	count := 0
+	for _, other := range s.webhooks {
+		if other.Owner == owner {
+			count++
+		}
+	}
+	if count >= s.cfg.MaxWebhooks {
+		return nil, ErrTooManyWebhooks
+	}
+
+	registered := *webhook
+	registered.Owner = owner
+	registered.ID = randomHex(16)
+	registered.Secret = randomHex(32)
+	registered.CreatedAt = uint64(time.Now().UnixMilli())
+	registered.LastNotifiedTick = 0
+	registered.DeliveryStatus = entities.WebhookDeliveryStatus{}
+	if s.status != nil {
+		registered.LastNotifiedTick = processedTickForWebhook(&registered, s.status)
+	}
+	if err := s.repo.SaveWebhook(ctx, &registered); err != nil {
+		return nil, fmt.Errorf("saving webhook: %w", err)
+	}
+	s.webhooks[registered.ID] = &registered
+	result := registered
+	return &result, nil
+}
+
+// GetWebhook returns the webhook or nil, if the owner has no webhook with the given id.
+func (s *WebhookService) GetWebhook(_ context.Context, owner, id string) (*entities.Webhook, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	webhook, ok := s.webhooks[id]
+	if !ok || webhook.Owner != owner {
+		return nil, nil
+	}
+	result := *webhook
+	return &result, nil
+}
+
+// ListWebhooks returns the webhooks of the owner ordered by creation time.
+func (s *WebhookService) ListWebhooks(_ context.Context, owner string) ([]*entities.Webhook, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	result := make([]*entities.Webhook, 0)
+	for _, webhook := range s.webhooks {
+		if webhook.Owner == owner {
+			webhookCopy := *webhook
+			result = append(result, &webhookCopy)
+		}
+	}
+	slices.SortFunc(result, func(a, b *entities.Webhook) int {
+		return cmp.Or(cmp.Compare(a.CreatedAt, b.CreatedAt), cmp.Compare(a.ID, b.ID))
+	})
+	return result, nil
+}
+
+// DeleteWebhook deletes the webhook. Returns false, if the owner has no webhook with the given id.
+func (s *WebhookService) DeleteWebhook(ctx context.Context, owner, id string) (bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	webhook, ok := s.webhooks[id]
+	if !ok || webhook.Owner != owner {
+		return false, nil
+	}
+	if err := s.repo.DeleteWebhook(ctx, id); err != nil {
+		return false, fmt.Errorf("deleting webhook: %w", err)
+	}
+	delete(s.webhooks, id)
+	return true, nil
+}
+
+// Start notifies the webhooks on every status update until the service is stopped.
+func (s *WebhookService) Start(updates <-chan *statusPb.GetStatusResponse) {
+	for {
+		select {
+		case <-s.stopCtx.Done():
+			return
+		case latest := <-updates:
+			s.dispatch(latest)
+		}
+	}
+}
+
+// Stop cancels running deliveries and waits for them to finish. Cancelled notifications are sent again after restart.
+func (s *WebhookService) Stop() {
+	s.stop()
+	s.wg.Wait()
+}
+
+func (s *WebhookService) dispatch(latest *statusPb.GetStatusResponse) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.status = latest
+	for id, webhook := range s.webhooks {
+		toTick := processedTickForWebhook(webhook, latest)
+		if webhook.LastNotifiedTick == 0 { // registered before the current tick was known
+			webhook.LastNotifiedTick = toTick
+			continue
+		}
+		if s.inFlight[id] || toTick <= webhook.LastNotifiedTick {
+			continue
+		}
+		s.inFlight[id] = true
+		s.wg.Add(1)
+		go func(webhook entities.Webhook) {
+			defer s.wg.Done()
+			s.notify(s.stopCtx, &webhook, latest.GetProcessingEpoch(), toTick)
+		}(*webhook)
+	}
+}
+
+// processedTickForWebhook returns the last tick that is available for the webhook type.
+func processedTickForWebhook(webhook *entities.Webhook, latest *statusPb.GetStatusResponse) uint32 {
+	if webhook.EventFilters != nil {
+		return latest.GetLastProcessedLogTick()
+	}
+	return latest.GetLastProcessedTick()
+}
+
+// notify delivers the data of the new ticks in ascending tick order, split into several payloads if necessary. If the
+// data cannot be queried, the ticks are included in the next notification. If there are too many results, only the
+// complete ticks are delivered and the remaining ticks are included in the next notification. If the delivery of a
+// payload fails after all retries, the ticks are skipped.
+func (s *WebhookService) notify(ctx context.Context, webhook *entities.Webhook, epoch, toTick uint32) {
+	defer func() {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+		delete(s.inFlight, webhook.ID)
+	}()
+
+	fromTick := webhook.LastNotifiedTick + 1
+	payloads, notifiedTick, err := s.createPayloads(ctx, webhook, epoch, fromTick, toTick)
+	if err != nil {
+		log.Printf("[WARN] webhook [%s]: querying ticks [%d-%d]: %v", webhook.ID, fromTick, toTick, err)
+		return
+	}
+	deliveryStatus := webhook.DeliveryStatus
+	for i, payload := range payloads {
+		body, err := json.Marshal(payload)
+		if err != nil {
+			log.Printf("[ERROR] webhook [%s]: marshalling payload: %v", webhook.ID, err)
+			return
+		}
+		delivered, stopped := s.deliver(ctx, webhook, body, &deliveryStatus)
+		if stopped {
+			return
+		}
+		if !delivered {
+			log.Printf("[WARN] webhook [%s]: delivery failed. skipping ticks [%d-%d] (%d of %d payloads sent)", webhook.ID,
+				fromTick, notifiedTick, i, len(payloads))
+			break
+		}
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	current, ok := s.webhooks[webhook.ID]
+	if !ok {
+		return // deleted in the meantime
+	}
+	current.LastNotifiedTick = notifiedTick
+	current.DeliveryStatus = deliveryStatus
+	if len(payloads) > 0 {
+		if err = s.repo.SaveWebhook(ctx, current); err != nil {
+			log.Printf("[WARN] webhook [%s]: saving delivery status: %v", webhook.ID, err)
+		}
+	}
+}
+
+// createPayloads queries the results of the ticks in ascending order and splits them into payloads. Returns the
+// payloads and the last tick that is completely included.
+func (s *WebhookService) createPayloads(ctx context.Context, webhook *entities.Webhook, epoch, fromTick, toTick uint32) ([]*WebhookPayload, uint32, error) {
+	header := WebhookPayload{WebhookID: webhook.ID, Epoch: epoch, FromTick: fromTick}
+	if webhook.EventFilters != nil {
+		filters := *webhook.EventFilters
+		filters.Ranges = TickRange(filters.Ranges, fromTick, toTick)
+		filters.Ascending = true
+		events, notifiedTick, truncated, err := CollectTickPages(func(from uint32) ([]*api.Event, *entities.Hits, error) {
+			return s.eventsRepo.GetEvents(ctx, filters, from, maxWebhookItems, toTick)
+		}, (*api.Event).GetTickNumber, fromTick, toTick)
+		if err != nil {
+			return nil, 0, err
+		}
+		header.ToTick, header.Truncated = notifiedTick, truncated
+		payloads, err := createWebhookPayloads(events, func(payload *WebhookPayload, items []json.RawMessage) { payload.EventLogs = items }, header)
+		return payloads, notifiedTick, err
+	}
+
+	filters := entities.Filters{Ranges: TickRange(nil, fromTick, toTick), Ascending: true}
+	txs, notifiedTick, truncated, err := CollectTickPages(func(from uint32) ([]*api.Transaction, *entities.Hits, error) {
+		return s.txRepo.GetTransactionsForIdentity(ctx, webhook.Identity, toTick, filters, from, maxWebhookItems)
+	}, (*api.Transaction).GetTickNumber, fromTick, toTick)
+	if err != nil {
+		return nil, 0, err
+	}
+	setExecutionStatuses(txs)
+	header.ToTick, header.Truncated = notifiedTick, truncated
+	payloads, err := createWebhookPayloads(txs, func(payload *WebhookPayload, items []json.RawMessage) { payload.Transactions = items }, header)
+	return payloads, notifiedTick, err
+}
+
+// createWebhookPayloads splits the items into payloads with at most maxWebhookItems items.
+func createWebhookPayloads[T proto.Message](items []T, set func(payload *WebhookPayload, items []json.RawMessage), header WebhookPayload) ([]*WebhookPayload, error) {
+	payloads := make([]*WebhookPayload, 0, (len(items)+maxWebhookItems-1)/maxWebhookItems)
+	for chunk := range slices.Chunk(items, maxWebhookItems) {
+		marshalled, err := MarshalNotificationItems(chunk)
+		if err != nil {
+			return nil, err
+		}
+		payload := header
+		set(&payload, marshalled)
+		payloads = append(payloads, &payload)
+	}
+	return payloads, nil
+}
+
+// deliver posts the payload until it is accepted or the maximum number of attempts is reached. Returns if the payload
+// was delivered and if the service was stopped.
+func (s *WebhookService) deliver(ctx context.Context, webhook *entities.Webhook, body []byte, deliveryStatus *entities.WebhookDeliveryStatus) (delivered, stopped bool) {
+	backoff := s.cfg.InitialBackoff
+	for attempt := 1; attempt <= s.cfg.MaxAttempts; attempt++ {
+		if attempt > 1 {
+			select {
+			case <-ctx.Done():
+				return false, true
+			case <-time.After(backoff):
+			}
+			backoff = min(2*backoff, s.cfg.MaxBackoff)
+		}
+
+		statusCode, err := s.post(ctx, webhook, body)
+		if ctx.Err() != nil {
+			return false, true
+		}
+		deliveryStatus.LastAttempt = uint64(time.Now().UnixMilli())
+		deliveryStatus.LastStatusCode = int32(statusCode)
+		if err == nil {
+			deliveryStatus.Delivered++
+			deliveryStatus.ConsecutiveFailures = 0
+			deliveryStatus.LastSuccess = deliveryStatus.LastAttempt
+			deliveryStatus.LastError = ""
+			return true, false
+		}
+		deliveryStatus.LastError = err.Error()
+		log.Printf("[DEBUG] webhook [%s]: delivery attempt %d failed: %v", webhook.ID, attempt, err)
+	}
+	deliveryStatus.Failed++
+	deliveryStatus.ConsecutiveFailures++
+	return false, false
+}
+
+// post sends the signed payload and returns the response status code.
+func (s *WebhookService) post(ctx context.Context, webhook *entities.Webhook, body []byte) (int, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("creating request: %w", err)
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(WebhookIDHeader, webhook.ID)
+	request.Header.Set(WebhookTimestampHeader, timestamp)
+	request.Header.Set(WebhookSignatureHeader, SignWebhookPayload(webhook.Secret, timestamp, body))
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		return 0, fmt.Errorf("sending request: %w", err)
+	}
+	defer response.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(response.Body, 4096)) // allow connection reuse
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return response.StatusCode, fmt.Errorf("unexpected status code [%d]", response.StatusCode)
+	}
+	return response.StatusCode, nil
+}
+
+// newWebhookTransport creates the transport for the webhook requests. Unless private addresses are allowed, the
+// address is checked again when connecting, because the host could resolve to another address than on registration
+// (dns rebinding). No proxy is used, so that the checked address is the one that is connected.
+func newWebhookTransport(allowPrivateAddresses bool) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	if !allowPrivateAddresses {
+		dialer := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second, Control: webhookDialControl}
+		transport.DialContext = dialer.DialContext
+	}
+	return transport
+}
+
+// webhookDialControl rejects connections to addresses that are not public.
+func webhookDialControl(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return fmt.Errorf("%w: invalid address [%s]", ErrWebhookAddressNotAllowed, address)
+	}
+	ip, err := netip.ParseAddr(host)
+	if err != nil || !isPublicAddress(ip) {
+		return fmt.Errorf("%w: [%s]", ErrWebhookAddressNotAllowed, host)
+	}
+	return nil
+}
+
+// checkWebhookHost resolves the host of the webhook url and returns ErrWebhookAddressNotAllowed, if it cannot be
+// resolved or if one of the addresses is not public.
+func checkWebhookHost(ctx context.Context, webhookURL string) error {
+	parsed, err := url.Parse(webhookURL)
+	if err != nil {
+		return fmt.Errorf("%w: invalid url", ErrWebhookAddressNotAllowed)
+	}
+	host := parsed.Hostname()
+	addresses, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return fmt.Errorf("%w: resolving host [%s]: %v", ErrWebhookAddressNotAllowed, host, err)
+	}
+	for _, address := range addresses {
+		if !isPublicAddress(address) {
+			return fmt.Errorf("%w: host [%s] resolves to [%s]", ErrWebhookAddressNotAllowed, host, address)
+		}
+	}
+	return nil
+}
+
+// nonPublicPrefixes are special purpose ranges that are global unicast, but can reach internal services.
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("100.64.0.0/10"),      // carrier-grade nat
+	netip.MustParsePrefix("192.0.0.0/24"),       // ietf protocol assignments
+	netip.MustParsePrefix("198.18.0.0/15"),      // benchmarking
+	netip.MustParsePrefix("64:ff9b::/96"),       // nat64, maps to ipv4 addresses
+	netip.MustParsePrefix("64:ff9b:1::/48"),     // local-use nat64
+	netip.MustParsePrefix("2001:db8::/32"),      // documentation
+	netip.MustParsePrefix("2002::/16"),          // 6to4, maps to ipv4 addresses
+	netip.MustParsePrefix("2001::/32"),          // teredo, maps to ipv4 addresses
+	netip.MustParsePrefix("::/96"),              // deprecated ipv4-compatible addresses
+	netip.MustParsePrefix("fec0::/10"),          // deprecated site-local addresses
+	netip.MustParsePrefix("240.0.0.0/4"),        // reserved
+	netip.MustParsePrefix("0.0.0.0/8"),          // this network
+	netip.MustParsePrefix("255.255.255.255/32"), // broadcast
+}
+
+// isPublicAddress returns false for loopback, private, link-local, unspecified, multicast and broadcast addresses
+// and for the special purpose ranges that can reach internal services.
+func isPublicAddress(address netip.Addr) bool {
+	address = address.Unmap()
+	if !address.IsGlobalUnicast() || address.IsPrivate() {
+		return false
+	}
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(address) {
+			return false
+		}
+	}
+	return true
+}
+
+// SignWebhookPayload returns the signature header value: the hex encoded HMAC-SHA256 of timestamp.body, keyed with
+// the webhook secret.
+func SignWebhookPayload(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func randomHex(size int) string {
+	data := make([]byte, size)
+	_, _ = rand.Read(data) // never returns an error
+	return hex.EncodeToString(data)
+}
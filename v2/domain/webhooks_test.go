@@ -0,0 +1,258 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	"github.com/qubic/archive-query-service/v2/domain/mock"
+	"github.com/qubic/archive-query-service/v2/entities"
+	statusPb "github.com/qubic/go-data-publisher/status-service/protobuf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const webhookIdentity = "BZBQFLLBNCXEMGLOBHUVFTLUPLVCPQUASSILFABOFFBCADQSSUPNWLZBQEXK"
+
+type webhookMocks struct {
+	repo       *mock.MockWebhookRepository
+	txRepo     *mock.MockWebhookTransactionsRepository
+	eventsRepo *mock.MockWebhookEventsRepository
+}
+
+func newTestWebhookService(t *testing.T, cfg WebhookConfig) (*WebhookService, *webhookMocks) {
+	ctrl := gomock.NewController(t)
+	mocks := &webhookMocks{
+		repo:       mock.NewMockWebhookRepository(ctrl),
+		txRepo:     mock.NewMockWebhookTransactionsRepository(ctrl),
+		eventsRepo: mock.NewMockWebhookEventsRepository(ctrl),
+	}
+	mocks.repo.EXPECT().GetWebhooks(gomock.Any()).Return(nil, nil)
+	mocks.repo.EXPECT().SaveWebhook(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	service, err := NewWebhookService(context.Background(), mocks.repo, mocks.txRepo, mocks.eventsRepo, cfg)
+	require.NoError(t, err)
+	t.Cleanup(service.Stop)
+	return service, mocks
+}
+
+// dispatchAndWait notifies the webhooks and waits until all deliveries are finished.
+func (s *WebhookService) dispatchAndWait(latest *statusPb.GetStatusResponse) {
+	s.dispatch(latest)
+	s.wg.Wait()
+}
+
+func TestWebhookService_NotifyTransactions(t *testing.T) {
+	service, mocks := newTestWebhookService(t, WebhookConfig{MaxWebhooks: 1, MaxAttempts: 1, Timeout: time.Second,
+		AllowPrivateAddresses: true})
+
+	received := make(chan *http.Request, 1)
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = io.ReadAll(r.Body)
+		received <- r
+	}))
+	defer server.Close()
+
+	service.dispatchAndWait(&statusPb.GetStatusResponse{ProcessingEpoch: 100, LastProcessedTick: 10})
+	webhook, err := service.RegisterWebhook(context.Background(), "owner", &entities.Webhook{URL: server.URL, Identity: webhookIdentity})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(10), webhook.LastNotifiedTick)
+	assert.NotEmpty(t, webhook.ID)
+	assert.NotEmpty(t, webhook.Secret)
+
+	mocks.txRepo.EXPECT().GetTransactionsForIdentity(gomock.Any(), webhookIdentity, uint32(12), entities.Filters{
+		Ranges:    map[string][]entities.Range{"tickNumber": {{Operation: "gte", Value: "11"}, {Operation: "lte", Value: "12"}}},
+		Ascending: true,
+	}, uint32(0), uint32(maxWebhookItems)).Return([]*api.Transaction{{Hash: "a", TickNumber: 11, InputType: 0, Amount: 10}}, &entities.Hits{Total: 1}, nil)
+	service.dispatchAndWait(&statusPb.GetStatusResponse{ProcessingEpoch: 100, LastProcessedTick: 12})
+
+	request := <-received
+	assert.Equal(t, webhook.ID, request.Header.Get(WebhookIDHeader))
+	assert.Equal(t, SignWebhookPayload(webhook.Secret, request.Header.Get(WebhookTimestampHeader), body), request.Header.Get(WebhookSignatureHeader))
+	var payload WebhookPayload
+	require.NoError(t, json.Unmarshal(body, &payload))
+	assert.Equal(t, uint32(100), payload.Epoch)
+	assert.Equal(t, uint32(11), payload.FromTick)
+	assert.Equal(t, uint32(12), payload.ToTick)
+	assert.False(t, payload.Truncated)
+	require.Len(t, payload.Transactions, 1)
+	assert.Contains(t, string(payload.Transactions[0]), `"executionStatus":"EXECUTION_STATUS_NOT_EXECUTED"`, "execution status is set")
+
+	stored, err := service.GetWebhook(context.Background(), "owner", webhook.ID)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(12), stored.LastNotifiedTick)
+	assert.Equal(t, uint64(1), stored.DeliveryStatus.Delivered)
+	assert.Equal(t, int32(http.StatusOK), stored.DeliveryStatus.LastStatusCode)
+	assert.NotZero(t, stored.DeliveryStatus.LastSuccess)
+
+	// no new ticks, no notification
+	service.dispatchAndWait(&statusPb.GetStatusResponse{ProcessingEpoch: 100, LastProcessedTick: 12})
+	assert.Empty(t, received)
+}
+
+func TestWebhookService_NotifyEventLogs_GivenFailingServer_ThenRetry(t *testing.T) {
+	service, mocks := newTestWebhookService(t, WebhookConfig{MaxWebhooks: 1, MaxAttempts: 3, InitialBackoff: time.Millisecond,
+		MaxBackoff: time.Millisecond, Timeout: time.Second, AllowPrivateAddresses: true})
+
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if attempts.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	filters := &entities.Filters{Include: map[string][]string{"logType": {"0"}}}
+	service.dispatchAndWait(&statusPb.GetStatusResponse{LastProcessedTick: 20, LastProcessedLogTick: 10})
+	webhook, err := service.RegisterWebhook(context.Background(), "owner", &entities.Webhook{URL: server.URL, EventFilters: filters})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(10), webhook.LastNotifiedTick, "log tick")
+
+	mocks.eventsRepo.EXPECT().GetEvents(gomock.Any(), gomock.Any(), uint32(0), uint32(maxWebhookItems), uint32(11)).
+		DoAndReturn(func(_ context.Context, f entities.Filters, _, _, _ uint32) ([]*api.Event, *entities.Hits, error) {
+			assert.Equal(t, filters.Include, f.Include)
+			assert.True(t, f.Ascending)
+			assert.Equal(t, []entities.Range{{Operation: "gte", Value: "11"}, {Operation: "lte", Value: "11"}}, f.Ranges["tickNumber"])
+			return []*api.Event{{TickNumber: 11}}, &entities.Hits{Total: 2}, nil
+		})
+	service.dispatchAndWait(&statusPb.GetStatusResponse{LastProcessedTick: 21, LastProcessedLogTick: 11})
+	assert.Nil(t, filters.Ranges, "filters are not modified")
+
+	stored, err := service.GetWebhook(context.Background(), "owner", webhook.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), attempts.Load())
+	assert.Equal(t, entities.WebhookDeliveryStatus{Delivered: 1, LastAttempt: stored.DeliveryStatus.LastAttempt,
+		LastSuccess: stored.DeliveryStatus.LastAttempt, LastStatusCode: http.StatusOK}, stored.DeliveryStatus)
+
+	// all attempts fail
+	attempts.Store(-10)
+	mocks.eventsRepo.EXPECT().GetEvents(gomock.Any(), gomock.Any(), uint32(0), uint32(maxWebhookItems), uint32(12)).
+		Return([]*api.Event{{TickNumber: 12}}, &entities.Hits{Total: 1}, nil)
+	service.dispatchAndWait(&statusPb.GetStatusResponse{LastProcessedTick: 22, LastProcessedLogTick: 12})
+	stored, err = service.GetWebhook(context.Background(), "owner", webhook.ID)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(12), stored.LastNotifiedTick, "failed ticks are skipped")
+	assert.Equal(t, uint64(1), stored.DeliveryStatus.Failed)
+	assert.Equal(t, uint32(1), stored.DeliveryStatus.ConsecutiveFailures)
+	assert.Equal(t, int32(http.StatusServiceUnavailable), stored.DeliveryStatus.LastStatusCode)
+	assert.Equal(t, "unexpected status code [503]", stored.DeliveryStatus.LastError)
+}
+
+func TestWebhookService_NotifyEventLogs_GivenResultWindowExhausted_ThenSeveralPayloadsWithCompleteTicks(t *testing.T) {
+	service, mocks := newTestWebhookService(t, WebhookConfig{MaxWebhooks: 1, MaxAttempts: 1, Timeout: time.Second,
+		AllowPrivateAddresses: true})
+
+	var payloads []WebhookPayload
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload WebhookPayload
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		payloads = append(payloads, payload)
+	}))
+	defer server.Close()
+
+	service.dispatchAndWait(&statusPb.GetStatusResponse{LastProcessedTick: 10, LastProcessedLogTick: 10})
+	webhook, err := service.RegisterWebhook(context.Background(), "owner", &entities.Webhook{URL: server.URL,
+		EventFilters: &entities.Filters{}})
+	require.NoError(t, err)
+
+	// two pages per tick, the window ends within tick 15
+	mocks.eventsRepo.EXPECT().GetEvents(gomock.Any(), gomock.Any(), gomock.Any(), uint32(maxWebhookItems), uint32(20)).
+		DoAndReturn(func(_ context.Context, _ entities.Filters, from, size, _ uint32) ([]*api.Event, *entities.Hits, error) {
+			events := make([]*api.Event, size)
+			for i := range events {
+				events[i] = &api.Event{TickNumber: 11 + from/(2*maxWebhookItems)}
+			}
+			return events, &entities.Hits{Total: MaxNotificationResultWindow, Relation: "gte"}, nil
+		}).Times(MaxNotificationResultWindow / maxWebhookItems)
+	service.dispatchAndWait(&statusPb.GetStatusResponse{LastProcessedTick: 20, LastProcessedLogTick: 20})
+
+	require.Len(t, payloads, 8)
+	for _, payload := range payloads {
+		assert.Equal(t, uint32(11), payload.FromTick)
+		assert.Equal(t, uint32(14), payload.ToTick)
+		assert.False(t, payload.Truncated)
+		assert.Len(t, payload.EventLogs, maxWebhookItems)
+	}
+	stored, err := service.GetWebhook(context.Background(), "owner", webhook.ID)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(14), stored.LastNotifiedTick, "incomplete tick is notified later")
+	assert.Equal(t, uint64(8), stored.DeliveryStatus.Delivered)
+}
+
+func TestWebhookService_Owner(t *testing.T) {
+	service, mocks := newTestWebhookService(t, WebhookConfig{MaxWebhooks: 1})
+	ctx := context.Background()
+
+	webhook, err := service.RegisterWebhook(ctx, "owner", &entities.Webhook{URL: "http://203.0.113.1", Identity: webhookIdentity})
+	require.NoError(t, err)
+	_, err = service.RegisterWebhook(ctx, "owner", &entities.Webhook{URL: "http://203.0.113.1", Identity: webhookIdentity})
+	require.ErrorIs(t, err, ErrTooManyWebhooks)
+
+	other, err := service.GetWebhook(ctx, "other", webhook.ID)
+	require.NoError(t, err)
+	assert.Nil(t, other)
+	webhooks, err := service.ListWebhooks(ctx, "other")
+	require.NoError(t, err)
+	assert.Empty(t, webhooks)
+	deleted, err := service.DeleteWebhook(ctx, "other", webhook.ID)
+	require.NoError(t, err)
+	assert.False(t, deleted)
+
+	webhooks, err = service.ListWebhooks(ctx, "owner")
+	require.NoError(t, err)
+	assert.Equal(t, []*entities.Webhook{webhook}, webhooks)
+	mocks.repo.EXPECT().DeleteWebhook(gomock.Any(), webhook.ID).Return(nil)
+	deleted, err = service.DeleteWebhook(ctx, "owner", webhook.ID)
+	require.NoError(t, err)
+	assert.True(t, deleted)
+}
+
+func TestWebhookService_RegisterWebhook_GivenPrivateAddress_ThenNotAllowed(t *testing.T) {
+	service, _ := newTestWebhookService(t, WebhookConfig{MaxWebhooks: 10})
+
+	for _, webhookURL := range []string{"http://127.0.0.1:8080", "http://localhost", "http://10.0.0.1", "https://192.168.1.1",
+		"http://[::1]", "http://169.254.169.254/latest", "http://0.0.0.0", "http://[::ffff:127.0.0.1]"} {
+		_, err := service.RegisterWebhook(context.Background(), "owner", &entities.Webhook{URL: webhookURL, Identity: webhookIdentity})
+		assert.ErrorIs(t, err, ErrWebhookAddressNotAllowed, webhookURL)
+	}
+}
+
+func TestWebhookService_GivenPrivateAddressAfterRegistration_ThenConnectionRejected(t *testing.T) {
+	service, _ := newTestWebhookService(t, WebhookConfig{MaxWebhooks: 1, Timeout: time.Second})
+
+	var called atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) { called.Store(true) }))
+	defer server.Close()
+
+	_, err := service.post(context.Background(), &entities.Webhook{URL: server.URL}, []byte("{}"))
+	require.ErrorIs(t, err, ErrWebhookAddressNotAllowed)
+	assert.False(t, called.Load())
+}
+
+func TestWebhookDialControl(t *testing.T) {
+	require.NoError(t, webhookDialControl("tcp", "203.0.113.1:443", nil))
+	require.NoError(t, webhookDialControl("tcp6", "[2001:4860:4860::8888]:443", nil))
+	require.ErrorIs(t, webhookDialControl("tcp", "127.0.0.1:80", nil), ErrWebhookAddressNotAllowed)
+	require.ErrorIs(t, webhookDialControl("tcp", "172.16.0.1:80", nil), ErrWebhookAddressNotAllowed)
+	require.ErrorIs(t, webhookDialControl("tcp6", "[fe80::1]:80", nil), ErrWebhookAddressNotAllowed)
+	require.ErrorIs(t, webhookDialControl("tcp6", "[fd00::1]:80", nil), ErrWebhookAddressNotAllowed)
+}
+
+func TestIsPublicAddress(t *testing.T) {
+	for _, address := range []string{"203.0.113.1", "8.8.8.8", "2001:4860:4860::8888"} {
+		assert.True(t, isPublicAddress(netip.MustParseAddr(address)), address)
+	}
+	for _, address := range []string{"127.0.0.1", "10.0.0.1", "169.254.169.254", "0.0.0.0", "::1", "::ffff:10.0.0.1",
+		"100.64.0.1", "100.127.255.254", "192.0.0.1", "198.18.0.1", "198.19.255.1", "64:ff9b::a00:1", "64:ff9b::7f00:1",
+		"2002:a00:1::", "2001::1", "::a00:1", "240.0.0.1", "255.255.255.255"} {
+		assert.False(t, isPublicAddress(netip.MustParseAddr(address)), address)
+	}
+}
@@ -0,0 +1,22 @@
+package entities
+
+// TickDataStats contains aggregated information about the tick data of one epoch.
+type TickDataStats struct {
+	Count          uint64
+	FirstTick      uint32
+	FirstTimestamp uint64
+	LastTick       uint32
+	LastTimestamp  uint64
+}
+
+// TransactionStats contains aggregated information about the transactions of a tick range.
+type TransactionStats struct {
+	Count  uint64
+	Volume uint64
+}
+
+// EventStats contains aggregated information about the events of one epoch.
+type EventStats struct {
+	CountsByLogType map[uint32]uint64
+	BurnedAmount    uint64
+}
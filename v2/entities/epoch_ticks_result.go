@@ -0,0 +1,27 @@
+package entities
+
+import api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+
+type EpochTicksResult struct {
+	Total uint32
+	Ticks []*api.EpochTick
+}
+
+func (r *EpochTicksResult) GetTicks() []*api.EpochTick {
+	if r == nil || r.Ticks == nil {
+		return make([]*api.EpochTick, 0)
+	}
+	return r.Ticks
+}
+
+type EmptyTicksResult struct {
+	Total       uint32
+	TickNumbers []uint32
+}
+
+func (r *EmptyTicksResult) GetTickNumbers() []uint32 {
+	if r == nil || r.TickNumbers == nil {
+		return make([]uint32, 0)
+	}
+	return r.TickNumbers
+}
@@ -20,3 +20,15 @@ func (r *EventsResult) GetEvents() []*api.Event {
 	}
 	return r.Events
 }
+
+type TransactionEventsResult struct {
+	Events  []*api.Event
+	HasMore bool
+}
+
+func (r *TransactionEventsResult) GetEvents() []*api.Event {
+	if r == nil || r.Events == nil {
+		return make([]*api.Event, 0)
+	}
+	return r.Events
+}
@@ -5,6 +5,8 @@ type Filters struct {
 	Exclude map[string][]string
 	Should  []ShouldFilter
 	Ranges  map[string][]Range
+	// Ascending sorts the results by tick number ascending instead of descending.
+	Ascending bool
 }
 
 type Range struct {
@@ -0,0 +1,22 @@
+package entities
+
+import api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+
+// IdentityTransactionStats contains aggregated information about the transactions of one identity.
+type IdentityTransactionStats struct {
+	FirstSeenTick      uint32
+	FirstSeenTimestamp uint64
+	LastSeenTick       uint32
+	LastSeenTimestamp  uint64
+	TopIncoming        []*api.Counterparty
+	TopOutgoing        []*api.Counterparty
+}
+
+// IdentityEventStats contains aggregated information about the events of one identity.
+type IdentityEventStats struct {
+	ReceivedCount  uint64
+	ReceivedAmount uint64
+	SentCount      uint64
+	SentAmount     uint64
+	Assets         []*api.IdentityAsset
+}
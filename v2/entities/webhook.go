@@ -0,0 +1,26 @@
+package entities
+
+// Webhook notifies about new transactions of the identity or about new event logs that match the event filters.
+type Webhook struct {
+	ID       string `json:"id"`
+	Owner    string `json:"owner"` // hash of the api key that registered the webhook
+	URL      string `json:"url"`
+	Secret   string `json:"secret"`
+	Identity string `json:"identity,omitempty"`
+	// EventLogsRequest is the original event logs filter in api json format. EventFilters is the parsed filter.
+	EventLogsRequest string                `json:"eventLogsRequest,omitempty"`
+	EventFilters     *Filters              `json:"eventFilters,omitempty"`
+	CreatedAt        uint64                `json:"createdAt"`
+	LastNotifiedTick uint32                `json:"lastNotifiedTick"`
+	DeliveryStatus   WebhookDeliveryStatus `json:"deliveryStatus"`
+}
+
+type WebhookDeliveryStatus struct {
+	Delivered           uint64 `json:"delivered"`
+	Failed              uint64 `json:"failed"`
+	ConsecutiveFailures uint32 `json:"consecutiveFailures"`
+	LastAttempt         uint64 `json:"lastAttempt"`
+	LastSuccess         uint64 `json:"lastSuccess"`
+	LastStatusCode      int32  `json:"lastStatusCode"`
+	LastError           string `json:"lastError,omitempty"`
+}
@@ -0,0 +1,144 @@
+package grpc
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/andybalholm/brotli"
+)
+
+const (
+	encodingBrotli = "br"
+	encodingGzip   = "gzip"
+	brotliLevel    = 4 // good compression for json at a fraction of the cost of the default level
+)
+
+var (
+	gzipWriters   = sync.Pool{New: func() any { return gzip.NewWriter(io.Discard) }}
+	brotliWriters = sync.Pool{New: func() any { return brotli.NewWriterLevel(io.Discard, brotliLevel) }}
+)
+
+type compressor interface {
+	io.WriteCloser
+	Flush() error
+	Reset(w io.Writer)
+}
+
+// compressionHandler compresses the responses of the handler with brotli or gzip, if the client accepts it
+// (Accept-Encoding header). Websocket upgrades are passed through unchanged.
+func compressionHandler(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", "Accept-Encoding")
+		encoding := negotiateEncoding(r.Header.Values("Accept-Encoding"))
+		if encoding == "" || r.Header.Get("Upgrade") != "" {
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		cw := &compressWriter{ResponseWriter: w, encoding: encoding}
+		defer cw.close()
+		handler.ServeHTTP(cw, r)
+	})
+}
+
+// negotiateEncoding returns the supported encoding with the highest quality value. Brotli is preferred over gzip
+// for equal quality values. Returns an empty string, if no supported encoding is accepted.
+func negotiateEncoding(acceptEncoding []string) string {
+	var best string
+	var bestQuality float64
+	for _, header := range acceptEncoding {
+		for _, value := range strings.Split(header, ",") {
+			name, params, _ := strings.Cut(strings.TrimSpace(value), ";")
+			name = strings.ToLower(strings.TrimSpace(name))
+			if name != encodingBrotli && name != encodingGzip {
+				continue
+			}
+			quality := 1.0
+			if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+				parsed, err := strconv.ParseFloat(q, 64)
+				if err != nil {
+					continue
+				}
+				quality = parsed
+			}
+			if quality <= 0 {
+				continue
+			}
+			if quality > bestQuality || (quality == bestQuality && name == encodingBrotli) {
+				best, bestQuality = name, quality
+			}
+		}
+	}
+	return best
+}
+
+// compressWriter compresses the response body. The decision is taken when the header is written, so that handlers
+// can opt out by setting a Content-Encoding themselves.
+type compressWriter struct {
+	http.ResponseWriter
+	encoding    string
+	compressor  compressor
+	wroteHeader bool
+}
+
+func (w *compressWriter) WriteHeader(statusCode int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	header := w.Header()
+	if header.Get("Content-Encoding") == "" && statusCode != http.StatusNoContent && statusCode != http.StatusNotModified {
+		header.Set("Content-Encoding", w.encoding)
+		header.Del("Content-Length")
+		switch w.encoding {
+		case encodingBrotli:
+			w.compressor = brotliWriters.Get().(*brotli.Writer)
+		default:
+			w.compressor = gzipWriters.Get().(*gzip.Writer)
+		}
+		w.compressor.Reset(w.ResponseWriter)
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *compressWriter) Write(data []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.compressor == nil {
+		return w.ResponseWriter.Write(data)
+	}
+	return w.compressor.Write(data)
+}
+
+// Flush writes the buffered compressed data to the client. Needed for streamed responses (export, server-sent events).
+func (w *compressWriter) Flush() {
+	if w.compressor != nil {
+		_ = w.compressor.Flush()
+	}
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (w *compressWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func (w *compressWriter) close() {
+	if w.compressor == nil {
+		return
+	}
+	_ = w.compressor.Close()
+	w.compressor.Reset(io.Discard)
+	switch c := w.compressor.(type) {
+	case *brotli.Writer:
+		brotliWriters.Put(c)
+	case *gzip.Writer:
+		gzipWriters.Put(c)
+	}
+}
@@ -0,0 +1,79 @@
+package grpc
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNegotiateEncoding(t *testing.T) {
+	for header, expected := range map[string]string{
+		"":                         "",
+		"identity":                 "",
+		"deflate":                  "",
+		"gzip":                     "gzip",
+		"GZIP":                     "gzip",
+		"gzip, deflate, br":        "br",
+		"br;q=0.5, gzip":           "gzip",
+		"br;q=0, gzip;q=0":         "",
+		"gzip;q=0.8, br;q=invalid": "gzip",
+		"*":                        "",
+	} {
+		assert.Equal(t, expected, negotiateEncoding([]string{header}), header)
+	}
+}
+
+func TestCompressionHandler(t *testing.T) {
+	body := strings.Repeat(`{"tickNumber":1,"data":""}`, 100)
+	handler := compressionHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/empty" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, body[:100])
+		http.NewResponseController(w).Flush() //nolint:errcheck
+		_, _ = io.WriteString(w, body[100:])
+	}))
+
+	for encoding, reader := range map[string]func(io.Reader) (io.Reader, error){
+		"gzip": func(r io.Reader) (io.Reader, error) { return gzip.NewReader(r) },
+		"br":   func(r io.Reader) (io.Reader, error) { return brotli.NewReader(r), nil },
+	} {
+		for range 2 { // writers are reused
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(http.MethodPost, "/getEventLogs", nil)
+			request.Header.Set("Accept-Encoding", encoding)
+			handler.ServeHTTP(recorder, request)
+
+			assert.Equal(t, encoding, recorder.Header().Get("Content-Encoding"))
+			assert.Equal(t, "Accept-Encoding", recorder.Header().Get("Vary"))
+			assert.Less(t, recorder.Body.Len(), len(body))
+			decompressed, err := reader(recorder.Body)
+			require.NoError(t, err)
+			data, err := io.ReadAll(decompressed)
+			require.NoError(t, err)
+			assert.Equal(t, body, string(data))
+		}
+	}
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/getEventLogs", nil))
+	assert.Empty(t, recorder.Header().Get("Content-Encoding"))
+	assert.Equal(t, body, recorder.Body.String())
+
+	recorder = httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/empty", nil)
+	request.Header.Set("Accept-Encoding", "gzip")
+	handler.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+	assert.Empty(t, recorder.Header().Get("Content-Encoding"))
+	assert.Zero(t, recorder.Body.Len())
+}
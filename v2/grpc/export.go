@@ -0,0 +1,355 @@
+package grpc
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/csv"
+	"fmt"
+	"log"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	"github.com/qubic/archive-query-service/v2/domain/decoder"
+	"github.com/qubic/archive-query-service/v2/entities"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	exportFormatNDJSON = "ndjson"
+	exportFormatCSV    = "csv"
+	exportAPIKeyHeader = "X-Api-Key"
+	maxExportBodySize  = 1024 * 1024
+)
+
+type ExportService interface {
+	ExportTransactionsForIdentity(ctx context.Context, identity string, filters entities.Filters, limit uint32, fn func([]*api.Transaction) error) error
+	ExportEvents(ctx context.Context, filters entities.Filters, limit uint32, fn func([]*api.Event) error) error
+}
+
+type ExportConfig struct {
+	APIKeys []string // clients need to send one of the keys in the X-Api-Key header
+	MaxRows uint32   // maximum number of rows per export
+}
+
+// ExportHandler streams the complete results of transactions for identity and event logs queries as NDJSON or CSV.
+// The request bodies are the same as for the paged endpoints. Pagination is ignored.
+type ExportHandler struct {
+	service ExportService
+	cfg     ExportConfig
+	mux     *runtime.ServeMux
+}
+
+func NewExportHandler(service ExportService, cfg ExportConfig) *ExportHandler {
+	return &ExportHandler{
+		service: service,
+		cfg:     cfg,
+	}
+}
+
+// Register adds the export endpoints to the http gateway mux.
+func (h *ExportHandler) Register(mux *runtime.ServeMux) error {
+	if len(h.cfg.APIKeys) == 0 {
+		return fmt.Errorf("no api keys configured")
+	}
+	h.mux = mux
+	if err := mux.HandlePath(http.MethodPost, "/export/transactionsForIdentity", h.exportTransactionsForIdentity); err != nil {
+		return fmt.Errorf("registering transactions export: %w", err)
+	}
+	if err := mux.HandlePath(http.MethodPost, "/export/eventLogs", h.exportEventLogs); err != nil {
+		return fmt.Errorf("registering event logs export: %w", err)
+	}
+	return nil
+}
+
+var transactionsCSVHeader = []string{"hash", "source", "destination", "amount", "tickNumber", "timestamp", "inputType",
+	"inputSize", "inputData", "signature", "moneyFlew", "executionStatus", "decodedInput"}
+
+func (h *ExportHandler) exportTransactionsForIdentity(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	format, limit, err := h.validateExportRequest(r)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	var request api.GetTransactionsForIdentityRequest
+	if err = h.decodeBody(w, r, &request); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	queryFilters, err := createIdentityTransactionQueryFilters(&request)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	stream := newExportStream(w, format, "transactions", transactionsCSVHeader, transactionToCSVRow)
+	err = h.service.ExportTransactionsForIdentity(r.Context(), request.GetIdentity(), queryFilters, limit, func(txs []*api.Transaction) error {
+		if request.GetDecodeInput() {
+			decoder.DecodeInputs(txs)
+		}
+		return stream.write(txs)
+	})
+	h.finish(w, r, stream, err, fmt.Sprintf("failed to export transactions for identity [%s]", request.GetIdentity()))
+}
+
+var eventsCSVHeader = []string{"epoch", "tickNumber", "timestamp", "transactionHash", "logType", "logId", "logDigest",
+	"categories", "rawPayload", "data"}
+
+func (h *ExportHandler) exportEventLogs(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	format, limit, err := h.validateExportRequest(r)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	var request api.GetEventLogsRequest
+	if err = h.decodeBody(w, r, &request); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	queryFilters, err := CreateEventQueryFilters(&request)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	stream := newExportStream(w, format, "event-logs", eventsCSVHeader, eventToCSVRow)
+	err = h.service.ExportEvents(r.Context(), queryFilters, limit, stream.write)
+	h.finish(w, r, stream, err, "failed to export events")
+}
+
+// validateExportRequest checks the api key and returns the requested format and row limit. The format can be selected
+// with the format query parameter or the accept header and defaults to NDJSON.
+func (h *ExportHandler) validateExportRequest(r *http.Request) (string, uint32, error) {
+	if !h.isAuthorized(r.Header.Get(exportAPIKeyHeader)) {
+		return "", 0, status.Error(codes.Unauthenticated, "missing or invalid api key")
+	}
+
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = exportFormatNDJSON
+		for _, accepted := range strings.Split(r.Header.Get("Accept"), ",") {
+			if mediaType, _, err := mime.ParseMediaType(accepted); err == nil && mediaType == "text/csv" {
+				format = exportFormatCSV
+				break
+			}
+		}
+	}
+	if format != exportFormatNDJSON && format != exportFormatCSV {
+		return "", 0, status.Errorf(codes.InvalidArgument, "unsupported format [%s]", format)
+	}
+
+	limit := h.cfg.MaxRows
+	if value := r.URL.Query().Get("limit"); value != "" {
+		parsed, err := strconv.ParseUint(value, 10, 32)
+		if err != nil || parsed == 0 || parsed > uint64(h.cfg.MaxRows) {
+			return "", 0, status.Errorf(codes.InvalidArgument, "limit must be between 1 and %d", h.cfg.MaxRows)
+		}
+		limit = uint32(parsed)
+	}
+	return format, limit, nil
+}
+
+func (h *ExportHandler) isAuthorized(apiKey string) bool {
+	return isValidAPIKey(h.cfg.APIKeys, apiKey)
+}
+
+// isValidAPIKey compares the api key in constant time with the configured keys.
+func isValidAPIKey(apiKeys []string, apiKey string) bool {
+	if apiKey == "" {
+		return false
+	}
+	for _, key := range apiKeys {
+		if subtle.ConstantTimeCompare([]byte(apiKey), []byte(key)) == 1 {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *ExportHandler) decodeBody(w http.ResponseWriter, r *http.Request, request proto.Message) error {
+	inbound, _ := runtime.MarshalerForRequest(h.mux, r)
+	if err := inbound.NewDecoder(http.MaxBytesReader(w, r.Body, maxExportBodySize)).Decode(request); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid request body: %v", err)
+	}
+	return nil
+}
+
+// finish completes the export. Errors are only reported with a status code, if nothing was sent yet. Otherwise, the
+// connection is aborted, so that clients notice the incomplete response.
+func (h *ExportHandler) finish(w http.ResponseWriter, r *http.Request, stream exportCloser, err error, message string) {
+	if err == nil {
+		err = stream.close()
+	}
+	if err == nil {
+		return
+	}
+	if !stream.hasStarted() {
+		h.writeError(w, r, createInternalError(message, err))
+		return
+	}
+	log.Printf("[ERROR] %s: %v", message, err)
+	panic(http.ErrAbortHandler)
+}
+
+func (h *ExportHandler) writeError(w http.ResponseWriter, r *http.Request, err error) {
+	_, outbound := runtime.MarshalerForRequest(h.mux, r)
+	runtime.HTTPError(r.Context(), h.mux, outbound, w, r, err)
+}
+
+var ndjsonMarshaler = protojson.MarshalOptions{EmitDefaultValues: true, EmitUnpopulated: true}
+
+type exportCloser interface {
+	close() error
+	hasStarted() bool
+}
+
+// exportStream writes the rows with chunked transfer encoding. The status and headers are sent with the first rows.
+type exportStream[T proto.Message] struct {
+	w         http.ResponseWriter
+	format    string
+	filename  string
+	csvHeader []string
+	csvRow    func(T) []string
+	csv       *csv.Writer
+	started   bool
+}
+
+func newExportStream[T proto.Message](w http.ResponseWriter, format, filename string, csvHeader []string, csvRow func(T) []string) *exportStream[T] {
+	// large exports take longer than the server read and write timeouts allow
+	controller := http.NewResponseController(w)
+	_ = controller.SetReadDeadline(time.Time{})
+	_ = controller.SetWriteDeadline(time.Time{})
+	return &exportStream[T]{
+		w:         w,
+		format:    format,
+		filename:  filename,
+		csvHeader: csvHeader,
+		csvRow:    csvRow,
+		csv:       csv.NewWriter(w),
+	}
+}
+
+func (s *exportStream[T]) hasStarted() bool {
+	return s.started
+}
+
+func (s *exportStream[T]) start() error {
+	if s.started {
+		return nil
+	}
+	s.started = true
+	contentType := "application/x-ndjson"
+	if s.format == exportFormatCSV {
+		contentType = "text/csv"
+	}
+	s.w.Header().Set("Content-Type", contentType)
+	s.w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.%s"`, s.filename, s.format))
+	s.w.WriteHeader(http.StatusOK)
+	if s.format == exportFormatCSV {
+		return s.csv.Write(s.csvHeader)
+	}
+	return nil
+}
+
+func (s *exportStream[T]) write(rows []T) error {
+	if err := s.start(); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if s.format == exportFormatCSV {
+			if err := s.csv.Write(s.csvRow(row)); err != nil {
+				return err
+			}
+			continue
+		}
+		line, err := ndjsonMarshaler.Marshal(row)
+		if err != nil {
+			return fmt.Errorf("marshalling row: %w", err)
+		}
+		if _, err = s.w.Write(append(line, '\n')); err != nil {
+			return err
+		}
+	}
+	return s.flush()
+}
+
+// close sends the headers (and csv header row) in case of empty results and flushes the remaining data.
+func (s *exportStream[T]) close() error {
+	if err := s.start(); err != nil {
+		return err
+	}
+	return s.flush()
+}
+
+func (s *exportStream[T]) flush() error {
+	s.csv.Flush()
+	if err := s.csv.Error(); err != nil {
+		return err
+	}
+	return http.NewResponseController(s.w).Flush()
+}
+
+func transactionToCSVRow(tx *api.Transaction) []string {
+	var decodedInput string
+	if tx.GetDecodedInput() != nil {
+		decodedInput = marshalCSVJSON(tx.GetDecodedInput())
+	}
+	return []string{
+		tx.GetHash(),
+		tx.GetSource(),
+		tx.GetDestination(),
+		strconv.FormatUint(tx.GetAmount(), 10),
+		strconv.FormatUint(uint64(tx.GetTickNumber()), 10),
+		strconv.FormatUint(tx.GetTimestamp(), 10),
+		strconv.FormatUint(uint64(tx.GetInputType()), 10),
+		strconv.FormatUint(uint64(tx.GetInputSize()), 10),
+		tx.GetInputData(),
+		tx.GetSignature(),
+		strconv.FormatBool(tx.GetMoneyFlew()),
+		tx.GetExecutionStatus().String(),
+		decodedInput,
+	}
+}
+
+// eventToCSVRow converts the event into one row. The log type specific data is added as json.
+func eventToCSVRow(event *api.Event) []string {
+	categories := make([]string, 0, len(event.GetCategories()))
+	for _, category := range event.GetCategories() {
+		categories = append(categories, strconv.FormatInt(int64(category), 10))
+	}
+	var data string
+	message := event.ProtoReflect()
+	if field := message.WhichOneof(message.Descriptor().Oneofs().ByName("event_data")); field != nil {
+		data = marshalCSVJSON(message.Get(field).Message().Interface())
+	}
+	return []string{
+		strconv.FormatUint(uint64(event.GetEpoch()), 10),
+		strconv.FormatUint(uint64(event.GetTickNumber()), 10),
+		strconv.FormatUint(event.GetTimestamp(), 10),
+		event.GetTransactionHash(),
+		strconv.FormatUint(uint64(event.GetLogType()), 10),
+		strconv.FormatUint(event.GetLogId(), 10),
+		event.GetLogDigest(),
+		strings.Join(categories, ";"),
+		base64.StdEncoding.EncodeToString(event.GetRawPayload()),
+		data,
+	}
+}
+
+func marshalCSVJSON(message proto.Message) string {
+	data, err := protojson.Marshal(message)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
@@ -0,0 +1,165 @@
+package grpc
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	"github.com/qubic/archive-query-service/v2/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const exportIdentity = "BZBQFLLBNCXEMGLOBHUVFTLUPLVCPQUASSILFABOFFBCADQSSUPNWLZBQEXK"
+
+type ExportServiceStub struct {
+	transactions [][]*api.Transaction
+	events       [][]*api.Event
+	limit        uint32
+	filters      entities.Filters
+	err          error
+}
+
+func (e *ExportServiceStub) ExportTransactionsForIdentity(_ context.Context, _ string, filters entities.Filters, limit uint32, fn func([]*api.Transaction) error) error {
+	e.limit, e.filters = limit, filters
+	for _, batch := range e.transactions {
+		if err := fn(batch); err != nil {
+			return err
+		}
+	}
+	return e.err
+}
+
+func (e *ExportServiceStub) ExportEvents(_ context.Context, filters entities.Filters, limit uint32, fn func([]*api.Event) error) error {
+	e.limit, e.filters = limit, filters
+	for _, batch := range e.events {
+		if err := fn(batch); err != nil {
+			return err
+		}
+	}
+	return e.err
+}
+
+func newExportTestServer(t *testing.T, stub *ExportServiceStub) *httptest.Server {
+	mux := runtime.NewServeMux()
+	require.NoError(t, NewExportHandler(stub, ExportConfig{APIKeys: []string{"secret"}, MaxRows: 100}).Register(mux))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func postExport(t *testing.T, url, apiKey, accept, body string) *http.Response {
+	request, err := http.NewRequest(http.MethodPost, url, strings.NewReader(body))
+	require.NoError(t, err)
+	if apiKey != "" {
+		request.Header.Set("X-Api-Key", apiKey)
+	}
+	if accept != "" {
+		request.Header.Set("Accept", accept)
+	}
+	response, err := http.DefaultClient.Do(request)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = response.Body.Close() })
+	return response
+}
+
+func TestExportHandler_ExportTransactionsForIdentity_NDJSON(t *testing.T) {
+	stub := &ExportServiceStub{transactions: [][]*api.Transaction{
+		{{Hash: "a", TickNumber: 3}, {Hash: "b", TickNumber: 2}},
+		{{Hash: "c", TickNumber: 1}},
+	}}
+	server := newExportTestServer(t, stub)
+
+	response := postExport(t, server.URL+"/export/transactionsForIdentity?limit=50", "secret", "",
+		`{"identity":"`+exportIdentity+`","filters":{"inputType":"0"},"pagination":{"size":10}}`)
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, "application/x-ndjson", response.Header.Get("Content-Type"))
+	assert.Equal(t, uint32(50), stub.limit)
+	assert.Equal(t, []string{"0"}, stub.filters.Include["inputType"])
+
+	decoder := json.NewDecoder(response.Body)
+	var hashes []string
+	for decoder.More() {
+		var tx map[string]any
+		require.NoError(t, decoder.Decode(&tx))
+		hashes = append(hashes, tx["hash"].(string))
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, hashes)
+}
+
+func TestExportHandler_ExportEventLogs_CSV(t *testing.T) {
+	hash := "hash"
+	stub := &ExportServiceStub{events: [][]*api.Event{{
+		{Epoch: 100, TickNumber: 42, TransactionHash: &hash, LogType: 0, LogId: 7, Categories: []int32{1, 2},
+			EventData: &api.Event_QuTransfer{QuTransfer: &api.QuTransferData{Source: "S", Destination: "D", Amount: 10}}},
+	}}}
+	server := newExportTestServer(t, stub)
+
+	response := postExport(t, server.URL+"/export/eventLogs", "secret", "text/csv", `{"filters":{"logType":"0"}}`)
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, "text/csv", response.Header.Get("Content-Type"))
+	assert.Equal(t, uint32(100), stub.limit)
+
+	records, err := csv.NewReader(response.Body).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, eventsCSVHeader, records[0])
+	assert.Equal(t, []string{"100", "42", "0", "hash", "0", "7", "", "1;2", ""}, records[1][:9])
+	assert.JSONEq(t, `{"source":"S","destination":"D","amount":"10"}`, records[1][9])
+}
+
+func TestExportHandler_GivenNoResults_ThenCSVHeaderOnly(t *testing.T) {
+	server := newExportTestServer(t, &ExportServiceStub{})
+
+	response := postExport(t, server.URL+"/export/transactionsForIdentity?format=csv", "secret", "", `{"identity":"`+exportIdentity+`"}`)
+	require.Equal(t, http.StatusOK, response.StatusCode)
+
+	records, err := csv.NewReader(response.Body).ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{transactionsCSVHeader}, records)
+}
+
+func TestExportHandler_GivenInvalidRequest_ThenError(t *testing.T) {
+	server := newExportTestServer(t, &ExportServiceStub{})
+
+	tests := []struct {
+		name   string
+		path   string
+		apiKey string
+		body   string
+		status int
+	}{
+		{name: "missing api key", path: "/export/eventLogs", body: `{}`, status: http.StatusUnauthorized},
+		{name: "wrong api key", path: "/export/eventLogs", apiKey: "wrong", body: `{}`, status: http.StatusUnauthorized},
+		{name: "unknown format", path: "/export/eventLogs?format=xml", apiKey: "secret", body: `{}`, status: http.StatusBadRequest},
+		{name: "limit too high", path: "/export/eventLogs?limit=101", apiKey: "secret", body: `{}`, status: http.StatusBadRequest},
+		{name: "invalid body", path: "/export/eventLogs", apiKey: "secret", body: `{`, status: http.StatusBadRequest},
+		{name: "invalid filter", path: "/export/eventLogs", apiKey: "secret", body: `{"filters":{"foo":"bar"}}`, status: http.StatusBadRequest},
+		{name: "invalid identity", path: "/export/transactionsForIdentity", apiKey: "secret", body: `{"identity":"foo"}`, status: http.StatusBadRequest},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			response := postExport(t, server.URL+tc.path, tc.apiKey, "", tc.body)
+			assert.Equal(t, tc.status, response.StatusCode)
+		})
+	}
+}
+
+func TestExportHandler_GivenErrorBeforeFirstRows_ThenInternalError(t *testing.T) {
+	server := newExportTestServer(t, &ExportServiceStub{err: errors.New("test")})
+
+	response := postExport(t, server.URL+"/export/eventLogs", "secret", "", `{}`)
+	assert.Equal(t, http.StatusInternalServerError, response.StatusCode)
+}
+
+func TestExportHandler_Register_GivenNoAPIKeys_ThenError(t *testing.T) {
+	err := NewExportHandler(&ExportServiceStub{}, ExportConfig{MaxRows: 100}).Register(runtime.NewServeMux())
+	require.Error(t, err)
+}
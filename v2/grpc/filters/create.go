@@ -13,13 +13,13 @@ import (
 func CreateFilters(value string, maxValues, maxLength int) ([]string, error) {
 	// check max length to avoid further more costly processing
 	if maxLength > 0 && len(value) > maxLength {
-		return nil, fmt.Errorf("exceeds maximum length")
+		return nil, NewFieldError("", ReasonValueTooLong, fmt.Errorf("exceeds maximum length"))
 	}
 
 	// count commas first to avoid input with many strings before splitting
 	valCount := strings.Count(value, ",")
 	if valCount >= maxValues {
-		return nil, fmt.Errorf("more than [%d] values", maxValues)
+		return nil, NewFieldError("", ReasonInvalidNumberOfValues, fmt.Errorf("more than [%d] values", maxValues))
 	}
 
 	var err error
@@ -60,7 +60,7 @@ func createNumericRange[T numeric](r *api.Range, bitSize int, parseNumeric parse
 		lowerBound, err = parseNumeric(r.GetGt(), bitSize)
 		lowerBound = utils.If(lowerBound >= 0, lowerBound+1, lowerBound-1) // for later comparison
 		if err != nil {
-			return nil, fmt.Errorf("invalid [gt] value: %w", err)
+			return nil, NewFieldError("gt", ReasonInvalidValue, fmt.Errorf("invalid [gt] value: %w", err))
 		}
 		ranges = append(ranges, entities.Range{
 			Operation: "gt",
@@ -69,7 +69,7 @@ func createNumericRange[T numeric](r *api.Range, bitSize int, parseNumeric parse
 	case *api.Range_Gte:
 		lowerBound, err = parseNumeric(r.GetGte(), bitSize)
 		if err != nil {
-			return nil, fmt.Errorf("invalid [gte] value: %w", err)
+			return nil, NewFieldError("gte", ReasonInvalidValue, fmt.Errorf("invalid [gte] value: %w", err))
 		}
 		ranges = append(ranges, entities.Range{
 			Operation: "gte",
@@ -82,7 +82,7 @@ func createNumericRange[T numeric](r *api.Range, bitSize int, parseNumeric parse
 		upperBound, err = parseNumeric(r.GetLt(), bitSize)
 		upperBound = utils.If(upperBound >= 0, upperBound-1, upperBound+1) // for later comparison
 		if err != nil {
-			return nil, fmt.Errorf("invalid [lt] value: %w", err)
+			return nil, NewFieldError("lt", ReasonInvalidValue, fmt.Errorf("invalid [lt] value: %w", err))
 		}
 		ranges = append(ranges, entities.Range{
 			Operation: "lt",
@@ -91,7 +91,7 @@ func createNumericRange[T numeric](r *api.Range, bitSize int, parseNumeric parse
 	case *api.Range_Lte:
 		upperBound, err = parseNumeric(r.GetLte(), bitSize)
 		if err != nil {
-			return nil, fmt.Errorf("invalid [lte] value: %w", err)
+			return nil, NewFieldError("lte", ReasonInvalidValue, fmt.Errorf("invalid [lte] value: %w", err))
 		}
 		ranges = append(ranges, entities.Range{
 			Operation: "lte",
@@ -100,11 +100,11 @@ func createNumericRange[T numeric](r *api.Range, bitSize int, parseNumeric parse
 	}
 
 	if len(ranges) == 0 {
-		return nil, fmt.Errorf("invalid range: no bounds")
+		return nil, NewFieldError("", ReasonInvalidRange, fmt.Errorf("invalid range: no bounds"))
 	}
 
 	if len(ranges) > 1 && lowerBound >= upperBound {
-		return nil, fmt.Errorf("invalid range: [%d:%d]", lowerBound, upperBound)
+		return nil, NewFieldError("", ReasonInvalidRange, fmt.Errorf("invalid range: [%d:%d]", lowerBound, upperBound))
 	}
 
 	return ranges, nil
@@ -157,10 +157,10 @@ func splitFilterValue(value string) ([]string, error) {
 	for _, s := range split {
 		trimmed := strings.TrimSpace(s)
 		if trimmed == "" {
-			return nil, fmt.Errorf("contains empty value")
+			return nil, NewFieldError("", ReasonEmptyValue, fmt.Errorf("contains empty value"))
 		}
 		if seen[trimmed] {
-			return nil, fmt.Errorf("contains duplicate value [%s]", trimmed)
+			return nil, NewFieldError("", ReasonDuplicateValue, fmt.Errorf("contains duplicate value [%s]", trimmed))
 		}
 		seen[trimmed] = true
 		values = append(values, trimmed)
@@ -171,7 +171,7 @@ func splitFilterValue(value string) ([]string, error) {
 func trimFilterValue(value string) ([]string, error) {
 	trimmed := strings.TrimSpace(value)
 	if len(trimmed) == 0 {
-		return nil, fmt.Errorf("empty value")
+		return nil, NewFieldError("", ReasonEmptyValue, fmt.Errorf("empty value"))
 	}
 	return []string{trimmed}, nil
 }
@@ -0,0 +1,64 @@
+package filters
+
+import (
+	"errors"
+	"strings"
+)
+
+// Reason codes of the validation errors.
+const (
+	ReasonInvalidValue          = "INVALID_VALUE"
+	ReasonEmptyValue            = "EMPTY_VALUE"
+	ReasonDuplicateValue        = "DUPLICATE_VALUE"
+	ReasonValueTooLong          = "VALUE_TOO_LONG"
+	ReasonInvalidNumberOfValues = "INVALID_NUMBER_OF_VALUES"
+	ReasonUnsupportedFilter     = "UNSUPPORTED_FILTER"
+	ReasonTooManyFilters        = "TOO_MANY_FILTERS"
+	ReasonNotEnoughFilters      = "NOT_ENOUGH_FILTERS"
+	ReasonDuplicateFilter       = "DUPLICATE_FILTER"
+	ReasonInvalidRange          = "INVALID_RANGE"
+)
+
+// FieldError is a validation error of a request field. The field is a path relative to the validated filters (for
+// example the filter key), the reason is a machine-readable code. The message is the one of the wrapped error.
+type FieldError struct {
+	Field  string
+	Reason string
+	Err    error
+}
+
+func NewFieldError(field, reason string, err error) *FieldError {
+	return &FieldError{Field: field, Reason: reason, Err: err}
+}
+
+func (e *FieldError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// withField prefixes the field of the wrapped field error. Errors without field error are invalid values of the field.
+func withField(field string, err error) error {
+	var fieldErr *FieldError
+	if errors.As(err, &fieldErr) {
+		fieldErr.Field = JoinField(field, fieldErr.Field)
+		return err
+	}
+	return NewFieldError(field, ReasonInvalidValue, err)
+}
+
+// JoinField joins the field paths. Index elements (for example '[0]') are appended without separator.
+func JoinField(parent, child string) string {
+	switch {
+	case parent == "":
+		return child
+	case child == "":
+		return parent
+	case strings.HasPrefix(child, "["):
+		return parent + child
+	default:
+		return parent + "." + child
+	}
+}
@@ -0,0 +1,105 @@
+package filters
+
+import (
+	"errors"
+	"testing"
+
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	"github.com/qubic/archive-query-service/v2/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func requireFieldError(t *testing.T, err error, field, reason string) {
+	t.Helper()
+	var fieldErr *FieldError
+	require.True(t, errors.As(err, &fieldErr), "field error expected: %v", err)
+	assert.Equal(t, field, fieldErr.Field)
+	assert.Equal(t, reason, fieldErr.Reason)
+}
+
+func TestFieldError_GivenInvalidEventFilters_ThenFieldAndReason(t *testing.T) {
+	_, err := CreateEventFilters(map[string]string{"foo": "1"}, AllowedEventIncludeFilters)
+	requireFieldError(t, err, "foo", ReasonUnsupportedFilter)
+	assert.ErrorContains(t, err, "validating filter: unsupported filter [foo]", "message is unchanged")
+
+	_, err = CreateEventFilters(map[string]string{EventFilterLogType: "256"}, AllowedEventIncludeFilters)
+	requireFieldError(t, err, "logType", ReasonInvalidValue)
+
+	_, err = CreateEventFilters(map[string]string{EventFilterLogType: "1,1"}, AllowedEventIncludeFilters)
+	requireFieldError(t, err, "logType", ReasonDuplicateValue)
+
+	_, err = CreateEventFilters(map[string]string{EventFilterLogType: "1,2,3,4,5,6"}, AllowedEventIncludeFilters)
+	requireFieldError(t, err, "logType", ReasonInvalidNumberOfValues)
+
+	_, err = CreateEventFilters(map[string]string{EventFilterEpoch: " "}, AllowedEventIncludeFilters)
+	requireFieldError(t, err, "epoch", ReasonEmptyValue)
+
+	_, err = CreateEventFilters(map[string]string{EventFilterAssetName: "TOOLONGNAME"}, AllowedEventIncludeFilters)
+	requireFieldError(t, err, "assetName", ReasonValueTooLong)
+}
+
+func TestFieldError_GivenInvalidEventRanges_ThenFieldAndReason(t *testing.T) {
+	_, err := CreateEventRanges(map[string]*api.Range{EventFilterAmount: {LowerBound: &api.Range_Gte{Gte: "x"}}}, AllowedEventRanges)
+	requireFieldError(t, err, "amount.gte", ReasonInvalidValue)
+
+	_, err = CreateEventRanges(map[string]*api.Range{EventFilterAmount: {}}, AllowedEventRanges)
+	requireFieldError(t, err, "amount", ReasonInvalidRange)
+
+	_, err = CreateEventRanges(map[string]*api.Range{EventFilterLogType: {LowerBound: &api.Range_Gte{Gte: "1"}}}, AllowedEventRanges)
+	requireFieldError(t, err, "logType", ReasonUnsupportedFilter)
+}
+
+func TestFieldError_GivenInvalidShouldFilters_ThenFieldAndReason(t *testing.T) {
+	should := []*api.ShouldFilter{
+		{Terms: map[string]string{EventFilterSource: validId, EventFilterDestination: validId}},
+		{Terms: map[string]string{EventFilterSource: validId}, Ranges: map[string]*api.Range{EventFilterAmount: {}}},
+	}
+	_, err := CreateShouldFilters(should, AllowedEventShouldFilters, AllowedEventShouldRanges)
+	requireFieldError(t, err, "[1].ranges.amount", ReasonInvalidRange)
+
+	should[1] = &api.ShouldFilter{Terms: map[string]string{EventFilterSource: validId}}
+	_, err = CreateShouldFilters(should, AllowedEventShouldFilters, AllowedEventShouldRanges)
+	requireFieldError(t, err, "[1]", ReasonNotEnoughFilters)
+
+	_, err = CreateShouldFilters(append(should, should...), AllowedEventShouldFilters, AllowedEventShouldRanges)
+	requireFieldError(t, err, "", ReasonTooManyFilters)
+}
+
+func TestFieldError_GivenInvalidTransactionFilters_ThenFieldAndReason(t *testing.T) {
+	_, err := CreateIdentityTransactionFilters(map[string]string{IdentityFilterSource: "invalid"})
+	requireFieldError(t, err, "source", ReasonInvalidValue)
+
+	_, err = CreateIdentityTransactionQueryRanges(map[string]*api.Range{IdentityFilterTickNumber: {LowerBound: &api.Range_Gt{Gt: "2"}, UpperBound: &api.Range_Lt{Lt: "1"}}})
+	requireFieldError(t, err, "tickNumber", ReasonInvalidRange)
+
+	err = ValidateExcludeFilterKeys(map[string][]string{IdentityFilterAmount: {"1"}})
+	requireFieldError(t, err, "amount", ReasonUnsupportedFilter)
+
+	_, err = CreateTickTransactionsFilters(map[string]string{TickFilterTimestamp: "1"})
+	requireFieldError(t, err, "timestamp", ReasonUnsupportedFilter)
+
+	_, err = ValidateTickTransactionQueryRanges(map[string][]string{TickFilterAmount: {"1"}}, map[string]*api.Range{TickFilterAmount: {LowerBound: &api.Range_Gt{Gt: "2"}}})
+	requireFieldError(t, err, "amount", ReasonDuplicateFilter)
+}
+
+func TestFieldError_GivenConflictingFilters_ThenRequestField(t *testing.T) {
+	err := VerifyNoConflictingFilters(entities.Filters{
+		Include: map[string][]string{"amount": {"1"}},
+		Ranges:  map[string][]entities.Range{"amount": {{Operation: "gt", Value: "1"}}},
+	})
+	requireFieldError(t, err, "ranges.amount", ReasonDuplicateFilter)
+
+	err = VerifyNoConflictingFilters(entities.Filters{
+		Include: map[string][]string{"source": {validId}},
+		Should:  []entities.ShouldFilter{{Terms: map[string][]string{"source": {validId}}}},
+	})
+	requireFieldError(t, err, "should[0].terms.source", ReasonDuplicateFilter)
+}
+
+func TestJoinField(t *testing.T) {
+	assert.Equal(t, "filters.source", JoinField("filters", "source"))
+	assert.Equal(t, "should[0].terms", JoinField("should", "[0].terms"))
+	assert.Equal(t, "filters", JoinField("filters", ""))
+	assert.Equal(t, "source", JoinField("", "source"))
+}
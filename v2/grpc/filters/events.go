@@ -91,7 +91,7 @@ func CreateEventFilters(filterMap map[string]string, allowedKeys map[string]bool
 
 		vs, err := CreateFilters(v, maxValues, maxLength)
 		if err != nil {
-			return nil, fmt.Errorf("handling filter [%s]: %w", k, err)
+			return nil, fmt.Errorf("handling filter [%s]: %w", k, withField(k, err))
 		}
 		res[k] = vs
 	}
@@ -133,21 +133,21 @@ func validateEventsFilters(filterMap map[string][]string, allowedKeys map[string
 	}
 
 	if len(filterMap) > len(allowedKeys) {
-		return fmt.Errorf("too many filters (%d)", len(filterMap))
+		return NewFieldError("", ReasonTooManyFilters, fmt.Errorf("too many filters (%d)", len(filterMap)))
 	}
 
 	for key, values := range filterMap {
 		if _, ok := allowedKeys[key]; !ok {
-			return fmt.Errorf("unsupported filter [%s]", key)
+			return NewFieldError(key, ReasonUnsupportedFilter, fmt.Errorf("unsupported filter [%s]", key))
 		}
 
 		validator, ok := eventFilterValidators[key]
 		if !ok {
-			return fmt.Errorf("unhandled filter: [%s]", key)
+			return NewFieldError(key, ReasonUnsupportedFilter, fmt.Errorf("unhandled filter: [%s]", key))
 		}
 
 		if err := validator(values); err != nil {
-			return fmt.Errorf("invalid [%s] filter: %w", key, err)
+			return fmt.Errorf("invalid [%s] filter: %w", key, withField(key, err))
 		}
 	}
 	return nil
@@ -159,20 +159,20 @@ func CreateEventRanges(ranges map[string]*api.Range, allowedKeys map[string]bool
 		return nil, nil
 	}
 	if len(ranges) > len(allowedKeys) {
-		return nil, fmt.Errorf("too many ranges (%d)", len(ranges))
+		return nil, NewFieldError("", ReasonTooManyFilters, fmt.Errorf("too many ranges (%d)", len(ranges)))
 	}
 
 	for key, value := range ranges {
 
 		if _, ok := allowedKeys[key]; !ok {
-			return nil, fmt.Errorf("unsupported filter [%s]", key)
+			return nil, NewFieldError(key, ReasonUnsupportedFilter, fmt.Errorf("unsupported filter [%s]", key))
 		}
 
 		switch key {
 		case EventFilterAmount, EventFilterNumberOfShares, EventRangeTimestamp, EventFilterDeductedAmount:
 			r, err := CreateUnsignedNumericRange(value, 64)
 			if err != nil {
-				return nil, fmt.Errorf("invalid [%s] range: %w", key, err)
+				return nil, fmt.Errorf("invalid [%s] range: %w", key, withField(key, err))
 			}
 			if len(r) > 0 {
 				convertedRanges[key] = r
@@ -180,7 +180,7 @@ func CreateEventRanges(ranges map[string]*api.Range, allowedKeys map[string]bool
 		case EventFilterRemainingAmount:
 			r, err := CreateSignedNumericRange(value, 64)
 			if err != nil {
-				return nil, fmt.Errorf("invalid [%s] range: %w", key, err)
+				return nil, fmt.Errorf("invalid [%s] range: %w", key, withField(key, err))
 			}
 			if len(r) > 0 {
 				convertedRanges[key] = r
@@ -188,13 +188,13 @@ func CreateEventRanges(ranges map[string]*api.Range, allowedKeys map[string]bool
 		case EventFilterTickNumber, EventFilterEpoch:
 			r, err := CreateUnsignedNumericRange(value, 32)
 			if err != nil {
-				return nil, fmt.Errorf("invalid [%s] range: %w", key, err)
+				return nil, fmt.Errorf("invalid [%s] range: %w", key, withField(key, err))
 			}
 			if len(r) > 0 {
 				convertedRanges[key] = r
 			}
 		default:
-			return nil, fmt.Errorf("unhandled range: [%s]", key)
+			return nil, NewFieldError(key, ReasonUnsupportedFilter, fmt.Errorf("unhandled range: [%s]", key))
 		}
 	}
 
@@ -205,20 +205,20 @@ const maxNumberOfShouldFilters = 2
 
 func CreateShouldFilters(should []*api.ShouldFilter, allowedFilters, allowedRanges map[string]bool) ([]entities.ShouldFilter, error) {
 	if len(should) > maxNumberOfShouldFilters {
-		return nil, fmt.Errorf("too many should filters (%d)", len(should))
+		return nil, NewFieldError("", ReasonTooManyFilters, fmt.Errorf("too many should filters (%d)", len(should)))
 	}
 	var shouldFilters = make([]entities.ShouldFilter, 0, len(should))
-	for _, shouldFilter := range should {
+	for i, shouldFilter := range should {
 		shouldFilterTerms, err := CreateEventFilters(shouldFilter.GetTerms(), allowedFilters)
 		if err != nil {
-			return nil, fmt.Errorf("creating filters: %w", err)
+			return nil, fmt.Errorf("creating filters: %w", withField(fmt.Sprintf("[%d].terms", i), err))
 		}
 		shouldFilterRanges, err := CreateEventRanges(shouldFilter.GetRanges(), allowedRanges)
 		if err != nil {
-			return nil, fmt.Errorf("creating ranges: %w", err)
+			return nil, fmt.Errorf("creating ranges: %w", withField(fmt.Sprintf("[%d].ranges", i), err))
 		}
 		if len(shouldFilterTerms)+len(shouldFilterRanges) < 2 {
-			return nil, fmt.Errorf("needs at least two filters")
+			return nil, NewFieldError(fmt.Sprintf("[%d]", i), ReasonNotEnoughFilters, fmt.Errorf("needs at least two filters"))
 		}
 		shouldFilters = append(shouldFilters, entities.ShouldFilter{
 			Terms:  shouldFilterTerms,
@@ -31,7 +31,7 @@ func CreateIdentityTransactionFilters(filterMap map[string]string) (map[string][
 
 		vs, err := CreateFilters(v, maxValues, maxLength)
 		if err != nil {
-			return nil, fmt.Errorf("handling filter [%s]: %w", k, err)
+			return nil, fmt.Errorf("handling filter [%s]: %w", k, withField(k, err))
 		}
 		res[k] = vs
 
@@ -48,7 +48,7 @@ func CreateIdentityTransactionFilters(filterMap map[string]string) (map[string][
 func ValidateExcludeFilterKeys(excludeFilters map[string][]string) error {
 	for k := range excludeFilters {
 		if k != EventFilterSource && k != EventFilterDestination {
-			return fmt.Errorf("unsupported exclude filter [%s]", k)
+			return NewFieldError(k, ReasonUnsupportedFilter, fmt.Errorf("unsupported exclude filter [%s]", k))
 		}
 	}
 	return nil
@@ -60,7 +60,7 @@ func validateIdentityTransactionQueryFilters(filterMap map[string][]string) erro
 	}
 
 	if len(filterMap) > maxNumberOfPerIdentityFilters {
-		return fmt.Errorf("too many filters (%d)", len(filterMap))
+		return NewFieldError("", ReasonTooManyFilters, fmt.Errorf("too many filters (%d)", len(filterMap)))
 	}
 
 	for key, values := range filterMap {
@@ -68,20 +68,20 @@ func validateIdentityTransactionQueryFilters(filterMap map[string][]string) erro
 		case IdentityFilterSource, IdentityFilterDestination:
 			err := ValidateIdentityFilterValues(values, maxValuesPerIdentityFilter)
 			if err != nil {
-				return fmt.Errorf("invalid [%s] filter: %w", key, err)
+				return fmt.Errorf("invalid [%s] filter: %w", key, withField(key, err))
 			}
 		case IdentityFilterAmount:
 			err := ValidateUnsignedNumericFilterValues(values, 64, 1)
 			if err != nil {
-				return fmt.Errorf("invalid [%s] filter: %w", key, err)
+				return fmt.Errorf("invalid [%s] filter: %w", key, withField(key, err))
 			}
 		case IdentityFilterTickNumber, IdentityFilterInputType:
 			err := ValidateUnsignedNumericFilterValues(values, 32, 1)
 			if err != nil {
-				return fmt.Errorf("invalid [%s] filter: %w", key, err)
+				return fmt.Errorf("invalid [%s] filter: %w", key, withField(key, err))
 			}
 		default:
-			return fmt.Errorf("unsupported filter: [%s]", key)
+			return NewFieldError(key, ReasonUnsupportedFilter, fmt.Errorf("unsupported filter: [%s]", key))
 		}
 	}
 	return nil
@@ -95,7 +95,7 @@ func CreateIdentityTransactionQueryRanges(ranges map[string]*api.Range) (map[str
 		return nil, nil
 	}
 	if len(ranges) > allowedNumberOfPerIdentityQueryRanges {
-		return nil, fmt.Errorf("too many ranges (%d)", len(ranges))
+		return nil, NewFieldError("", ReasonTooManyFilters, fmt.Errorf("too many ranges (%d)", len(ranges)))
 	}
 
 	for key, value := range ranges {
@@ -103,7 +103,7 @@ func CreateIdentityTransactionQueryRanges(ranges map[string]*api.Range) (map[str
 		case IdentityFilterAmount, IdentityFilterTimestamp:
 			r, err := CreateUnsignedNumericRange(value, 64)
 			if err != nil {
-				return nil, fmt.Errorf("invalid %s range: %w", key, err)
+				return nil, fmt.Errorf("invalid %s range: %w", key, withField(key, err))
 			}
 			if len(r) > 0 {
 				convertedRanges[key] = r
@@ -111,13 +111,13 @@ func CreateIdentityTransactionQueryRanges(ranges map[string]*api.Range) (map[str
 		case IdentityFilterTickNumber, IdentityFilterInputType:
 			r, err := CreateUnsignedNumericRange(value, 32)
 			if err != nil {
-				return nil, fmt.Errorf("invalid %s range: %w", key, err)
+				return nil, fmt.Errorf("invalid %s range: %w", key, withField(key, err))
 			}
 			if len(r) > 0 {
 				convertedRanges[key] = r
 			}
 		default:
-			return nil, fmt.Errorf("unsupported range: [%s]", key)
+			return nil, NewFieldError(key, ReasonUnsupportedFilter, fmt.Errorf("unsupported range: [%s]", key))
 		}
 	}
 
@@ -24,7 +24,7 @@ func CreateTickTransactionsFilters(filterMap map[string]string) (map[string][]st
 	for k, v := range filterMap {
 		f, err := CreateFilters(v, 1, 60) // 60 character identity
 		if err != nil {
-			return nil, fmt.Errorf("creating tick transactions filter [%s]: %w", k, err)
+			return nil, fmt.Errorf("creating tick transactions filter [%s]: %w", k, withField(k, err))
 		}
 		res[k] = f
 	}
@@ -43,7 +43,7 @@ func validateTickTransactionQueryFilters(filterMap map[string][]string) error {
 	}
 
 	if len(filterMap) > len(allowedTickTermFilters) {
-		return errors.New("too many filters")
+		return NewFieldError("", ReasonTooManyFilters, errors.New("too many filters"))
 	}
 
 	for key, values := range filterMap {
@@ -51,20 +51,20 @@ func validateTickTransactionQueryFilters(filterMap map[string][]string) error {
 		case TickFilterSource, TickFilterDestination:
 			err := ValidateIdentityFilterValues(values, 1)
 			if err != nil {
-				return fmt.Errorf("invalid [%s] filter: %w", key, err)
+				return fmt.Errorf("invalid [%s] filter: %w", key, withField(key, err))
 			}
 		case TickFilterAmount:
 			err := ValidateUnsignedNumericFilterValues(values, 64, 1)
 			if err != nil {
-				return fmt.Errorf("invalid [%s] filter: %w", key, err)
+				return fmt.Errorf("invalid [%s] filter: %w", key, withField(key, err))
 			}
 		case TickFilterInputType:
 			err := ValidateUnsignedNumericFilterValues(values, 32, 1)
 			if err != nil {
-				return fmt.Errorf("invalid [%s] filter: %w", key, err)
+				return fmt.Errorf("invalid [%s] filter: %w", key, withField(key, err))
 			}
 		default:
-			return fmt.Errorf("unsupported filter: [%s]", key)
+			return NewFieldError(key, ReasonUnsupportedFilter, fmt.Errorf("unsupported filter: [%s]", key))
 		}
 	}
 	return nil
@@ -78,12 +78,12 @@ func ValidateTickTransactionQueryRanges(filterMap map[string][]string, ranges ma
 		return nil, nil
 	}
 	if len(ranges) > allowedNumberOfTickQueryRanges {
-		return nil, fmt.Errorf("too many ranges (%d)", len(ranges))
+		return nil, NewFieldError("", ReasonTooManyFilters, fmt.Errorf("too many ranges (%d)", len(ranges)))
 	}
 
 	for k := range ranges {
 		if _, found := filterMap[k]; found {
-			return nil, fmt.Errorf("duplicate [%s] filter", k)
+			return nil, NewFieldError(k, ReasonDuplicateFilter, fmt.Errorf("duplicate [%s] filter", k))
 		}
 	}
 
@@ -92,7 +92,7 @@ func ValidateTickTransactionQueryRanges(filterMap map[string][]string, ranges ma
 		case TickFilterAmount:
 			r, err := CreateUnsignedNumericRange(value, 64)
 			if err != nil {
-				return nil, fmt.Errorf("invalid [%s] range: %w", key, err)
+				return nil, fmt.Errorf("invalid [%s] range: %w", key, withField(key, err))
 			}
 			if len(r) > 0 {
 				convertedRanges[key] = r
@@ -100,13 +100,13 @@ func ValidateTickTransactionQueryRanges(filterMap map[string][]string, ranges ma
 		case TickFilterInputType:
 			r, err := CreateUnsignedNumericRange(value, 32)
 			if err != nil {
-				return nil, fmt.Errorf("invalid [%s] range: %w", key, err)
+				return nil, fmt.Errorf("invalid [%s] range: %w", key, withField(key, err))
 			}
 			if len(r) > 0 {
 				convertedRanges[key] = r
 			}
 		default:
-			return nil, fmt.Errorf("unsupported range: [%s]", key)
+			return nil, NewFieldError(key, ReasonUnsupportedFilter, fmt.Errorf("unsupported range: [%s]", key))
 		}
 	}
 
@@ -44,7 +44,7 @@ func ValidateStringFilterLength(values []string, maxLength, maxNumberOfValues in
 	}
 	for _, val := range values {
 		if len(val) > maxLength {
-			return fmt.Errorf("invalid string length: %d", len(val))
+			return NewFieldError("", ReasonValueTooLong, fmt.Errorf("invalid string length: %d", len(val)))
 		}
 	}
 	return nil
@@ -66,28 +66,28 @@ func validateDigest(values []string, maxValues int, lowercase bool) error {
 
 func VerifyNoConflictingFilters(queryFilters entities.Filters) error {
 	keys := make(map[string]bool, 10)
-	err := checkForConflictingKeys(keys, queryFilters.Include, true)
+	err := checkForConflictingKeys(keys, "filters", queryFilters.Include, true)
 	if err != nil {
 		return err
 	}
-	err = checkForConflictingKeys(keys, queryFilters.Ranges, true)
+	err = checkForConflictingKeys(keys, "ranges", queryFilters.Ranges, true)
 	if err != nil {
 		return err
 	}
 
 	// we do not check the exclude filters against the should filters
 	// allow excluding values that are returned by applying the should filters
-	err = checkForConflictingKeys(keys, queryFilters.Exclude, false) // do not modify
+	err = checkForConflictingKeys(keys, "exclude", queryFilters.Exclude, false) // do not modify
 	if err != nil {
 		return err
 	}
 
-	for _, should := range queryFilters.Should {
-		err = checkForConflictingKeys(keys, should.Ranges, true)
+	for i, should := range queryFilters.Should {
+		err = checkForConflictingKeys(keys, fmt.Sprintf("should[%d].ranges", i), should.Ranges, true)
 		if err != nil {
 			return err
 		}
-		err = checkForConflictingKeys(keys, should.Terms, true)
+		err = checkForConflictingKeys(keys, fmt.Sprintf("should[%d].terms", i), should.Terms, true)
 		if err != nil {
 			return err
 		}
@@ -95,10 +95,10 @@ func VerifyNoConflictingFilters(queryFilters entities.Filters) error {
 	return nil
 }
 
-func checkForConflictingKeys[F any](known map[string]bool, checked map[string]F, add bool) error {
+func checkForConflictingKeys[F any](known map[string]bool, field string, checked map[string]F, add bool) error {
 	for k := range checked {
 		if _, found := known[k]; found {
-			return fmt.Errorf("duplicate [%s] filter", k)
+			return NewFieldError(JoinField(field, k), ReasonDuplicateFilter, fmt.Errorf("duplicate [%s] filter", k))
 		}
 		if add {
 			known[k] = true
@@ -109,7 +109,7 @@ func checkForConflictingKeys[F any](known map[string]bool, checked map[string]F,
 
 func checkQuantity(values []string, maxValues int) error {
 	if len(values) == 0 || len(values) > maxValues {
-		return fmt.Errorf("invalid number of values (%d>%d)", len(values), maxValues)
+		return NewFieldError("", ReasonInvalidNumberOfValues, fmt.Errorf("invalid number of values (%d>%d)", len(values), maxValues))
 	}
 	return nil
 }
@@ -0,0 +1,101 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/parser"
+	rpc "github.com/qubic/archive-query-service/v2/grpc"
+)
+
+const maxRequestBodySize = 1024 * 1024
+
+type Config struct {
+	MaxDepth      int // maximum nesting level of fields
+	MaxComplexity int // maximum number of fields, multiplied by the list sizes
+}
+
+// Handler serves GraphQL queries. The resolvers use the same services as the grpc api.
+type Handler struct {
+	schema          graphql.Schema
+	txService       rpc.TransactionsService
+	cfg             Config
+	defaultPageSize int
+}
+
+func NewHandler(
+	txService rpc.TransactionsService, tdService rpc.TickDataService, evService rpc.EventsService,
+	clService rpc.ComputorsListService, statusService rpc.StatusService, pageSizeLimits rpc.PageSizeLimits, cfg Config,
+) (*Handler, error) {
+	schema, err := newSchema(&resolver{
+		txService:      txService,
+		tdService:      tdService,
+		evService:      evService,
+		clService:      clService,
+		statusService:  statusService,
+		pageSizeLimits: pageSizeLimits,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating graphql schema: %w", err)
+	}
+	_, defaultPageSize, err := pageSizeLimits.ValidatePagination(nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting default page size: %w", err)
+	}
+	return &Handler{
+		schema:          schema,
+		txService:       txService,
+		cfg:             cfg,
+		defaultPageSize: int(defaultPageSize),
+	}, nil
+}
+
+type request struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req request
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBodySize)).Decode(&req); err != nil {
+		writeResult(w, http.StatusBadRequest, &graphql.Result{Errors: gqlerrors.FormatErrors(fmt.Errorf("invalid request body: %w", err))})
+		return
+	}
+	writeResult(w, http.StatusOK, h.execute(r.Context(), req))
+}
+
+// execute parses and validates the query and checks the limits before executing it.
+func (h *Handler) execute(ctx context.Context, req request) *graphql.Result {
+	document, err := parser.Parse(parser.ParseParams{Source: req.Query})
+	if err != nil {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(err)}
+	}
+	validation := graphql.ValidateDocument(&h.schema, document, nil)
+	if !validation.IsValid {
+		return &graphql.Result{Errors: validation.Errors}
+	}
+	if err = h.checkLimits(document, req.OperationName, req.Variables); err != nil {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(err)}
+	}
+	return graphql.Execute(graphql.ExecuteParams{
+		Schema:        h.schema,
+		AST:           document,
+		OperationName: req.OperationName,
+		Args:          req.Variables,
+		Context:       withLoaders(ctx, newLoaders(h.txService)),
+	})
+}
+
+func writeResult(w http.ResponseWriter, statusCode int, result *graphql.Result) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		log.Printf("[WARN] writing graphql response: %v", err)
+	}
+}
@@ -0,0 +1,155 @@
+package graphql
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	"github.com/qubic/archive-query-service/v2/entities"
+	rpc "github.com/qubic/archive-query-service/v2/grpc"
+	"github.com/qubic/archive-query-service/v2/grpc/mock"
+	statusPb "github.com/qubic/go-data-publisher/status-service/protobuf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type services struct {
+	tx     *mock.MockTransactionsService
+	td     *mock.MockTickDataService
+	ev     *mock.MockEventsService
+	cl     *mock.MockComputorsListService
+	status *mock.MockStatusService
+}
+
+func newTestHandler(t *testing.T, cfg Config) (*Handler, *services) {
+	ctrl := gomock.NewController(t)
+	s := &services{
+		tx:     mock.NewMockTransactionsService(ctrl),
+		td:     mock.NewMockTickDataService(ctrl),
+		ev:     mock.NewMockEventsService(ctrl),
+		cl:     mock.NewMockComputorsListService(ctrl),
+		status: mock.NewMockStatusService(ctrl),
+	}
+	handler, err := NewHandler(s.tx, s.td, s.ev, s.cl, s.status, rpc.NewPageSizeLimits(100, 10), cfg)
+	require.NoError(t, err)
+	return handler, s
+}
+
+type response struct {
+	Data   map[string]any   `json:"data"`
+	Errors []map[string]any `json:"errors"`
+}
+
+func query(t *testing.T, handler http.Handler, query string, variables map[string]any) response {
+	body, err := json.Marshal(map[string]any{"query": query, "variables": variables})
+	require.NoError(t, err)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/graphql", bytes.NewReader(body)))
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var result response
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &result))
+	return result
+}
+
+func TestHandler_TickDataWithTransactions_GivenDuplicateHashes_ThenLookupOnce(t *testing.T) {
+	handler, s := newTestHandler(t, Config{MaxDepth: 5, MaxComplexity: 10000})
+	s.td.EXPECT().GetTickData(gomock.Any(), uint32(42)).Return(&api.TickData{TickNumber: 42, Epoch: 100, Timestamp: 1234567890123,
+		TransactionHashes: []string{"a", "b", "a"}}, nil)
+	s.tx.EXPECT().GetTransactionByHash(gomock.Any(), "a").Return(&api.Transaction{Hash: "a", Amount: 10}, nil).Times(1)
+	s.tx.EXPECT().GetTransactionByHash(gomock.Any(), "b").Return(nil, nil).Times(1)
+
+	result := query(t, handler, `{ tickData(tickNumber: 42) { epoch timestamp transactions { hash amount } } }`, nil)
+	require.Empty(t, result.Errors)
+	assert.Equal(t, map[string]any{
+		"epoch":     float64(100),
+		"timestamp": "1234567890123",
+		"transactions": []any{
+			map[string]any{"hash": "a", "amount": "10"},
+			nil,
+			map[string]any{"hash": "a", "amount": "10"},
+		},
+	}, result.Data["tickData"])
+}
+
+func TestHandler_EventLogsWithTransaction(t *testing.T) {
+	handler, s := newTestHandler(t, Config{MaxDepth: 5, MaxComplexity: 10000})
+	hash := "a"
+	s.status.EXPECT().GetStatus(gomock.Any()).Return(&statusPb.GetStatusResponse{LastProcessedLogTick: 1000}, nil)
+	s.ev.EXPECT().GetEvents(gomock.Any(), gomock.Any(), uint32(0), uint32(5), uint32(1000)).
+		DoAndReturn(func(_ any, filters entities.Filters, _, _, _ uint32) (*entities.EventsResult, error) {
+			assert.Equal(t, []string{"0"}, filters.Include["logType"])
+			return &entities.EventsResult{Hits: &entities.Hits{Total: 1}, Events: []*api.Event{
+				{LogId: 7, TransactionHash: &hash, EventData: &api.Event_QuTransfer{QuTransfer: &api.QuTransferData{Amount: 10}}},
+			}}, nil
+		})
+	s.tx.EXPECT().GetTransactionByHash(gomock.Any(), "a").Return(&api.Transaction{Hash: "a", TickNumber: 42}, nil)
+
+	result := query(t, handler, `query($size: Int) {
+		eventLogs(filters: [{key: "logType", value: "0"}], size: $size) {
+			validForTick hits { total } eventLogs { logId data transaction { tickNumber } }
+		}
+	}`, map[string]any{"size": 5})
+	require.Empty(t, result.Errors)
+	eventLogs := result.Data["eventLogs"].(map[string]any)
+	assert.Equal(t, float64(1000), eventLogs["validForTick"])
+	event := eventLogs["eventLogs"].([]any)[0].(map[string]any)
+	assert.Equal(t, "7", event["logId"])
+	assert.JSONEq(t, `{"amount":"10"}`, event["data"].(string))
+	assert.Equal(t, map[string]any{"tickNumber": float64(42)}, event["transaction"])
+}
+
+func TestHandler_EventLogs_GivenInvalidFilter_ThenError(t *testing.T) {
+	handler, _ := newTestHandler(t, Config{MaxDepth: 5, MaxComplexity: 10000})
+
+	result := query(t, handler, `{ eventLogs(filters: [{key: "foo", value: "bar"}]) { validForTick } }`, nil)
+	require.Len(t, result.Errors, 1)
+	assert.Contains(t, result.Errors[0]["message"], "foo")
+}
+
+func TestHandler_Transactions_GivenTooManyHashes_ThenError(t *testing.T) {
+	handler, _ := newTestHandler(t, Config{MaxDepth: 5, MaxComplexity: 1000000})
+	hashes := make([]any, 101)
+	for i := range hashes {
+		hashes[i] = "a"
+	}
+
+	result := query(t, handler, `query($hashes: [String!]!) { transactions(hashes: $hashes) { hash } }`, map[string]any{"hashes": hashes})
+	require.Len(t, result.Errors, 1)
+	assert.Contains(t, result.Errors[0]["message"], "invalid number of hashes")
+}
+
+func TestHandler_GivenTooDeepQuery_ThenError(t *testing.T) {
+	handler, _ := newTestHandler(t, Config{MaxDepth: 3, MaxComplexity: 10000})
+
+	result := query(t, handler, `{ transaction(hash: "a") { tickData { transactions { tickData { epoch } } } } }`, nil)
+	require.Len(t, result.Errors, 1)
+	assert.Equal(t, "query depth 5 exceeds the maximum of 3", result.Errors[0]["message"])
+}
+
+func TestHandler_GivenTooComplexQuery_ThenError(t *testing.T) {
+	handler, _ := newTestHandler(t, Config{MaxDepth: 10, MaxComplexity: 1000})
+
+	// 1 + 1 * (1 + 1024 * 1)
+	result := query(t, handler, `{ tickData(tickNumber: 1) { transactions { hash } } }`, nil)
+	require.Len(t, result.Errors, 1)
+	assert.Equal(t, "query complexity 1026 exceeds the maximum of 1000", result.Errors[0]["message"])
+
+	// fragments are counted, too: 1 + 20 * (1 + 1)
+	handler.cfg.MaxComplexity = 40
+	result = query(t, handler, `{ eventLogs(size: 20) { ...logs } } fragment logs on EventLogs { eventLogs { logId } }`, nil)
+	require.Len(t, result.Errors, 1)
+	assert.Equal(t, "query complexity 41 exceeds the maximum of 40", result.Errors[0]["message"])
+}
+
+func TestHandler_GivenInvalidQuery_ThenError(t *testing.T) {
+	handler, _ := newTestHandler(t, Config{MaxDepth: 10, MaxComplexity: 1000})
+
+	result := query(t, handler, `{ unknown }`, nil)
+	require.Len(t, result.Errors, 1)
+	assert.Contains(t, result.Errors[0]["message"], "unknown")
+}
@@ -0,0 +1,157 @@
+package graphql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// limitChecker calculates the depth and complexity of a query before it is executed. Every field costs one. The cost
+// of the selected sub fields is multiplied by the (maximum) number of returned list items.
+type limitChecker struct {
+	schema          graphql.Schema
+	fragments       map[string]*ast.FragmentDefinition
+	variables       map[string]any
+	defaultPageSize int
+}
+
+type queryCost struct {
+	depth      int
+	complexity int
+}
+
+func (h *Handler) checkLimits(document *ast.Document, operationName string, variables map[string]any) error {
+	checker := &limitChecker{
+		schema:          h.schema,
+		fragments:       map[string]*ast.FragmentDefinition{},
+		variables:       variables,
+		defaultPageSize: h.defaultPageSize,
+	}
+	var operation *ast.OperationDefinition
+	for _, definition := range document.Definitions {
+		switch definition := definition.(type) {
+		case *ast.FragmentDefinition:
+			checker.fragments[definition.Name.Value] = definition
+		case *ast.OperationDefinition:
+			if operationName == "" || (definition.Name != nil && definition.Name.Value == operationName) {
+				operation = definition
+			}
+		}
+	}
+	if operation == nil || operation.Operation != ast.OperationTypeQuery {
+		return nil // rejected on execution
+	}
+
+	cost := checker.selectionSetCost(operation.SelectionSet, h.schema.QueryType(), 1)
+	if cost.depth > h.cfg.MaxDepth {
+		return fmt.Errorf("query depth %d exceeds the maximum of %d", cost.depth, h.cfg.MaxDepth)
+	}
+	if cost.complexity > h.cfg.MaxComplexity {
+		return fmt.Errorf("query complexity %d exceeds the maximum of %d", cost.complexity, h.cfg.MaxComplexity)
+	}
+	return nil
+}
+
+func (c *limitChecker) selectionSetCost(selectionSet *ast.SelectionSet, parentType *graphql.Object, depth int) queryCost {
+	cost := queryCost{depth: depth}
+	if selectionSet == nil || parentType == nil {
+		return cost
+	}
+	for _, selection := range selectionSet.Selections {
+		var selectionCost queryCost
+		switch selection := selection.(type) {
+		case *ast.Field:
+			selectionCost = c.fieldCost(selection, parentType, depth)
+		case *ast.InlineFragment:
+			fragmentType := parentType
+			if selection.TypeCondition != nil {
+				fragmentType = c.objectType(selection.TypeCondition.Name.Value)
+			}
+			selectionCost = c.selectionSetCost(selection.SelectionSet, fragmentType, depth)
+		case *ast.FragmentSpread:
+			if fragment, ok := c.fragments[selection.Name.Value]; ok {
+				selectionCost = c.selectionSetCost(fragment.SelectionSet, c.objectType(fragment.TypeCondition.Name.Value), depth)
+			}
+		}
+		cost.depth = max(cost.depth, selectionCost.depth)
+		cost.complexity += selectionCost.complexity
+	}
+	return cost
+}
+
+func (c *limitChecker) fieldCost(field *ast.Field, parentType *graphql.Object, depth int) queryCost {
+	name := field.Name.Value
+	if strings.HasPrefix(name, "__") { // introspection is not limited
+		return queryCost{depth: depth}
+	}
+	definition, ok := parentType.Fields()[name]
+	if !ok {
+		return queryCost{depth: depth}
+	}
+	fieldType, ok := graphql.GetNamed(definition.Type).(*graphql.Object)
+	if !ok || field.SelectionSet == nil { // scalar
+		return queryCost{depth: depth, complexity: 1}
+	}
+	subCost := c.selectionSetCost(field.SelectionSet, fieldType, depth+1)
+	return queryCost{
+		depth:      subCost.depth,
+		complexity: 1 + c.listSize(parentType.Name(), field)*subCost.complexity,
+	}
+}
+
+// listSize returns the maximum number of items of a list field.
+func (c *limitChecker) listSize(typeName string, field *ast.Field) int {
+	switch typeName + "." + field.Name.Value {
+	case "Query.eventLogs":
+		if size, ok := c.argument(field, "size").(int); ok && size > 0 {
+			return size
+		}
+		return c.defaultPageSize
+	case "Query.transactions":
+		hashes, _ := c.argument(field, "hashes").([]any)
+		return len(hashes)
+	case "TickData.transactions":
+		return maxTransactionsPerTick
+	default:
+		return 1
+	}
+}
+
+func (c *limitChecker) argument(field *ast.Field, name string) any {
+	for _, argument := range field.Arguments {
+		if argument.Name.Value == name {
+			return c.value(argument.Value)
+		}
+	}
+	return nil
+}
+
+// value returns the literal or variable value. Numbers are returned as int.
+func (c *limitChecker) value(value ast.Value) any {
+	switch value := value.(type) {
+	case *ast.Variable:
+		if number, ok := c.variables[value.Name.Value].(float64); ok { // json numbers
+			return int(number)
+		}
+		return c.variables[value.Name.Value]
+	case *ast.IntValue:
+		number, _ := strconv.Atoi(value.Value)
+		return number
+	case *ast.ListValue:
+		values := make([]any, 0, len(value.Values))
+		for _, v := range value.Values {
+			values = append(values, c.value(v))
+		}
+		return values
+	default:
+		return value.GetValue()
+	}
+}
+
+func (c *limitChecker) objectType(name string) *graphql.Object {
+	object, _ := c.schema.Type(name).(*graphql.Object)
+	return object
+}
@@ -0,0 +1,60 @@
+package graphql
+
+import (
+	"context"
+	"time"
+
+	"github.com/graph-gophers/dataloader/v7"
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	rpc "github.com/qubic/archive-query-service/v2/grpc"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	loaderBatchWait         = 2 * time.Millisecond
+	loaderMaxBatchSize      = 1024
+	loaderLookupsInParallel = 16
+)
+
+type loadersKey struct{}
+
+// loaders are created per request, so that results are only cached for the duration of one query.
+type loaders struct {
+	transactions *dataloader.Loader[string, *api.Transaction]
+}
+
+func newLoaders(txService rpc.TransactionsService) *loaders {
+	return &loaders{
+		transactions: dataloader.NewBatchedLoader(transactionsBatchFunc(txService),
+			dataloader.WithWait[string, *api.Transaction](loaderBatchWait),
+			dataloader.WithBatchCapacity[string, *api.Transaction](loaderMaxBatchSize),
+		),
+	}
+}
+
+func withLoaders(ctx context.Context, l *loaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, l)
+}
+
+func loadersFromContext(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
+
+// transactionsBatchFunc looks up all transaction hashes that are collected while resolving one level of the query.
+// Duplicate hashes are only looked up once. Unknown hashes resolve to nil.
+func transactionsBatchFunc(txService rpc.TransactionsService) dataloader.BatchFunc[string, *api.Transaction] {
+	return func(ctx context.Context, hashes []string) []*dataloader.Result[*api.Transaction] {
+		results := make([]*dataloader.Result[*api.Transaction], len(hashes))
+		var group errgroup.Group
+		group.SetLimit(loaderLookupsInParallel)
+		for i, hash := range hashes {
+			group.Go(func() error {
+				tx, err := txService.GetTransactionByHash(ctx, hash)
+				results[i] = &dataloader.Result[*api.Transaction]{Data: tx, Error: err}
+				return nil
+			})
+		}
+		_ = group.Wait()
+		return results
+	}
+}
@@ -0,0 +1,450 @@
+package graphql
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/graphql-go/graphql"
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	rpc "github.com/qubic/archive-query-service/v2/grpc"
+	statusPb "github.com/qubic/go-data-publisher/status-service/protobuf"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// maxTransactionsPerTick is the upper bound of transaction hashes in one tick. Used for the complexity calculation.
+const maxTransactionsPerTick = 1024
+
+type resolver struct {
+	txService      rpc.TransactionsService
+	tdService      rpc.TickDataService
+	evService      rpc.EventsService
+	clService      rpc.ComputorsListService
+	statusService  rpc.StatusService
+	pageSizeLimits rpc.PageSizeLimits
+}
+
+// field creates a field that resolves the value with a getter of the source object.
+func field[T, V any](fieldType graphql.Output, get func(T) V) *graphql.Field {
+	return &graphql.Field{
+		Type: fieldType,
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			return get(p.Source.(T)), nil
+		},
+	}
+}
+
+// uint64Field creates a string field, because 64 bit integers exceed the range of GraphQL integers.
+func uint64Field[T any](get func(T) uint64) *graphql.Field {
+	return field(graphql.String, func(source T) string {
+		return strconv.FormatUint(get(source), 10)
+	})
+}
+
+func newSchema(r *resolver) (graphql.Schema, error) {
+	var transactionType, tickDataType *graphql.Object
+
+	transactionType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Transaction",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"hash":        field(graphql.String, (*api.Transaction).GetHash),
+				"amount":      uint64Field((*api.Transaction).GetAmount),
+				"source":      field(graphql.String, (*api.Transaction).GetSource),
+				"destination": field(graphql.String, (*api.Transaction).GetDestination),
+				"tickNumber":  field(graphql.Int, (*api.Transaction).GetTickNumber),
+				"timestamp":   uint64Field((*api.Transaction).GetTimestamp),
+				"inputType":   field(graphql.Int, (*api.Transaction).GetInputType),
+				"inputSize":   field(graphql.Int, (*api.Transaction).GetInputSize),
+				"inputData":   field(graphql.String, (*api.Transaction).GetInputData),
+				"signature":   field(graphql.String, (*api.Transaction).GetSignature),
+				"moneyFlew":   field(graphql.Boolean, (*api.Transaction).GetMoneyFlew),
+				"executionStatus": field(graphql.String, func(tx *api.Transaction) string {
+					return tx.GetExecutionStatus().String()
+				}),
+				"tickData": {
+					Type:        tickDataType,
+					Description: "The tick data of the tick the transaction is included in.",
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						return r.tickData(p.Context, p.Source.(*api.Transaction).GetTickNumber())
+					},
+				},
+			}
+		}),
+	})
+
+	tickDataType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "TickData",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"tickNumber":        field(graphql.Int, (*api.TickData).GetTickNumber),
+				"epoch":             field(graphql.Int, (*api.TickData).GetEpoch),
+				"computorIndex":     field(graphql.Int, (*api.TickData).GetComputorIndex),
+				"timestamp":         uint64Field((*api.TickData).GetTimestamp),
+				"varStruct":         field(graphql.String, (*api.TickData).GetVarStruct),
+				"timeLock":          field(graphql.String, (*api.TickData).GetTimeLock),
+				"transactionHashes": field(graphql.NewList(graphql.NewNonNull(graphql.String)), (*api.TickData).GetTransactionHashes),
+				"signature":         field(graphql.String, (*api.TickData).GetSignature),
+				"transactions": {
+					Type:        graphql.NewList(transactionType),
+					Description: "The transactions included in the tick.",
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						return r.loadTransactions(p.Context, p.Source.(*api.TickData).GetTransactionHashes()), nil
+					},
+				},
+			}
+		}),
+	})
+
+	eventType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "EventLog",
+		Fields: graphql.Fields{
+			"epoch":           field(graphql.Int, (*api.Event).GetEpoch),
+			"tickNumber":      field(graphql.Int, (*api.Event).GetTickNumber),
+			"timestamp":       uint64Field((*api.Event).GetTimestamp),
+			"transactionHash": field(graphql.String, (*api.Event).GetTransactionHash),
+			"logType":         field(graphql.Int, (*api.Event).GetLogType),
+			"logId":           uint64Field((*api.Event).GetLogId),
+			"logDigest":       field(graphql.String, (*api.Event).GetLogDigest),
+			"categories":      field(graphql.NewList(graphql.NewNonNull(graphql.Int)), (*api.Event).GetCategories),
+			"rawPayload": field(graphql.String, func(event *api.Event) string {
+				return base64.StdEncoding.EncodeToString(event.GetRawPayload())
+			}),
+			"data": &graphql.Field{
+				Type:        graphql.String,
+				Description: "The log type specific data as json.",
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return eventDataJSON(p.Source.(*api.Event))
+				},
+			},
+			"transaction": &graphql.Field{
+				Type:        transactionType,
+				Description: "The transaction that triggered the event, if any.",
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					hash := p.Source.(*api.Event).GetTransactionHash()
+					if hash == "" {
+						return nil, nil
+					}
+					return r.loadTransaction(p.Context, hash), nil
+				},
+			},
+		},
+	})
+
+	computorListType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "ComputorList",
+		Fields: graphql.Fields{
+			"epoch":      field(graphql.Int, (*api.ComputorList).GetEpoch),
+			"tickNumber": field(graphql.Int, (*api.ComputorList).GetTickNumber),
+			"identities": field(graphql.NewList(graphql.NewNonNull(graphql.String)), (*api.ComputorList).GetIdentities),
+			"signature":  field(graphql.String, (*api.ComputorList).GetSignature),
+		},
+	})
+
+	hitsType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Hits",
+		Fields: graphql.Fields{
+			"total": field(graphql.Int, (*api.Hits).GetTotal),
+			"from":  field(graphql.Int, (*api.Hits).GetFrom),
+			"size":  field(graphql.Int, (*api.Hits).GetSize),
+		},
+	})
+
+	eventLogsType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "EventLogs",
+		Fields: graphql.Fields{
+			"hits":         field(hitsType, (*api.GetEventLogsResponse).GetHits),
+			"eventLogs":    field(graphql.NewList(graphql.NewNonNull(eventType)), (*api.GetEventLogsResponse).GetEventLogs),
+			"validForTick": field(graphql.Int, (*api.GetEventLogsResponse).GetValidForTick),
+		},
+	})
+
+	tickIntervalType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "ProcessedTickInterval",
+		Fields: graphql.Fields{
+			"epoch":     field(graphql.Int, (*api.ProcessedTickInterval).GetEpoch),
+			"firstTick": field(graphql.Int, (*api.ProcessedTickInterval).GetFirstTick),
+			"lastTick":  field(graphql.Int, (*api.ProcessedTickInterval).GetLastTick),
+		},
+	})
+
+	statusType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Status",
+		Fields: graphql.Fields{
+			"lastProcessedTick":    field(graphql.Int, (*statusPb.GetStatusResponse).GetLastProcessedTick),
+			"lastProcessedLogTick": field(graphql.Int, (*statusPb.GetStatusResponse).GetLastProcessedLogTick),
+			"processingEpoch":      field(graphql.Int, (*statusPb.GetStatusResponse).GetProcessingEpoch),
+			"processedTickIntervals": &graphql.Field{
+				Type: graphql.NewList(graphql.NewNonNull(tickIntervalType)),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return r.processedTickIntervals(p.Context)
+				},
+			},
+		},
+	})
+
+	filterType := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "Filter",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"key":   {Type: graphql.NewNonNull(graphql.String)},
+			"value": {Type: graphql.NewNonNull(graphql.String)},
+		},
+	})
+
+	rangeType := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "Range",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"field": {Type: graphql.NewNonNull(graphql.String)},
+			"gt":    {Type: graphql.String},
+			"gte":   {Type: graphql.String},
+			"lt":    {Type: graphql.String},
+			"lte":   {Type: graphql.String},
+		},
+	})
+
+	queryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"transaction": &graphql.Field{
+				Type: transactionType,
+				Args: graphql.FieldConfigArgument{
+					"hash": {Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return r.loadTransaction(p.Context, p.Args["hash"].(string)), nil
+				},
+			},
+			"transactions": &graphql.Field{
+				Type:        graphql.NewList(transactionType),
+				Description: "Transactions by hash. Unknown hashes resolve to null.",
+				Args: graphql.FieldConfigArgument{
+					"hashes": {Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.String)))},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return r.transactions(p.Context, toStrings(p.Args["hashes"]))
+				},
+			},
+			"tickData": &graphql.Field{
+				Type: tickDataType,
+				Args: graphql.FieldConfigArgument{
+					"tickNumber": {Type: graphql.NewNonNull(graphql.Int)},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return r.tickData(p.Context, uint32(p.Args["tickNumber"].(int))) //nolint: gosec
+				},
+			},
+			"eventLogs": &graphql.Field{
+				Type:        eventLogsType,
+				Description: "Event logs with the same filters as /getEventLogs.",
+				Args: graphql.FieldConfigArgument{
+					"filters": {Type: graphql.NewList(graphql.NewNonNull(filterType))},
+					"exclude": {Type: graphql.NewList(graphql.NewNonNull(filterType))},
+					"ranges":  {Type: graphql.NewList(graphql.NewNonNull(rangeType))},
+					"offset":  {Type: graphql.Int},
+					"size":    {Type: graphql.Int},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return r.eventLogs(p.Context, p.Args)
+				},
+			},
+			"computorListsForEpoch": &graphql.Field{
+				Type: graphql.NewList(graphql.NewNonNull(computorListType)),
+				Args: graphql.FieldConfigArgument{
+					"epoch": {Type: graphql.NewNonNull(graphql.Int)},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return r.computorListsForEpoch(p.Context, uint32(p.Args["epoch"].(int))) //nolint: gosec
+				},
+			},
+			"status": &graphql.Field{
+				Type: statusType,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return r.status(p.Context)
+				},
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: queryType})
+}
+
+// loadTransaction returns a thunk, so that the lookups of all transactions on the same level are batched.
+func (r *resolver) loadTransaction(ctx context.Context, hash string) func() (any, error) {
+	thunk := loadersFromContext(ctx).transactions.Load(ctx, hash)
+	return func() (any, error) {
+		tx, err := thunk()
+		if err != nil {
+			return nil, internalError(fmt.Sprintf("failed to get transaction [%s]", hash), err)
+		}
+		return tx, nil
+	}
+}
+
+func (r *resolver) loadTransactions(ctx context.Context, hashes []string) []any {
+	txs := make([]any, 0, len(hashes))
+	for _, hash := range hashes {
+		txs = append(txs, r.loadTransaction(ctx, hash))
+	}
+	return txs
+}
+
+func (r *resolver) transactions(ctx context.Context, hashes []string) ([]any, error) {
+	// same limit as for pages
+	_, _, err := r.pageSizeLimits.ValidatePagination(&api.Pagination{Size: uint32(len(hashes))}) //nolint: gosec
+	if err != nil {
+		return nil, fmt.Errorf("invalid number of hashes: %w", err)
+	}
+	return r.loadTransactions(ctx, hashes), nil
+}
+
+func (r *resolver) tickData(ctx context.Context, tickNumber uint32) (*api.TickData, error) {
+	tickData, err := r.tdService.GetTickData(ctx, tickNumber)
+	if err != nil {
+		return nil, internalError(fmt.Sprintf("failed to get tick data for tick [%d]", tickNumber), err)
+	}
+	return tickData, nil
+}
+
+func (r *resolver) eventLogs(ctx context.Context, args map[string]any) (*api.GetEventLogsResponse, error) {
+	request, err := toEventLogsRequest(args)
+	if err != nil {
+		return nil, err
+	}
+	queryFilters, err := rpc.CreateEventQueryFilters(request)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	from, size, err := r.pageSizeLimits.ValidatePagination(request.GetPagination())
+	if err != nil {
+		return nil, fmt.Errorf("invalid pagination: %w", err)
+	}
+
+	cachedStatus, err := r.statusService.GetStatus(ctx)
+	if err != nil {
+		return nil, internalError("failed to get status", err)
+	}
+	result, err := r.evService.GetEvents(ctx, queryFilters, from, size, cachedStatus.GetLastProcessedLogTick())
+	if err != nil {
+		return nil, internalError("failed to get events", err)
+	}
+
+	return &api.GetEventLogsResponse{
+		Hits: &api.Hits{
+			Total: uint32(result.GetHits().GetTotal()), //nolint: gosec
+			From:  from,
+			Size:  size,
+		},
+		EventLogs:    result.Events,
+		ValidForTick: cachedStatus.GetLastProcessedLogTick(),
+	}, nil
+}
+
+func (r *resolver) computorListsForEpoch(ctx context.Context, epoch uint32) ([]*api.ComputorList, error) {
+	computorLists, err := r.clService.GetComputorsListsForEpoch(ctx, epoch)
+	if err != nil {
+		return nil, internalError(fmt.Sprintf("failed to get computor lists for epoch [%d]", epoch), err)
+	}
+	return computorLists, nil
+}
+
+func (r *resolver) status(ctx context.Context) (*statusPb.GetStatusResponse, error) {
+	cachedStatus, err := r.statusService.GetStatus(ctx)
+	if err != nil {
+		return nil, internalError("failed to get status", err)
+	}
+	return cachedStatus, nil
+}
+
+func (r *resolver) processedTickIntervals(ctx context.Context) ([]*api.ProcessedTickInterval, error) {
+	intervals, err := r.statusService.GetProcessedTickIntervals(ctx)
+	if err != nil {
+		return nil, internalError("failed to get processed tick intervals", err)
+	}
+	return intervals, nil
+}
+
+// toEventLogsRequest converts the arguments into a request, so that the filters are validated the same way as for
+// the /getEventLogs endpoint.
+func toEventLogsRequest(args map[string]any) (*api.GetEventLogsRequest, error) {
+	request := &api.GetEventLogsRequest{
+		Filters: toFilterMap(args["filters"]),
+		Exclude: toFilterMap(args["exclude"]),
+		Ranges:  map[string]*api.Range{},
+	}
+	for _, value := range toList(args["ranges"]) {
+		input := value.(map[string]any)
+		apiRange := &api.Range{}
+		if gt, ok := input["gt"].(string); ok {
+			apiRange.LowerBound = &api.Range_Gt{Gt: gt}
+		}
+		if gte, ok := input["gte"].(string); ok {
+			apiRange.LowerBound = &api.Range_Gte{Gte: gte}
+		}
+		if lt, ok := input["lt"].(string); ok {
+			apiRange.UpperBound = &api.Range_Lt{Lt: lt}
+		}
+		if lte, ok := input["lte"].(string); ok {
+			apiRange.UpperBound = &api.Range_Lte{Lte: lte}
+		}
+		request.Ranges[input["field"].(string)] = apiRange
+	}
+	offset, hasOffset := args["offset"].(int)
+	size, hasSize := args["size"].(int)
+	if hasOffset || hasSize {
+		if offset < 0 || size < 0 {
+			return nil, fmt.Errorf("offset and size must not be negative")
+		}
+		request.Pagination = &api.Pagination{Offset: uint32(offset), Size: uint32(size)} //nolint: gosec
+	}
+	return request, nil
+}
+
+func toList(value any) []any {
+	list, _ := value.([]any)
+	return list
+}
+
+func toStrings(value any) []string {
+	list := toList(value)
+	values := make([]string, 0, len(list))
+	for _, v := range list {
+		values = append(values, v.(string))
+	}
+	return values
+}
+
+func toFilterMap(value any) map[string]string {
+	filters := map[string]string{}
+	for _, v := range toList(value) {
+		input := v.(map[string]any)
+		filters[input["key"].(string)] = input["value"].(string)
+	}
+	return filters
+}
+
+func eventDataJSON(event *api.Event) (any, error) {
+	message := event.ProtoReflect()
+	field := message.WhichOneof(message.Descriptor().Oneofs().ByName("event_data"))
+	if field == nil {
+		return nil, nil
+	}
+	data, err := protojson.Marshal(message.Get(field).Message().Interface())
+	if err != nil {
+		return nil, fmt.Errorf("marshalling event data: %w", err)
+	}
+	return string(data), nil
+}
+
+// internalError logs the cause and hides the details from the client.
+func internalError(message string, err error) error {
+	log.Printf("[ERROR] %s: %v", message, err)
+	return fmt.Errorf("%s", message)
+}
+
+// statusError returns the message of the status error, that is created by the validation of the grpc api.
+func statusError(err error) error {
+	return fmt.Errorf("%s", status.Convert(err).Message())
+}
@@ -0,0 +1,151 @@
+package grpc
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc"
+)
+
+const (
+	grpcWebContentType     = "application/grpc-web"
+	grpcWebTextContentType = "application/grpc-web-text"
+	grpcWebTrailerFlag     = byte(0x80)
+)
+
+// grpcWebTrailers are the trailers that the grpc server sets after the response body.
+var grpcWebTrailers = []string{"Grpc-Status", "Grpc-Message", "Grpc-Status-Details-Bin"}
+
+// grpcWebHandler serves grpc-web requests (including cors preflight requests) with the grpc server and all other
+// requests with the http gateway. Cross-origin requests are only allowed from the allowed origins ('*' allows all).
+func grpcWebHandler(srv *grpc.Server, mux http.Handler, allowedOrigins []string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case isGrpcWebRequest(r):
+			if origin := r.Header.Get("Origin"); origin != "" && isAllowedOrigin(allowedOrigins, origin) {
+				w.Header().Set("Access-Control-Allow-Origin", origin)
+				w.Header().Set("Access-Control-Expose-Headers", strings.Join(grpcWebTrailers, ", "))
+				w.Header().Add("Vary", "Origin")
+			}
+			serveGrpcWeb(srv, w, r)
+		case isGrpcWebPreflightRequest(r):
+			if origin := r.Header.Get("Origin"); isAllowedOrigin(allowedOrigins, origin) {
+				w.Header().Set("Access-Control-Allow-Origin", origin)
+				w.Header().Set("Access-Control-Allow-Methods", http.MethodPost)
+				w.Header().Set("Access-Control-Allow-Headers", r.Header.Get("Access-Control-Request-Headers"))
+				w.Header().Set("Access-Control-Max-Age", "600")
+			}
+			w.Header().Add("Vary", "Origin")
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			mux.ServeHTTP(w, r)
+		}
+	})
+}
+
+func isAllowedOrigin(allowedOrigins []string, origin string) bool {
+	return slices.Contains(allowedOrigins, "*") || slices.Contains(allowedOrigins, origin)
+}
+
+func isGrpcWebRequest(r *http.Request) bool {
+	return r.Method == http.MethodPost && strings.HasPrefix(r.Header.Get("Content-Type"), grpcWebContentType)
+}
+
+func isGrpcWebPreflightRequest(r *http.Request) bool {
+	return r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") == http.MethodPost &&
+		strings.Contains(strings.ToLower(r.Header.Get("Access-Control-Request-Headers")), "x-grpc-web")
+}
+
+// serveGrpcWeb translates the grpc-web request into a grpc request for the grpc server and the grpc response into a
+// grpc-web response. The trailers are sent in a trailer frame at the end of the body. The grpc-web-text variant is
+// base64 encoded.
+func serveGrpcWeb(srv *grpc.Server, w http.ResponseWriter, r *http.Request) {
+	contentType := r.Header.Get("Content-Type")
+	text := strings.HasPrefix(contentType, grpcWebTextContentType)
+
+	request := r.Clone(r.Context())
+	request.ProtoMajor, request.ProtoMinor, request.Proto = 2, 0, "HTTP/2"
+	if text {
+		request.Header.Set("Content-Type", "application/grpc"+strings.TrimPrefix(contentType, grpcWebTextContentType))
+		request.Body = io.NopCloser(base64.NewDecoder(base64.StdEncoding, r.Body))
+	} else {
+		request.Header.Set("Content-Type", "application/grpc"+strings.TrimPrefix(contentType, grpcWebContentType))
+	}
+	request.Header.Del("Content-Length")
+	request.ContentLength = -1
+
+	writer := &grpcWebResponseWriter{ResponseWriter: w, header: make(http.Header), text: text, contentType: contentType}
+	srv.ServeHTTP(writer, request)
+	writer.writeTrailers()
+}
+
+// grpcWebResponseWriter collects the headers of the grpc server and writes the trailers into the body.
+type grpcWebResponseWriter struct {
+	http.ResponseWriter
+	header        http.Header
+	text          bool
+	contentType   string
+	headerWritten bool
+}
+
+func (w *grpcWebResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *grpcWebResponseWriter) WriteHeader(statusCode int) {
+	if w.headerWritten {
+		return
+	}
+	w.headerWritten = true
+	header := w.ResponseWriter.Header()
+	for key, values := range w.header {
+		if key == "Trailer" || key == "Content-Type" || slices.Contains(grpcWebTrailers, key) || strings.HasPrefix(key, http.TrailerPrefix) {
+			continue
+		}
+		header[key] = values
+	}
+	header.Set("Content-Type", w.contentType)
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *grpcWebResponseWriter) Write(data []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	if w.text {
+		if _, err := io.WriteString(w.ResponseWriter, base64.StdEncoding.EncodeToString(data)); err != nil {
+			return 0, err
+		}
+		return len(data), nil
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *grpcWebResponseWriter) Flush() {
+	w.WriteHeader(http.StatusOK)
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// writeTrailers writes the trailer frame with the status and the trailer metadata of the grpc server.
+func (w *grpcWebResponseWriter) writeTrailers() {
+	var trailers bytes.Buffer
+	for key, values := range w.header {
+		name, isTrailer := strings.CutPrefix(key, http.TrailerPrefix)
+		if !isTrailer && !slices.Contains(grpcWebTrailers, key) {
+			continue
+		}
+		for _, value := range values {
+			trailers.WriteString(strings.ToLower(name) + ": " + value + "\r\n")
+		}
+	}
+	frame := make([]byte, 5, 5+trailers.Len())
+	frame[0] = grpcWebTrailerFlag
+	binary.BigEndian.PutUint32(frame[1:], uint32(trailers.Len()))
+	_, _ = w.Write(append(frame, trailers.Bytes()...))
+	w.Flush()
+}
@@ -0,0 +1,465 @@
+package legacy
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	protobuf "github.com/qubic/archive-query-service/v2/api/archive-query-service/legacy"
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	"github.com/qubic/archive-query-service/v2/entities"
+	rpc "github.com/qubic/archive-query-service/v2/grpc"
+	statusPb "github.com/qubic/go-data-publisher/status-service/protobuf"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var _ protobuf.TransactionsServiceServer = &TransactionsService{}
+
+const maxPageSize uint32 = 250
+const defaultPageSize uint32 = 100
+const maxResultSize uint32 = 10000
+
+const maxTickListPageSize = int32(1000)
+const defaultTickListPageSize = int32(10)
+
+// TransactionsService serves the legacy transactions service api using the v2 domain services.
+type TransactionsService struct {
+	protobuf.UnimplementedTransactionsServiceServer
+	txService     rpc.TransactionsService
+	tdService     rpc.TickDataService
+	statusService rpc.StatusService
+	clService     rpc.ComputorsListService
+	statusClient  statusPb.StatusServiceClient
+}
+
+func NewTransactionsService(
+	txService rpc.TransactionsService, tdService rpc.TickDataService, statusService rpc.StatusService,
+	clService rpc.ComputorsListService, statusClient statusPb.StatusServiceClient,
+) *TransactionsService {
+	return &TransactionsService{
+		txService:     txService,
+		tdService:     tdService,
+		statusService: statusService,
+		clService:     clService,
+		statusClient:  statusClient,
+	}
+}
+
+func (s *TransactionsService) GetIdentityTransactions(ctx context.Context, req *protobuf.GetIdentityTransactionsRequest) (*protobuf.GetIdentityTransactionsResponse, error) {
+	pageNumber, pageSize, err := getPageNumberAndSize(req.GetPage(), req.GetPageSize())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.getIdentityTransactions(ctx, req.GetIdentity(), pageNumber, pageSize, req.GetDesc(), 0, 0)
+	if err != nil {
+		return nil, createInternalError("performing identities transactions query", err)
+	}
+
+	transactions := make([]*protobuf.NewTransaction, 0, len(result.GetTransactions()))
+	for _, tx := range result.GetTransactions() {
+		transactions = append(transactions, TxToNewFormat(tx))
+	}
+
+	pagination, err := getPaginationInformation(int(result.GetHits().Total), int(pageNumber)+1, int(pageSize))
+	if err != nil {
+		return nil, createInternalError("creating pagination info", err)
+	}
+
+	return &protobuf.GetIdentityTransactionsResponse{
+		Pagination:   pagination,
+		Transactions: transactions,
+	}, nil
+}
+
+func (s *TransactionsService) GetIdentityTransfersInTickRangeV2(ctx context.Context, req *protobuf.GetTransferTransactionsPerTickRequestV2) (*protobuf.GetIdentityTransfersInTickRangeResponseV2, error) {
+	pageNumber, pageSize, err := getPageNumberAndSize(req.GetPage(), req.GetPageSize())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.getIdentityTransactions(ctx, req.GetIdentity(), pageNumber, pageSize, req.GetDesc(), req.GetStartTick(), req.GetEndTick())
+	if err != nil {
+		return nil, createInternalError("performing identities transactions query", err)
+	}
+
+	totalTransfers := make([]*protobuf.PerTickIdentityTransfers, 0, len(result.GetTransactions()))
+	for _, tx := range result.GetTransactions() {
+		txData, err := TxToArchiveFullFormat(tx)
+		if err != nil {
+			return nil, createInternalError("converting transactions to output format", err)
+		}
+
+		totalTransfers = append(totalTransfers, &protobuf.PerTickIdentityTransfers{
+			TickNumber:   tx.GetTickNumber(),
+			Identity:     req.GetIdentity(),
+			Transactions: []*protobuf.TransactionData{txData},
+		})
+	}
+
+	pagination, err := getPaginationInformation(int(result.GetHits().Total), int(pageNumber)+1, int(pageSize))
+	if err != nil {
+		return nil, createInternalError("creating pagination info", err)
+	}
+
+	return &protobuf.GetIdentityTransfersInTickRangeResponseV2{
+		Pagination:   pagination,
+		Transactions: totalTransfers,
+	}, nil
+}
+
+// getIdentityTransactions queries one page of the identity transactions. The page number starts with '0'.
+// A start or end tick of '0' does not restrict the tick range.
+func (s *TransactionsService) getIdentityTransactions(ctx context.Context, identity string, pageNumber, pageSize uint32,
+	desc bool, startTick, endTick uint32) (*entities.TransactionsResult, error) {
+
+	tickRange := make([]entities.Range, 0, 2)
+	if startTick > 0 {
+		tickRange = append(tickRange, entities.Range{Operation: "gte", Value: fmt.Sprintf("%d", startTick)})
+	}
+	if endTick > 0 {
+		tickRange = append(tickRange, entities.Range{Operation: "lte", Value: fmt.Sprintf("%d", endTick)})
+	}
+
+	filters := entities.Filters{Ascending: !desc}
+	if len(tickRange) > 0 {
+		filters.Ranges = map[string][]entities.Range{"tickNumber": tickRange}
+	}
+
+	return s.txService.GetTransactionsForIdentity(ctx, identity, filters, pageNumber*pageSize, pageSize)
+}
+
+func (s *TransactionsService) GetTickTransactionsV2(ctx context.Context, req *protobuf.GetTickTransactionsRequestV2) (*protobuf.GetTickTransactionsResponseV2, error) {
+	txs, err := s.txService.GetTransactionsForTickNumber(ctx, req.GetTickNumber(), nil, nil)
+	if err != nil {
+		return nil, createInternalError("getting tick transactions", err)
+	}
+
+	var transactions []*protobuf.TransactionData
+	for _, tx := range txs {
+		if req.GetApproved() && !tx.GetMoneyFlew() {
+			continue
+		}
+		if !req.GetApproved() && req.GetTransfers() && tx.GetAmount() == 0 {
+			continue
+		}
+
+		txData, err := TxToArchiveFullFormat(tx)
+		if err != nil {
+			return nil, createInternalError("converting transaction to output format", err)
+		}
+		transactions = append(transactions, txData)
+	}
+
+	return &protobuf.GetTickTransactionsResponseV2{Transactions: transactions}, nil
+}
+
+func (s *TransactionsService) GetTickTransactions(ctx context.Context, req *protobuf.GetTickTransactionsRequest) (*protobuf.GetTickTransactionsResponse, error) {
+	transactions, err := s.getTickTransactionsInPartialFormat(ctx, req.GetTickNumber(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protobuf.GetTickTransactionsResponse{Transactions: transactions}, nil
+}
+
+func (s *TransactionsService) GetTickApprovedTransactions(ctx context.Context, req *protobuf.GetTickApprovedTransactionsRequest) (*protobuf.GetTickApprovedTransactionsResponse, error) {
+	transactions, err := s.getTickTransactionsInPartialFormat(ctx, req.GetTickNumber(), true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protobuf.GetTickApprovedTransactionsResponse{ApprovedTransactions: transactions}, nil
+}
+
+func (s *TransactionsService) getTickTransactionsInPartialFormat(ctx context.Context, tickNumber uint32, approvedOnly bool) ([]*protobuf.Transaction, error) {
+	txs, err := s.txService.GetTransactionsForTickNumber(ctx, tickNumber, nil, nil)
+	if err != nil {
+		return nil, createInternalError("getting tick transactions", err)
+	}
+
+	var transactions []*protobuf.Transaction
+	for _, tx := range txs {
+		// money flew of send many transactions is already recomputed in the domain layer
+		if approvedOnly && !tx.GetMoneyFlew() {
+			continue
+		}
+
+		txData, err := TxToArchivePartialFormat(tx)
+		if err != nil {
+			return nil, createInternalError("converting transaction to output format", err)
+		}
+		transactions = append(transactions, txData)
+	}
+
+	return transactions, nil
+}
+
+func (s *TransactionsService) GetTransaction(ctx context.Context, req *protobuf.GetTransactionRequest) (*protobuf.GetTransactionResponse, error) {
+	tx, err := s.getTransaction(ctx, req.GetTxId())
+	if err != nil {
+		return nil, err
+	}
+
+	txData, err := TxToArchivePartialFormat(tx)
+	if err != nil {
+		return nil, createInternalError("converting transaction to archive partial format", err)
+	}
+
+	return &protobuf.GetTransactionResponse{Transaction: txData}, nil
+}
+
+func (s *TransactionsService) GetTransactionStatus(ctx context.Context, req *protobuf.GetTransactionRequest) (*protobuf.GetTransactionStatusResponse, error) {
+	tx, err := s.getTransaction(ctx, req.GetTxId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &protobuf.GetTransactionStatusResponse{
+		TransactionStatus: &protobuf.TransactionStatus{TxId: tx.GetHash(), MoneyFlew: tx.GetMoneyFlew()},
+	}, nil
+}
+
+func (s *TransactionsService) GetTransactionV2(ctx context.Context, req *protobuf.GetTransactionRequest) (*protobuf.GetTransactionResponseV2, error) {
+	tx, err := s.getTransaction(ctx, req.GetTxId())
+	if err != nil {
+		return nil, err
+	}
+
+	txData, err := TxToArchiveFullFormat(tx)
+	if err != nil {
+		return nil, createInternalError("converting transaction to output format", err)
+	}
+
+	return &protobuf.GetTransactionResponseV2{Transaction: txData.Transaction, Timestamp: txData.Timestamp, MoneyFlew: txData.MoneyFlew}, nil
+}
+
+func (s *TransactionsService) getTransaction(ctx context.Context, hash string) (*api.Transaction, error) {
+	tx, err := s.txService.GetTransactionByHash(ctx, hash)
+	if err != nil {
+		return nil, createInternalError("getting transaction", err)
+	}
+	if tx == nil {
+		return nil, status.Error(codes.NotFound, "transaction with specified ID not found")
+	}
+	return tx, nil
+}
+
+func (s *TransactionsService) GetTickData(ctx context.Context, req *protobuf.GetTickDataRequest) (*protobuf.GetTickDataResponse, error) {
+	td, err := s.tdService.GetTickData(ctx, req.GetTickNumber())
+	if err != nil {
+		return nil, createInternalError("error querying tick data", err)
+	}
+	if td == nil { // empty tick
+		return &protobuf.GetTickDataResponse{TickData: nil}, nil
+	}
+
+	tickData, err := TickDataToArchiveFormat(td)
+	if err != nil {
+		return nil, createInternalError("converting tick data to output format", err)
+	}
+
+	return &protobuf.GetTickDataResponse{TickData: tickData}, nil
+}
+
+func (s *TransactionsService) GetArchiverStatus(ctx context.Context, empty *emptypb.Empty) (*protobuf.GetArchiverStatusResponse, error) {
+	archiverStatus, err := s.statusClient.GetArchiverStatus(ctx, empty)
+	if err != nil {
+		return nil, createInternalError("getting status", err)
+	}
+
+	response, err := convertArchiverStatus(archiverStatus)
+	if err != nil {
+		return nil, createInternalError("converting status", err)
+	}
+
+	return response, nil
+}
+
+func (s *TransactionsService) GetComputorsList(ctx context.Context, req *protobuf.GetComputorsRequest) (*protobuf.GetComputorsResponse, error) {
+	computorsLists, err := s.clService.GetComputorsListsForEpoch(ctx, req.GetEpoch())
+	if err != nil {
+		return nil, createInternalError("performing computors list query", err)
+	}
+
+	if len(computorsLists) == 0 {
+		return nil, status.Error(codes.NotFound, "computors list for specified epoch not found")
+	}
+
+	// the lists are sorted by tick number descending. The latest list is the valid one.
+	computors, err := ComputorsListToArchiveFormat(computorsLists[0])
+	if err != nil {
+		return nil, createInternalError("converting computors list to output format", err)
+	}
+
+	return &protobuf.GetComputorsResponse{Computors: computors}, nil
+}
+
+func (s *TransactionsService) GetLatestTick(ctx context.Context, _ *emptypb.Empty) (*protobuf.GetLatestTickResponse, error) {
+	cachedStatus, err := s.statusService.GetStatus(ctx)
+	if err != nil {
+		return nil, createInternalError("fetching last processed tick", err)
+	}
+
+	return &protobuf.GetLatestTickResponse{
+		LatestTick: cachedStatus.GetLastProcessedTick(),
+	}, nil
+}
+
+func (s *TransactionsService) GetEpochTickListV2(ctx context.Context, req *protobuf.GetEpochTickListRequestV2) (*protobuf.GetEpochTickListResponseV2, error) {
+	page, pageSize, err := getTickListPageAndSize(req.GetPage(), req.GetPageSize())
+	if err != nil {
+		return nil, err
+	}
+
+	from := uint32((page - 1) * pageSize)                                                                   //nolint: gosec
+	result, err := s.tdService.GetTicksForEpoch(ctx, req.GetEpoch(), from, uint32(pageSize), req.GetDesc()) //nolint: gosec
+	if err != nil {
+		return nil, createInternalError("getting tick intervals", err)
+	}
+	if result == nil {
+		return nil, status.Errorf(codes.NotFound, "no processed ticks for epoch %d", req.GetEpoch())
+	}
+
+	ticks := make([]*protobuf.Tick, 0, len(result.Ticks))
+	for _, tick := range result.Ticks {
+		ticks = append(ticks, &protobuf.Tick{
+			TickNumber: tick.GetTickNumber(),
+			IsEmpty:    tick.GetIsEmpty(),
+		})
+	}
+
+	pagination, err := getPaginationInformation(int(result.Total), int(page), int(pageSize))
+	if err != nil {
+		return nil, createInternalError("creating pagination info", err)
+	}
+
+	return &protobuf.GetEpochTickListResponseV2{
+		Pagination: pagination,
+		Ticks:      ticks,
+	}, nil
+}
+
+func (s *TransactionsService) GetEmptyTickListV2(ctx context.Context, req *protobuf.GetEpochEmptyTickListRequestV2) (*protobuf.GetEpochEmptyTickListResponseV2, error) {
+	page, pageSize, err := getTickListPageAndSize(req.GetPage(), req.GetPageSize())
+	if err != nil {
+		return nil, err
+	}
+
+	from := uint32((page - 1) * pageSize)                                                         //nolint: gosec
+	result, err := s.tdService.GetEmptyTicksForEpoch(ctx, req.GetEpoch(), from, uint32(pageSize)) //nolint: gosec
+	if err != nil {
+		return nil, createInternalError("getting tick intervals", err)
+	}
+	if result == nil {
+		return nil, status.Errorf(codes.NotFound, "no processed ticks for epoch %d", req.GetEpoch())
+	}
+
+	pagination, err := getPaginationInformation(int(result.Total), int(page), int(pageSize))
+	if err != nil {
+		return nil, createInternalError("creating pagination info", err)
+	}
+
+	emptyTicks := result.TickNumbers
+	if emptyTicks == nil {
+		emptyTicks = make([]uint32, 0)
+	}
+
+	return &protobuf.GetEpochEmptyTickListResponseV2{
+		Pagination: pagination,
+		EmptyTicks: emptyTicks,
+	}, nil
+}
+
+// getPageNumberAndSize validates the legacy page information. The returned page number starts with '0'.
+func getPageNumberAndSize(page, size uint32) (uint32, uint32, error) {
+	var pageSize uint32
+	switch {
+	case size > maxPageSize:
+		return 0, 0, status.Errorf(codes.InvalidArgument, "Invalid page size %d (maximum is %d).", size, maxPageSize)
+	case size == 0:
+		pageSize = defaultPageSize
+	default:
+		pageSize = size
+	}
+
+	pageNumber := max(1, page) - 1 // API index starts with '1', implementation index starts with '0'.
+	if pageNumber*pageSize+pageSize > maxResultSize {
+		return 0, 0, status.Errorf(codes.InvalidArgument, "Invalid pagination information. Page number and page size exceeds maximum result size of %d.", maxResultSize)
+	}
+	return pageNumber, pageSize, nil
+}
+
+// getTickListPageAndSize validates the legacy tick list page information. The returned page starts with '1'.
+func getTickListPageAndSize(page, size int32) (int32, int32, error) {
+	if size > maxTickListPageSize {
+		return 0, 0, status.Errorf(codes.InvalidArgument, "invalid page size %d exceeds maximum %d.", size, maxTickListPageSize)
+	}
+	if size <= 0 {
+		size = defaultTickListPageSize
+	}
+	return max(1, page), size, nil
+}
+
+func convertArchiverStatus(source *statusPb.GetArchiverStatusResponse) (*protobuf.GetArchiverStatusResponse, error) {
+	marshalled, err := proto.Marshal(source)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling status: %w", err)
+	}
+	var target protobuf.GetArchiverStatusResponse
+	err = proto.Unmarshal(marshalled, &target)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshalling status: %w", err)
+	}
+	return &target, nil
+}
+
+// ATTENTION: first page has pageNumber == 1 as API starts with index 1
+func getPaginationInformation(totalRecords, pageNumber, pageSize int) (*protobuf.Pagination, error) {
+
+	if pageNumber < 1 {
+		return nil, fmt.Errorf("invalid page number [%d]", pageNumber)
+	}
+
+	if pageSize < 1 {
+		return nil, fmt.Errorf("invalid page size [%d]", pageSize)
+	}
+
+	if totalRecords < 0 {
+		return nil, fmt.Errorf("invalid number of total records [%d]", totalRecords)
+	}
+
+	totalPages := totalRecords / pageSize // rounds down
+	if totalRecords%pageSize != 0 {
+		totalPages++
+	}
+
+	// next page starts at index 1. -1 if no next page.
+	nextPage := pageNumber + 1
+	if nextPage > totalPages {
+		nextPage = -1
+	}
+
+	// previous page starts at index 1. -1 if no previous page
+	previousPage := pageNumber - 1
+	if previousPage == 0 {
+		previousPage = -1
+	}
+
+	return &protobuf.Pagination{
+		TotalRecords: int32(totalRecords),
+		CurrentPage:  int32(min(totalRecords, pageNumber)), // 0 if there are no records
+		TotalPages:   int32(totalPages),                    // 0 if there are no records
+		PageSize:     int32(pageSize),
+		NextPage:     int32(nextPage),                      // -1 if there is none
+		PreviousPage: int32(min(totalPages, previousPage)), // -1 if there is none, do not exceed total pages
+	}, nil
+}
+
+func createInternalError(message string, err error) error {
+	log.Printf("[ERROR] %s: %v", message, err)
+	return status.Error(codes.Internal, message)
+}
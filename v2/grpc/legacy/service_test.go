@@ -0,0 +1,286 @@
+package legacy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	protobuf "github.com/qubic/archive-query-service/v2/api/archive-query-service/legacy"
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	"github.com/qubic/archive-query-service/v2/entities"
+	statusPb "github.com/qubic/go-data-publisher/status-service/protobuf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+type TransactionServiceStub struct {
+	tx              *api.Transaction
+	txs             []*api.Transaction
+	result          *entities.TransactionsResult
+	err             error
+	ReceivedFilters entities.Filters
+	ReceivedFrom    uint32
+	ReceivedSize    uint32
+}
+
+func (t *TransactionServiceStub) GetTransactionByHash(context.Context, string) (*api.Transaction, error) {
+	return t.tx, t.err
+}
+
+func (t *TransactionServiceStub) GetTransactionsForTickNumber(context.Context, uint32, map[string][]string, map[string][]entities.Range) ([]*api.Transaction, error) {
+	return t.txs, t.err
+}
+
+func (t *TransactionServiceStub) GetTransactionsForIdentity(_ context.Context, _ string, filters entities.Filters, from, size uint32) (*entities.TransactionsResult, error) {
+	t.ReceivedFilters = filters
+	t.ReceivedFrom = from
+	t.ReceivedSize = size
+	return t.result, t.err
+}
+
+type TickDataServiceStub struct {
+	tickData     *api.TickData
+	ticks        *entities.EpochTicksResult
+	emptyTicks   *entities.EmptyTicksResult
+	ReceivedFrom uint32
+	ReceivedSize uint32
+	ReceivedDesc bool
+}
+
+func (t *TickDataServiceStub) GetTickData(context.Context, uint32) (*api.TickData, error) {
+	return t.tickData, nil
+}
+
+func (t *TickDataServiceStub) GetTickDataRange(context.Context, uint32, uint32) ([]*api.TickData, error) {
+	return nil, nil
+}
+
+func (t *TickDataServiceStub) GetTicksForEpoch(_ context.Context, _, from, size uint32, desc bool) (*entities.EpochTicksResult, error) {
+	t.ReceivedFrom = from
+	t.ReceivedSize = size
+	t.ReceivedDesc = desc
+	return t.ticks, nil
+}
+
+func (t *TickDataServiceStub) GetEmptyTicksForEpoch(_ context.Context, _, from, size uint32) (*entities.EmptyTicksResult, error) {
+	t.ReceivedFrom = from
+	t.ReceivedSize = size
+	return t.emptyTicks, nil
+}
+
+func (t *TickDataServiceStub) GetComputorTickStats(context.Context, uint32) ([]*api.ComputorTickStats, error) {
+	return nil, nil
+}
+
+func TestTransactionsService_GetIdentityTransactions(t *testing.T) {
+	txService := &TransactionServiceStub{result: &entities.TransactionsResult{
+		Hits:         &entities.Hits{Total: 3},
+		Transactions: []*api.Transaction{{Hash: "a", Amount: 1}, {Hash: "b", Amount: 2}},
+	}}
+	service := NewTransactionsService(txService, nil, nil, nil, nil)
+
+	response, err := service.GetIdentityTransactions(context.Background(), &protobuf.GetIdentityTransactionsRequest{
+		Identity: "some-identity",
+		Page:     2,
+		PageSize: 2,
+	})
+	require.NoError(t, err)
+	require.Len(t, response.GetTransactions(), 2)
+	assert.Equal(t, "a", response.GetTransactions()[0].GetTxId())
+	assert.Equal(t, int64(2), response.GetTransactions()[1].GetAmount())
+
+	assert.Equal(t, entities.Filters{Ascending: true}, txService.ReceivedFilters)
+	assert.Equal(t, uint32(2), txService.ReceivedFrom)
+	assert.Equal(t, uint32(2), txService.ReceivedSize)
+
+	assert.Equal(t, &protobuf.Pagination{TotalRecords: 3, CurrentPage: 2, TotalPages: 2, PageSize: 2, NextPage: -1, PreviousPage: 1}, response.GetPagination())
+}
+
+func TestTransactionsService_GetIdentityTransactions_GivenNoResult_ThenReturnEmptyPage(t *testing.T) {
+	service := NewTransactionsService(&TransactionServiceStub{}, nil, nil, nil, nil)
+
+	response, err := service.GetIdentityTransactions(context.Background(), &protobuf.GetIdentityTransactionsRequest{Desc: true})
+	require.NoError(t, err)
+	assert.NotNil(t, response.GetTransactions())
+	assert.Empty(t, response.GetTransactions())
+	assert.Equal(t, int32(100), response.GetPagination().GetPageSize())
+}
+
+func TestTransactionsService_GetIdentityTransactions_GivenExceedsMaximumResultSize_ThenError(t *testing.T) {
+	service := NewTransactionsService(&TransactionServiceStub{}, nil, nil, nil, nil)
+
+	_, err := service.GetIdentityTransactions(context.Background(), &protobuf.GetIdentityTransactionsRequest{
+		Page:     101,
+		PageSize: 100,
+	})
+	require.ErrorContains(t, err, "code = InvalidArgument")
+	require.ErrorContains(t, err, "exceeds maximum result size")
+
+	_, err = service.GetIdentityTransactions(context.Background(), &protobuf.GetIdentityTransactionsRequest{PageSize: 251})
+	require.ErrorContains(t, err, "code = InvalidArgument")
+	require.ErrorContains(t, err, "Invalid page size 251 (maximum is 250).")
+}
+
+func TestTransactionsService_GetIdentityTransactions_GivenQueryError_ThenReturnGenericErrorMessage(t *testing.T) {
+	service := NewTransactionsService(&TransactionServiceStub{err: errors.New("test error")}, nil, nil, nil, nil)
+
+	_, err := service.GetIdentityTransactions(context.Background(), &protobuf.GetIdentityTransactionsRequest{})
+	require.Equal(t, status.Error(codes.Internal, "performing identities transactions query"), err)
+}
+
+func TestTransactionsService_GetIdentityTransfersInTickRangeV2(t *testing.T) {
+	txService := &TransactionServiceStub{result: &entities.TransactionsResult{
+		Hits:         &entities.Hits{Total: 1},
+		Transactions: []*api.Transaction{{Hash: "a", TickNumber: 42, Timestamp: 1234, MoneyFlew: true}},
+	}}
+	service := NewTransactionsService(txService, nil, nil, nil, nil)
+
+	response, err := service.GetIdentityTransfersInTickRangeV2(context.Background(), &protobuf.GetTransferTransactionsPerTickRequestV2{
+		Identity:  "some-identity",
+		StartTick: 10,
+		EndTick:   100,
+		Desc:      true,
+	})
+	require.NoError(t, err)
+	require.Len(t, response.GetTransactions(), 1)
+	assert.Equal(t, uint32(42), response.GetTransactions()[0].GetTickNumber())
+	assert.Equal(t, "some-identity", response.GetTransactions()[0].GetIdentity())
+	assert.Equal(t, uint64(1234), response.GetTransactions()[0].GetTransactions()[0].GetTimestamp())
+
+	assert.Equal(t, entities.Filters{Ranges: map[string][]entities.Range{
+		"tickNumber": {{Operation: "gte", Value: "10"}, {Operation: "lte", Value: "100"}},
+	}}, txService.ReceivedFilters)
+}
+
+func TestTransactionsService_GetTickTransactionsV2(t *testing.T) {
+	txService := &TransactionServiceStub{txs: []*api.Transaction{
+		{Hash: "executed", Amount: 1, MoneyFlew: true},
+		{Hash: "failed", Amount: 1},
+		{Hash: "no-amount"},
+	}}
+	service := NewTransactionsService(txService, nil, nil, nil, nil)
+
+	response, err := service.GetTickTransactionsV2(context.Background(), &protobuf.GetTickTransactionsRequestV2{})
+	require.NoError(t, err)
+	assert.Len(t, response.GetTransactions(), 3)
+
+	response, err = service.GetTickTransactionsV2(context.Background(), &protobuf.GetTickTransactionsRequestV2{Transfers: true})
+	require.NoError(t, err)
+	require.Len(t, response.GetTransactions(), 2)
+	assert.Equal(t, "failed", response.GetTransactions()[1].GetTransaction().GetTxId())
+
+	response, err = service.GetTickTransactionsV2(context.Background(), &protobuf.GetTickTransactionsRequestV2{Approved: true})
+	require.NoError(t, err)
+	require.Len(t, response.GetTransactions(), 1)
+	assert.Equal(t, "executed", response.GetTransactions()[0].GetTransaction().GetTxId())
+}
+
+func TestTransactionsService_GetTickApprovedTransactions(t *testing.T) {
+	txService := &TransactionServiceStub{txs: []*api.Transaction{
+		{Hash: "executed", Amount: 1, MoneyFlew: true},
+		{Hash: "failed", Amount: 1},
+	}}
+	service := NewTransactionsService(txService, nil, nil, nil, nil)
+
+	response, err := service.GetTickApprovedTransactions(context.Background(), &protobuf.GetTickApprovedTransactionsRequest{TickNumber: 42})
+	require.NoError(t, err)
+	require.Len(t, response.GetApprovedTransactions(), 1)
+	assert.Equal(t, "executed", response.GetApprovedTransactions()[0].GetTxId())
+}
+
+func TestTransactionsService_GetTransaction(t *testing.T) {
+	txService := &TransactionServiceStub{tx: &api.Transaction{
+		Hash:      "some-hash",
+		InputData: "AQID",
+		Signature: "BAUG",
+		MoneyFlew: true,
+	}}
+	service := NewTransactionsService(txService, nil, nil, nil, nil)
+
+	response, err := service.GetTransaction(context.Background(), &protobuf.GetTransactionRequest{TxId: "some-hash"})
+	require.NoError(t, err)
+	assert.Equal(t, "010203", response.GetTransaction().GetInputHex())
+	assert.Equal(t, "040506", response.GetTransaction().GetSignatureHex())
+
+	statusResponse, err := service.GetTransactionStatus(context.Background(), &protobuf.GetTransactionRequest{TxId: "some-hash"})
+	require.NoError(t, err)
+	assert.Equal(t, &protobuf.TransactionStatus{TxId: "some-hash", MoneyFlew: true}, statusResponse.GetTransactionStatus())
+}
+
+func TestTransactionsService_GetTransaction_GivenUnknownHash_ThenNotFound(t *testing.T) {
+	service := NewTransactionsService(&TransactionServiceStub{}, nil, nil, nil, nil)
+
+	_, err := service.GetTransactionV2(context.Background(), &protobuf.GetTransactionRequest{TxId: "unknown"})
+	require.Equal(t, status.Error(codes.NotFound, "transaction with specified ID not found"), err)
+}
+
+func TestTransactionsService_GetTickData_GivenEmptyTick_ThenReturnNilTickData(t *testing.T) {
+	service := NewTransactionsService(nil, &TickDataServiceStub{}, nil, nil, nil)
+
+	response, err := service.GetTickData(context.Background(), &protobuf.GetTickDataRequest{TickNumber: 42})
+	require.NoError(t, err)
+	assert.Nil(t, response.GetTickData())
+}
+
+func TestTransactionsService_GetEpochTickListV2(t *testing.T) {
+	tdService := &TickDataServiceStub{ticks: &entities.EpochTicksResult{
+		Total: 25,
+		Ticks: []*api.EpochTick{{TickNumber: 11}, {TickNumber: 12, IsEmpty: true}},
+	}}
+	service := NewTransactionsService(nil, tdService, nil, nil, nil)
+
+	response, err := service.GetEpochTickListV2(context.Background(), &protobuf.GetEpochTickListRequestV2{Epoch: 100, Page: 2, Desc: true})
+	require.NoError(t, err)
+	assert.Equal(t, []*protobuf.Tick{{TickNumber: 11}, {TickNumber: 12, IsEmpty: true}}, response.GetTicks())
+	assert.Equal(t, &protobuf.Pagination{TotalRecords: 25, CurrentPage: 2, TotalPages: 3, PageSize: 10, NextPage: 3, PreviousPage: 1}, response.GetPagination())
+	assert.Equal(t, uint32(10), tdService.ReceivedFrom)
+	assert.Equal(t, uint32(10), tdService.ReceivedSize)
+	assert.True(t, tdService.ReceivedDesc)
+}
+
+func TestTransactionsService_GetEpochTickListV2_GivenInvalidPageSize_ThenError(t *testing.T) {
+	service := NewTransactionsService(nil, &TickDataServiceStub{}, nil, nil, nil)
+
+	_, err := service.GetEpochTickListV2(context.Background(), &protobuf.GetEpochTickListRequestV2{PageSize: 1001})
+	require.ErrorContains(t, err, "code = InvalidArgument")
+}
+
+func TestTransactionsService_GetEmptyTickListV2_GivenUnknownEpoch_ThenNotFound(t *testing.T) {
+	service := NewTransactionsService(nil, &TickDataServiceStub{}, nil, nil, nil)
+
+	_, err := service.GetEmptyTickListV2(context.Background(), &protobuf.GetEpochEmptyTickListRequestV2{Epoch: 100})
+	require.Equal(t, status.Error(codes.NotFound, "no processed ticks for epoch 100"), err)
+}
+
+func TestTransactionsService_ConvertArchiverStatus(t *testing.T) {
+	source := &statusPb.GetArchiverStatusResponse{
+		LastProcessedTick:          &statusPb.ProcessedTick{TickNumber: 42, Epoch: 1},
+		LastProcessedTicksPerEpoch: map[uint32]uint32{1: 42},
+		ProcessedTickIntervalsPerEpoch: []*statusPb.ProcessedTickIntervalsPerEpoch{
+			{Epoch: 1, Intervals: []*statusPb.ProcessedTickInterval{{InitialProcessedTick: 1, LastProcessedTick: 42}}},
+		},
+	}
+	expected := &protobuf.GetArchiverStatusResponse{
+		LastProcessedTick:          &protobuf.ProcessedTick{TickNumber: 42, Epoch: 1},
+		LastProcessedTicksPerEpoch: map[uint32]uint32{1: 42},
+		ProcessedTickIntervalsPerEpoch: []*protobuf.ProcessedTickIntervalsPerEpoch{
+			{Epoch: 1, Intervals: []*protobuf.ProcessedTickInterval{{InitialProcessedTick: 1, LastProcessedTick: 42}}},
+		},
+	}
+
+	converted, err := convertArchiverStatus(source)
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(expected, converted))
+}
+
+func TestTransactionsService_GetPaginationInformation(t *testing.T) {
+	pagination, err := getPaginationInformation(0, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, &protobuf.Pagination{TotalRecords: 0, CurrentPage: 0, TotalPages: 0, PageSize: 10, NextPage: -1, PreviousPage: -1}, pagination)
+
+	_, err = getPaginationInformation(10, 0, 10)
+	require.Error(t, err)
+}
@@ -0,0 +1,106 @@
+package legacy
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+
+	protobuf "github.com/qubic/archive-query-service/v2/api/archive-query-service/legacy"
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+)
+
+func TxToArchivePartialFormat(tx *api.Transaction) (*protobuf.Transaction, error) {
+	inputBytes, err := base64.StdEncoding.DecodeString(tx.GetInputData())
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64 input for tx with id %s: %w", tx.GetHash(), err)
+	}
+
+	sigBytes, err := base64.StdEncoding.DecodeString(tx.GetSignature())
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64 signature for tx with id %s: %w", tx.GetHash(), err)
+	}
+
+	return &protobuf.Transaction{
+		SourceId:     tx.GetSource(),
+		DestId:       tx.GetDestination(),
+		Amount:       int64(tx.GetAmount()), //nolint: gosec
+		TickNumber:   tx.GetTickNumber(),
+		InputType:    tx.GetInputType(),
+		InputSize:    tx.GetInputSize(),
+		InputHex:     hex.EncodeToString(inputBytes),
+		SignatureHex: hex.EncodeToString(sigBytes),
+		TxId:         tx.GetHash(),
+	}, nil
+}
+
+func TxToArchiveFullFormat(tx *api.Transaction) (*protobuf.TransactionData, error) {
+	partialTx, err := TxToArchivePartialFormat(tx)
+	if err != nil {
+		return nil, fmt.Errorf("converting tx to partial format: %w", err)
+	}
+
+	return &protobuf.TransactionData{
+		Transaction: partialTx,
+		Timestamp:   tx.GetTimestamp(),
+		MoneyFlew:   tx.GetMoneyFlew(),
+	}, nil
+}
+
+func TxToNewFormat(tx *api.Transaction) *protobuf.NewTransaction {
+	return &protobuf.NewTransaction{
+		SourceId:   tx.GetSource(),
+		DestId:     tx.GetDestination(),
+		Amount:     int64(tx.GetAmount()), //nolint: gosec
+		TickNumber: tx.GetTickNumber(),
+		InputType:  tx.GetInputType(),
+		InputSize:  tx.GetInputSize(),
+		Input:      tx.GetInputData(),
+		Signature:  tx.GetSignature(),
+		TxId:       tx.GetHash(),
+		Timestamp:  tx.GetTimestamp(),
+		MoneyFlew:  tx.GetMoneyFlew(),
+	}
+}
+
+func TickDataToArchiveFormat(tickData *api.TickData) (*protobuf.TickData, error) {
+	sigBytes, err := base64.StdEncoding.DecodeString(tickData.GetSignature())
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64 signature for tick data with number %d: %w", tickData.GetTickNumber(), err)
+	}
+
+	varStructBytes, err := base64.StdEncoding.DecodeString(tickData.GetVarStruct())
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64 varStruct for tick data with number %d: %w", tickData.GetTickNumber(), err)
+	}
+
+	timeLockBytes, err := base64.StdEncoding.DecodeString(tickData.GetTimeLock())
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64 timelock for tick data with number %d: %w", tickData.GetTickNumber(), err)
+	}
+
+	return &protobuf.TickData{
+		ComputorIndex:  tickData.GetComputorIndex(),
+		Epoch:          tickData.GetEpoch(),
+		TickNumber:     tickData.GetTickNumber(),
+		Timestamp:      tickData.GetTimestamp(),
+		VarStruct:      varStructBytes,
+		TimeLock:       timeLockBytes,
+		TransactionIds: tickData.GetTransactionHashes(),
+		ContractFees:   tickData.GetContractFees(),
+		SignatureHex:   hex.EncodeToString(sigBytes),
+	}, nil
+}
+
+func ComputorsListToArchiveFormat(computorsList *api.ComputorList) (*protobuf.Computors, error) {
+
+	sigBytes, err := base64.StdEncoding.DecodeString(computorsList.GetSignature())
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64 computor list signature for epoch with id %d: %w", computorsList.GetEpoch(), err)
+	}
+
+	return &protobuf.Computors{
+		Epoch:        computorsList.GetEpoch(),
+		Identities:   computorsList.GetIdentities(),
+		SignatureHex: hex.EncodeToString(sigBytes),
+	}, nil
+}
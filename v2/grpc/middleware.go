@@ -10,10 +10,12 @@ import (
 	"strings"
 	"time"
 
+	legacy "github.com/qubic/archive-query-service/v2/api/archive-query-service/legacy"
 	"github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
 	"github.com/qubic/archive-query-service/v2/grpc/utils"
 	"github.com/redis/go-redis/v9"
 	"golang.org/x/sync/singleflight"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -40,6 +42,16 @@ func (twb *TickWithinBoundsInterceptor) GetInterceptor(ctx context.Context, req
 		err = twb.checkTickWithinArchiverIntervals(ctx, request.TickNumber)
 	case *api.GetTransactionsForTickRequest:
 		err = twb.checkTickWithinArchiverIntervals(ctx, request.TickNumber)
+	case *api.VerifyTickRequest:
+		err = twb.checkTickWithinArchiverIntervals(ctx, request.TickNumber)
+	case *legacy.GetTickDataRequest:
+		err = twb.checkTickWithinArchiverIntervals(ctx, request.TickNumber)
+	case *legacy.GetTickTransactionsRequest:
+		err = twb.checkTickWithinArchiverIntervals(ctx, request.TickNumber)
+	case *legacy.GetTickApprovedTransactionsRequest:
+		err = twb.checkTickWithinArchiverIntervals(ctx, request.TickNumber)
+	case *legacy.GetTickTransactionsRequestV2:
+		err = twb.checkTickWithinArchiverIntervals(ctx, request.TickNumber)
 
 	default:
 		break
@@ -62,8 +74,27 @@ func (i *IdentitiesValidatorInterceptor) GetInterceptor(ctx context.Context, req
 	switch request := req.(type) {
 	case *api.GetTransactionByHashRequest:
 		err = i.checkFormat(request.Hash, true)
+	case *api.GetTransactionDetailsRequest:
+		err = i.checkFormat(request.Hash, true)
 	case *api.GetTransactionsForIdentityRequest:
 		err = i.checkFormat(request.Identity, false)
+	case *api.GetComputorMembershipRequest:
+		err = i.checkFormat(request.Identity, false)
+	case *api.GetIdentityOverviewRequest:
+		err = i.checkFormat(request.Identity, false)
+	case *api.GetEventLogsForTransactionRequest:
+		err = i.checkFormat(request.TransactionHash, true)
+	case *api.VerifyTransactionRequest:
+		// the hash of supplied transactions is verified by the service
+		if request.Transaction == nil && request.Hash != "" {
+			err = i.checkFormat(request.Hash, true)
+		}
+	case *legacy.GetTransactionRequest:
+		err = i.checkFormat(request.TxId, true)
+	case *legacy.GetIdentityTransactionsRequest:
+		err = i.checkFormat(request.Identity, false)
+	case *legacy.GetTransferTransactionsPerTickRequestV2:
+		err = i.checkFormat(request.Identity, false)
 	default:
 		break
 	}
@@ -159,6 +190,15 @@ type Cacheable interface {
 }
 
 func CreateTTLMapFromJSONFile(jsonFilePath string) (map[string]time.Duration, error) {
+	return createDurationMapFromJSONFile(jsonFilePath, "ttl")
+}
+
+// CreateTimeoutMapFromJSONFile reads the request timeouts per grpc method (full method name) from a json file.
+func CreateTimeoutMapFromJSONFile(jsonFilePath string) (map[string]time.Duration, error) {
+	return createDurationMapFromJSONFile(jsonFilePath, "timeout")
+}
+
+func createDurationMapFromJSONFile(jsonFilePath, name string) (map[string]time.Duration, error) {
 	file, err := os.Open(jsonFilePath)
 	if err != nil {
 		return nil, fmt.Errorf("opening json file: %w", err)
@@ -170,16 +210,65 @@ func CreateTTLMapFromJSONFile(jsonFilePath string) (map[string]time.Duration, er
 		return nil, fmt.Errorf("parsing json file: %w", err)
 	}
 
-	ttlMap := make(map[string]time.Duration)
+	durationMap := make(map[string]time.Duration)
 	for k, v := range rawMap {
 		dur, err := time.ParseDuration(v)
 		if err != nil {
-			return nil, fmt.Errorf("converting ttl endpoint [%s] value [%s] to duration: %w", k, v, err)
+			return nil, fmt.Errorf("converting %s endpoint [%s] value [%s] to duration: %w", name, k, v, err)
 		}
-		ttlMap[k] = dur
+		durationMap[k] = dur
 	}
 
-	return ttlMap, nil
+	return durationMap, nil
+}
+
+// DeadlineInterceptor limits the processing time of the requests. The timeout is configured per method (full method
+// name), the other methods use the default timeout. A zero timeout disables the limit. A shorter deadline set by the
+// client is kept and its errors are not changed.
+type DeadlineInterceptor struct {
+	defaultTimeout time.Duration
+	timeouts       map[string]time.Duration
+}
+
+func NewDeadlineInterceptor(defaultTimeout time.Duration, timeouts map[string]time.Duration) *DeadlineInterceptor {
+	return &DeadlineInterceptor{defaultTimeout: defaultTimeout, timeouts: timeouts}
+}
+
+func (di *DeadlineInterceptor) GetInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	timeout, ok := di.timeouts[info.FullMethod]
+	if !ok {
+		timeout = di.defaultTimeout
+	}
+	if timeout <= 0 {
+		return handler(ctx, req)
+	}
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) <= timeout {
+		return handler(ctx, req) // the client deadline is effective
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	res, err := handler(ctx, req)
+	if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		log.Printf("[WARN] [%s] request did not finish within %s: %v", info.FullMethod, timeout, err)
+		return nil, createDeadlineExceededError(info.FullMethod, timeout)
+	}
+	return res, err
+}
+
+// createDeadlineExceededError creates the error for requests that took too long. The error info contains the method
+// and the timeout.
+func createDeadlineExceededError(fullMethod string, timeout time.Duration) error {
+	st := status.Newf(codes.DeadlineExceeded, "request did not finish within %s", timeout)
+	st, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   "REQUEST_TIMEOUT",
+		Domain:   errorDomain,
+		Metadata: map[string]string{"method": fullMethod, "timeout": timeout.String()},
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "creating custom status")
+	}
+	return st.Err()
 }
 
 type RedisCacheInterceptor struct {
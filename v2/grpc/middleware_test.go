@@ -1,13 +1,23 @@
 package grpc
 
 import (
+	"context"
+	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
+	legacy "github.com/qubic/archive-query-service/v2/api/archive-query-service/legacy"
 	"github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	statusPb "github.com/qubic/go-data-publisher/status-service/protobuf"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func Test_WasSkippedByArchive(t *testing.T) {
@@ -168,6 +178,65 @@ func Test_WasSkippedByArchive(t *testing.T) {
 	}
 }
 
+func TestTickWithinBoundsInterceptor(t *testing.T) {
+	interceptor := NewTickWithinBoundsInterceptor(&StatusServiceStub{
+		statusResponse: &statusPb.GetStatusResponse{LastProcessedTick: 100},
+		intervals:      []*api.ProcessedTickInterval{{Epoch: 1, FirstTick: 50, LastTick: 100}},
+	})
+	handler := func(context.Context, any) (any, error) { return "handled", nil }
+
+	tests := []struct {
+		name         string
+		req          any
+		expectedCode codes.Code
+	}{
+		{name: "tick data", req: &api.GetTickDataRequest{TickNumber: 101}, expectedCode: codes.InvalidArgument},
+		{name: "legacy tick data", req: &legacy.GetTickDataRequest{TickNumber: 101}, expectedCode: codes.InvalidArgument},
+		{name: "legacy tick transactions", req: &legacy.GetTickTransactionsRequest{TickNumber: 10}, expectedCode: codes.InvalidArgument},
+		{name: "legacy approved tick transactions", req: &legacy.GetTickApprovedTransactionsRequest{TickNumber: 101}, expectedCode: codes.InvalidArgument},
+		{name: "legacy tick transactions v2", req: &legacy.GetTickTransactionsRequestV2{TickNumber: 10}, expectedCode: codes.InvalidArgument},
+		{name: "legacy tick within bounds", req: &legacy.GetTickTransactionsRequestV2{TickNumber: 75}, expectedCode: codes.OK},
+		{name: "other request", req: &legacy.GetComputorsRequest{Epoch: 1}, expectedCode: codes.OK},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := interceptor.GetInterceptor(context.Background(), tc.req, &grpc.UnaryServerInfo{}, handler)
+			assert.Equal(t, tc.expectedCode, status.Code(err))
+			if tc.expectedCode == codes.OK {
+				assert.Equal(t, "handled", res)
+			}
+		})
+	}
+}
+
+func TestIdentitiesValidatorInterceptor(t *testing.T) {
+	var interceptor IdentitiesValidatorInterceptor
+	handler := func(context.Context, any) (any, error) { return "handled", nil }
+
+	tests := []struct {
+		name         string
+		req          any
+		expectedCode codes.Code
+	}{
+		{name: "transaction hash", req: &api.GetTransactionByHashRequest{Hash: validId1}, expectedCode: codes.InvalidArgument},
+		{name: "legacy transaction", req: &legacy.GetTransactionRequest{TxId: validId1}, expectedCode: codes.InvalidArgument},
+		{name: "legacy valid transaction", req: &legacy.GetTransactionRequest{TxId: validTransactionHash1}, expectedCode: codes.OK},
+		{name: "legacy identity transactions", req: &legacy.GetIdentityTransactionsRequest{Identity: "invalid"}, expectedCode: codes.InvalidArgument},
+		{name: "legacy valid identity transactions", req: &legacy.GetIdentityTransactionsRequest{Identity: validId1}, expectedCode: codes.OK},
+		{name: "legacy identity transfers", req: &legacy.GetTransferTransactionsPerTickRequestV2{Identity: validTransactionHash1}, expectedCode: codes.InvalidArgument},
+		{name: "other request", req: &legacy.GetTickDataRequest{TickNumber: 1}, expectedCode: codes.OK},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := interceptor.GetInterceptor(context.Background(), tc.req, &grpc.UnaryServerInfo{}, handler)
+			assert.Equal(t, tc.expectedCode, status.Code(err))
+			if tc.expectedCode == codes.OK {
+				assert.Equal(t, "handled", res)
+			}
+		})
+	}
+}
+
 func Test_createTTLMapFromJSONFile(t *testing.T) {
 	tmpFile, err := os.CreateTemp("", "ttlmap-*.json")
 	require.NoError(t, err, "could not create temp file")
@@ -210,3 +279,91 @@ func Test_createTTLMapFromJSONFile(t *testing.T) {
 	_, err = CreateTTLMapFromJSONFile(tmpFile.Name()) // nolint:ineffassign
 	require.Error(t, err)
 }
+
+func TestDeadlineInterceptor(t *testing.T) {
+	interceptor := NewDeadlineInterceptor(time.Minute, map[string]time.Duration{
+		"/slow":      10 * time.Millisecond,
+		"/unlimited": 0,
+	})
+	remaining := func(ctx context.Context, _ any) (any, error) {
+		deadline, ok := ctx.Deadline()
+		if !ok {
+			return time.Duration(0), nil
+		}
+		return time.Until(deadline), nil
+	}
+
+	res, err := interceptor.GetInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/other"}, remaining)
+	require.NoError(t, err)
+	assert.InDelta(t, time.Minute, res, float64(time.Second), "default timeout")
+	res, err = interceptor.GetInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/unlimited"}, remaining)
+	require.NoError(t, err)
+	assert.Zero(t, res)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	res, err = interceptor.GetInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/other"}, remaining)
+	require.NoError(t, err)
+	assert.LessOrEqual(t, res, time.Second, "shorter client deadline is kept")
+}
+
+func TestDeadlineInterceptor_GivenTimeout_ThenDeadlineExceeded(t *testing.T) {
+	interceptor := NewDeadlineInterceptor(time.Minute, map[string]time.Duration{"/slow": 10 * time.Millisecond})
+	slow := func(ctx context.Context, _ any) (any, error) {
+		<-ctx.Done()
+		return nil, createInternalError("failed to get data", fmt.Errorf("performing search: %w", ctx.Err()))
+	}
+
+	_, err := interceptor.GetInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/slow"}, slow)
+	st := status.Convert(err)
+	assert.Equal(t, codes.DeadlineExceeded, st.Code())
+	assert.Equal(t, "request did not finish within 10ms", st.Message())
+	require.Len(t, st.Details(), 1)
+	info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+	require.True(t, ok)
+	assert.Equal(t, "REQUEST_TIMEOUT", info.GetReason())
+	assert.Equal(t, errorDomain, info.GetDomain())
+	assert.Equal(t, map[string]string{"method": "/slow", "timeout": "10ms"}, info.GetMetadata())
+
+	failing := func(context.Context, any) (any, error) {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	_, err = interceptor.GetInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/slow"}, failing)
+	assert.Equal(t, codes.NotFound, status.Code(err), "other errors are unchanged")
+}
+
+func TestDeadlineInterceptor_GivenOtherDeadline_ThenErrorUnchanged(t *testing.T) {
+	interceptor := NewDeadlineInterceptor(time.Minute, map[string]time.Duration{"/slow": 100 * time.Millisecond})
+	slow := func(ctx context.Context, _ any) (any, error) {
+		<-ctx.Done()
+		return nil, createInternalError("failed to get data", fmt.Errorf("performing search: %w", ctx.Err()))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := interceptor.GetInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/slow"}, slow)
+	st := status.Convert(err)
+	assert.Equal(t, codes.DeadlineExceeded, st.Code())
+	assert.Equal(t, "failed to get data", st.Message(), "client deadline")
+	assert.Empty(t, st.Details())
+
+	downstream := func(context.Context, any) (any, error) {
+		return nil, createInternalError("failed to get data", fmt.Errorf("performing search: %w", context.DeadlineExceeded))
+	}
+	_, err = interceptor.GetInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/slow"}, downstream)
+	st = status.Convert(err)
+	assert.Equal(t, codes.DeadlineExceeded, st.Code())
+	assert.Equal(t, "failed to get data", st.Message(), "server timeout not expired")
+}
+
+func Test_createTimeoutMapFromJSONFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "timeouts.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"/qubic.v2.archive.pb.ArchiveQueryService/GetTransactionsForIdentity": "5s"}`), 0600))
+	timeouts, err := CreateTimeoutMapFromJSONFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]time.Duration{"/qubic.v2.archive.pb.ArchiveQueryService/GetTransactionsForIdentity": 5 * time.Second}, timeouts)
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"method": "5"}`), 0600))
+	_, err = CreateTimeoutMapFromJSONFile(path)
+	require.ErrorContains(t, err, "converting timeout endpoint [method]")
+}
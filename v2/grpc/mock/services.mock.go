@@ -112,6 +112,36 @@ func (m *MockTickDataService) EXPECT() *MockTickDataServiceMockRecorder {
 	return m.recorder
 }
 
+// GetComputorTickStats mocks base method.
+func (m *MockTickDataService) GetComputorTickStats(ctx context.Context, epoch uint32) ([]*api.ComputorTickStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComputorTickStats", ctx, epoch)
+	ret0, _ := ret[0].([]*api.ComputorTickStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComputorTickStats indicates an expected call of GetComputorTickStats.
+func (mr *MockTickDataServiceMockRecorder) GetComputorTickStats(ctx, epoch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComputorTickStats", reflect.TypeOf((*MockTickDataService)(nil).GetComputorTickStats), ctx, epoch)
+}
+
+// GetEmptyTicksForEpoch mocks base method.
+func (m *MockTickDataService) GetEmptyTicksForEpoch(ctx context.Context, epoch, from, size uint32) (*entities.EmptyTicksResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEmptyTicksForEpoch", ctx, epoch, from, size)
+	ret0, _ := ret[0].(*entities.EmptyTicksResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEmptyTicksForEpoch indicates an expected call of GetEmptyTicksForEpoch.
+func (mr *MockTickDataServiceMockRecorder) GetEmptyTicksForEpoch(ctx, epoch, from, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmptyTicksForEpoch", reflect.TypeOf((*MockTickDataService)(nil).GetEmptyTicksForEpoch), ctx, epoch, from, size)
+}
+
 // GetTickData mocks base method.
 func (m *MockTickDataService) GetTickData(ctx context.Context, tickNumber uint32) (*api.TickData, error) {
 	m.ctrl.T.Helper()
@@ -127,6 +157,36 @@ func (mr *MockTickDataServiceMockRecorder) GetTickData(ctx, tickNumber any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTickData", reflect.TypeOf((*MockTickDataService)(nil).GetTickData), ctx, tickNumber)
 }
 
+// GetTickDataRange mocks base method.
+func (m *MockTickDataService) GetTickDataRange(ctx context.Context, fromTick, toTick uint32) ([]*api.TickData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTickDataRange", ctx, fromTick, toTick)
+	ret0, _ := ret[0].([]*api.TickData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTickDataRange indicates an expected call of GetTickDataRange.
+func (mr *MockTickDataServiceMockRecorder) GetTickDataRange(ctx, fromTick, toTick any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTickDataRange", reflect.TypeOf((*MockTickDataService)(nil).GetTickDataRange), ctx, fromTick, toTick)
+}
+
+// GetTicksForEpoch mocks base method.
+func (m *MockTickDataService) GetTicksForEpoch(ctx context.Context, epoch, from, size uint32, desc bool) (*entities.EpochTicksResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicksForEpoch", ctx, epoch, from, size, desc)
+	ret0, _ := ret[0].(*entities.EpochTicksResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTicksForEpoch indicates an expected call of GetTicksForEpoch.
+func (mr *MockTickDataServiceMockRecorder) GetTicksForEpoch(ctx, epoch, from, size, desc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicksForEpoch", reflect.TypeOf((*MockTickDataService)(nil).GetTicksForEpoch), ctx, epoch, from, size, desc)
+}
+
 // MockStatusService is a mock of StatusService interface.
 type MockStatusService struct {
 	ctrl     *gomock.Controller
@@ -205,6 +265,21 @@ func (m *MockComputorsListService) EXPECT() *MockComputorsListServiceMockRecorde
 	return m.recorder
 }
 
+// GetComputorMembership mocks base method.
+func (m *MockComputorsListService) GetComputorMembership(ctx context.Context, identity string) ([]*api.ComputorMembership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComputorMembership", ctx, identity)
+	ret0, _ := ret[0].([]*api.ComputorMembership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComputorMembership indicates an expected call of GetComputorMembership.
+func (mr *MockComputorsListServiceMockRecorder) GetComputorMembership(ctx, identity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComputorMembership", reflect.TypeOf((*MockComputorsListService)(nil).GetComputorMembership), ctx, identity)
+}
+
 // GetComputorsListsForEpoch mocks base method.
 func (m *MockComputorsListService) GetComputorsListsForEpoch(ctx context.Context, epoch uint32) ([]*api.ComputorList, error) {
 	m.ctrl.T.Helper()
@@ -220,6 +295,21 @@ func (mr *MockComputorsListServiceMockRecorder) GetComputorsListsForEpoch(ctx, e
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComputorsListsForEpoch", reflect.TypeOf((*MockComputorsListService)(nil).GetComputorsListsForEpoch), ctx, epoch)
 }
 
+// GetComputorsListsForEpochRange mocks base method.
+func (m *MockComputorsListService) GetComputorsListsForEpochRange(ctx context.Context, fromEpoch, toEpoch uint32) ([]*api.ComputorList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComputorsListsForEpochRange", ctx, fromEpoch, toEpoch)
+	ret0, _ := ret[0].([]*api.ComputorList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComputorsListsForEpochRange indicates an expected call of GetComputorsListsForEpochRange.
+func (mr *MockComputorsListServiceMockRecorder) GetComputorsListsForEpochRange(ctx, fromEpoch, toEpoch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComputorsListsForEpochRange", reflect.TypeOf((*MockComputorsListService)(nil).GetComputorsListsForEpochRange), ctx, fromEpoch, toEpoch)
+}
+
 // MockEventsService is a mock of EventsService interface.
 type MockEventsService struct {
 	ctrl     *gomock.Controller
@@ -244,6 +334,21 @@ func (m *MockEventsService) EXPECT() *MockEventsServiceMockRecorder {
 	return m.recorder
 }
 
+// GetEvent mocks base method.
+func (m *MockEventsService) GetEvent(ctx context.Context, epoch uint32, logID uint64, maxTick uint32) (*api.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEvent", ctx, epoch, logID, maxTick)
+	ret0, _ := ret[0].(*api.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEvent indicates an expected call of GetEvent.
+func (mr *MockEventsServiceMockRecorder) GetEvent(ctx, epoch, logID, maxTick any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvent", reflect.TypeOf((*MockEventsService)(nil).GetEvent), ctx, epoch, logID, maxTick)
+}
+
 // GetEvents mocks base method.
 func (m *MockEventsService) GetEvents(ctx context.Context, queryFilters entities.Filters, from, size, maxTick uint32) (*entities.EventsResult, error) {
 	m.ctrl.T.Helper()
@@ -258,3 +363,96 @@ func (mr *MockEventsServiceMockRecorder) GetEvents(ctx, queryFilters, from, size
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvents", reflect.TypeOf((*MockEventsService)(nil).GetEvents), ctx, queryFilters, from, size, maxTick)
 }
+
+// GetEventsForTransaction mocks base method.
+func (m *MockEventsService) GetEventsForTransaction(ctx context.Context, hash string, afterLogID *uint64, size, maxTick uint32) (*entities.TransactionEventsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventsForTransaction", ctx, hash, afterLogID, size, maxTick)
+	ret0, _ := ret[0].(*entities.TransactionEventsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventsForTransaction indicates an expected call of GetEventsForTransaction.
+func (mr *MockEventsServiceMockRecorder) GetEventsForTransaction(ctx, hash, afterLogID, size, maxTick any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventsForTransaction", reflect.TypeOf((*MockEventsService)(nil).GetEventsForTransaction), ctx, hash, afterLogID, size, maxTick)
+}
+
+// MockEpochSummaryService is a mock of EpochSummaryService interface.
+type MockEpochSummaryService struct {
+	ctrl     *gomock.Controller
+	recorder *MockEpochSummaryServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockEpochSummaryServiceMockRecorder is the mock recorder for MockEpochSummaryService.
+type MockEpochSummaryServiceMockRecorder struct {
+	mock *MockEpochSummaryService
+}
+
+// NewMockEpochSummaryService creates a new mock instance.
+func NewMockEpochSummaryService(ctrl *gomock.Controller) *MockEpochSummaryService {
+	mock := &MockEpochSummaryService{ctrl: ctrl}
+	mock.recorder = &MockEpochSummaryServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEpochSummaryService) EXPECT() *MockEpochSummaryServiceMockRecorder {
+	return m.recorder
+}
+
+// GetEpochSummary mocks base method.
+func (m *MockEpochSummaryService) GetEpochSummary(ctx context.Context, epoch uint32) (*api.EpochSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEpochSummary", ctx, epoch)
+	ret0, _ := ret[0].(*api.EpochSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEpochSummary indicates an expected call of GetEpochSummary.
+func (mr *MockEpochSummaryServiceMockRecorder) GetEpochSummary(ctx, epoch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEpochSummary", reflect.TypeOf((*MockEpochSummaryService)(nil).GetEpochSummary), ctx, epoch)
+}
+
+// MockIdentityOverviewService is a mock of IdentityOverviewService interface.
+type MockIdentityOverviewService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdentityOverviewServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockIdentityOverviewServiceMockRecorder is the mock recorder for MockIdentityOverviewService.
+type MockIdentityOverviewServiceMockRecorder struct {
+	mock *MockIdentityOverviewService
+}
+
+// NewMockIdentityOverviewService creates a new mock instance.
+func NewMockIdentityOverviewService(ctrl *gomock.Controller) *MockIdentityOverviewService {
+	mock := &MockIdentityOverviewService{ctrl: ctrl}
+	mock.recorder = &MockIdentityOverviewServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdentityOverviewService) EXPECT() *MockIdentityOverviewServiceMockRecorder {
+	return m.recorder
+}
+
+// GetIdentityOverview mocks base method.
+func (m *MockIdentityOverviewService) GetIdentityOverview(ctx context.Context, identity string, topCounterparties uint32) (*api.IdentityOverview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIdentityOverview", ctx, identity, topCounterparties)
+	ret0, _ := ret[0].(*api.IdentityOverview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIdentityOverview indicates an expected call of GetIdentityOverview.
+func (mr *MockIdentityOverviewServiceMockRecorder) GetIdentityOverview(ctx, identity, topCounterparties any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIdentityOverview", reflect.TypeOf((*MockIdentityOverviewService)(nil).GetIdentityOverview), ctx, identity, topCounterparties)
+}
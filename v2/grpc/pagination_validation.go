@@ -4,10 +4,14 @@ import (
 	"fmt"
 
 	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	"github.com/qubic/archive-query-service/v2/grpc/filters"
 )
 
 const maxHitsSize uint32 = 10000
 
+// reasonLimitExceeded is the reason of pagination values that exceed the allowed maximum.
+const reasonLimitExceeded = "LIMIT_EXCEEDED"
+
 type PageSizeLimits struct {
 	maxPageSize     uint32
 	defaultPageSize uint32
@@ -35,7 +39,7 @@ func (psl PageSizeLimits) ValidatePagination(pagination *api.Pagination) (uint32
 
 	pageSize, err := psl.validatePageSize(pageSize)
 	if err != nil {
-		return 0, 0, fmt.Errorf("validating page size: %w", err)
+		return 0, 0, fmt.Errorf("validating page size: %w", filters.NewFieldError("size", reasonLimitExceeded, err))
 	}
 
 	offset, err = psl.validatePageOffset(pageSize, offset)
@@ -48,7 +52,7 @@ func (psl PageSizeLimits) ValidatePagination(pagination *api.Pagination) (uint32
 
 func (psl PageSizeLimits) validatePageSize(pageSize uint32) (uint32, error) {
 	if pageSize > psl.maxPageSize {
-		return 0, fmt.Errorf("page size [%d] exceeds allowed maximum [%d]", pageSize, psl.maxPageSize)
+		return 0, filters.NewFieldError("", reasonLimitExceeded, fmt.Errorf("page size [%d] exceeds allowed maximum [%d]", pageSize, psl.maxPageSize))
 	}
 
 	if pageSize == 0 {
@@ -60,11 +64,11 @@ func (psl PageSizeLimits) validatePageSize(pageSize uint32) (uint32, error) {
 
 func (psl PageSizeLimits) validatePageOffset(pageSize, offset uint32) (uint32, error) {
 	if offset > maxHitsSize {
-		return 0, fmt.Errorf("offset [%d] exceeds maximum allowed [%d]", offset, maxHitsSize)
+		return 0, filters.NewFieldError("offset", reasonLimitExceeded, fmt.Errorf("offset [%d] exceeds maximum allowed [%d]", offset, maxHitsSize))
 	}
 
 	if offset+pageSize > maxHitsSize {
-		return 0, fmt.Errorf("offset [%d] + size [%d] exceeds maximum allowed [%d]", offset, pageSize, maxHitsSize)
+		return 0, filters.NewFieldError("offset", reasonLimitExceeded, fmt.Errorf("offset [%d] + size [%d] exceeds maximum allowed [%d]", offset, pageSize, maxHitsSize))
 	}
 
 	return offset, nil
@@ -2,32 +2,74 @@ package grpc
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	protobuf "github.com/qubic/archive-query-service/v2/api/archive-query-service/legacy"
 	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	_ "google.golang.org/grpc/encoding/gzip" // enables gzip compression of grpc messages
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
+// mimeProtobuf is the content type for binary protobuf requests and responses on the http gateway.
+const mimeProtobuf = "application/x-protobuf"
+
 type StartConfig struct {
 	ListenAddrGRPC string
 	ListenAddrHTTP string
-	MaxRecvMsgSize int // limit receive size (request)
-	MaxSendMsgSize int // limit send size (response)
+	MaxRecvMsgSize int           // limit receive size (request)
+	MaxSendMsgSize int           // limit send size (response)
+	ReadTimeout    time.Duration // http request read timeout, streamed responses are not limited
+	WriteTimeout   time.Duration // http response write timeout, streamed responses are not limited
+	// GrpcTLS enables tls for the grpc listener. Plaintext, if nil.
+	GrpcTLS *tls.Config
+	// HTTPTLS enables tls for the http listener. Plaintext, if nil.
+	HTTPTLS *tls.Config
+	// GatewayTLS is the tls config of the http gateway connection to the grpc listener. Required, if GrpcTLS is set.
+	GatewayTLS *tls.Config
+	// Compression enables gzip and brotli compression of the http responses (negotiated with Accept-Encoding).
+	Compression bool
+	// CompactJSON omits fields with default values from the http json responses.
+	CompactJSON bool
+	// GrpcWeb enables grpc-web requests on the http port. The requests are handled by the grpc server directly,
+	// so the same interceptors apply as for native grpc requests.
+	GrpcWeb bool
+	// GrpcWebAllowedOrigins are the origins that are allowed to send cross-origin grpc-web requests. '*' allows all.
+	GrpcWebAllowedOrigins []string
 }
 
 func (s *ArchiveQueryService) Start(cfg StartConfig, errCh chan error, interceptors ...grpc.UnaryServerInterceptor) error {
-	srv := grpc.NewServer(
+	serverOpts := []grpc.ServerOption{
 		grpc.MaxRecvMsgSize(cfg.MaxRecvMsgSize),
 		grpc.MaxSendMsgSize(cfg.MaxSendMsgSize),
 		grpc.ChainUnaryInterceptor(interceptors...),
-	)
+	}
+	gatewayCredentials := insecure.NewCredentials()
+	if cfg.GrpcTLS != nil {
+		if cfg.GatewayTLS == nil {
+			return fmt.Errorf("gateway tls config is required for grpc tls")
+		}
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(cfg.GrpcTLS)))
+		gatewayCredentials = credentials.NewTLS(cfg.GatewayTLS)
+	}
+	srv := grpc.NewServer(serverOpts...)
 	api.RegisterArchiveQueryServiceServer(srv, s)
+	if s.legacyService != nil {
+		protobuf.RegisterTransactionsServiceServer(srv, s.legacyService)
+	}
+	if s.webhookServer != nil {
+		api.RegisterWebhookServiceServer(srv, s.webhookServer)
+	}
 	reflection.Register(srv)
 
 	lis, err := net.Listen("tcp", cfg.ListenAddrGRPC)
@@ -35,56 +77,236 @@ func (s *ArchiveQueryService) Start(cfg StartConfig, errCh chan error, intercept
 		return fmt.Errorf("listening on grpc port: %w", err)
 	}
 
+	var httpServer *http.Server
+	var httpLis net.Listener
+	if cfg.ListenAddrHTTP != "" {
+		handler, err := s.createHTTPHandler(srv, cfg, lis.Addr().String(), gatewayCredentials)
+		if err != nil {
+			_ = lis.Close()
+			return err
+		}
+		httpLis, err = net.Listen("tcp", cfg.ListenAddrHTTP)
+		if err != nil {
+			_ = lis.Close()
+			return fmt.Errorf("listening on http port: %w", err)
+		}
+		httpServer = &http.Server{
+			Handler:           handler,
+			TLSConfig:         cfg.HTTPTLS,
+			ReadHeaderTimeout: cfg.ReadTimeout,
+			ReadTimeout:       cfg.ReadTimeout,
+			WriteTimeout:      cfg.WriteTimeout,
+		}
+		if s.subscriptionsHandler != nil {
+			// the subscription connections never become idle, so they are ended when the shutdown starts
+			httpServer.RegisterOnShutdown(s.subscriptionsHandler.Close)
+		}
+	}
+
 	go func() {
 		if err := srv.Serve(lis); err != nil {
 			panic(err)
 		}
 	}()
 
-	if cfg.ListenAddrHTTP != "" {
+	if httpServer != nil {
 		go func() {
-			mux := runtime.NewServeMux(runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
-				MarshalOptions: protojson.MarshalOptions{EmitDefaultValues: true, EmitUnpopulated: true},
-			}))
-			// Configuration for the http gateway grpc client (http request -> http gateway (grpc client) -> grpc server)
-			// The send and recv values are reversed on purpose as the client's send is the server's receive and vice versa.
-			opts := []grpc.DialOption{
-				grpc.WithTransportCredentials(insecure.NewCredentials()),
-				grpc.WithDefaultCallOptions(
-					grpc.MaxCallRecvMsgSize(cfg.MaxSendMsgSize),
-					grpc.MaxCallSendMsgSize(cfg.MaxRecvMsgSize),
-				),
+			var err error
+			if cfg.HTTPTLS != nil {
+				err = httpServer.ServeTLS(httpLis, "", "") // certificates are provided by the tls config
+			} else {
+				err = httpServer.Serve(httpLis)
 			}
-
-			if err := api.RegisterArchiveQueryServiceHandlerFromEndpoint(
-				context.Background(),
-				mux,
-				cfg.ListenAddrGRPC,
-				opts,
-			); err != nil {
-				errCh <- fmt.Errorf("registering http handler: %w", err)
-				return
-			}
-
-			if err := http.ListenAndServe(cfg.ListenAddrHTTP, mux); err != nil { // nolint: gosec
-				errCh <- fmt.Errorf("listening in http port: %w", err)
-				return
+			if err != nil && !errors.Is(err, http.ErrServerClosed) {
+				errCh <- fmt.Errorf("serving http: %w", err)
 			}
 		}()
+		s.httpListenAddr = httpLis.Addr()
 	}
 
 	s.srv = srv
+	s.httpServer = httpServer
 	s.grpcListenAddr = lis.Addr()
 
 	return nil
 }
 
+// createHTTPHandler creates the http gateway for the grpc server listening on grpcAddr and adds the optional
+// endpoints.
+func (s *ArchiveQueryService) createHTTPHandler(srv *grpc.Server, cfg StartConfig, grpcAddr string, gatewayCredentials credentials.TransportCredentials) (http.Handler, error) {
+	mux := runtime.NewServeMux(runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+		MarshalOptions: protojson.MarshalOptions{EmitDefaultValues: !cfg.CompactJSON, EmitUnpopulated: !cfg.CompactJSON},
+	}), runtime.WithMarshalerOption(mimeProtobuf, &runtime.ProtoMarshaller{}),
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher))
+	// Configuration for the http gateway grpc client (http request -> http gateway (grpc client) -> grpc server)
+	// The send and recv values are reversed on purpose as the client's send is the server's receive and vice versa.
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(gatewayCredentials),
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(cfg.MaxSendMsgSize),
+			grpc.MaxCallSendMsgSize(cfg.MaxRecvMsgSize),
+		),
+	}
+
+	if err := api.RegisterArchiveQueryServiceHandlerFromEndpoint(
+		context.Background(),
+		mux,
+		grpcAddr,
+		opts,
+	); err != nil {
+		return nil, fmt.Errorf("registering http handler: %w", err)
+	}
+
+	if s.legacyService != nil {
+		if err := protobuf.RegisterTransactionsServiceHandlerFromEndpoint(
+			context.Background(),
+			mux,
+			grpcAddr,
+			opts,
+		); err != nil {
+			return nil, fmt.Errorf("registering legacy http handler: %w", err)
+		}
+	}
+
+	if s.webhookServer != nil {
+		if err := api.RegisterWebhookServiceHandlerFromEndpoint(
+			context.Background(),
+			mux,
+			grpcAddr,
+			opts,
+		); err != nil {
+			return nil, fmt.Errorf("registering webhook http handler: %w", err)
+		}
+	}
+
+	if s.graphqlHandler != nil {
+		if err := mux.HandlePath(http.MethodPost, "/graphql", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+			s.graphqlHandler.ServeHTTP(w, r)
+		}); err != nil {
+			return nil, fmt.Errorf("registering graphql http handler: %w", err)
+		}
+	}
+
+	if s.subscriptionsHandler != nil {
+		if err := mux.HandlePath(http.MethodGet, "/subscriptions", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+			s.subscriptionsHandler.ServeHTTP(w, r)
+		}); err != nil {
+			return nil, fmt.Errorf("registering subscriptions http handler: %w", err)
+		}
+	}
+
+	if s.exportHandler != nil {
+		if err := s.exportHandler.Register(mux); err != nil {
+			return nil, fmt.Errorf("registering export http handler: %w", err)
+		}
+	}
+
+	var handler http.Handler = mux
+	if cfg.Compression {
+		handler = compressionHandler(handler)
+	}
+	if cfg.GrpcWeb {
+		handler = grpcWebHandler(srv, handler, cfg.GrpcWebAllowedOrigins)
+	}
+	return handler, nil
+}
+
+// SetLegacyService sets the legacy transactions service that is served additionally on the same grpc server
+// and http gateway. Needs to be called before starting the server.
+func (s *ArchiveQueryService) SetLegacyService(legacyService protobuf.TransactionsServiceServer) {
+	s.legacyService = legacyService
+}
+
+// SetWebhookServer enables the webhook management service on the grpc server and http gateway. Needs to be called
+// before starting the server.
+func (s *ArchiveQueryService) SetWebhookServer(webhookServer *WebhookServer) {
+	s.webhookServer = webhookServer
+}
+
+// SetExportService enables the streaming export endpoints on the http gateway. Needs to be called before starting the
+// server.
+func (s *ArchiveQueryService) SetExportService(exportService ExportService, cfg ExportConfig) {
+	s.exportHandler = NewExportHandler(exportService, cfg)
+}
+
+// SetGraphQLHandler enables the graphql endpoint on the http gateway. Needs to be called before starting the server.
+func (s *ArchiveQueryService) SetGraphQLHandler(handler http.Handler) {
+	s.graphqlHandler = handler
+}
+
+// SubscriptionsHandler serves long-lived subscription connections. Close ends all connections on shutdown and Wait
+// waits until they are finished, including the hijacked websocket connections that the http server does not track.
+type SubscriptionsHandler interface {
+	http.Handler
+	Close()
+	Wait(ctx context.Context) error
+}
+
+// SetSubscriptionsHandler enables the subscriptions endpoint (server-sent events and websocket) on the http gateway.
+// Needs to be called before starting the server.
+func (s *ArchiveQueryService) SetSubscriptionsHandler(handler SubscriptionsHandler) {
+	s.subscriptionsHandler = handler
+}
+
+// incomingHeaderMatcher additionally forwards the api key header to the grpc server.
+func incomingHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, exportAPIKeyHeader) {
+		return apiKeyMetadata, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// Stop closes the http server and stops the grpc server after the pending requests are finished.
 func (s *ArchiveQueryService) Stop() {
+	if s.httpServer != nil {
+		_ = s.httpServer.Close()
+		if s.subscriptionsHandler != nil {
+			s.subscriptionsHandler.Close()
+		}
+	}
 	if s.srv != nil {
 		s.srv.GracefulStop()
 	}
 }
 
+// Shutdown stops the servers gracefully. The health check reports the service as unavailable, the listeners are
+// closed, the subscription connections are ended and the in-flight requests (http first, then grpc) are completed.
+// When the context is done, the remaining connections are closed.
+func (s *ArchiveQueryService) Shutdown(ctx context.Context) error {
+	s.shuttingDown.Store(true)
+
+	var err error
+	if s.httpServer != nil {
+		if shutdownErr := s.httpServer.Shutdown(ctx); shutdownErr != nil {
+			err = fmt.Errorf("shutting down http server: %w", shutdownErr)
+			_ = s.httpServer.Close()
+		}
+		if s.subscriptionsHandler != nil {
+			if waitErr := s.subscriptionsHandler.Wait(ctx); waitErr != nil {
+				err = errors.Join(err, fmt.Errorf("closing subscriptions: %w", waitErr))
+			}
+		}
+	}
+	if s.srv != nil {
+		stopped := make(chan struct{})
+		go func() {
+			s.srv.GracefulStop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+		case <-ctx.Done():
+			s.srv.Stop()
+			err = errors.Join(err, fmt.Errorf("stopping grpc server: %w", ctx.Err()))
+		}
+	}
+	return err
+}
+
 func (s *ArchiveQueryService) GetGRPCListenAddr() net.Addr {
 	return s.grpcListenAddr
 }
+
+func (s *ArchiveQueryService) GetHTTPListenAddr() net.Addr {
+	return s.httpListenAddr
+}
@@ -0,0 +1,365 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	statusPb "github.com/qubic/go-data-publisher/status-service/protobuf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func newGrpcWebTestServer(t *testing.T, intercepted *[]string) *httptest.Server {
+	statusService := &StatusServiceStub{statusResponse: &statusPb.GetStatusResponse{LastProcessedTick: 42, ProcessingEpoch: 100}}
+	srv := grpc.NewServer(grpc.ChainUnaryInterceptor(func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		*intercepted = append(*intercepted, info.FullMethod)
+		return handler(ctx, req)
+	}))
+	api.RegisterArchiveQueryServiceServer(srv, NewArchiveQueryService(nil, nil, statusService, nil, nil, NewPageSizeLimits(1000, 10)))
+	gateway := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+	server := httptest.NewServer(grpcWebHandler(srv, gateway, []string{"https://example.com"}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func grpcWebFrame(t *testing.T, message proto.Message) []byte {
+	data, err := proto.Marshal(message)
+	require.NoError(t, err)
+	frame := make([]byte, 5, 5+len(data))
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(data)))
+	return append(frame, data...)
+}
+
+func TestGrpcWebHandler_GivenGrpcWebRequest_ThenServeWithGrpcServer(t *testing.T) {
+	var intercepted []string
+	server := newGrpcWebTestServer(t, &intercepted)
+
+	request, err := http.NewRequest(http.MethodPost, server.URL+"/qubic.v2.archive.pb.ArchiveQueryService/GetLastProcessedTick",
+		bytes.NewReader(grpcWebFrame(t, &emptypb.Empty{})))
+	require.NoError(t, err)
+	request.Header.Set("Content-Type", "application/grpc-web+proto")
+	request.Header.Set("Origin", "https://example.com")
+	response, err := http.DefaultClient.Do(request)
+	require.NoError(t, err)
+	defer response.Body.Close()
+
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, "https://example.com", response.Header.Get("Access-Control-Allow-Origin"))
+	body, err := io.ReadAll(response.Body)
+	require.NoError(t, err)
+	require.Greater(t, len(body), 5)
+	require.Equal(t, byte(0), body[0], "data frame")
+	length := binary.BigEndian.Uint32(body[1:5])
+	var message api.GetLastProcessedTickResponse
+	require.NoError(t, proto.Unmarshal(body[5:5+length], &message))
+	assert.Equal(t, uint32(42), message.GetTickNumber())
+	assert.Equal(t, uint32(100), message.GetEpoch())
+	assert.Contains(t, string(body[5+length:]), "grpc-status: 0", "trailer frame")
+	assert.Equal(t, []string{"/qubic.v2.archive.pb.ArchiveQueryService/GetLastProcessedTick"}, intercepted)
+}
+
+func TestGrpcWebHandler_GivenGrpcWebTextRequest_ThenBase64Response(t *testing.T) {
+	var intercepted []string
+	server := newGrpcWebTestServer(t, &intercepted)
+
+	response, err := http.Post(server.URL+"/qubic.v2.archive.pb.ArchiveQueryService/GetLastProcessedTick", "application/grpc-web-text",
+		strings.NewReader(base64.StdEncoding.EncodeToString(grpcWebFrame(t, &emptypb.Empty{}))))
+	require.NoError(t, err)
+	defer response.Body.Close()
+
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, "application/grpc-web-text", response.Header.Get("Content-Type"))
+	encoded, err := io.ReadAll(response.Body)
+	require.NoError(t, err)
+	// the frames are encoded separately, so padding can occur in the middle of the body. each quantum of 4 characters
+	// is decoded on its own.
+	require.Zero(t, len(encoded)%4)
+	var body []byte
+	for i := 0; i < len(encoded); i += 4 {
+		decoded, err := base64.StdEncoding.DecodeString(string(encoded[i : i+4]))
+		require.NoError(t, err)
+		body = append(body, decoded...)
+	}
+	require.Greater(t, len(body), 5)
+	length := binary.BigEndian.Uint32(body[1:5])
+	var message api.GetLastProcessedTickResponse
+	require.NoError(t, proto.Unmarshal(body[5:5+length], &message))
+	assert.Equal(t, uint32(42), message.GetTickNumber())
+	assert.Equal(t, byte(0x80), body[5+length], "trailer frame")
+	assert.Contains(t, string(body[5+length:]), "grpc-status: 0")
+}
+
+func TestGrpcWebHandler_GivenError_ThenStatusInTrailerFrame(t *testing.T) {
+	var intercepted []string
+	server := newGrpcWebTestServer(t, &intercepted)
+
+	response, err := http.Post(server.URL+"/qubic.v2.archive.pb.ArchiveQueryService/Unknown", "application/grpc-web+proto",
+		bytes.NewReader(grpcWebFrame(t, &emptypb.Empty{})))
+	require.NoError(t, err)
+	defer response.Body.Close()
+
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	body, err := io.ReadAll(response.Body)
+	require.NoError(t, err)
+	require.Greater(t, len(body), 5)
+	assert.Equal(t, byte(0x80), body[0], "trailer frame only")
+	assert.Contains(t, string(body[5:]), fmt.Sprintf("grpc-status: %d\r\n", codes.Unimplemented))
+}
+
+func TestGrpcWebHandler_GivenAllowedOrigin_ThenCorsPreflightAllowed(t *testing.T) {
+	var intercepted []string
+	server := newGrpcWebTestServer(t, &intercepted)
+
+	request, err := http.NewRequest(http.MethodOptions, server.URL+"/qubic.v2.archive.pb.ArchiveQueryService/GetLastProcessedTick", nil)
+	require.NoError(t, err)
+	request.Header.Set("Origin", "https://example.com")
+	request.Header.Set("Access-Control-Request-Method", http.MethodPost)
+	request.Header.Set("Access-Control-Request-Headers", "content-type,x-grpc-web")
+	response, err := http.DefaultClient.Do(request)
+	require.NoError(t, err)
+	defer response.Body.Close()
+	assert.Equal(t, http.StatusNoContent, response.StatusCode)
+	assert.Equal(t, "https://example.com", response.Header.Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "content-type,x-grpc-web", response.Header.Get("Access-Control-Allow-Headers"))
+	assert.Empty(t, intercepted)
+}
+
+func TestGrpcWebHandler_GivenOtherRequest_ThenServeWithGateway(t *testing.T) {
+	var intercepted []string
+	server := newGrpcWebTestServer(t, &intercepted)
+
+	response, err := http.Post(server.URL+"/getLastProcessedTick", "application/json", nil)
+	require.NoError(t, err)
+	defer response.Body.Close()
+	assert.Equal(t, http.StatusTeapot, response.StatusCode)
+	assert.Empty(t, intercepted)
+}
+
+func TestGrpcWebHandler_GivenDisallowedOrigin_ThenNoCorsHeaders(t *testing.T) {
+	var intercepted []string
+	server := newGrpcWebTestServer(t, &intercepted)
+
+	request, err := http.NewRequest(http.MethodOptions, server.URL+"/qubic.v2.archive.pb.ArchiveQueryService/GetLastProcessedTick", nil)
+	require.NoError(t, err)
+	request.Header.Set("Origin", "https://other.com")
+	request.Header.Set("Access-Control-Request-Method", http.MethodPost)
+	request.Header.Set("Access-Control-Request-Headers", "content-type,x-grpc-web")
+	response, err := http.DefaultClient.Do(request)
+	require.NoError(t, err)
+	defer response.Body.Close()
+	assert.Empty(t, response.Header.Get("Access-Control-Allow-Origin"))
+}
+
+// blockingStatusService blocks the status requests until released.
+type blockingStatusService struct {
+	StatusServiceStub
+	started chan struct{}
+	release chan struct{}
+}
+
+func (s *blockingStatusService) GetStatus(ctx context.Context) (*statusPb.GetStatusResponse, error) {
+	s.started <- struct{}{}
+	select {
+	case <-s.release:
+		return &statusPb.GetStatusResponse{LastProcessedTick: 42}, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func startTestServer(t *testing.T, statusService StatusService) *ArchiveQueryService {
+	server := NewArchiveQueryService(nil, nil, statusService, nil, nil, NewPageSizeLimits(1000, 10))
+	err := server.Start(StartConfig{
+		ListenAddrGRPC: "127.0.0.1:0",
+		ListenAddrHTTP: "127.0.0.1:0",
+		MaxRecvMsgSize: 1024 * 1024,
+		MaxSendMsgSize: 1024 * 1024,
+		ReadTimeout:    time.Second,
+		WriteTimeout:   time.Second,
+	}, make(chan error, 1))
+	require.NoError(t, err)
+	return server
+}
+
+func TestArchiveQueryService_Shutdown_GivenInFlightRequests_ThenComplete(t *testing.T) {
+	statusService := &blockingStatusService{started: make(chan struct{}, 2), release: make(chan struct{})}
+	server := startTestServer(t, statusService)
+	httpURL := "http://" + server.GetHTTPListenAddr().String()
+
+	conn, err := grpc.NewClient(server.GetGRPCListenAddr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	grpcResult := make(chan error, 1)
+	go func() {
+		_, err := api.NewArchiveQueryServiceClient(conn).GetLastProcessedTick(context.Background(), &emptypb.Empty{})
+		grpcResult <- err
+	}()
+	httpResult := make(chan *http.Response, 1)
+	go func() {
+		response, err := http.Get(httpURL + "/getLastProcessedTick")
+		assert.NoError(t, err)
+		httpResult <- response
+	}()
+	<-statusService.started
+	<-statusService.started
+
+	shutdownResult := make(chan error, 1)
+	go func() {
+		shutdownResult <- server.Shutdown(context.Background())
+	}()
+	require.Eventually(t, func() bool {
+		_, err := server.GetHealth(context.Background(), &emptypb.Empty{})
+		return status.Code(err) == codes.Unavailable
+	}, time.Second, 10*time.Millisecond, "not ready")
+	require.Eventually(t, func() bool {
+		response, err := http.Get(httpURL + "/health")
+		if err == nil {
+			response.Body.Close()
+		}
+		return err != nil
+	}, time.Second, 10*time.Millisecond, "http listener closed")
+	assert.Empty(t, shutdownResult, "waiting for in-flight requests")
+
+	close(statusService.release)
+	response := <-httpResult
+	require.NotNil(t, response)
+	defer response.Body.Close()
+	body, err := io.ReadAll(response.Body)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Contains(t, string(body), `"tickNumber":42`)
+	require.NoError(t, <-grpcResult)
+	require.NoError(t, <-shutdownResult)
+}
+
+func TestArchiveQueryService_Shutdown_GivenDeadline_ThenCancelRequests(t *testing.T) {
+	statusService := &blockingStatusService{started: make(chan struct{}, 1), release: make(chan struct{})}
+	server := startTestServer(t, statusService)
+
+	conn, err := grpc.NewClient(server.GetGRPCListenAddr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	grpcResult := make(chan error, 1)
+	go func() {
+		_, err := api.NewArchiveQueryServiceClient(conn).GetLastProcessedTick(context.Background(), &emptypb.Empty{})
+		grpcResult <- err
+	}()
+	<-statusService.started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err = server.Shutdown(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, codes.Unavailable, status.Code(<-grpcResult))
+}
+
+// streamingSubscriptionsHandler keeps the event streams open until it is closed.
+type streamingSubscriptionsHandler struct {
+	done   chan struct{}
+	active sync.WaitGroup
+}
+
+func (h *streamingSubscriptionsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.active.Add(1)
+	defer h.active.Done()
+	w.Header().Set("Content-Type", "text/event-stream")
+	_, _ = w.Write([]byte(": connected\n\n"))
+	_ = http.NewResponseController(w).Flush()
+	select {
+	case <-h.done:
+	case <-r.Context().Done():
+	}
+}
+
+func (h *streamingSubscriptionsHandler) Close() {
+	close(h.done)
+}
+
+func (h *streamingSubscriptionsHandler) Wait(context.Context) error {
+	h.active.Wait()
+	return nil
+}
+
+func TestArchiveQueryService_Shutdown_GivenOpenEventStream_ThenStreamEnded(t *testing.T) {
+	server := NewArchiveQueryService(nil, nil, defaultStatusStub(), nil, nil, NewPageSizeLimits(1000, 10))
+	server.SetSubscriptionsHandler(&streamingSubscriptionsHandler{done: make(chan struct{})})
+	require.NoError(t, server.Start(StartConfig{
+		ListenAddrGRPC: "127.0.0.1:0",
+		ListenAddrHTTP: "127.0.0.1:0",
+		MaxRecvMsgSize: 1024 * 1024,
+		MaxSendMsgSize: 1024 * 1024,
+		ReadTimeout:    time.Second,
+		WriteTimeout:   time.Second,
+	}, make(chan error, 1)))
+
+	response, err := http.Get("http://" + server.GetHTTPListenAddr().String() + "/subscriptions")
+	require.NoError(t, err)
+	defer response.Body.Close()
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	_, err = response.Body.Read(make([]byte, 1))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	start := time.Now()
+	require.NoError(t, server.Shutdown(ctx))
+	assert.Less(t, time.Since(start), time.Second, "shutdown does not wait for the deadline")
+	_, err = io.ReadAll(response.Body)
+	assert.NoError(t, err, "stream ended")
+}
+
+func TestArchiveQueryService_GivenInvalidHttpRequest_ThenErrorDetailsInBody(t *testing.T) {
+	server := startTestServer(t, defaultStatusStub())
+	defer server.Stop()
+
+	response, err := http.Post("http://"+server.GetHTTPListenAddr().String()+"/getEventLogs", "application/json",
+		strings.NewReader(`{"filters":{"logType":"256"}}`))
+	require.NoError(t, err)
+	defer response.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+
+	var body struct {
+		Code    int
+		Message string
+		Details []struct {
+			Type            string `json:"@type"`
+			FieldViolations []struct {
+				Field  string
+				Reason string
+			}
+			Reason string
+			Domain string
+		}
+	}
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&body))
+	assert.Equal(t, int(codes.InvalidArgument), body.Code)
+	assert.Contains(t, body.Message, "invalid [logType] filter")
+	require.Len(t, body.Details, 2)
+	assert.Equal(t, "type.googleapis.com/google.rpc.BadRequest", body.Details[0].Type)
+	require.Len(t, body.Details[0].FieldViolations, 1)
+	assert.Equal(t, "filters.logType", body.Details[0].FieldViolations[0].Field)
+	assert.Equal(t, "INVALID_VALUE", body.Details[0].FieldViolations[0].Reason)
+	assert.Equal(t, "type.googleapis.com/google.rpc.ErrorInfo", body.Details[1].Type)
+	assert.Equal(t, "INVALID_VALUE", body.Details[1].Reason)
+	assert.Equal(t, errorDomain, body.Details[1].Domain)
+}
@@ -2,16 +2,25 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net"
+	"net/http"
+	"slices"
 	"strconv"
+	"sync/atomic"
 
+	protobuf "github.com/qubic/archive-query-service/v2/api/archive-query-service/legacy"
 	"github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	"github.com/qubic/archive-query-service/v2/domain/decoder"
+	"github.com/qubic/archive-query-service/v2/domain/verifier"
 	"github.com/qubic/archive-query-service/v2/entities"
 	"github.com/qubic/archive-query-service/v2/grpc/filters"
 	"github.com/qubic/archive-query-service/v2/grpc/utils"
 	statusPb "github.com/qubic/go-data-publisher/status-service/protobuf"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -35,6 +44,10 @@ type TransactionsService interface {
 
 type TickDataService interface {
 	GetTickData(ctx context.Context, tickNumber uint32) (*api.TickData, error)
+	GetTickDataRange(ctx context.Context, fromTick, toTick uint32) ([]*api.TickData, error)
+	GetTicksForEpoch(ctx context.Context, epoch, from, size uint32, desc bool) (*entities.EpochTicksResult, error)
+	GetEmptyTicksForEpoch(ctx context.Context, epoch, from, size uint32) (*entities.EmptyTicksResult, error)
+	GetComputorTickStats(ctx context.Context, epoch uint32) ([]*api.ComputorTickStats, error)
 }
 
 type StatusService interface {
@@ -44,22 +57,44 @@ type StatusService interface {
 
 type ComputorsListService interface {
 	GetComputorsListsForEpoch(ctx context.Context, epoch uint32) ([]*api.ComputorList, error)
+	GetComputorsListsForEpochRange(ctx context.Context, fromEpoch, toEpoch uint32) ([]*api.ComputorList, error)
+	GetComputorMembership(ctx context.Context, identity string) ([]*api.ComputorMembership, error)
 }
 
 type EventsService interface {
 	GetEvents(ctx context.Context, queryFilters entities.Filters, from, size, maxTick uint32) (*entities.EventsResult, error)
+	GetEvent(ctx context.Context, epoch uint32, logID uint64, maxTick uint32) (*api.Event, error)
+	GetEventsForTransaction(ctx context.Context, hash string, afterLogID *uint64, size, maxTick uint32) (*entities.TransactionEventsResult, error)
+}
+
+type EpochSummaryService interface {
+	GetEpochSummary(ctx context.Context, epoch uint32) (*api.EpochSummary, error)
+}
+
+type IdentityOverviewService interface {
+	GetIdentityOverview(ctx context.Context, identity string, topCounterparties uint32) (*api.IdentityOverview, error)
 }
 
 type ArchiveQueryService struct {
 	srv            *grpc.Server
 	grpcListenAddr net.Addr
+	httpServer     *http.Server
+	httpListenAddr net.Addr
+	shuttingDown   atomic.Bool
 	api.UnimplementedArchiveQueryServiceServer
-	txService      TransactionsService
-	tdService      TickDataService
-	statusService  StatusService
-	clService      ComputorsListService
-	evService      EventsService
-	pageSizeLimits PageSizeLimits
+	txService            TransactionsService
+	tdService            TickDataService
+	statusService        StatusService
+	clService            ComputorsListService
+	evService            EventsService
+	esService            EpochSummaryService
+	ioService            IdentityOverviewService
+	pageSizeLimits       PageSizeLimits
+	legacyService        protobuf.TransactionsServiceServer
+	exportHandler        *ExportHandler
+	webhookServer        *WebhookServer
+	graphqlHandler       http.Handler
+	subscriptionsHandler SubscriptionsHandler
 }
 
 func NewArchiveQueryService(
@@ -76,6 +111,16 @@ func NewArchiveQueryService(
 	}
 }
 
+// SetEpochSummaryService enables the epoch summary endpoint. Needs to be called before starting the server.
+func (s *ArchiveQueryService) SetEpochSummaryService(esService EpochSummaryService) {
+	s.esService = esService
+}
+
+// SetIdentityOverviewService enables the identity overview endpoint. Needs to be called before starting the server.
+func (s *ArchiveQueryService) SetIdentityOverviewService(ioService IdentityOverviewService) {
+	s.ioService = ioService
+}
+
 func (s *ArchiveQueryService) GetTransactionByHash(ctx context.Context, req *api.GetTransactionByHashRequest) (*api.GetTransactionByHashResponse, error) {
 	tx, err := s.txService.GetTransactionByHash(ctx, req.Hash)
 	if err != nil {
@@ -84,24 +129,122 @@ func (s *ArchiveQueryService) GetTransactionByHash(ctx context.Context, req *api
 	if tx == nil {
 		return nil, status.Error(codes.NotFound, "transaction not found")
 	}
+	if req.GetDecodeInput() {
+		decoder.DecodeInputs([]*api.Transaction{tx})
+	}
 	return &api.GetTransactionByHashResponse{Transaction: tx}, nil
 }
 
+func (s *ArchiveQueryService) GetTransactionDetails(ctx context.Context, req *api.GetTransactionDetailsRequest) (*api.GetTransactionDetailsResponse, error) {
+	tx, err := s.txService.GetTransactionByHash(ctx, req.GetHash())
+	if err != nil {
+		return nil, createInternalError(fmt.Sprintf("failed to get transaction by hash [%v]", req.GetHash()), err)
+	}
+	if tx == nil {
+		return nil, status.Error(codes.NotFound, "transaction not found")
+	}
+
+	cachedStatus, err := s.statusService.GetStatus(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get status: %v", err)
+	}
+	eventsLastProcessedTick := cachedStatus.GetLastProcessedLogTick()
+	// event logs are not available before the tick is processed. return the rest in that case.
+	eventLogsAvailable := tx.GetTickNumber() <= eventsLastProcessedTick
+
+	var td *api.TickData
+	var events *entities.TransactionEventsResult
+	eg, egCtx := errgroup.WithContext(ctx)
+	eg.Go(func() error {
+		var tdErr error
+		td, tdErr = s.tdService.GetTickData(egCtx, tx.GetTickNumber())
+		if tdErr != nil {
+			return fmt.Errorf("getting tick data for tick [%d]: %w", tx.GetTickNumber(), tdErr)
+		}
+		return nil
+	})
+	if eventLogsAvailable {
+		eg.Go(func() error {
+			var evErr error
+			events, evErr = s.evService.GetEventsForTransaction(egCtx, tx.GetHash(), nil, s.pageSizeLimits.maxPageSize, eventsLastProcessedTick)
+			if evErr != nil {
+				return fmt.Errorf("getting event logs: %w", evErr)
+			}
+			return nil
+		})
+	}
+	err = eg.Wait()
+	if err != nil {
+		return nil, createInternalError(fmt.Sprintf("failed to get transaction details [%v]", req.GetHash()), err)
+	}
+	if req.GetDecodeInput() {
+		decoder.DecodeInputs([]*api.Transaction{tx})
+	}
+
+	return &api.GetTransactionDetailsResponse{
+		Transaction:        tx,
+		Epoch:              td.GetEpoch(),
+		TickTimestamp:      td.GetTimestamp(),
+		ComputorIndex:      td.GetComputorIndex(),
+		IncludedInTick:     slices.Contains(td.GetTransactionHashes(), tx.GetHash()),
+		EventLogsAvailable: eventLogsAvailable,
+		EventLogs:          events.GetEvents(),
+		HasMoreEventLogs:   events != nil && events.HasMore,
+		ValidForTick:       eventsLastProcessedTick,
+	}, nil
+}
+
+func (s *ArchiveQueryService) VerifyTransaction(ctx context.Context, req *api.VerifyTransactionRequest) (*api.VerifyTransactionResponse, error) {
+	tx := req.GetTransaction()
+	if tx == nil {
+		if req.GetHash() == "" {
+			return nil, status.Error(codes.InvalidArgument, "either hash or transaction is required")
+		}
+		var err error
+		tx, err = s.txService.GetTransactionByHash(ctx, req.GetHash())
+		if err != nil {
+			return nil, createInternalError(fmt.Sprintf("failed to get transaction by hash [%v]", req.GetHash()), err)
+		}
+		if tx == nil {
+			return nil, status.Error(codes.NotFound, "transaction not found")
+		}
+	}
+
+	verification, err := verifier.VerifyTransaction(tx)
+	if err != nil {
+		if req.GetTransaction() != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid transaction: %v", err)
+		}
+		return nil, createInternalError(fmt.Sprintf("failed to verify transaction [%v]", tx.GetHash()), err)
+	}
+
+	return &api.VerifyTransactionResponse{
+		Hash:           tx.GetHash(),
+		CalculatedHash: verification.Hash,
+		HashValid:      verification.HashValid,
+		SignatureValid: verification.SignatureValid,
+		Valid:          verification.HashValid && verification.SignatureValid,
+	}, nil
+}
+
 func (s *ArchiveQueryService) GetTransactionsForTick(ctx context.Context, req *api.GetTransactionsForTickRequest) (*api.GetTransactionsForTickResponse, error) {
 	filterMap, err := filters.CreateTickTransactionsFilters(req.GetFilters())
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid filters: %v", err)
+		return nil, createInvalidArgumentError("invalid filters", "filters", err)
 	}
 
 	ranges, err := filters.ValidateTickTransactionQueryRanges(filterMap, req.GetRanges())
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid range: %v", err)
+		return nil, createInvalidArgumentError("invalid range", "ranges", err)
 	}
 
 	txs, err := s.txService.GetTransactionsForTickNumber(ctx, req.TickNumber, filterMap, ranges)
 	if err != nil {
 		return nil, createInternalError(fmt.Sprintf("failed to get transactions for tick [%d]", req.GetTickNumber()), err)
 	}
+	if req.GetDecodeInput() {
+		decoder.DecodeInputs(txs)
+	}
 
 	return &api.GetTransactionsForTickResponse{Transactions: txs}, nil
 }
@@ -113,60 +256,189 @@ func (s *ArchiveQueryService) GetTickData(ctx context.Context, req *api.GetTickD
 		return nil, createInternalError(fmt.Sprintf("failed to get tick data for tick [%d]", req.GetTickNumber()), err)
 	}
 
-	return &api.GetTickDataResponse{TickData: td}, nil
+	return &api.GetTickDataResponse{TickData: td, IsEmpty: td == nil}, nil
 }
 
-func (s *ArchiveQueryService) GetTransactionsForIdentity(ctx context.Context, request *api.GetTransactionsForIdentityRequest) (*api.GetTransactionsForIdentityResponse, error) {
-	err := utils.ValidateIdentity(request.GetIdentity())
+const maxTickDataRangeSize = 100
+
+func (s *ArchiveQueryService) GetTickDataRange(ctx context.Context, req *api.GetTickDataRangeRequest) (*api.GetTickDataRangeResponse, error) {
+	startTick, endTick := req.GetStartTick(), req.GetEndTick()
+	if startTick == 0 || endTick < startTick {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tick range [%d-%d]", startTick, endTick)
+	}
+	if endTick-startTick >= maxTickDataRangeSize {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tick range [%d-%d]: maximum range size is %d", startTick, endTick, maxTickDataRangeSize)
+	}
+
+	cachedStatus, err := s.statusService.GetStatus(ctx)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid identity: %v", err)
+		return nil, createInternalError("failed to get status", err)
+	}
+	lastProcessedTick := cachedStatus.GetLastProcessedTick()
+	if endTick > lastProcessedTick {
+		st := status.Newf(codes.FailedPrecondition, "requested tick number %d is greater than last processed tick %d", endTick, lastProcessedTick)
+		st, err = st.WithDetails(&api.LastProcessedTick{TickNumber: lastProcessedTick})
+		if err != nil {
+			return nil, createInternalError("creating custom status", err)
+		}
+		return nil, st.Err()
 	}
 
-	// we need to stay backwards compatible here. exclude filters are postfixed with -exclude.
-	includes, excludes := filters.SplitDeprecatedIncludeExcludeFilters(request.GetFilters())
-	if len(excludes) > 0 && len(request.GetExclude()) > 0 { // old and new api mismatch
-		return nil, status.Errorf(codes.InvalidArgument, "cannot use both -exclude filters postfix and exclude filters together")
-	} else if len(excludes) == 0 { // use new exclude filters
-		excludes = request.GetExclude()
+	intervals, err := s.statusService.GetProcessedTickIntervals(ctx)
+	if err != nil {
+		return nil, createInternalError("failed to get processed tick intervals", err)
 	}
 
-	includeFilters, err := filters.CreateIdentityTransactionFilters(includes)
+	tickData, err := s.tdService.GetTickDataRange(ctx, startTick, endTick)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "creating include filters: %v", err)
+		return nil, createInternalError(fmt.Sprintf("failed to get tick data for tick range [%d-%d]", startTick, endTick), err)
+	}
+	tickDataByTickNumber := make(map[uint32]*api.TickData, len(tickData))
+	for _, td := range tickData {
+		tickDataByTickNumber[td.GetTickNumber()] = td
 	}
 
-	excludeFilters, err := filters.CreateIdentityTransactionFilters(excludes)
+	ticks := make([]*api.RangeTickData, 0, endTick-startTick+1)
+	for tickNumber := startTick; tickNumber <= endTick; tickNumber++ {
+		// skipped ticks are not processed. We don't know, if they are empty.
+		if skipped, _ := WasSkippedByArchive(tickNumber, intervals); skipped {
+			ticks = append(ticks, &api.RangeTickData{TickNumber: tickNumber, IsSkipped: true})
+			continue
+		}
+		td := tickDataByTickNumber[tickNumber]
+		ticks = append(ticks, &api.RangeTickData{TickNumber: tickNumber, IsEmpty: td == nil, TickData: td})
+	}
+
+	return &api.GetTickDataRangeResponse{Ticks: ticks}, nil
+}
+
+func (s *ArchiveQueryService) VerifyTick(ctx context.Context, req *api.VerifyTickRequest) (*api.VerifyTickResponse, error) {
+	// it is important that the tick range is checked in advance because a nil result will be returned as an empty tick and not as 404
+	td, err := s.tdService.GetTickData(ctx, req.GetTickNumber())
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "creating exclude filters: %v", err)
+		return nil, createInternalError(fmt.Sprintf("failed to get tick data for tick [%d]", req.GetTickNumber()), err)
+	}
+	if td == nil {
+		return &api.VerifyTickResponse{TickNumber: req.GetTickNumber(), IsEmpty: true}, nil
 	}
 
-	err = filters.ValidateExcludeFilterKeys(excludeFilters)
+	computorLists, err := s.clService.GetComputorsListsForEpoch(ctx, td.GetEpoch())
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, createInternalError(fmt.Sprintf("failed to get computors lists for epoch [%d]", td.GetEpoch()), err)
 	}
 
-	filterRanges, err := filters.CreateIdentityTransactionQueryRanges(request.GetRanges())
+	transactions, err := s.txService.GetTransactionsForTickNumber(ctx, td.GetTickNumber(), nil, nil)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid range: %v", err)
+		return nil, createInternalError(fmt.Sprintf("failed to get transactions for tick [%d]", td.GetTickNumber()), err)
 	}
 
-	queryFilters := entities.Filters{Include: includeFilters, Exclude: excludeFilters, Ranges: filterRanges}
-	err = filters.VerifyNoConflictingFilters(queryFilters)
+	response := &api.VerifyTickResponse{TickNumber: td.GetTickNumber()}
+	computorList := computorListForTick(computorLists, td.GetTickNumber())
+	if computorList != nil {
+		if err = verifier.VerifyComputorList(computorList); err != nil {
+			log.Printf("[WARN] computor list of epoch [%d] is invalid: %v", computorList.GetEpoch(), err)
+		}
+		response.ComputorListSignatureValid = err == nil
+		if err = verifier.VerifyTickData(td, computorList); err != nil {
+			log.Printf("[WARN] tick data of tick [%d] is invalid: %v", td.GetTickNumber(), err)
+		}
+		response.TickSignatureValid = err == nil
+	}
+
+	archivedHashes := make(map[string]struct{}, len(transactions))
+	for _, tx := range transactions {
+		archivedHashes[tx.GetHash()] = struct{}{}
+	}
+	response.MissingTransactionHashes = make([]string, 0)
+	for _, hash := range td.GetTransactionHashes() {
+		if _, ok := archivedHashes[hash]; !ok {
+			response.MissingTransactionHashes = append(response.MissingTransactionHashes, hash)
+		}
+	}
+	response.TransactionsComplete = len(response.MissingTransactionHashes) == 0
+
+	response.Valid = response.ComputorListSignatureValid && response.TickSignatureValid && response.TransactionsComplete
+	return response, nil
+}
+
+// computorListForTick returns the computor list that was valid at the given tick. Expects the lists to be sorted by
+// tick number in descending order. If all lists were received after the tick, the oldest list is returned.
+func computorListForTick(computorLists []*api.ComputorList, tickNumber uint32) *api.ComputorList {
+	for _, computorList := range computorLists {
+		if computorList.GetTickNumber() <= tickNumber {
+			return computorList
+		}
+	}
+	if len(computorLists) > 0 {
+		return computorLists[len(computorLists)-1]
+	}
+	return nil
+}
+
+func (s *ArchiveQueryService) GetTicksForEpoch(ctx context.Context, req *api.GetTicksForEpochRequest) (*api.GetTicksForEpochResponse, error) {
+	// the ticks are not queried with offset from the data store. no need to limit the offset.
+	size, err := s.pageSizeLimits.validatePageSize(req.GetPagination().GetSize())
+	if err != nil {
+		return nil, createInvalidArgumentError("invalid pagination", "pagination.size", err)
+	}
+	from := req.GetPagination().GetOffset()
+
+	result, err := s.tdService.GetTicksForEpoch(ctx, req.GetEpoch(), from, size, req.GetDesc())
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "conflicting filters: %v", err)
+		return nil, createInternalError(fmt.Sprintf("failed to get ticks for epoch [%d]", req.GetEpoch()), err)
+	}
+	if result == nil {
+		return nil, status.Errorf(codes.NotFound, "no processed ticks for epoch %d", req.GetEpoch())
+	}
+
+	return &api.GetTicksForEpochResponse{
+		Hits:  &api.Hits{Total: result.Total, From: from, Size: size},
+		Ticks: result.GetTicks(),
+	}, nil
+}
+
+func (s *ArchiveQueryService) GetEmptyTicksForEpoch(ctx context.Context, req *api.GetEmptyTicksForEpochRequest) (*api.GetEmptyTicksForEpochResponse, error) {
+	// the empty ticks are not queried with offset from the data store. no need to limit the offset.
+	size, err := s.pageSizeLimits.validatePageSize(req.GetPagination().GetSize())
+	if err != nil {
+		return nil, createInvalidArgumentError("invalid pagination", "pagination.size", err)
+	}
+	from := req.GetPagination().GetOffset()
+
+	result, err := s.tdService.GetEmptyTicksForEpoch(ctx, req.GetEpoch(), from, size)
+	if err != nil {
+		return nil, createInternalError(fmt.Sprintf("failed to get empty ticks for epoch [%d]", req.GetEpoch()), err)
+	}
+	if result == nil {
+		return nil, status.Errorf(codes.NotFound, "no processed ticks for epoch %d", req.GetEpoch())
+	}
+
+	return &api.GetEmptyTicksForEpochResponse{
+		Hits:       &api.Hits{Total: result.Total, From: from, Size: size},
+		EmptyTicks: result.GetTickNumbers(),
+	}, nil
+}
+
+func (s *ArchiveQueryService) GetTransactionsForIdentity(ctx context.Context, request *api.GetTransactionsForIdentityRequest) (*api.GetTransactionsForIdentityResponse, error) {
+	queryFilters, err := createIdentityTransactionQueryFilters(request)
+	if err != nil {
+		return nil, err
 	}
 
 	from, size, err := s.pageSizeLimits.ValidatePagination(request.GetPagination())
 	if err != nil {
 		// debug log temporarily. we need to find out how many users use strange pagination parameters.
 		log.Printf("[DEBUG] Invalid pagination: %v. Request: %v", err, request)
-		return nil, status.Errorf(codes.InvalidArgument, "invalid pagination: %v", err)
+		return nil, createInvalidArgumentError("invalid pagination", "pagination", err)
 	}
 
 	result, err := s.txService.GetTransactionsForIdentity(ctx, request.Identity, queryFilters, from, size)
 	if err != nil {
 		return nil, createInternalError(fmt.Sprintf("failed to get transactions for identity [%s]", request.GetIdentity()), err)
 	}
+	if request.GetDecodeInput() {
+		decoder.DecodeInputs(result.GetTransactions())
+	}
 
 	// paging information
 	apiHits := &api.Hits{
@@ -182,7 +454,86 @@ func (s *ArchiveQueryService) GetTransactionsForIdentity(ctx context.Context, re
 	}, nil
 }
 
+// createIdentityTransactionQueryFilters validates the identity and creates the query filters of the request.
+// Returns a status error, if the request is invalid.
+func createIdentityTransactionQueryFilters(request *api.GetTransactionsForIdentityRequest) (entities.Filters, error) {
+	err := utils.ValidateIdentity(request.GetIdentity())
+	if err != nil {
+		return entities.Filters{}, createInvalidArgumentError("invalid identity", "identity", err)
+	}
+
+	// we need to stay backwards compatible here. exclude filters are postfixed with -exclude.
+	includes, excludes := filters.SplitDeprecatedIncludeExcludeFilters(request.GetFilters())
+	if len(excludes) > 0 && len(request.GetExclude()) > 0 { // old and new api mismatch
+		return entities.Filters{}, createInvalidArgumentError("", "exclude",
+			filters.NewFieldError("", filters.ReasonDuplicateFilter, errors.New("cannot use both -exclude filters postfix and exclude filters together")))
+	} else if len(excludes) == 0 { // use new exclude filters
+		excludes = request.GetExclude()
+	}
+
+	includeFilters, err := filters.CreateIdentityTransactionFilters(includes)
+	if err != nil {
+		return entities.Filters{}, createInvalidArgumentError("creating include filters", "filters", err)
+	}
+
+	excludeFilters, err := filters.CreateIdentityTransactionFilters(excludes)
+	if err != nil {
+		return entities.Filters{}, createInvalidArgumentError("creating exclude filters", "exclude", err)
+	}
+
+	err = filters.ValidateExcludeFilterKeys(excludeFilters)
+	if err != nil {
+		return entities.Filters{}, createInvalidArgumentError("", "exclude", err)
+	}
+
+	filterRanges, err := filters.CreateIdentityTransactionQueryRanges(request.GetRanges())
+	if err != nil {
+		return entities.Filters{}, createInvalidArgumentError("invalid range", "ranges", err)
+	}
+
+	queryFilters := entities.Filters{Include: includeFilters, Exclude: excludeFilters, Ranges: filterRanges}
+	err = filters.VerifyNoConflictingFilters(queryFilters)
+	if err != nil {
+		return entities.Filters{}, createInvalidArgumentError("conflicting filters", "", err)
+	}
+	return queryFilters, nil
+}
+
+// errorDomain is the domain of the error info details.
+const errorDomain = "archive-query-service.qubic.org"
+
+// createInvalidArgumentError creates an invalid argument status with a field violation (google.rpc.BadRequest) and
+// error info details. Field and reason are taken from the wrapped field error, if available. The field of the field
+// error is relative to the given request field. The status message is the error message prefixed with the message,
+// if the message is not empty.
+func createInvalidArgumentError(message, field string, err error) error {
+	reason := filters.ReasonInvalidValue
+	var fieldErr *filters.FieldError
+	if errors.As(err, &fieldErr) {
+		field, reason = filters.JoinField(field, fieldErr.Field), fieldErr.Reason
+	}
+	statusMessage := err.Error()
+	if message != "" {
+		statusMessage = message + ": " + statusMessage
+	}
+	st := status.New(codes.InvalidArgument, statusMessage)
+	st, detailErr := st.WithDetails(
+		&errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: field, Description: err.Error(), Reason: reason},
+		}},
+		&errdetails.ErrorInfo{Reason: reason, Domain: errorDomain, Metadata: map[string]string{"field": field}},
+	)
+	if detailErr != nil {
+		return status.Errorf(codes.Internal, "creating custom status")
+	}
+	return st.Err()
+}
+
 func createInternalError(message string, err error) error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		log.Printf("[WARN] %s: %v", message, err)
+		return status.Error(codes.DeadlineExceeded, message)
+	}
 	log.Printf("[ERROR] %s: %v", message, err)
 	return status.Error(codes.Internal, message)
 }
@@ -225,36 +576,178 @@ func (s *ArchiveQueryService) GetComputorsListsForEpoch(ctx context.Context, req
 	}, nil
 }
 
-func (s *ArchiveQueryService) GetEventLogs(ctx context.Context, req *api.GetEventLogsRequest) (*api.GetEventLogsResponse, error) {
+const maxComputorListsEpochRangeSize = 50
+
+func (s *ArchiveQueryService) GetComputorListsForEpochRange(ctx context.Context, req *api.GetComputorListsForEpochRangeRequest) (*api.GetComputorListsForEpochRangeResponse, error) {
+	startEpoch, endEpoch := req.GetStartEpoch(), req.GetEndEpoch()
+	if endEpoch < startEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid epoch range [%d-%d]", startEpoch, endEpoch)
+	}
+	if endEpoch-startEpoch >= maxComputorListsEpochRangeSize {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid epoch range [%d-%d]: maximum range size is %d", startEpoch, endEpoch, maxComputorListsEpochRangeSize)
+	}
+
+	computorLists, err := s.clService.GetComputorsListsForEpochRange(ctx, startEpoch, endEpoch)
+	if err != nil {
+		return nil, createInternalError(fmt.Sprintf("failed to get computors lists for epoch range [%d-%d]", startEpoch, endEpoch), err)
+	}
+
+	response := &api.GetComputorListsForEpochRangeResponse{
+		ComputorsLists: computorLists,
+		Diffs:          computorListDiffs(computorLists),
+	}
+	if req.GetDiffsOnly() {
+		response.ComputorsLists = make([]*api.ComputorList, 0)
+	}
+	return response, nil
+}
+
+// computorListDiffs calculates the joined and left identities between consecutive computor lists. Expects the lists
+// to be sorted by tick number in ascending order.
+func computorListDiffs(computorLists []*api.ComputorList) []*api.ComputorListDiff {
+	diffs := make([]*api.ComputorListDiff, 0, max(len(computorLists)-1, 0))
+	for i := 1; i < len(computorLists); i++ {
+		previous, current := computorLists[i-1], computorLists[i]
+		diffs = append(diffs, &api.ComputorListDiff{
+			Epoch:              current.GetEpoch(),
+			TickNumber:         current.GetTickNumber(),
+			PreviousEpoch:      previous.GetEpoch(),
+			PreviousTickNumber: previous.GetTickNumber(),
+			Joined:             identitiesNotIn(current.GetIdentities(), previous.GetIdentities()),
+			Left:               identitiesNotIn(previous.GetIdentities(), current.GetIdentities()),
+		})
+	}
+	return diffs
+}
+
+// identitiesNotIn returns the identities that are not contained in the other identities keeping the order.
+func identitiesNotIn(identities, others []string) []string {
+	otherSet := make(map[string]struct{}, len(others))
+	for _, identity := range others {
+		otherSet[identity] = struct{}{}
+	}
+	result := make([]string, 0)
+	for _, identity := range identities {
+		if _, ok := otherSet[identity]; !ok {
+			result = append(result, identity)
+		}
+	}
+	return result
+}
+
+func (s *ArchiveQueryService) GetComputorMembership(ctx context.Context, req *api.GetComputorMembershipRequest) (*api.GetComputorMembershipResponse, error) {
+	memberships, err := s.clService.GetComputorMembership(ctx, req.GetIdentity())
+	if err != nil {
+		return nil, createInternalError(fmt.Sprintf("failed to get computor membership for identity [%s]", req.GetIdentity()), err)
+	}
+
+	return &api.GetComputorMembershipResponse{Memberships: memberships}, nil
+}
+
+func (s *ArchiveQueryService) GetComputorTickStats(ctx context.Context, req *api.GetComputorTickStatsRequest) (*api.GetComputorTickStatsResponse, error) {
+	stats, err := s.tdService.GetComputorTickStats(ctx, req.GetEpoch())
+	if err != nil {
+		return nil, createInternalError(fmt.Sprintf("failed to get computor tick stats for epoch [%d]", req.GetEpoch()), err)
+	}
+	if stats == nil {
+		return nil, status.Errorf(codes.NotFound, "no processed ticks for epoch %d", req.GetEpoch())
+	}
+
+	computorLists, err := s.clService.GetComputorsListsForEpoch(ctx, req.GetEpoch())
+	if err != nil {
+		return nil, createInternalError(fmt.Sprintf("failed to get computors lists for epoch [%d]", req.GetEpoch()), err)
+	}
+	if len(computorLists) > 0 { // the lists are sorted by tick number descending. use the latest one.
+		identities := computorLists[0].GetIdentities()
+		for _, computorStats := range stats {
+			if int(computorStats.GetComputorIndex()) < len(identities) {
+				computorStats.Identity = identities[computorStats.GetComputorIndex()]
+			}
+		}
+	}
+
+	return &api.GetComputorTickStatsResponse{Epoch: req.GetEpoch(), Computors: stats}, nil
+}
+
+func (s *ArchiveQueryService) GetEpochSummary(ctx context.Context, req *api.GetEpochSummaryRequest) (*api.GetEpochSummaryResponse, error) {
+	if s.esService == nil {
+		return nil, status.Error(codes.Unimplemented, "epoch summary is not enabled")
+	}
+	summary, err := s.esService.GetEpochSummary(ctx, req.GetEpoch())
+	if err != nil {
+		return nil, createInternalError(fmt.Sprintf("failed to get summary for epoch [%d]", req.GetEpoch()), err)
+	}
+	if summary == nil {
+		return nil, status.Errorf(codes.NotFound, "no processed ticks for epoch %d", req.GetEpoch())
+	}
+
+	return &api.GetEpochSummaryResponse{Summary: summary}, nil
+}
+
+const (
+	defaultTopCounterparties = 10
+	maxTopCounterparties     = 100
+)
+
+func (s *ArchiveQueryService) GetIdentityOverview(ctx context.Context, req *api.GetIdentityOverviewRequest) (*api.GetIdentityOverviewResponse, error) {
+	if s.ioService == nil {
+		return nil, status.Error(codes.Unimplemented, "identity overview is not enabled")
+	}
+	topCounterparties := req.GetTopCounterparties()
+	if topCounterparties == 0 {
+		topCounterparties = defaultTopCounterparties
+	}
+	if topCounterparties > maxTopCounterparties {
+		return nil, status.Errorf(codes.InvalidArgument, "top counterparties must not exceed %d", maxTopCounterparties)
+	}
+
+	overview, err := s.ioService.GetIdentityOverview(ctx, req.GetIdentity(), topCounterparties)
+	if err != nil {
+		return nil, createInternalError(fmt.Sprintf("failed to get overview for identity [%s]", req.GetIdentity()), err)
+	}
+
+	return &api.GetIdentityOverviewResponse{Overview: overview}, nil
+}
+
+// CreateEventQueryFilters creates the query filters of the request. Returns a status error, if the request is invalid.
+func CreateEventQueryFilters(req *api.GetEventLogsRequest) (entities.Filters, error) {
 	includeFilters, err := filters.CreateEventFilters(req.GetFilters(), filters.AllowedEventIncludeFilters)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "creating include filters: %v", err)
+		return entities.Filters{}, createInvalidArgumentError("creating include filters", "filters", err)
 	}
 
 	excludeFilters, err := filters.CreateEventFilters(req.GetExclude(), filters.AllowedEventExcludeFilters)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "creating exclude filters: %v", err)
+		return entities.Filters{}, createInvalidArgumentError("creating exclude filters", "exclude", err)
 	}
 
 	queryRanges, err := filters.CreateEventRanges(req.GetRanges(), filters.AllowedEventRanges)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "creating range filters: %v", err)
+		return entities.Filters{}, createInvalidArgumentError("creating range filters", "ranges", err)
 	}
 
 	shouldFilters, err := filters.CreateShouldFilters(req.GetShould(), filters.AllowedEventShouldFilters, filters.AllowedEventShouldRanges)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "creating should filters: %v", err)
+		return entities.Filters{}, createInvalidArgumentError("creating should filters", "should", err)
 	}
 
 	queryFilters := entities.Filters{Include: includeFilters, Exclude: excludeFilters, Ranges: queryRanges, Should: shouldFilters}
 	err = filters.VerifyNoConflictingFilters(queryFilters)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "conflicting filters: %v", err)
+		return entities.Filters{}, createInvalidArgumentError("conflicting filters", "", err)
+	}
+	return queryFilters, nil
+}
+
+func (s *ArchiveQueryService) GetEventLogs(ctx context.Context, req *api.GetEventLogsRequest) (*api.GetEventLogsResponse, error) {
+	queryFilters, err := CreateEventQueryFilters(req)
+	if err != nil {
+		return nil, err
 	}
 
 	from, size, err := s.pageSizeLimits.ValidatePagination(req.GetPagination())
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid pagination: %v", err)
+		return nil, createInvalidArgumentError("invalid pagination", "pagination", err)
 	}
 
 	cachedStatus, err := s.statusService.GetStatus(ctx)
@@ -263,7 +756,7 @@ func (s *ArchiveQueryService) GetEventLogs(ctx context.Context, req *api.GetEven
 	}
 	eventsLastProcessedTick := cachedStatus.GetLastProcessedLogTick()
 
-	if tickValues, ok := includeFilters[filters.EventFilterTickNumber]; ok && len(tickValues) > 0 {
+	if tickValues, ok := queryFilters.Include[filters.EventFilterTickNumber]; ok && len(tickValues) > 0 {
 		tickNumber, convErr := strconv.ParseUint(tickValues[0], 10, 32)
 		if convErr == nil && uint32(tickNumber) > eventsLastProcessedTick {
 			st := status.Newf(codes.FailedPrecondition, "requested tick number %d is greater than last processed tick %d", tickNumber, eventsLastProcessedTick)
@@ -293,7 +786,76 @@ func (s *ArchiveQueryService) GetEventLogs(ctx context.Context, req *api.GetEven
 	}, nil
 }
 
+func (s *ArchiveQueryService) GetEventLog(ctx context.Context, req *api.GetEventLogRequest) (*api.GetEventLogResponse, error) {
+	cachedStatus, err := s.statusService.GetStatus(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get status: %v", err)
+	}
+	eventsLastProcessedTick := cachedStatus.GetLastProcessedLogTick()
+
+	event, err := s.evService.GetEvent(ctx, req.GetEpoch(), req.GetLogId(), eventsLastProcessedTick)
+	if err != nil {
+		return nil, createInternalError(fmt.Sprintf("failed to get event log [%d] for epoch [%d]", req.GetLogId(), req.GetEpoch()), err)
+	}
+	if event == nil {
+		return nil, createNotFoundWithLastProcessedTick("event log not found", eventsLastProcessedTick)
+	}
+
+	return &api.GetEventLogResponse{EventLog: event}, nil
+}
+
+func (s *ArchiveQueryService) GetEventLogsForTransaction(ctx context.Context, req *api.GetEventLogsForTransactionRequest) (*api.GetEventLogsForTransactionResponse, error) {
+	size, err := s.pageSizeLimits.validatePageSize(req.GetSize())
+	if err != nil {
+		return nil, createInvalidArgumentError("invalid size", "size", fmt.Errorf("validating page size: %w", err))
+	}
+
+	tx, err := s.txService.GetTransactionByHash(ctx, req.GetTransactionHash())
+	if err != nil {
+		return nil, createInternalError(fmt.Sprintf("failed to get transaction by hash [%v]", req.GetTransactionHash()), err)
+	}
+	if tx == nil {
+		return nil, status.Error(codes.NotFound, "transaction not found")
+	}
+
+	cachedStatus, err := s.statusService.GetStatus(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get status: %v", err)
+	}
+	eventsLastProcessedTick := cachedStatus.GetLastProcessedLogTick()
+
+	// events of the transaction are not available before the tick is processed
+	if tx.GetTickNumber() > eventsLastProcessedTick {
+		return nil, createNotFoundWithLastProcessedTick(
+			fmt.Sprintf("event logs for tick %d are not processed yet, last processed tick is %d", tx.GetTickNumber(), eventsLastProcessedTick),
+			eventsLastProcessedTick)
+	}
+
+	result, err := s.evService.GetEventsForTransaction(ctx, req.GetTransactionHash(), req.AfterLogId, size, eventsLastProcessedTick)
+	if err != nil {
+		return nil, createInternalError(fmt.Sprintf("failed to get event logs for transaction [%s]", req.GetTransactionHash()), err)
+	}
+
+	return &api.GetEventLogsForTransactionResponse{
+		ValidForTick: eventsLastProcessedTick,
+		TickNumber:   tx.GetTickNumber(),
+		EventLogs:    result.GetEvents(),
+		HasMore:      result.HasMore,
+	}, nil
+}
+
+func createNotFoundWithLastProcessedTick(message string, lastProcessedTick uint32) error {
+	st, err := status.New(codes.NotFound, message).WithDetails(&api.LastProcessedTick{TickNumber: lastProcessedTick})
+	if err != nil {
+		return status.Errorf(codes.Internal, "creating custom status")
+	}
+	return st.Err()
+}
+
 func (s *ArchiveQueryService) GetHealth(context.Context, *emptypb.Empty) (*api.HealthResponse, error) {
+	if s.shuttingDown.Load() {
+		return nil, status.Error(codes.Unavailable, "shutting down")
+	}
 	return &api.HealthResponse{
 		Status: "UP",
 	}, nil
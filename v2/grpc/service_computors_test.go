@@ -12,13 +12,24 @@ import (
 )
 
 type ComputorsServiceStub struct {
-	computors []*api.ComputorList
+	computors        []*api.ComputorList
+	memberships      []*api.ComputorMembership
+	receivedIdentity string
 }
 
 func (c *ComputorsServiceStub) GetComputorsListsForEpoch(_ context.Context, _ uint32) ([]*api.ComputorList, error) {
 	return c.computors, nil
 }
 
+func (c *ComputorsServiceStub) GetComputorsListsForEpochRange(_ context.Context, _, _ uint32) ([]*api.ComputorList, error) {
+	return c.computors, nil
+}
+
+func (c *ComputorsServiceStub) GetComputorMembership(_ context.Context, identity string) ([]*api.ComputorMembership, error) {
+	c.receivedIdentity = identity
+	return c.memberships, nil
+}
+
 func TestArchiverQueryService_GetComputorsList(t *testing.T) {
 	expected := &api.TickData{TickNumber: 42}
 
@@ -40,3 +51,77 @@ func TestArchiverQueryService_GetComputorsList_GivenNoComputors_ThenReturnNotFou
 	assert.Error(t, err)
 	require.Equal(t, status.Error(codes.NotFound, "computor lists not found"), err)
 }
+
+func TestArchiveQueryService_GetComputorTickStats(t *testing.T) {
+	tdService := &TickDataServiceStub{computorStats: []*api.ComputorTickStats{
+		{ComputorIndex: 0, ProducedTicks: 10, EmptyTicks: 1},
+		{ComputorIndex: 1, ProducedTicks: 11},
+		{ComputorIndex: 2, EmptyTicks: 3},
+	}}
+	clService := &ComputorsServiceStub{computors: []*api.ComputorList{
+		{TickNumber: 200, Identities: []string{"A", "B"}},
+		{TickNumber: 100, Identities: []string{"C", "D", "E"}},
+	}}
+	service := NewArchiveQueryService(nil, tdService, nil, clService, nil, NewPageSizeLimits(1000, 10))
+
+	response, err := service.GetComputorTickStats(context.Background(), &api.GetComputorTickStatsRequest{Epoch: 100})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(100), tdService.receivedEpoch)
+	assert.Equal(t, uint32(100), response.Epoch)
+	assert.Equal(t, []*api.ComputorTickStats{
+		{ComputorIndex: 0, Identity: "A", ProducedTicks: 10, EmptyTicks: 1},
+		{ComputorIndex: 1, Identity: "B", ProducedTicks: 11},
+		{ComputorIndex: 2, EmptyTicks: 3},
+	}, response.Computors)
+}
+
+func TestArchiveQueryService_GetComputorTickStats_GivenUnknownEpoch_ThenNotFound(t *testing.T) {
+	service := NewArchiveQueryService(nil, &TickDataServiceStub{}, nil, &ComputorsServiceStub{}, nil, NewPageSizeLimits(1000, 10))
+
+	_, err := service.GetComputorTickStats(context.Background(), &api.GetComputorTickStatsRequest{Epoch: 100})
+	require.Error(t, err)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestArchiveQueryService_GetComputorListsForEpochRange(t *testing.T) {
+	clService := &ComputorsServiceStub{computors: []*api.ComputorList{
+		{Epoch: 100, TickNumber: 100, Identities: []string{"A", "B", "C"}},
+		{Epoch: 100, TickNumber: 150, Identities: []string{"A", "B", "C"}},
+		{Epoch: 101, TickNumber: 200, Identities: []string{"D", "B", "A"}},
+	}}
+	service := NewArchiveQueryService(nil, nil, nil, clService, nil, NewPageSizeLimits(1000, 10))
+
+	response, err := service.GetComputorListsForEpochRange(context.Background(), &api.GetComputorListsForEpochRangeRequest{StartEpoch: 100, EndEpoch: 101})
+	require.NoError(t, err)
+	assert.Len(t, response.ComputorsLists, 3)
+	assert.Equal(t, []*api.ComputorListDiff{
+		{Epoch: 100, TickNumber: 150, PreviousEpoch: 100, PreviousTickNumber: 100, Joined: []string{}, Left: []string{}},
+		{Epoch: 101, TickNumber: 200, PreviousEpoch: 100, PreviousTickNumber: 150, Joined: []string{"D"}, Left: []string{"C"}},
+	}, response.Diffs)
+
+	response, err = service.GetComputorListsForEpochRange(context.Background(), &api.GetComputorListsForEpochRangeRequest{StartEpoch: 100, EndEpoch: 101, DiffsOnly: true})
+	require.NoError(t, err)
+	assert.Empty(t, response.ComputorsLists)
+	assert.Len(t, response.Diffs, 2)
+}
+
+func TestArchiveQueryService_GetComputorListsForEpochRange_GivenInvalidRange_ThenError(t *testing.T) {
+	service := NewArchiveQueryService(nil, nil, nil, &ComputorsServiceStub{}, nil, NewPageSizeLimits(1000, 10))
+
+	_, err := service.GetComputorListsForEpochRange(context.Background(), &api.GetComputorListsForEpochRangeRequest{StartEpoch: 101, EndEpoch: 100})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = service.GetComputorListsForEpochRange(context.Background(), &api.GetComputorListsForEpochRangeRequest{StartEpoch: 100, EndEpoch: 150})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestArchiveQueryService_GetComputorMembership(t *testing.T) {
+	expected := []*api.ComputorMembership{{Epoch: 100, TickNumber: 1000, ComputorIndex: 42}}
+	clService := &ComputorsServiceStub{memberships: expected}
+	service := NewArchiveQueryService(nil, nil, nil, clService, nil, NewPageSizeLimits(1000, 10))
+
+	response, err := service.GetComputorMembership(context.Background(), &api.GetComputorMembershipRequest{Identity: "BZBQFLLBNCXEMGLOBHUVFTLUPLVCPQUASSILFABOFFBCADQSSUPNWLZBQEXK"})
+	require.NoError(t, err)
+	assert.Equal(t, "BZBQFLLBNCXEMGLOBHUVFTLUPLVCPQUASSILFABOFFBCADQSSUPNWLZBQEXK", clService.receivedIdentity)
+	assert.Equal(t, expected, response.Memberships)
+}
@@ -0,0 +1,58 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type EpochSummaryServiceStub struct {
+	summary *api.EpochSummary
+	err     error
+}
+
+func (e *EpochSummaryServiceStub) GetEpochSummary(_ context.Context, epoch uint32) (*api.EpochSummary, error) {
+	if e.summary != nil && e.summary.Epoch == epoch {
+		return e.summary, e.err
+	}
+	return nil, e.err
+}
+
+func TestArchiveQueryService_GetEpochSummary(t *testing.T) {
+	expected := &api.EpochSummary{Epoch: 100, TransactionCount: 42}
+	service := NewArchiveQueryService(nil, nil, nil, nil, nil, NewPageSizeLimits(1000, 10))
+	service.SetEpochSummaryService(&EpochSummaryServiceStub{summary: expected})
+
+	response, err := service.GetEpochSummary(context.Background(), &api.GetEpochSummaryRequest{Epoch: 100})
+	require.NoError(t, err)
+	assert.Equal(t, expected, response.Summary)
+}
+
+func TestArchiveQueryService_GetEpochSummary_GivenUnknownEpoch_ThenNotFound(t *testing.T) {
+	service := NewArchiveQueryService(nil, nil, nil, nil, nil, NewPageSizeLimits(1000, 10))
+	service.SetEpochSummaryService(&EpochSummaryServiceStub{})
+
+	_, err := service.GetEpochSummary(context.Background(), &api.GetEpochSummaryRequest{Epoch: 100})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestArchiveQueryService_GetEpochSummary_GivenError_ThenInternalError(t *testing.T) {
+	service := NewArchiveQueryService(nil, nil, nil, nil, nil, NewPageSizeLimits(1000, 10))
+	service.SetEpochSummaryService(&EpochSummaryServiceStub{err: errors.New("test")})
+
+	_, err := service.GetEpochSummary(context.Background(), &api.GetEpochSummaryRequest{Epoch: 100})
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestArchiveQueryService_GetEpochSummary_GivenNoService_ThenUnimplemented(t *testing.T) {
+	service := NewArchiveQueryService(nil, nil, nil, nil, nil, NewPageSizeLimits(1000, 10))
+
+	_, err := service.GetEpochSummary(context.Background(), &api.GetEpochSummaryRequest{Epoch: 100})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
@@ -18,6 +18,7 @@ import (
 type StatusServiceStub struct {
 	statusResponse *statusPb.GetStatusResponse
 	statusErr      error
+	intervals      []*api.ProcessedTickInterval
 }
 
 func (s *StatusServiceStub) GetStatus(_ context.Context) (*statusPb.GetStatusResponse, error) {
@@ -25,7 +26,7 @@ func (s *StatusServiceStub) GetStatus(_ context.Context) (*statusPb.GetStatusRes
 }
 
 func (s *StatusServiceStub) GetProcessedTickIntervals(_ context.Context) ([]*api.ProcessedTickInterval, error) {
-	return nil, nil
+	return s.intervals, nil
 }
 
 func defaultStatusStub() *StatusServiceStub {
@@ -35,10 +36,13 @@ func defaultStatusStub() *StatusServiceStub {
 }
 
 type EventsServiceStub struct {
-	events          []*api.Event
-	hits            *entities.Hits
-	err             error
-	ReceivedFilters entities.Filters
+	events             []*api.Event
+	hits               *entities.Hits
+	hasMore            bool
+	err                error
+	ReceivedFilters    entities.Filters
+	ReceivedAfterLogID *uint64
+	ReceivedSize       uint32
 }
 
 const validId1 = "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFXIB"
@@ -54,6 +58,27 @@ func (s *EventsServiceStub) GetEvents(_ context.Context, queryFilters entities.F
 	return &entities.EventsResult{Hits: s.hits, Events: s.events}, nil
 }
 
+func (s *EventsServiceStub) GetEvent(_ context.Context, epoch uint32, logID uint64, _ uint32) (*api.Event, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	for _, event := range s.events {
+		if event.Epoch == epoch && event.LogId == logID {
+			return event, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *EventsServiceStub) GetEventsForTransaction(_ context.Context, _ string, afterLogID *uint64, size, _ uint32) (*entities.TransactionEventsResult, error) {
+	s.ReceivedAfterLogID = afterLogID
+	s.ReceivedSize = size
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &entities.TransactionEventsResult{Events: s.events, HasMore: s.hasMore}, nil
+}
+
 func TestArchiveQueryService_GetEventLogs_Success(t *testing.T) {
 	evService := &EventsServiceStub{
 		events: []*api.Event{
@@ -284,3 +309,132 @@ func TestArchiveQueryService_GetEventLogs_StatusServiceError(t *testing.T) {
 	require.True(t, ok)
 	assert.Equal(t, codes.Internal, st.Code())
 }
+
+func TestArchiveQueryService_GetEventLog(t *testing.T) {
+	expected := &api.Event{Epoch: 100, LogId: 42, TickNumber: 15000}
+	evService := &EventsServiceStub{events: []*api.Event{{Epoch: 100, LogId: 41}, expected}}
+	service := NewArchiveQueryService(nil, nil, defaultStatusStub(), nil, evService, NewPageSizeLimits(1000, 10))
+
+	response, err := service.GetEventLog(context.Background(), &api.GetEventLogRequest{Epoch: 100, LogId: 42})
+	require.NoError(t, err)
+	assert.Equal(t, expected, response.EventLog)
+}
+
+func TestArchiveQueryService_GetEventLog_GivenNotFound_ThenNotFoundWithLastProcessedTick(t *testing.T) {
+	statusStub := &StatusServiceStub{
+		statusResponse: &statusPb.GetStatusResponse{LastProcessedLogTick: 50000},
+	}
+	service := NewArchiveQueryService(nil, nil, statusStub, nil, &EventsServiceStub{}, NewPageSizeLimits(1000, 10))
+
+	_, err := service.GetEventLog(context.Background(), &api.GetEventLogRequest{Epoch: 100, LogId: 42})
+	require.Error(t, err)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.NotFound, st.Code())
+
+	details := st.Details()
+	require.Len(t, details, 1)
+	lpt, ok := details[0].(*api.LastProcessedTick)
+	require.True(t, ok)
+	assert.Equal(t, uint32(50000), lpt.TickNumber)
+}
+
+func TestArchiveQueryService_GetEventLog_ServiceError(t *testing.T) {
+	evService := &EventsServiceStub{err: fmt.Errorf("elasticsearch unavailable")}
+	service := NewArchiveQueryService(nil, nil, defaultStatusStub(), nil, evService, NewPageSizeLimits(1000, 10))
+
+	_, err := service.GetEventLog(context.Background(), &api.GetEventLogRequest{Epoch: 100, LogId: 42})
+	require.Error(t, err)
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestArchiveQueryService_GetEventLogsForTransaction(t *testing.T) {
+	txService := &TransactionServiceStub{
+		transactions: []*api.Transaction{{Hash: validTransactionHash1, TickNumber: 40000}},
+	}
+	evService := &EventsServiceStub{
+		events:  []*api.Event{{LogId: 1}, {LogId: 2}},
+		hasMore: true,
+	}
+	service := NewArchiveQueryService(txService, nil, defaultStatusStub(), nil, evService, NewPageSizeLimits(1000, 10))
+
+	afterLogID := uint64(0)
+	response, err := service.GetEventLogsForTransaction(context.Background(), &api.GetEventLogsForTransactionRequest{
+		TransactionHash: validTransactionHash1,
+		AfterLogId:      &afterLogID,
+		Size:            2,
+	})
+	require.NoError(t, err)
+	assert.Len(t, response.EventLogs, 2)
+	assert.True(t, response.HasMore)
+	assert.Equal(t, uint32(40000), response.TickNumber)
+	assert.Equal(t, uint32(999999), response.ValidForTick)
+	assert.Equal(t, &afterLogID, evService.ReceivedAfterLogID)
+	assert.Equal(t, uint32(2), evService.ReceivedSize)
+}
+
+func TestArchiveQueryService_GetEventLogsForTransaction_GivenNoSize_ThenDefaultSize(t *testing.T) {
+	txService := &TransactionServiceStub{
+		transactions: []*api.Transaction{{Hash: validTransactionHash1, TickNumber: 40000}},
+	}
+	evService := &EventsServiceStub{}
+	service := NewArchiveQueryService(txService, nil, defaultStatusStub(), nil, evService, NewPageSizeLimits(1000, 10))
+
+	response, err := service.GetEventLogsForTransaction(context.Background(), &api.GetEventLogsForTransactionRequest{
+		TransactionHash: validTransactionHash1,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, response.EventLogs)
+	assert.False(t, response.HasMore)
+	assert.Nil(t, evService.ReceivedAfterLogID)
+	assert.Equal(t, uint32(10), evService.ReceivedSize)
+}
+
+func TestArchiveQueryService_GetEventLogsForTransaction_GivenInvalidSize_ThenError(t *testing.T) {
+	service := NewArchiveQueryService(&TransactionServiceStub{}, nil, defaultStatusStub(), nil, &EventsServiceStub{}, NewPageSizeLimits(1000, 10))
+
+	_, err := service.GetEventLogsForTransaction(context.Background(), &api.GetEventLogsForTransactionRequest{
+		TransactionHash: validTransactionHash1,
+		Size:            1001,
+	})
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestArchiveQueryService_GetEventLogsForTransaction_GivenUnknownTransaction_ThenNotFound(t *testing.T) {
+	service := NewArchiveQueryService(&TransactionServiceStub{}, nil, defaultStatusStub(), nil, &EventsServiceStub{}, NewPageSizeLimits(1000, 10))
+
+	_, err := service.GetEventLogsForTransaction(context.Background(), &api.GetEventLogsForTransactionRequest{
+		TransactionHash: validTransactionHash1,
+	})
+	require.Error(t, err)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.NotFound, st.Code())
+	assert.Empty(t, st.Details())
+}
+
+func TestArchiveQueryService_GetEventLogsForTransaction_GivenTickNotProcessed_ThenNotFoundWithLastProcessedTick(t *testing.T) {
+	txService := &TransactionServiceStub{
+		transactions: []*api.Transaction{{Hash: validTransactionHash1, TickNumber: 60000}},
+	}
+	statusStub := &StatusServiceStub{
+		statusResponse: &statusPb.GetStatusResponse{LastProcessedLogTick: 50000},
+	}
+	service := NewArchiveQueryService(txService, nil, statusStub, nil, &EventsServiceStub{}, NewPageSizeLimits(1000, 10))
+
+	_, err := service.GetEventLogsForTransaction(context.Background(), &api.GetEventLogsForTransactionRequest{
+		TransactionHash: validTransactionHash1,
+	})
+	require.Error(t, err)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.NotFound, st.Code())
+	assert.Contains(t, st.Message(), "not processed yet")
+
+	details := st.Details()
+	require.Len(t, details, 1)
+	lpt, ok := details[0].(*api.LastProcessedTick)
+	require.True(t, ok)
+	assert.Equal(t, uint32(50000), lpt.TickNumber)
+}
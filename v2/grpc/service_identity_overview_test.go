@@ -0,0 +1,56 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type IdentityOverviewServiceStub struct {
+	overview          *api.IdentityOverview
+	topCounterparties uint32
+	err               error
+}
+
+func (i *IdentityOverviewServiceStub) GetIdentityOverview(_ context.Context, _ string, topCounterparties uint32) (*api.IdentityOverview, error) {
+	i.topCounterparties = topCounterparties
+	return i.overview, i.err
+}
+
+func TestArchiveQueryService_GetIdentityOverview(t *testing.T) {
+	expected := &api.IdentityOverview{Identity: "ID", IncomingTransactionCount: 42}
+	stub := &IdentityOverviewServiceStub{overview: expected}
+	service := NewArchiveQueryService(nil, nil, nil, nil, nil, NewPageSizeLimits(1000, 10))
+	service.SetIdentityOverviewService(stub)
+
+	response, err := service.GetIdentityOverview(context.Background(), &api.GetIdentityOverviewRequest{Identity: "ID"})
+	require.NoError(t, err)
+	assert.Equal(t, expected, response.Overview)
+	assert.Equal(t, uint32(defaultTopCounterparties), stub.topCounterparties)
+
+	_, err = service.GetIdentityOverview(context.Background(), &api.GetIdentityOverviewRequest{Identity: "ID", TopCounterparties: 5})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(5), stub.topCounterparties)
+}
+
+func TestArchiveQueryService_GetIdentityOverview_GivenTooManyCounterparties_ThenInvalidArgument(t *testing.T) {
+	service := NewArchiveQueryService(nil, nil, nil, nil, nil, NewPageSizeLimits(1000, 10))
+	service.SetIdentityOverviewService(&IdentityOverviewServiceStub{})
+
+	_, err := service.GetIdentityOverview(context.Background(), &api.GetIdentityOverviewRequest{Identity: "ID", TopCounterparties: maxTopCounterparties + 1})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestArchiveQueryService_GetIdentityOverview_GivenError_ThenInternalError(t *testing.T) {
+	service := NewArchiveQueryService(nil, nil, nil, nil, nil, NewPageSizeLimits(1000, 10))
+	service.SetIdentityOverviewService(&IdentityOverviewServiceStub{err: errors.New("test")})
+
+	_, err := service.GetIdentityOverview(context.Background(), &api.GetIdentityOverviewRequest{Identity: "ID"})
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
@@ -1,10 +1,17 @@
 package grpc
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	"github.com/qubic/archive-query-service/v2/grpc/filters"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestArchiverQueryService_createInternalError(t *testing.T) {
@@ -14,3 +21,77 @@ func TestArchiverQueryService_createInternalError(t *testing.T) {
 	require.Contains(t, result.Error(), "code = Internal")
 	require.NotContains(t, result.Error(), "error details") // don't leak details
 }
+
+// requireFieldViolation verifies that the error is an invalid argument status with the field violation and error info.
+func requireFieldViolation(t *testing.T, err error, field, reason string) {
+	t.Helper()
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+	require.Len(t, st.Details(), 2)
+
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	require.Len(t, badRequest.GetFieldViolations(), 1)
+	violation := badRequest.GetFieldViolations()[0]
+	assert.Equal(t, field, violation.GetField())
+	assert.Equal(t, reason, violation.GetReason())
+	assert.NotEmpty(t, violation.GetDescription())
+
+	info, ok := st.Details()[1].(*errdetails.ErrorInfo)
+	require.True(t, ok)
+	assert.Equal(t, reason, info.GetReason())
+	assert.Equal(t, errorDomain, info.GetDomain())
+	assert.Equal(t, field, info.GetMetadata()["field"])
+}
+
+func TestArchiverQueryService_createInvalidArgumentError(t *testing.T) {
+	err := createInvalidArgumentError("invalid filters", "filters",
+		fmt.Errorf("validating: %w", filters.NewFieldError("amount", filters.ReasonInvalidValue, fmt.Errorf("not a number"))))
+	requireFieldViolation(t, err, "filters.amount", filters.ReasonInvalidValue)
+	assert.Equal(t, "invalid filters: validating: not a number", status.Convert(err).Message())
+
+	err = createInvalidArgumentError("invalid identity", "identity", fmt.Errorf("invalid checksum"))
+	requireFieldViolation(t, err, "identity", filters.ReasonInvalidValue)
+}
+
+func TestArchiveQueryService_GivenInvalidRequests_ThenFieldViolations(t *testing.T) {
+	service := NewArchiveQueryService(nil, nil, defaultStatusStub(), nil, &EventsServiceStub{}, NewPageSizeLimits(1000, 10))
+	ctx := context.Background()
+	identity := "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFXIB"
+
+	_, err := service.GetTransactionsForIdentity(ctx, &api.GetTransactionsForIdentityRequest{Identity: "invalid"})
+	requireFieldViolation(t, err, "identity", filters.ReasonInvalidValue)
+
+	_, err = service.GetTransactionsForIdentity(ctx, &api.GetTransactionsForIdentityRequest{Identity: identity, Filters: map[string]string{"source": "invalid"}})
+	requireFieldViolation(t, err, "filters.source", filters.ReasonInvalidValue)
+
+	_, err = service.GetTransactionsForIdentity(ctx, &api.GetTransactionsForIdentityRequest{Identity: identity, Exclude: map[string]string{"amount": "1"}})
+	requireFieldViolation(t, err, "exclude.amount", filters.ReasonUnsupportedFilter)
+
+	_, err = service.GetTransactionsForIdentity(ctx, &api.GetTransactionsForIdentityRequest{Identity: identity,
+		Filters: map[string]string{"source-exclude": identity}, Exclude: map[string]string{"source": identity}})
+	requireFieldViolation(t, err, "exclude", filters.ReasonDuplicateFilter)
+
+	_, err = service.GetTransactionsForIdentity(ctx, &api.GetTransactionsForIdentityRequest{Identity: identity,
+		Ranges: map[string]*api.Range{"amount": {LowerBound: &api.Range_Gte{Gte: "x"}}}})
+	requireFieldViolation(t, err, "ranges.amount.gte", filters.ReasonInvalidValue)
+
+	_, err = service.GetTransactionsForIdentity(ctx, &api.GetTransactionsForIdentityRequest{Identity: identity, Pagination: &api.Pagination{Size: 1001}})
+	requireFieldViolation(t, err, "pagination.size", reasonLimitExceeded)
+
+	_, err = service.GetEventLogs(ctx, &api.GetEventLogsRequest{Should: []*api.ShouldFilter{{Terms: map[string]string{"source": identity}}}})
+	requireFieldViolation(t, err, "should[0]", filters.ReasonNotEnoughFilters)
+
+	_, err = service.GetEventLogs(ctx, &api.GetEventLogsRequest{Pagination: &api.Pagination{Offset: 10000, Size: 10}})
+	requireFieldViolation(t, err, "pagination.offset", reasonLimitExceeded)
+
+	_, err = service.GetTransactionsForTick(ctx, &api.GetTransactionsForTickRequest{Filters: map[string]string{"tickNumber": "1"}})
+	requireFieldViolation(t, err, "filters.tickNumber", filters.ReasonUnsupportedFilter)
+
+	_, err = service.GetTransactionsForTick(ctx, &api.GetTransactionsForTickRequest{Ranges: map[string]*api.Range{"amount": {}}})
+	requireFieldViolation(t, err, "ranges.amount", filters.ReasonInvalidRange)
+
+	_, err = service.GetEventLogsForTransaction(ctx, &api.GetEventLogsForTransactionRequest{Size: 1001})
+	requireFieldViolation(t, err, "size", reasonLimitExceeded)
+}
@@ -5,11 +5,24 @@ import (
 	"testing"
 
 	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	"github.com/qubic/archive-query-service/v2/entities"
+	statusPb "github.com/qubic/go-data-publisher/status-service/protobuf"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type TickDataServiceStub struct {
-	tickData *api.TickData
+	tickData      *api.TickData
+	tickDataRange []*api.TickData
+	epochTicks    *entities.EpochTicksResult
+	emptyTicks    *entities.EmptyTicksResult
+	computorStats []*api.ComputorTickStats
+	receivedEpoch uint32
+	receivedFrom  uint32
+	receivedSize  uint32
+	receivedDesc  bool
 }
 
 func (t *TickDataServiceStub) GetTickData(_ context.Context, tickNumber uint32) (*api.TickData, error) {
@@ -19,6 +32,26 @@ func (t *TickDataServiceStub) GetTickData(_ context.Context, tickNumber uint32)
 	return nil, nil
 }
 
+func (t *TickDataServiceStub) GetTickDataRange(_ context.Context, fromTick, toTick uint32) ([]*api.TickData, error) {
+	t.receivedFrom, t.receivedSize = fromTick, toTick-fromTick+1
+	return t.tickDataRange, nil
+}
+
+func (t *TickDataServiceStub) GetTicksForEpoch(_ context.Context, epoch, from, size uint32, desc bool) (*entities.EpochTicksResult, error) {
+	t.receivedEpoch, t.receivedFrom, t.receivedSize, t.receivedDesc = epoch, from, size, desc
+	return t.epochTicks, nil
+}
+
+func (t *TickDataServiceStub) GetEmptyTicksForEpoch(_ context.Context, epoch, from, size uint32) (*entities.EmptyTicksResult, error) {
+	t.receivedEpoch, t.receivedFrom, t.receivedSize = epoch, from, size
+	return t.emptyTicks, nil
+}
+
+func (t *TickDataServiceStub) GetComputorTickStats(_ context.Context, epoch uint32) ([]*api.ComputorTickStats, error) {
+	t.receivedEpoch = epoch
+	return t.computorStats, nil
+}
+
 func TestArchiverQueryService_GetTickData(t *testing.T) {
 	expected := &api.TickData{TickNumber: 42}
 
@@ -29,6 +62,7 @@ func TestArchiverQueryService_GetTickData(t *testing.T) {
 	response, err := service.GetTickData(context.Background(), &api.GetTickDataRequest{TickNumber: 42})
 	require.NoError(t, err)
 	require.Equal(t, expected, response.TickData)
+	require.False(t, response.IsEmpty)
 }
 
 func TestArchiverQueryService_GetTickData_GivenNoTickData_ThenReturnEmptyTickData(t *testing.T) {
@@ -41,4 +75,153 @@ func TestArchiverQueryService_GetTickData_GivenNoTickData_ThenReturnEmptyTickDat
 	response, err := service.GetTickData(context.Background(), &api.GetTickDataRequest{TickNumber: 666})
 	require.NoError(t, err)
 	require.Nil(t, response.TickData)
+	require.True(t, response.IsEmpty)
+}
+
+func TestArchiveQueryService_GetTickDataRange(t *testing.T) {
+	tdService := &TickDataServiceStub{tickDataRange: []*api.TickData{{TickNumber: 101}, {TickNumber: 104}}}
+	statusService := &StatusServiceStub{
+		statusResponse: &statusPb.GetStatusResponse{LastProcessedTick: 200},
+		intervals: []*api.ProcessedTickInterval{
+			{Epoch: 99, FirstTick: 1, LastTick: 99},
+			{Epoch: 100, FirstTick: 101, LastTick: 200},
+		},
+	}
+	service := NewArchiveQueryService(nil, tdService, statusService, nil, nil, NewPageSizeLimits(1000, 10))
+
+	response, err := service.GetTickDataRange(context.Background(), &api.GetTickDataRangeRequest{StartTick: 99, EndTick: 104})
+	require.NoError(t, err)
+	assert.Equal(t, []*api.RangeTickData{
+		{TickNumber: 99, IsEmpty: true},
+		{TickNumber: 100, IsSkipped: true},
+		{TickNumber: 101, TickData: &api.TickData{TickNumber: 101}},
+		{TickNumber: 102, IsEmpty: true},
+		{TickNumber: 103, IsEmpty: true},
+		{TickNumber: 104, TickData: &api.TickData{TickNumber: 104}},
+	}, response.Ticks)
+	assert.Equal(t, uint32(99), tdService.receivedFrom)
+	assert.Equal(t, uint32(6), tdService.receivedSize)
+}
+
+func TestArchiveQueryService_GetTickDataRange_GivenInvalidRange_ThenError(t *testing.T) {
+	service := NewArchiveQueryService(nil, &TickDataServiceStub{}, defaultStatusStub(), nil, nil, NewPageSizeLimits(1000, 10))
+
+	for _, request := range []*api.GetTickDataRangeRequest{
+		{StartTick: 0, EndTick: 10},
+		{StartTick: 10, EndTick: 9},
+		{StartTick: 1, EndTick: 101},
+	} {
+		_, err := service.GetTickDataRange(context.Background(), request)
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+}
+
+func TestArchiveQueryService_GetTickDataRange_GivenEndAfterLastProcessedTick_ThenError(t *testing.T) {
+	statusService := &StatusServiceStub{statusResponse: &statusPb.GetStatusResponse{LastProcessedTick: 200}}
+	service := NewArchiveQueryService(nil, &TickDataServiceStub{}, statusService, nil, nil, NewPageSizeLimits(1000, 10))
+
+	_, err := service.GetTickDataRange(context.Background(), &api.GetTickDataRangeRequest{StartTick: 190, EndTick: 201})
+	require.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestArchiveQueryService_GetTicksForEpoch(t *testing.T) {
+	tdService := &TickDataServiceStub{epochTicks: &entities.EpochTicksResult{
+		Total: 500000,
+		Ticks: []*api.EpochTick{{TickNumber: 20001, IsEmpty: true}, {TickNumber: 20000}},
+	}}
+	service := NewArchiveQueryService(nil, tdService, nil, nil, nil, NewPageSizeLimits(1000, 10))
+
+	response, err := service.GetTicksForEpoch(context.Background(), &api.GetTicksForEpochRequest{
+		Epoch:      100,
+		Pagination: &api.Pagination{Offset: 20000, Size: 2},
+		Desc:       true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, tdService.epochTicks.Ticks, response.Ticks)
+	assert.Equal(t, &api.Hits{Total: 500000, From: 20000, Size: 2}, response.Hits)
+	assert.Equal(t, uint32(100), tdService.receivedEpoch)
+	assert.Equal(t, uint32(20000), tdService.receivedFrom)
+	assert.Equal(t, uint32(2), tdService.receivedSize)
+	assert.True(t, tdService.receivedDesc)
+}
+
+func TestArchiveQueryService_GetTicksForEpoch_GivenUnknownEpoch_ThenNotFound(t *testing.T) {
+	service := NewArchiveQueryService(nil, &TickDataServiceStub{}, nil, nil, nil, NewPageSizeLimits(1000, 10))
+
+	_, err := service.GetTicksForEpoch(context.Background(), &api.GetTicksForEpochRequest{Epoch: 100})
+	require.Error(t, err)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestArchiveQueryService_GetTicksForEpoch_GivenInvalidSize_ThenError(t *testing.T) {
+	service := NewArchiveQueryService(nil, &TickDataServiceStub{}, nil, nil, nil, NewPageSizeLimits(1000, 10))
+
+	_, err := service.GetTicksForEpoch(context.Background(), &api.GetTicksForEpochRequest{Epoch: 100, Pagination: &api.Pagination{Size: 1001}})
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestArchiveQueryService_GetEmptyTicksForEpoch(t *testing.T) {
+	tdService := &TickDataServiceStub{emptyTicks: &entities.EmptyTicksResult{Total: 3, TickNumbers: []uint32{1, 2, 3}}}
+	service := NewArchiveQueryService(nil, tdService, nil, nil, nil, NewPageSizeLimits(1000, 10))
+
+	response, err := service.GetEmptyTicksForEpoch(context.Background(), &api.GetEmptyTicksForEpochRequest{Epoch: 100})
+	require.NoError(t, err)
+	assert.Equal(t, []uint32{1, 2, 3}, response.EmptyTicks)
+	assert.Equal(t, &api.Hits{Total: 3, From: 0, Size: 10}, response.Hits)
+	assert.Equal(t, uint32(10), tdService.receivedSize)
+}
+
+func TestArchiveQueryService_GetEmptyTicksForEpoch_GivenUnknownEpoch_ThenNotFound(t *testing.T) {
+	service := NewArchiveQueryService(nil, &TickDataServiceStub{}, nil, nil, nil, NewPageSizeLimits(1000, 10))
+
+	_, err := service.GetEmptyTicksForEpoch(context.Background(), &api.GetEmptyTicksForEpochRequest{Epoch: 100})
+	require.Error(t, err)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestArchiveQueryService_VerifyTick_GivenNoTickData_ThenEmpty(t *testing.T) {
+	service := NewArchiveQueryService(nil, &TickDataServiceStub{tickData: &api.TickData{TickNumber: 42}}, nil, nil, nil, NewPageSizeLimits(1000, 10))
+
+	response, err := service.VerifyTick(context.Background(), &api.VerifyTickRequest{TickNumber: 666})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(666), response.TickNumber)
+	assert.True(t, response.IsEmpty)
+	assert.False(t, response.Valid)
+}
+
+func TestArchiveQueryService_VerifyTick_GivenMissingTransactions_ThenInvalid(t *testing.T) {
+	tdService := &TickDataServiceStub{tickData: &api.TickData{
+		TickNumber:        42,
+		Epoch:             100,
+		TransactionHashes: []string{"tx-hash-1", "tx-hash-2", "tx-hash-3"},
+	}}
+	txService := &TransactionServiceStub{transactions: []*api.Transaction{
+		{Hash: "tx-hash-1", TickNumber: 42},
+		{Hash: "tx-hash-3", TickNumber: 42},
+	}}
+	clService := &ComputorsServiceStub{computors: []*api.ComputorList{{Epoch: 100, TickNumber: 10}}}
+	service := NewArchiveQueryService(txService, tdService, nil, clService, nil, NewPageSizeLimits(1000, 10))
+
+	response, err := service.VerifyTick(context.Background(), &api.VerifyTickRequest{TickNumber: 42})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(42), response.TickNumber)
+	assert.False(t, response.IsEmpty)
+	assert.False(t, response.ComputorListSignatureValid)
+	assert.False(t, response.TickSignatureValid)
+	assert.False(t, response.TransactionsComplete)
+	assert.Equal(t, []string{"tx-hash-2"}, response.MissingTransactionHashes)
+	assert.False(t, response.Valid)
+}
+
+func TestArchiveQueryService_computorListForTick(t *testing.T) {
+	computorLists := []*api.ComputorList{{TickNumber: 300}, {TickNumber: 200}, {TickNumber: 100}}
+
+	assert.Equal(t, uint32(300), computorListForTick(computorLists, 300).TickNumber)
+	assert.Equal(t, uint32(200), computorListForTick(computorLists, 299).TickNumber)
+	assert.Equal(t, uint32(100), computorListForTick(computorLists, 150).TickNumber)
+	assert.Equal(t, uint32(100), computorListForTick(computorLists, 50).TickNumber)
+	assert.Nil(t, computorListForTick(nil, 50))
 }
@@ -2,10 +2,14 @@ package grpc
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
 	"testing"
 
 	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
 	"github.com/qubic/archive-query-service/v2/entities"
+	statusPb "github.com/qubic/go-data-publisher/status-service/protobuf"
+	"github.com/qubic/go-node-connector/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
@@ -206,7 +210,8 @@ func TestArchiveQueryService_GetTransactionsForIdentity_DeprecatedApiMismatchErr
 	}
 
 	_, err := service.GetTransactionsForIdentity(ctx, request)
-	require.ErrorContains(t, err, "cannot use both")
+	require.Error(t, err)
+	assert.Equal(t, "cannot use both -exclude filters postfix and exclude filters together", status.Convert(err).Message())
 }
 
 func TestArchiveQueryService_GetTransactionsForIdentity_GivenInvalidExcludeFilter_ThenErrors(t *testing.T) {
@@ -220,3 +225,172 @@ func TestArchiveQueryService_GetTransactionsForIdentity_GivenInvalidExcludeFilte
 	_, err := service.GetTransactionsForIdentity(nil, request)
 	require.ErrorContains(t, err, "unsupported exclude filter")
 }
+
+func TestArchiveQueryService_GetTransactionDetails(t *testing.T) {
+	tx := &api.Transaction{Hash: validTransactionHash1, TickNumber: 42}
+	txService := &TransactionServiceStub{transactions: []*api.Transaction{tx}}
+	tdService := &TickDataServiceStub{tickData: &api.TickData{
+		TickNumber:        42,
+		Epoch:             100,
+		ComputorIndex:     7,
+		Timestamp:         1234567890,
+		TransactionHashes: []string{"other-hash", validTransactionHash1},
+	}}
+	evService := &EventsServiceStub{events: []*api.Event{{LogId: 1}, {LogId: 2}}, hasMore: true}
+	service := NewArchiveQueryService(txService, tdService, defaultStatusStub(), nil, evService, NewPageSizeLimits(1000, 10))
+
+	response, err := service.GetTransactionDetails(context.Background(), &api.GetTransactionDetailsRequest{Hash: validTransactionHash1})
+	require.NoError(t, err)
+	assert.Equal(t, tx, response.Transaction)
+	assert.Equal(t, uint32(100), response.Epoch)
+	assert.Equal(t, uint32(7), response.ComputorIndex)
+	assert.Equal(t, uint64(1234567890), response.TickTimestamp)
+	assert.True(t, response.IncludedInTick)
+	assert.True(t, response.EventLogsAvailable)
+	assert.Len(t, response.EventLogs, 2)
+	assert.True(t, response.HasMoreEventLogs)
+	assert.Equal(t, uint32(999999), response.ValidForTick)
+	assert.Nil(t, evService.ReceivedAfterLogID)
+	assert.Equal(t, uint32(1000), evService.ReceivedSize)
+}
+
+func TestArchiveQueryService_GetTransactionDetails_GivenEmptyTick_ThenNotIncluded(t *testing.T) {
+	tx := &api.Transaction{Hash: validTransactionHash1, TickNumber: 42}
+	txService := &TransactionServiceStub{transactions: []*api.Transaction{tx}}
+	tdService := &TickDataServiceStub{tickData: &api.TickData{TickNumber: 43}}
+	service := NewArchiveQueryService(txService, tdService, defaultStatusStub(), nil, &EventsServiceStub{}, NewPageSizeLimits(1000, 10))
+
+	response, err := service.GetTransactionDetails(context.Background(), &api.GetTransactionDetailsRequest{Hash: validTransactionHash1})
+	require.NoError(t, err)
+	assert.Equal(t, tx, response.Transaction)
+	assert.Zero(t, response.Epoch)
+	assert.False(t, response.IncludedInTick)
+	assert.True(t, response.EventLogsAvailable)
+	assert.Empty(t, response.EventLogs)
+}
+
+func TestArchiveQueryService_GetTransactionDetails_GivenEventsNotProcessed_ThenPartialResult(t *testing.T) {
+	tx := &api.Transaction{Hash: validTransactionHash1, TickNumber: 60000}
+	txService := &TransactionServiceStub{transactions: []*api.Transaction{tx}}
+	tdService := &TickDataServiceStub{tickData: &api.TickData{TickNumber: 60000, Epoch: 100, TransactionHashes: []string{validTransactionHash1}}}
+	statusStub := &StatusServiceStub{statusResponse: &statusPb.GetStatusResponse{LastProcessedLogTick: 50000}}
+	evService := &EventsServiceStub{err: errors.New("must not be called")}
+	service := NewArchiveQueryService(txService, tdService, statusStub, nil, evService, NewPageSizeLimits(1000, 10))
+
+	response, err := service.GetTransactionDetails(context.Background(), &api.GetTransactionDetailsRequest{Hash: validTransactionHash1})
+	require.NoError(t, err)
+	assert.Equal(t, tx, response.Transaction)
+	assert.Equal(t, uint32(100), response.Epoch)
+	assert.True(t, response.IncludedInTick)
+	assert.False(t, response.EventLogsAvailable)
+	assert.Empty(t, response.EventLogs)
+	assert.False(t, response.HasMoreEventLogs)
+	assert.Equal(t, uint32(50000), response.ValidForTick)
+}
+
+func TestArchiveQueryService_GetTransactionDetails_GivenNoTransaction_ThenNotFound(t *testing.T) {
+	service := NewArchiveQueryService(&TransactionServiceStub{}, nil, defaultStatusStub(), nil, &EventsServiceStub{}, NewPageSizeLimits(1000, 10))
+
+	_, err := service.GetTransactionDetails(context.Background(), &api.GetTransactionDetailsRequest{Hash: validTransactionHash1})
+	require.Equal(t, status.Error(codes.NotFound, "transaction not found"), err)
+}
+
+func TestArchiveQueryService_GetTransactionDetails_GivenEventsError_ThenInternalError(t *testing.T) {
+	txService := &TransactionServiceStub{transactions: []*api.Transaction{{Hash: validTransactionHash1, TickNumber: 42}}}
+	tdService := &TickDataServiceStub{tickData: &api.TickData{TickNumber: 42}}
+	evService := &EventsServiceStub{err: errors.New("elasticsearch unavailable")}
+	service := NewArchiveQueryService(txService, tdService, defaultStatusStub(), nil, evService, NewPageSizeLimits(1000, 10))
+
+	_, err := service.GetTransactionDetails(context.Background(), &api.GetTransactionDetailsRequest{Hash: validTransactionHash1})
+	require.Error(t, err)
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestArchiverQueryService_GetTransactionByHash_GivenDecodeInput_ThenDecodedInput(t *testing.T) {
+	payload, err := types.NewAssetTransferPayload("QX", "CAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAACNKL", "DAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAANMIG", 42)
+	require.NoError(t, err)
+	input, err := payload.MarshallBinary()
+	require.NoError(t, err)
+
+	txService := &TransactionServiceStub{transactions: []*api.Transaction{{
+		Hash:        "tx-hash",
+		Destination: types.QxAddress,
+		InputType:   types.QxTransferInputType,
+		InputData:   base64.StdEncoding.EncodeToString(input),
+	}}}
+	service := NewArchiveQueryService(txService, nil, nil, nil, nil, NewPageSizeLimits(1000, 10))
+
+	response, err := service.GetTransactionByHash(context.Background(), &api.GetTransactionByHashRequest{Hash: "tx-hash"})
+	require.NoError(t, err)
+	assert.Nil(t, response.Transaction.DecodedInput)
+
+	response, err = service.GetTransactionByHash(context.Background(), &api.GetTransactionByHashRequest{Hash: "tx-hash", DecodeInput: true})
+	require.NoError(t, err)
+	require.NotNil(t, response.Transaction.GetDecodedInput().GetQxTransferShare())
+	assert.Equal(t, int64(42), response.Transaction.GetDecodedInput().GetQxTransferShare().NumberOfShares)
+}
+
+func signedTransaction(t *testing.T) *api.Transaction {
+	t.Helper()
+	seed := "testseedtestseedtestseedtestseedtestseedtestseedtestsee"
+	wallet, err := types.NewWallet(seed)
+	require.NoError(t, err)
+
+	destination := types.Identity(types.QxAddress)
+	destinationPubKey, err := destination.ToPubKey(false)
+	require.NoError(t, err)
+
+	tx := types.Transaction{SourcePublicKey: wallet.PubKey, DestinationPublicKey: destinationPubKey, Amount: 1000, Tick: 42}
+	require.NoError(t, tx.Sign(seed))
+	hash, err := tx.ID()
+	require.NoError(t, err)
+
+	return &api.Transaction{
+		Hash:        hash,
+		Amount:      1000,
+		Source:      wallet.Identity.String(),
+		Destination: types.QxAddress,
+		TickNumber:  42,
+		Signature:   base64.StdEncoding.EncodeToString(tx.Signature[:]),
+	}
+}
+
+func TestArchiveQueryService_VerifyTransaction_GivenHash(t *testing.T) {
+	tx := signedTransaction(t)
+	service := NewArchiveQueryService(&TransactionServiceStub{transactions: []*api.Transaction{tx}}, nil, nil, nil, nil, NewPageSizeLimits(1000, 10))
+
+	response, err := service.VerifyTransaction(context.Background(), &api.VerifyTransactionRequest{Hash: tx.Hash})
+	require.NoError(t, err)
+	assert.Equal(t, tx.Hash, response.Hash)
+	assert.Equal(t, tx.Hash, response.CalculatedHash)
+	assert.True(t, response.HashValid)
+	assert.True(t, response.SignatureValid)
+	assert.True(t, response.Valid)
+}
+
+func TestArchiveQueryService_VerifyTransaction_GivenModifiedTransaction_ThenInvalid(t *testing.T) {
+	tx := signedTransaction(t)
+	tx.Amount = 1
+	service := NewArchiveQueryService(&TransactionServiceStub{}, nil, nil, nil, nil, NewPageSizeLimits(1000, 10))
+
+	response, err := service.VerifyTransaction(context.Background(), &api.VerifyTransactionRequest{Transaction: tx})
+	require.NoError(t, err)
+	assert.Equal(t, tx.Hash, response.Hash)
+	assert.NotEqual(t, tx.Hash, response.CalculatedHash)
+	assert.False(t, response.HashValid)
+	assert.False(t, response.SignatureValid)
+	assert.False(t, response.Valid)
+}
+
+func TestArchiveQueryService_VerifyTransaction_GivenInvalidRequest_ThenError(t *testing.T) {
+	service := NewArchiveQueryService(&TransactionServiceStub{}, nil, nil, nil, nil, NewPageSizeLimits(1000, 10))
+
+	_, err := service.VerifyTransaction(context.Background(), &api.VerifyTransactionRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = service.VerifyTransaction(context.Background(), &api.VerifyTransactionRequest{Hash: validTransactionHash1})
+	require.Equal(t, status.Error(codes.NotFound, "transaction not found"), err)
+
+	_, err = service.VerifyTransaction(context.Background(), &api.VerifyTransactionRequest{Transaction: &api.Transaction{Source: "invalid"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
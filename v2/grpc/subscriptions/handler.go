@@ -0,0 +1,301 @@
+package subscriptions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	rpc "github.com/qubic/archive-query-service/v2/grpc"
+	statusPb "github.com/qubic/go-data-publisher/status-service/protobuf"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	writeTimeout      = 10 * time.Second
+	keepAliveInterval = 30 * time.Second
+	maxClientMessage  = 64 * 1024
+)
+
+// TickWatcher notifies about the latest status, if new ticks are processed.
+type TickWatcher interface {
+	Subscribe() (<-chan *statusPb.GetStatusResponse, func())
+}
+
+type Config struct {
+	MaxConnections   int // maximum number of concurrent connections
+	MaxSubscriptions int // maximum number of subscriptions per connection
+	MaxIdentities    int // maximum number of identities per transactions subscription
+}
+
+// Handler serves subscriptions for new ticks, transactions of identities and event logs as server-sent events or
+// over a websocket connection. Notifications are triggered by the tick watcher. Clients that cannot keep up receive
+// the data of several ticks in one notification.
+type Handler struct {
+	watcher     TickWatcher
+	txService   rpc.TransactionsService
+	evService   rpc.EventsService
+	cfg         Config
+	connections chan struct{}
+	mutex       sync.Mutex
+	closed      bool
+	done        chan struct{} // closed on shutdown
+	active      sync.WaitGroup
+}
+
+func NewHandler(watcher TickWatcher, txService rpc.TransactionsService, evService rpc.EventsService, cfg Config) *Handler {
+	return &Handler{
+		watcher:     watcher,
+		txService:   txService,
+		evService:   evService,
+		cfg:         cfg,
+		connections: make(chan struct{}, cfg.MaxConnections),
+		done:        make(chan struct{}),
+	}
+}
+
+// Close ends all connections and rejects new connections.
+func (h *Handler) Close() {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if !h.closed {
+		h.closed = true
+		close(h.done)
+	}
+}
+
+// Wait waits until all connections are finished or the context is done.
+func (h *Handler) Wait(ctx context.Context) error {
+	finished := make(chan struct{})
+	go func() {
+		h.active.Wait()
+		close(finished)
+	}()
+	select {
+	case <-finished:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// acquire registers an active connection. Returns false, if the handler is closed.
+func (h *Handler) acquire() bool {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if h.closed {
+		return false
+	}
+	h.active.Add(1)
+	return true
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !h.acquire() {
+		writeError(w, status.Error(codes.Unavailable, "server is shutting down"))
+		return
+	}
+	defer h.active.Done()
+
+	select {
+	case h.connections <- struct{}{}:
+		defer func() { <-h.connections }()
+	default:
+		writeError(w, status.Error(codes.Unavailable, "too many subscription connections"))
+		return
+	}
+	// the connections are long-lived, so the server read and write timeouts do not apply
+	controller := http.NewResponseController(w)
+	_ = controller.SetReadDeadline(time.Time{})
+	_ = controller.SetWriteDeadline(time.Time{})
+
+	if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+		h.serveWebSocket(w, r)
+	} else {
+		h.serveEvents(w, r)
+	}
+}
+
+// serveEvents streams the notifications as server-sent events. The subscriptions are passed as json array in the
+// subscriptions query parameter and cannot be changed afterward.
+func (h *Handler) serveEvents(w http.ResponseWriter, r *http.Request) {
+	var requests []*subscriptionRequest
+	if err := json.Unmarshal([]byte(r.URL.Query().Get("subscriptions")), &requests); err != nil {
+		writeError(w, status.Errorf(codes.InvalidArgument, "invalid subscriptions parameter: %v", err))
+		return
+	}
+	if len(requests) == 0 {
+		writeError(w, status.Error(codes.InvalidArgument, "no subscriptions"))
+		return
+	}
+	controller := http.NewResponseController(w)
+	conn := &connection{handler: h, send: func(_ context.Context, msg *message) error {
+		data, err := json.Marshal(msg)
+		if err != nil {
+			return fmt.Errorf("marshalling message: %w", err)
+		}
+		return writeEvent(w, controller, fmt.Sprintf("event: %s\ndata: %s\n\n", msg.Type, data))
+	}}
+	for _, request := range requests {
+		if err := conn.subscribe(request); err != nil {
+			writeError(w, err)
+			return
+		}
+	}
+
+	updates, unsubscribe := h.watcher.Subscribe()
+	defer unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no") // disable proxy buffering
+	w.WriteHeader(http.StatusOK)
+	for _, sub := range conn.subscriptions {
+		if err := conn.send(r.Context(), &message{Type: typeSubscribed, SubscriptionID: sub.id}); err != nil {
+			return
+		}
+	}
+
+	keepAlive := time.NewTicker(keepAliveInterval)
+	defer keepAlive.Stop()
+	for {
+		var err error
+		select {
+		case <-r.Context().Done():
+			return
+		case <-h.done:
+			return
+		case latest := <-updates:
+			err = conn.update(r.Context(), latest)
+		case <-keepAlive.C:
+			err = writeEvent(w, controller, ": keep-alive\n\n")
+		}
+		if err != nil {
+			log.Printf("[DEBUG] closing event stream: %v", err)
+			return
+		}
+	}
+}
+
+func writeEvent(w http.ResponseWriter, controller *http.ResponseController, event string) error {
+	_ = controller.SetWriteDeadline(time.Now().Add(writeTimeout)) // not supported by all writers
+	if _, err := w.Write([]byte(event)); err != nil {
+		return fmt.Errorf("writing event: %w", err)
+	}
+	return controller.Flush()
+}
+
+// clientMessage subscribes or unsubscribes over the websocket connection.
+type clientMessage struct {
+	Action string `json:"action"` // subscribe or unsubscribe
+	subscriptionRequest
+}
+
+// serveWebSocket sends the notifications as json text messages. The client subscribes and unsubscribes by sending
+// messages, too.
+func (h *Handler) serveWebSocket(w http.ResponseWriter, r *http.Request) {
+	ws, err := websocket.Accept(w, r, &websocket.AcceptOptions{OriginPatterns: []string{"*"}}) // public read only data
+	if err != nil {
+		log.Printf("[DEBUG] accepting websocket: %v", err)
+		return
+	}
+	defer ws.CloseNow() //nolint:errcheck
+	ws.SetReadLimit(maxClientMessage)
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	requests := make(chan *clientMessage)
+	go func() {
+		defer cancel()
+		for {
+			var msg clientMessage
+			if err := wsjson.Read(ctx, ws, &msg); err != nil {
+				return
+			}
+			select {
+			case requests <- &msg:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	conn := &connection{handler: h, send: func(ctx context.Context, msg *message) error {
+		ctx, cancel := context.WithTimeout(ctx, writeTimeout)
+		defer cancel()
+		return wsjson.Write(ctx, ws, msg)
+	}}
+	updates, unsubscribe := h.watcher.Subscribe()
+	defer unsubscribe()
+
+	keepAlive := time.NewTicker(keepAliveInterval)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-h.done:
+			_ = ws.Close(websocket.StatusGoingAway, "server is shutting down")
+			return
+		case request := <-requests:
+			err = conn.send(ctx, conn.handle(request))
+		case latest := <-updates:
+			err = conn.update(ctx, latest)
+		case <-keepAlive.C:
+			pingCtx, cancelPing := context.WithTimeout(ctx, writeTimeout)
+			err = ws.Ping(pingCtx)
+			cancelPing()
+		}
+		if err != nil {
+			log.Printf("[DEBUG] closing websocket: %v", err)
+			return
+		}
+	}
+}
+
+// handle processes a client message and returns the response.
+func (c *connection) handle(request *clientMessage) *message {
+	var err error
+	response := &message{SubscriptionID: request.ID}
+	switch request.Action {
+	case "subscribe":
+		err = c.subscribe(&request.subscriptionRequest)
+		response.Type = typeSubscribed
+	case "unsubscribe":
+		err = c.unsubscribe(request.ID)
+		response.Type = typeUnsubscribed
+	default:
+		err = status.Errorf(codes.InvalidArgument, "unsupported action [%s]", request.Action)
+	}
+	if err != nil {
+		response.Type = typeError
+		response.Error = status.Convert(err).Message()
+	}
+	return response
+}
+
+// writeError writes the error in the same format as the http gateway.
+func writeError(w http.ResponseWriter, err error) {
+	st, ok := status.FromError(err)
+	if !ok {
+		st = status.New(codes.Internal, "internal error")
+	}
+	body, err := protojson.Marshal(st.Proto())
+	if err != nil {
+		body = []byte(`{"code":13,"message":"internal error"}`)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(runtime.HTTPStatusFromCode(st.Code()))
+	if _, err = w.Write(body); err != nil {
+		log.Printf("[WARN] writing subscription error: %v", err)
+	}
+}
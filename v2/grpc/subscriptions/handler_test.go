@@ -0,0 +1,218 @@
+package subscriptions
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	"github.com/qubic/archive-query-service/v2/entities"
+	"github.com/qubic/archive-query-service/v2/grpc/mock"
+	statusPb "github.com/qubic/go-data-publisher/status-service/protobuf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	identity1 = "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFXIB"
+	identity2 = "BAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAARMID"
+)
+
+type watcherStub struct {
+	updates chan *statusPb.GetStatusResponse
+}
+
+func (w *watcherStub) Subscribe() (<-chan *statusPb.GetStatusResponse, func()) {
+	return w.updates, func() {}
+}
+
+func newTestServer(t *testing.T, cfg Config) (*httptest.Server, *watcherStub, *mock.MockTransactionsService, *mock.MockEventsService) {
+	ctrl := gomock.NewController(t)
+	watcher := &watcherStub{updates: make(chan *statusPb.GetStatusResponse)}
+	txService := mock.NewMockTransactionsService(ctrl)
+	evService := mock.NewMockEventsService(ctrl)
+	server := httptest.NewServer(NewHandler(watcher, txService, evService, cfg))
+	t.Cleanup(server.Close)
+	return server, watcher, txService, evService
+}
+
+func tickRangeMatcher(fromTick, toTick string) gomock.Matcher {
+	return gomock.Cond(func(filters entities.Filters) bool {
+		return assert.ObjectsAreEqual([]entities.Range{{Operation: "gte", Value: fromTick}, {Operation: "lte", Value: toTick}}, filters.Ranges["tickNumber"])
+	})
+}
+
+func TestHandler_ServerSentEvents(t *testing.T) {
+	server, watcher, _, evService := newTestServer(t, Config{MaxConnections: 1, MaxSubscriptions: 2, MaxIdentities: 1})
+	evService.EXPECT().GetEvents(gomock.Any(), tickRangeMatcher("10", "12"), uint32(0), uint32(maxNotificationSize), uint32(12)).
+		DoAndReturn(func(_ context.Context, filters entities.Filters, _, _, _ uint32) (*entities.EventsResult, error) {
+			assert.Equal(t, []string{"0"}, filters.Include["logType"])
+			assert.True(t, filters.Ascending)
+			return &entities.EventsResult{Hits: &entities.Hits{Total: 2}, Events: []*api.Event{
+				{TickNumber: 11, LogId: 1}, {TickNumber: 12, LogId: 2},
+			}}, nil
+		})
+
+	subscriptions := `[{"id":"t","type":"ticks"},{"id":"e","type":"eventLogs","eventLogs":{"filters":{"logType":"0"}}}]`
+	response, err := http.Get(server.URL + "?subscriptions=" + url.QueryEscape(subscriptions))
+	require.NoError(t, err)
+	defer response.Body.Close()
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, "text/event-stream", response.Header.Get("Content-Type"))
+
+	events := bufio.NewReader(response.Body)
+	assert.Equal(t, message{Type: typeSubscribed, SubscriptionID: "t"}, readEvent(t, events))
+	assert.Equal(t, message{Type: typeSubscribed, SubscriptionID: "e"}, readEvent(t, events))
+
+	// the first status only sets the current ticks
+	watcher.updates <- &statusPb.GetStatusResponse{ProcessingEpoch: 100, LastProcessedTick: 10, LastProcessedLogTick: 9}
+	watcher.updates <- &statusPb.GetStatusResponse{ProcessingEpoch: 100, LastProcessedTick: 13, LastProcessedLogTick: 12}
+
+	assert.Equal(t, message{Type: typeTicks, SubscriptionID: "t", Epoch: 100, FromTick: 11, ToTick: 13}, readEvent(t, events))
+	eventLogs := readEvent(t, events)
+	assert.Equal(t, typeEventLogs, eventLogs.Type)
+	assert.Equal(t, uint32(10), eventLogs.FromTick)
+	assert.Equal(t, uint32(12), eventLogs.ToTick)
+	assert.False(t, eventLogs.Truncated)
+	require.Len(t, eventLogs.EventLogs, 2)
+	assert.Contains(t, string(eventLogs.EventLogs[0]), `"tickNumber":11`, "sorted by tick")
+}
+
+func readEvent(t *testing.T, reader *bufio.Reader) message {
+	eventType, err := reader.ReadString('\n')
+	require.NoError(t, err)
+	data, err := reader.ReadString('\n')
+	require.NoError(t, err)
+	_, err = reader.ReadString('\n')
+	require.NoError(t, err)
+
+	var msg message
+	require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(data, "data: ")), &msg))
+	assert.Equal(t, "event: "+msg.Type+"\n", eventType)
+	return msg
+}
+
+func TestHandler_ServerSentEvents_GivenInvalidSubscriptions_ThenBadRequest(t *testing.T) {
+	server, _, _, _ := newTestServer(t, Config{MaxConnections: 1, MaxSubscriptions: 1, MaxIdentities: 1})
+
+	for _, subscriptions := range []string{
+		``,
+		`[]`,
+		`[{"id":"1","type":"unknown"}]`,
+		`[{"id":"1","type":"ticks"},{"id":"2","type":"ticks"}]`,
+		`[{"id":"1","type":"transactions","identities":["` + identity1 + `","` + identity2 + `"]}]`,
+		`[{"id":"1","type":"eventLogs","eventLogs":{"ranges":{"tickNumber":{"gt":"1"}}}}]`,
+	} {
+		response, err := http.Get(server.URL + "?subscriptions=" + url.QueryEscape(subscriptions))
+		require.NoError(t, err)
+		_ = response.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode, subscriptions)
+	}
+}
+
+func TestHandler_WebSocket(t *testing.T) {
+	server, watcher, txService, _ := newTestServer(t, Config{MaxConnections: 1, MaxSubscriptions: 1, MaxIdentities: 2})
+	// transactions between both identities are only sent once
+	txService.EXPECT().GetTransactionsForIdentity(gomock.Any(), identity1, tickRangeMatcher("11", "12"), uint32(0), uint32(maxNotificationSize)).
+		Return(&entities.TransactionsResult{Hits: &entities.Hits{Total: 2}, Transactions: []*api.Transaction{{Hash: "a", TickNumber: 11}, {Hash: "b", TickNumber: 12}}}, nil)
+	txService.EXPECT().GetTransactionsForIdentity(gomock.Any(), identity2, tickRangeMatcher("11", "12"), uint32(0), uint32(maxNotificationSize)).
+		Return(&entities.TransactionsResult{Hits: &entities.Hits{Total: 2}, Transactions: []*api.Transaction{{Hash: "c", TickNumber: 11}, {Hash: "b", TickNumber: 12}}}, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	ws, _, err := websocket.Dial(ctx, "ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	require.NoError(t, err)
+	defer ws.CloseNow() //nolint:errcheck
+
+	// the current status is known before subscribing
+	watcher.updates <- &statusPb.GetStatusResponse{ProcessingEpoch: 100, LastProcessedTick: 10}
+
+	require.NoError(t, wsjson.Write(ctx, ws, map[string]any{"action": "subscribe", "id": "1", "type": "transactions", "identities": []string{identity2, identity1}}))
+	assert.Equal(t, message{Type: typeSubscribed, SubscriptionID: "1"}, readMessage(ctx, t, ws))
+
+	require.NoError(t, wsjson.Write(ctx, ws, map[string]any{"action": "subscribe", "id": "2", "type": "ticks"}))
+	assert.Equal(t, message{Type: typeError, SubscriptionID: "2", Error: "too many subscriptions. maximum: 1"}, readMessage(ctx, t, ws))
+
+	watcher.updates <- &statusPb.GetStatusResponse{ProcessingEpoch: 100, LastProcessedTick: 12}
+	transactions := readMessage(ctx, t, ws)
+	assert.Equal(t, typeTransactions, transactions.Type)
+	assert.Equal(t, uint32(11), transactions.FromTick)
+	assert.Equal(t, uint32(12), transactions.ToTick)
+	assert.False(t, transactions.Truncated)
+	require.Len(t, transactions.Transactions, 3)
+	assert.Contains(t, string(transactions.Transactions[0]), `"hash":"a"`)
+	assert.Contains(t, string(transactions.Transactions[1]), `"hash":"c"`)
+	assert.Contains(t, string(transactions.Transactions[2]), `"hash":"b"`)
+
+	require.NoError(t, wsjson.Write(ctx, ws, map[string]any{"action": "unsubscribe", "id": "1"}))
+	assert.Equal(t, message{Type: typeUnsubscribed, SubscriptionID: "1"}, readMessage(ctx, t, ws))
+
+	// no more queries after unsubscribing
+	watcher.updates <- &statusPb.GetStatusResponse{ProcessingEpoch: 100, LastProcessedTick: 13}
+	require.NoError(t, wsjson.Write(ctx, ws, map[string]any{"action": "unsubscribe", "id": "1"}))
+	assert.Equal(t, message{Type: typeError, SubscriptionID: "1", Error: "unknown subscription id [1]"}, readMessage(ctx, t, ws))
+}
+
+func readMessage(ctx context.Context, t *testing.T, ws *websocket.Conn) message {
+	var msg message
+	require.NoError(t, wsjson.Read(ctx, ws, &msg))
+	return msg
+}
+
+func TestHandler_GivenTooManyConnections_ThenUnavailable(t *testing.T) {
+	server, _, _, _ := newTestServer(t, Config{MaxConnections: 1, MaxSubscriptions: 1, MaxIdentities: 1})
+
+	response, err := http.Get(server.URL + "?subscriptions=" + url.QueryEscape(`[{"id":"t","type":"ticks"}]`))
+	require.NoError(t, err)
+	defer response.Body.Close()
+	require.Equal(t, http.StatusOK, response.StatusCode)
+
+	other, err := http.Get(server.URL + "?subscriptions=" + url.QueryEscape(`[{"id":"t","type":"ticks"}]`))
+	require.NoError(t, err)
+	_ = other.Body.Close()
+	assert.Equal(t, http.StatusServiceUnavailable, other.StatusCode)
+}
+
+func TestHandler_Close_ThenConnectionsEnded(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	handler := NewHandler(&watcherStub{updates: make(chan *statusPb.GetStatusResponse)}, mock.NewMockTransactionsService(ctrl),
+		mock.NewMockEventsService(ctrl), Config{MaxConnections: 2, MaxSubscriptions: 1, MaxIdentities: 1})
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	response, err := http.Get(server.URL + "?subscriptions=" + url.QueryEscape(`[{"id":"t","type":"ticks"}]`))
+	require.NoError(t, err)
+	defer response.Body.Close()
+	events := bufio.NewReader(response.Body)
+	assert.Equal(t, message{Type: typeSubscribed, SubscriptionID: "t"}, readEvent(t, events))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	ws, _, err := websocket.Dial(ctx, "ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	require.NoError(t, err)
+	defer ws.CloseNow() //nolint:errcheck
+	require.NoError(t, wsjson.Write(ctx, ws, map[string]any{"action": "subscribe", "id": "t", "type": "ticks"}))
+	assert.Equal(t, message{Type: typeSubscribed, SubscriptionID: "t"}, readMessage(ctx, t, ws))
+
+	handler.Close()
+	_, err = events.ReadString('\n')
+	assert.ErrorIs(t, err, io.EOF, "event stream ended")
+	_, _, err = ws.Read(ctx)
+	assert.Equal(t, websocket.StatusGoingAway, websocket.CloseStatus(err))
+	require.NoError(t, handler.Wait(ctx))
+
+	other, err := http.Get(server.URL + "?subscriptions=" + url.QueryEscape(`[{"id":"t","type":"ticks"}]`))
+	require.NoError(t, err)
+	_ = other.Body.Close()
+	assert.Equal(t, http.StatusServiceUnavailable, other.StatusCode)
+}
@@ -0,0 +1,264 @@
+package subscriptions
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"slices"
+
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	"github.com/qubic/archive-query-service/v2/domain"
+	"github.com/qubic/archive-query-service/v2/entities"
+	rpc "github.com/qubic/archive-query-service/v2/grpc"
+	"github.com/qubic/archive-query-service/v2/grpc/utils"
+	statusPb "github.com/qubic/go-data-publisher/status-service/protobuf"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	typeTicks        = "ticks"
+	typeTransactions = "transactions"
+	typeEventLogs    = "eventLogs"
+	typeSubscribed   = "subscribed"
+	typeUnsubscribed = "unsubscribed"
+	typeError        = "error"
+
+	// maxNotificationSize is the maximum number of transactions or event logs per notification and the page size of
+	// the queries. If there are more results for the new ticks, they are sent in several notifications.
+	maxNotificationSize = domain.MaxNotificationItems
+)
+
+// subscriptionRequest subscribes to new ticks, to the transactions of identities or to event logs. The event logs
+// filter has the same format as the /getEventLogs request body. Pagination is ignored.
+type subscriptionRequest struct {
+	ID         string          `json:"id"`
+	Type       string          `json:"type"`
+	Identities []string        `json:"identities,omitempty"`
+	EventLogs  json.RawMessage `json:"eventLogs,omitempty"`
+}
+
+// message is sent to the clients. Transactions and event logs have the same json format as in the api responses.
+type message struct {
+	Type           string            `json:"type"`
+	SubscriptionID string            `json:"subscriptionId,omitempty"`
+	Epoch          uint32            `json:"epoch,omitempty"`
+	FromTick       uint32            `json:"fromTick,omitempty"`
+	ToTick         uint32            `json:"toTick,omitempty"`
+	Transactions   []json.RawMessage `json:"transactions,omitempty"`
+	EventLogs      []json.RawMessage `json:"eventLogs,omitempty"`
+	Truncated      bool              `json:"truncated,omitempty"`
+	Error          string            `json:"error,omitempty"`
+}
+
+type subscription struct {
+	id         string
+	kind       string
+	identities []string
+	filters    entities.Filters
+	lastTick   uint32 // last notified tick. zero, if the current tick is not known yet.
+}
+
+// processedTick returns the last tick that is available for the subscription type.
+func (s *subscription) processedTick(latest *statusPb.GetStatusResponse) uint32 {
+	if s.kind == typeEventLogs {
+		return latest.GetLastProcessedLogTick()
+	}
+	return latest.GetLastProcessedTick()
+}
+
+// connection holds the subscriptions of one client. It is not safe for concurrent use.
+type connection struct {
+	handler       *Handler
+	send          func(ctx context.Context, msg *message) error
+	subscriptions []*subscription
+	status        *statusPb.GetStatusResponse // latest status received from the watcher
+}
+
+func (c *connection) subscribe(req *subscriptionRequest) error {
+	if req.ID == "" {
+		return status.Error(codes.InvalidArgument, "missing subscription id")
+	}
+	if c.find(req.ID) >= 0 {
+		return status.Errorf(codes.InvalidArgument, "duplicate subscription id [%s]", req.ID)
+	}
+	if len(c.subscriptions) >= c.handler.cfg.MaxSubscriptions {
+		return status.Errorf(codes.InvalidArgument, "too many subscriptions. maximum: %d", c.handler.cfg.MaxSubscriptions)
+	}
+
+	sub := &subscription{id: req.ID, kind: req.Type}
+	switch req.Type {
+	case typeTicks:
+	case typeTransactions:
+		if len(req.Identities) == 0 || len(req.Identities) > c.handler.cfg.MaxIdentities {
+			return status.Errorf(codes.InvalidArgument, "invalid number of identities [%d]. allowed: 1-%d", len(req.Identities), c.handler.cfg.MaxIdentities)
+		}
+		for _, identity := range req.Identities {
+			if err := utils.ValidateIdentity(identity); err != nil {
+				return status.Errorf(codes.InvalidArgument, "invalid identity: %v", err)
+			}
+		}
+		sub.identities = slices.Compact(slices.Sorted(slices.Values(req.Identities)))
+	case typeEventLogs:
+		filters, err := createEventLogsFilters(req.EventLogs)
+		if err != nil {
+			return err
+		}
+		sub.filters = filters
+	default:
+		return status.Errorf(codes.InvalidArgument, "unsupported subscription type [%s]", req.Type)
+	}
+
+	if c.status != nil {
+		sub.lastTick = sub.processedTick(c.status)
+	}
+	c.subscriptions = append(c.subscriptions, sub)
+	return nil
+}
+
+func createEventLogsFilters(raw json.RawMessage) (entities.Filters, error) {
+	var request api.GetEventLogsRequest
+	if len(raw) > 0 {
+		if err := protojson.Unmarshal(raw, &request); err != nil {
+			return entities.Filters{}, status.Errorf(codes.InvalidArgument, "invalid event logs filter: %v", err)
+		}
+	}
+	return rpc.CreateEventNotificationFilters(&request)
+}
+
+func (c *connection) unsubscribe(id string) error {
+	index := c.find(id)
+	if index < 0 {
+		return status.Errorf(codes.NotFound, "unknown subscription id [%s]", id)
+	}
+	c.subscriptions = slices.Delete(c.subscriptions, index, index+1)
+	return nil
+}
+
+func (c *connection) find(id string) int {
+	return slices.IndexFunc(c.subscriptions, func(sub *subscription) bool { return sub.id == id })
+}
+
+// update notifies all subscriptions about the ticks that were processed since the last notification. If a
+// notification fails, because the data could not be queried, the ticks are included in the next notification.
+func (c *connection) update(ctx context.Context, latest *statusPb.GetStatusResponse) error {
+	c.status = latest
+	for _, sub := range c.subscriptions {
+		toTick := sub.processedTick(latest)
+		if sub.lastTick == 0 || toTick <= sub.lastTick {
+			sub.lastTick = max(sub.lastTick, toTick)
+			continue
+		}
+
+		for sub.lastTick < toTick {
+			messages, notifiedTick, err := c.notifications(ctx, sub, latest.GetProcessingEpoch(), sub.lastTick+1, toTick)
+			if err != nil {
+				log.Printf("[WARN] subscription [%s]: querying ticks [%d-%d]: %v", sub.kind, sub.lastTick+1, toTick, err)
+				break
+			}
+			for _, msg := range messages {
+				if err = c.send(ctx, msg); err != nil {
+					return fmt.Errorf("sending notification: %w", err)
+				}
+			}
+			sub.lastTick = notifiedTick
+		}
+	}
+	return nil
+}
+
+// notifications returns the messages for the given ticks and the last tick that is covered by the messages. There
+// are no messages, if there is nothing to notify.
+func (c *connection) notifications(ctx context.Context, sub *subscription, epoch, fromTick, toTick uint32) ([]*message, uint32, error) {
+	switch sub.kind {
+	case typeTransactions:
+		transactions, notifiedTick, truncated, err := c.transactions(ctx, sub.identities, fromTick, toTick)
+		if err != nil {
+			return nil, 0, err
+		}
+		messages, err := createMessages(transactions, func(msg *message, values []json.RawMessage) { msg.Transactions = values },
+			message{Type: sub.kind, SubscriptionID: sub.id, Epoch: epoch, FromTick: fromTick, ToTick: notifiedTick, Truncated: truncated})
+		return messages, notifiedTick, err
+	case typeEventLogs:
+		events, notifiedTick, truncated, err := c.eventLogs(ctx, sub.filters, fromTick, toTick)
+		if err != nil {
+			return nil, 0, err
+		}
+		messages, err := createMessages(events, func(msg *message, values []json.RawMessage) { msg.EventLogs = values },
+			message{Type: sub.kind, SubscriptionID: sub.id, Epoch: epoch, FromTick: fromTick, ToTick: notifiedTick, Truncated: truncated})
+		return messages, notifiedTick, err
+	default:
+		return []*message{{Type: sub.kind, SubscriptionID: sub.id, Epoch: epoch, FromTick: fromTick, ToTick: toTick}}, toTick, nil
+	}
+}
+
+// createMessages splits the values into messages with at most maxNotificationSize values.
+func createMessages[T proto.Message](values []T, set func(msg *message, values []json.RawMessage), header message) ([]*message, error) {
+	messages := make([]*message, 0, (len(values)+maxNotificationSize-1)/maxNotificationSize)
+	for chunk := range slices.Chunk(values, maxNotificationSize) {
+		marshalled, err := domain.MarshalNotificationItems(chunk)
+		if err != nil {
+			return nil, err
+		}
+		msg := header
+		set(&msg, marshalled)
+		messages = append(messages, &msg)
+	}
+	return messages, nil
+}
+
+// transactions returns the transactions of the identities in ascending tick order, the last notified tick and if
+// the transactions of a single tick were truncated.
+func (c *connection) transactions(ctx context.Context, identities []string, fromTick, toTick uint32) ([]*api.Transaction, uint32, bool, error) {
+	var transactions []*api.Transaction
+	seen := make(map[string]struct{})
+	notifiedTick := toTick
+	truncated := false
+	for _, identity := range identities {
+		filters := entities.Filters{Ranges: domain.TickRange(nil, fromTick, toTick), Ascending: true}
+		identityTransactions, identityTick, identityTruncated, err := domain.CollectTickPages(func(from uint32) ([]*api.Transaction, *entities.Hits, error) {
+			result, err := c.handler.txService.GetTransactionsForIdentity(ctx, identity, filters, from, maxNotificationSize)
+			if err != nil || result == nil {
+				return nil, nil, err
+			}
+			return result.Transactions, result.Hits, nil
+		}, (*api.Transaction).GetTickNumber, fromTick, toTick)
+		if err != nil {
+			return nil, 0, false, fmt.Errorf("getting transactions for identity [%s]: %w", identity, err)
+		}
+		notifiedTick = min(notifiedTick, identityTick)
+		truncated = truncated || identityTruncated
+		for _, tx := range identityTransactions { // transactions between two subscribed identities are only sent once
+			if _, ok := seen[tx.GetHash()]; !ok {
+				seen[tx.GetHash()] = struct{}{}
+				transactions = append(transactions, tx)
+			}
+		}
+	}
+	// the transactions after the notified tick are notified in the next round
+	transactions = slices.DeleteFunc(transactions, func(tx *api.Transaction) bool { return tx.GetTickNumber() > notifiedTick })
+	slices.SortStableFunc(transactions, func(a, b *api.Transaction) int { return cmp.Compare(a.GetTickNumber(), b.GetTickNumber()) })
+	return transactions, notifiedTick, truncated, nil
+}
+
+// eventLogs returns the filtered events in ascending tick order, the last notified tick and if the events of a
+// single tick were truncated.
+func (c *connection) eventLogs(ctx context.Context, filters entities.Filters, fromTick, toTick uint32) ([]*api.Event, uint32, bool, error) {
+	filters.Ranges = domain.TickRange(filters.Ranges, fromTick, toTick)
+	filters.Ascending = true
+	events, notifiedTick, truncated, err := domain.CollectTickPages(func(from uint32) ([]*api.Event, *entities.Hits, error) {
+		result, err := c.handler.evService.GetEvents(ctx, filters, from, maxNotificationSize, toTick)
+		if err != nil || result == nil {
+			return nil, nil, err
+		}
+		return result.Events, result.Hits, nil
+	}, (*api.Event).GetTickNumber, fromTick, toTick)
+	if err != nil {
+		return nil, 0, false, fmt.Errorf("getting event logs: %w", err)
+	}
+	return events, notifiedTick, truncated, nil
+}
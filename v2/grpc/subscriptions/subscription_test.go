@@ -0,0 +1,54 @@
+package subscriptions
+
+import (
+	"context"
+	"testing"
+
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	"github.com/qubic/archive-query-service/v2/entities"
+	"github.com/qubic/archive-query-service/v2/grpc/mock"
+	statusPb "github.com/qubic/go-data-publisher/status-service/protobuf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// testEvents creates count events for each of the ticks.
+func testEvents(count int, ticks ...uint32) []*api.Event {
+	events := make([]*api.Event, 0, count*len(ticks))
+	for _, tick := range ticks {
+		for range count {
+			events = append(events, &api.Event{TickNumber: tick, LogId: uint64(len(events))})
+		}
+	}
+	return events
+}
+
+func TestConnection_Update_GivenMoreEventsThanPageSize_ThenAllEventsNotified(t *testing.T) {
+	evService := mock.NewMockEventsService(gomock.NewController(t))
+	events := testEvents(500, 11, 12, 13)
+	evService.EXPECT().GetEvents(gomock.Any(), tickRangeMatcher("11", "13"), uint32(0), uint32(maxNotificationSize), uint32(13)).
+		Return(&entities.EventsResult{Hits: &entities.Hits{Total: 1500, Relation: "eq"}, Events: events[:1000]}, nil)
+	evService.EXPECT().GetEvents(gomock.Any(), tickRangeMatcher("11", "13"), uint32(maxNotificationSize), uint32(maxNotificationSize), uint32(13)).
+		Return(&entities.EventsResult{Hits: &entities.Hits{Total: 1500, Relation: "eq"}, Events: events[1000:]}, nil)
+
+	var sent []*message
+	c := &connection{
+		handler:       &Handler{evService: evService},
+		send:          func(_ context.Context, msg *message) error { sent = append(sent, msg); return nil },
+		subscriptions: []*subscription{{id: "e", kind: typeEventLogs, lastTick: 10}},
+	}
+	require.NoError(t, c.update(context.Background(), &statusPb.GetStatusResponse{ProcessingEpoch: 100, LastProcessedLogTick: 13}))
+
+	require.Len(t, sent, 2)
+	assert.Len(t, sent[0].EventLogs, maxNotificationSize)
+	assert.Len(t, sent[1].EventLogs, 500)
+	for _, msg := range sent {
+		assert.Equal(t, uint32(11), msg.FromTick)
+		assert.Equal(t, uint32(13), msg.ToTick)
+		assert.False(t, msg.Truncated)
+	}
+	assert.Contains(t, string(sent[0].EventLogs[0]), `"tickNumber":11`, "oldest events first")
+	assert.Contains(t, string(sent[1].EventLogs[499]), `"tickNumber":13`)
+	assert.Equal(t, uint32(13), c.subscriptions[0].lastTick)
+}
@@ -0,0 +1,143 @@
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+const defaultReloadInterval = 10 * time.Second
+
+// KeyPair is a certificate with private key loaded from files. The files are checked for changes at most once per
+// reload interval and reloaded, so that renewed certificates are used without restarting the service. If reloading
+// fails (for example because only one of the files was replaced so far), the previous certificate is kept.
+type KeyPair struct {
+	certFile       string
+	keyFile        string
+	reloadInterval time.Duration
+	mutex          sync.Mutex
+	certificate    *tls.Certificate
+	modTime        time.Time
+	lastCheck      time.Time
+}
+
+func NewKeyPair(certFile, keyFile string) (*KeyPair, error) {
+	kp := &KeyPair{certFile: certFile, keyFile: keyFile, reloadInterval: defaultReloadInterval}
+	modTime, err := kp.latestModTime()
+	if err != nil {
+		return nil, err
+	}
+	certificate, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("loading key pair [%s, %s]: %w", certFile, keyFile, err)
+	}
+	kp.certificate, kp.modTime, kp.lastCheck = &certificate, modTime, time.Now()
+	return kp, nil
+}
+
+// Certificate returns the current certificate and reloads it, if the files changed.
+func (kp *KeyPair) Certificate() *tls.Certificate {
+	kp.mutex.Lock()
+	defer kp.mutex.Unlock()
+	if time.Since(kp.lastCheck) < kp.reloadInterval {
+		return kp.certificate
+	}
+	kp.lastCheck = time.Now()
+
+	modTime, err := kp.latestModTime()
+	if err != nil {
+		log.Printf("[ERROR] checking key pair for changes: %v", err)
+		return kp.certificate
+	}
+	if modTime.Equal(kp.modTime) {
+		return kp.certificate
+	}
+	certificate, err := tls.LoadX509KeyPair(kp.certFile, kp.keyFile)
+	if err != nil {
+		log.Printf("[ERROR] reloading key pair [%s, %s]: %v", kp.certFile, kp.keyFile, err)
+		return kp.certificate
+	}
+	log.Printf("[INFO] reloaded key pair [%s, %s]", kp.certFile, kp.keyFile)
+	kp.certificate, kp.modTime = &certificate, modTime
+	return kp.certificate
+}
+
+func (kp *KeyPair) latestModTime() (time.Time, error) {
+	var latest time.Time
+	for _, file := range []string{kp.certFile, kp.keyFile} {
+		info, err := os.Stat(file)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("reading file info: %w", err)
+		}
+		if info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+	return latest, nil
+}
+
+// ServerConfig creates the tls config of a listener. If a client ca file is given, clients need to present a
+// certificate signed by that ca (mTLS).
+func ServerConfig(certFile, keyFile, clientCAFile string) (*tls.Config, error) {
+	kp, err := NewKeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	cfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return kp.Certificate(), nil
+		},
+	}
+	if clientCAFile != "" {
+		cfg.ClientCAs, err = loadCertPool(clientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return cfg, nil
+}
+
+// ClientConfig creates the tls config of a client. The server certificate is verified with the ca file or with the
+// system certificates, if no ca file is given. If a key pair is given, it is presented as client certificate (mTLS).
+// The server name overrides the host name used for verification.
+func ClientConfig(caFile, certFile, keyFile, serverName string) (*tls.Config, error) {
+	cfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: serverName,
+	}
+	if caFile != "" {
+		pool, err := loadCertPool(caFile)
+		if err != nil {
+			return nil, err
+		}
+		cfg.RootCAs = pool
+	}
+	if certFile != "" || keyFile != "" {
+		kp, err := NewKeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, err
+		}
+		cfg.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return kp.Certificate(), nil
+		}
+	}
+	return cfg, nil
+}
+
+func loadCertPool(caFile string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("reading ca file: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("no certificates found in ca file [%s]", caFile)
+	}
+	return pool, nil
+}
@@ -0,0 +1,173 @@
+package tlsconfig
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	file string
+}
+
+func newTestCA(t *testing.T, dir string) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	file := filepath.Join(dir, "ca.crt")
+	require.NoError(t, os.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	return &testCA{cert: cert, key: key, file: file}
+}
+
+// writeKeyPair creates a certificate for localhost signed by the ca, usable for server and client authentication.
+func (ca *testCA) writeKeyPair(t *testing.T, dir, name string, serial int64) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	certFile, keyFile := filepath.Join(dir, name+".crt"), filepath.Join(dir, name+".key")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+	return certFile, keyFile
+}
+
+// handshake connects the client to the server and returns the peer certificates seen by both sides.
+func handshake(t *testing.T, serverCfg, clientCfg *tls.Config) (*x509.Certificate, []*x509.Certificate, error) {
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", serverCfg)
+	require.NoError(t, err)
+	defer listener.Close()
+
+	clientCerts := make(chan []*x509.Certificate, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			clientCerts <- nil
+			return
+		}
+		defer conn.Close()
+		tlsConn := conn.(*tls.Conn)
+		_ = tlsConn.Handshake()
+		clientCerts <- tlsConn.ConnectionState().PeerCertificates
+	}()
+
+	conn, err := tls.Dial("tcp", listener.Addr().String(), clientCfg)
+	if err != nil {
+		<-clientCerts
+		return nil, nil, err
+	}
+	defer conn.Close()
+	// with tls 1.3 the client certificate is verified after the client handshake, a read reports the rejection
+	_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+	if _, err = conn.Read(make([]byte, 1)); !errors.Is(err, io.EOF) {
+		<-clientCerts
+		return nil, nil, err
+	}
+	return conn.ConnectionState().PeerCertificates[0], <-clientCerts, nil
+}
+
+func TestConfig_MutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t, dir)
+	serverCert, serverKey := ca.writeKeyPair(t, dir, "server", 2)
+	clientCert, clientKey := ca.writeKeyPair(t, dir, "client", 3)
+
+	serverCfg, err := ServerConfig(serverCert, serverKey, ca.file)
+	require.NoError(t, err)
+	clientCfg, err := ClientConfig(ca.file, clientCert, clientKey, "localhost")
+	require.NoError(t, err)
+
+	seenByClient, seenByServer, err := handshake(t, serverCfg, clientCfg)
+	require.NoError(t, err)
+	assert.Equal(t, "server", seenByClient.Subject.CommonName)
+	require.Len(t, seenByServer, 1)
+	assert.Equal(t, "client", seenByServer[0].Subject.CommonName)
+
+	withoutClientCert, err := ClientConfig(ca.file, "", "", "localhost")
+	require.NoError(t, err)
+	_, _, err = handshake(t, serverCfg, withoutClientCert)
+	require.Error(t, err, "client certificate required")
+
+	wrongServerName, err := ClientConfig(ca.file, clientCert, clientKey, "other")
+	require.NoError(t, err)
+	_, _, err = handshake(t, serverCfg, wrongServerName)
+	require.Error(t, err, "server name is verified")
+}
+
+func TestKeyPair_GivenChangedFiles_ThenReload(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t, dir)
+	certFile, keyFile := ca.writeKeyPair(t, dir, "server", 2)
+
+	kp, err := NewKeyPair(certFile, keyFile)
+	require.NoError(t, err)
+	kp.reloadInterval = 0
+	first := kp.Certificate()
+	assert.Same(t, first, kp.Certificate(), "unchanged files are not reloaded")
+
+	ca.writeKeyPair(t, dir, "server", 3)
+	future := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(certFile, future, future))
+	second := kp.Certificate()
+	assert.NotSame(t, first, second)
+	leaf, err := x509.ParseCertificate(second.Certificate[0])
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), leaf.SerialNumber.Int64())
+
+	// invalid files keep the previous certificate
+	require.NoError(t, os.WriteFile(keyFile, []byte("invalid"), 0600))
+	future = future.Add(time.Minute)
+	require.NoError(t, os.Chtimes(keyFile, future, future))
+	assert.Same(t, second, kp.Certificate())
+}
+
+func TestConfig_GivenInvalidFiles_ThenError(t *testing.T) {
+	dir := t.TempDir()
+	invalid := filepath.Join(dir, "invalid.pem")
+	require.NoError(t, os.WriteFile(invalid, []byte("invalid"), 0600))
+
+	_, err := ServerConfig(filepath.Join(dir, "missing.crt"), filepath.Join(dir, "missing.key"), "")
+	require.Error(t, err)
+	_, err = ServerConfig(invalid, invalid, "")
+	require.ErrorContains(t, err, "loading key pair")
+	_, err = ClientConfig(invalid, "", "", "")
+	require.ErrorContains(t, err, "no certificates found")
+}
@@ -0,0 +1,198 @@
+package grpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	"github.com/qubic/archive-query-service/v2/domain"
+	"github.com/qubic/archive-query-service/v2/entities"
+	"github.com/qubic/archive-query-service/v2/grpc/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// apiKeyMetadata is the grpc metadata key of the api key. The http gateway forwards the X-Api-Key header.
+const apiKeyMetadata = "x-api-key"
+
+type WebhookService interface {
+	RegisterWebhook(ctx context.Context, owner string, webhook *entities.Webhook) (*entities.Webhook, error)
+	GetWebhook(ctx context.Context, owner, id string) (*entities.Webhook, error)
+	ListWebhooks(ctx context.Context, owner string) ([]*entities.Webhook, error)
+	DeleteWebhook(ctx context.Context, owner, id string) (bool, error)
+}
+
+// WebhookServer manages the webhooks of the authenticated clients. The webhooks are owned by the api key that
+// registered them.
+type WebhookServer struct {
+	api.UnimplementedWebhookServiceServer
+	service WebhookService
+	apiKeys []string
+}
+
+func NewWebhookServer(service WebhookService, apiKeys []string) *WebhookServer {
+	return &WebhookServer{service: service, apiKeys: apiKeys}
+}
+
+func (s *WebhookServer) RegisterWebhook(ctx context.Context, req *api.RegisterWebhookRequest) (*api.RegisterWebhookResponse, error) {
+	owner, err := s.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	webhook, err := createWebhook(req)
+	if err != nil {
+		return nil, err
+	}
+
+	registered, err := s.service.RegisterWebhook(ctx, owner, webhook)
+	if errors.Is(err, domain.ErrTooManyWebhooks) {
+		return nil, status.Error(codes.ResourceExhausted, "maximum number of webhooks reached")
+	}
+	if errors.Is(err, domain.ErrWebhookAddressNotAllowed) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid url [%s]: %v", req.GetUrl(), err)
+	}
+	if err != nil {
+		return nil, createInternalError("failed to register webhook", err)
+	}
+	return &api.RegisterWebhookResponse{Webhook: webhookToAPI(registered), Secret: registered.Secret}, nil
+}
+
+func createWebhook(req *api.RegisterWebhookRequest) (*entities.Webhook, error) {
+	callbackURL, err := url.Parse(req.GetUrl())
+	if err != nil || (callbackURL.Scheme != "http" && callbackURL.Scheme != "https") || callbackURL.Host == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid url [%s]: http or https url expected", req.GetUrl())
+	}
+	webhook := &entities.Webhook{URL: callbackURL.String()}
+
+	switch {
+	case req.GetIdentity() != "" && req.GetEventLogs() != nil:
+		return nil, status.Error(codes.InvalidArgument, "either identity or event logs filter expected, not both")
+	case req.GetIdentity() != "":
+		if err = utils.ValidateIdentity(req.GetIdentity()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid identity: %v", err)
+		}
+		webhook.Identity = req.GetIdentity()
+	case req.GetEventLogs() != nil:
+		filters, err := CreateEventNotificationFilters(req.GetEventLogs())
+		if err != nil {
+			return nil, err
+		}
+		eventLogs := &api.GetEventLogsRequest{Filters: req.GetEventLogs().GetFilters(), Exclude: req.GetEventLogs().GetExclude(),
+			Should: req.GetEventLogs().GetShould(), Ranges: req.GetEventLogs().GetRanges()}
+		eventLogsRequest, err := protojson.Marshal(eventLogs)
+		if err != nil {
+			return nil, createInternalError("failed to marshal event logs filter", err)
+		}
+		webhook.EventFilters = &filters
+		webhook.EventLogsRequest = string(eventLogsRequest)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "identity or event logs filter expected")
+	}
+	return webhook, nil
+}
+
+// CreateEventNotificationFilters creates the filters for notifications about new event logs. The tick number is
+// restricted by the notification, so tick number filters are not supported.
+func CreateEventNotificationFilters(req *api.GetEventLogsRequest) (entities.Filters, error) {
+	filters, err := CreateEventQueryFilters(req)
+	if err != nil {
+		return entities.Filters{}, err
+	}
+	if _, ok := filters.Include["tickNumber"]; ok {
+		return entities.Filters{}, status.Error(codes.InvalidArgument, "tickNumber filter is not supported for notifications")
+	}
+	if _, ok := filters.Ranges["tickNumber"]; ok {
+		return entities.Filters{}, status.Error(codes.InvalidArgument, "tickNumber range is not supported for notifications")
+	}
+	return filters, nil
+}
+
+func (s *WebhookServer) GetWebhook(ctx context.Context, req *api.GetWebhookRequest) (*api.GetWebhookResponse, error) {
+	owner, err := s.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	webhook, err := s.service.GetWebhook(ctx, owner, req.GetId())
+	if err != nil {
+		return nil, createInternalError(fmt.Sprintf("failed to get webhook [%s]", req.GetId()), err)
+	}
+	if webhook == nil {
+		return nil, status.Errorf(codes.NotFound, "webhook [%s] not found", req.GetId())
+	}
+	return &api.GetWebhookResponse{Webhook: webhookToAPI(webhook)}, nil
+}
+
+func (s *WebhookServer) ListWebhooks(ctx context.Context, _ *emptypb.Empty) (*api.ListWebhooksResponse, error) {
+	owner, err := s.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	webhooks, err := s.service.ListWebhooks(ctx, owner)
+	if err != nil {
+		return nil, createInternalError("failed to list webhooks", err)
+	}
+	response := &api.ListWebhooksResponse{Webhooks: make([]*api.Webhook, 0, len(webhooks))}
+	for _, webhook := range webhooks {
+		response.Webhooks = append(response.Webhooks, webhookToAPI(webhook))
+	}
+	return response, nil
+}
+
+func (s *WebhookServer) DeleteWebhook(ctx context.Context, req *api.DeleteWebhookRequest) (*emptypb.Empty, error) {
+	owner, err := s.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	deleted, err := s.service.DeleteWebhook(ctx, owner, req.GetId())
+	if err != nil {
+		return nil, createInternalError(fmt.Sprintf("failed to delete webhook [%s]", req.GetId()), err)
+	}
+	if !deleted {
+		return nil, status.Errorf(codes.NotFound, "webhook [%s] not found", req.GetId())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// authenticate checks the api key and returns the owner id (hash of the api key).
+func (s *WebhookServer) authenticate(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	keys := md.Get(apiKeyMetadata)
+	if len(keys) != 1 || !isValidAPIKey(s.apiKeys, keys[0]) {
+		return "", status.Error(codes.Unauthenticated, "missing or invalid api key")
+	}
+	hash := sha256.Sum256([]byte(keys[0]))
+	return hex.EncodeToString(hash[:]), nil
+}
+
+func webhookToAPI(webhook *entities.Webhook) *api.Webhook {
+	result := &api.Webhook{
+		Id:               webhook.ID,
+		Url:              webhook.URL,
+		Identity:         webhook.Identity,
+		CreatedAt:        webhook.CreatedAt,
+		LastNotifiedTick: webhook.LastNotifiedTick,
+		DeliveryStatus: &api.WebhookDeliveryStatus{
+			Delivered:           webhook.DeliveryStatus.Delivered,
+			Failed:              webhook.DeliveryStatus.Failed,
+			ConsecutiveFailures: webhook.DeliveryStatus.ConsecutiveFailures,
+			LastAttempt:         webhook.DeliveryStatus.LastAttempt,
+			LastSuccess:         webhook.DeliveryStatus.LastSuccess,
+			LastStatusCode:      webhook.DeliveryStatus.LastStatusCode,
+			LastError:           webhook.DeliveryStatus.LastError,
+		},
+	}
+	if webhook.EventLogsRequest != "" {
+		var eventLogs api.GetEventLogsRequest
+		if err := protojson.Unmarshal([]byte(webhook.EventLogsRequest), &eventLogs); err == nil {
+			result.EventLogs = &eventLogs
+		}
+	}
+	return result
+}
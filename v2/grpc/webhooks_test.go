@@ -0,0 +1,147 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	api "github.com/qubic/archive-query-service/v2/api/archive-query-service/v2"
+	"github.com/qubic/archive-query-service/v2/domain"
+	"github.com/qubic/archive-query-service/v2/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const webhookIdentity = "BZBQFLLBNCXEMGLOBHUVFTLUPLVCPQUASSILFABOFFBCADQSSUPNWLZBQEXK"
+
+type WebhookServiceStub struct {
+	owner    string
+	webhook  *entities.Webhook
+	webhooks []*entities.Webhook
+	deleted  bool
+	err      error
+}
+
+func (w *WebhookServiceStub) RegisterWebhook(_ context.Context, owner string, webhook *entities.Webhook) (*entities.Webhook, error) {
+	w.owner, w.webhook = owner, webhook
+	registered := *webhook
+	registered.ID, registered.Secret = "id", "secret"
+	return &registered, w.err
+}
+
+func (w *WebhookServiceStub) GetWebhook(_ context.Context, owner, _ string) (*entities.Webhook, error) {
+	w.owner = owner
+	return w.webhook, w.err
+}
+
+func (w *WebhookServiceStub) ListWebhooks(_ context.Context, owner string) ([]*entities.Webhook, error) {
+	w.owner = owner
+	return w.webhooks, w.err
+}
+
+func (w *WebhookServiceStub) DeleteWebhook(_ context.Context, owner, _ string) (bool, error) {
+	w.owner = owner
+	return w.deleted, w.err
+}
+
+func withAPIKey(key string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(apiKeyMetadata, key))
+}
+
+func TestWebhookServer_RegisterWebhook(t *testing.T) {
+	stub := &WebhookServiceStub{}
+	server := NewWebhookServer(stub, []string{"key-1", "key-2"})
+
+	response, err := server.RegisterWebhook(withAPIKey("key-2"), &api.RegisterWebhookRequest{Url: "https://example.com/hook", Identity: webhookIdentity})
+	require.NoError(t, err)
+	assert.Equal(t, "id", response.GetWebhook().GetId())
+	assert.Equal(t, "https://example.com/hook", response.GetWebhook().GetUrl())
+	assert.Equal(t, webhookIdentity, response.GetWebhook().GetIdentity())
+	assert.Equal(t, "secret", response.GetSecret())
+	assert.Len(t, stub.owner, 64, "hashed api key")
+	owner := stub.owner
+
+	response, err = server.RegisterWebhook(withAPIKey("key-2"), &api.RegisterWebhookRequest{Url: "http://localhost:8080",
+		EventLogs: &api.GetEventLogsRequest{Filters: map[string]string{"logType": "0"}, Pagination: &api.Pagination{Size: 5}}})
+	require.NoError(t, err)
+	assert.Equal(t, owner, stub.owner)
+	assert.Equal(t, []string{"0"}, stub.webhook.EventFilters.Include["logType"])
+	assert.Equal(t, map[string]string{"logType": "0"}, response.GetWebhook().GetEventLogs().GetFilters())
+	assert.Nil(t, response.GetWebhook().GetEventLogs().GetPagination())
+
+	_, err = server.RegisterWebhook(withAPIKey("key-1"), &api.RegisterWebhookRequest{Url: "http://localhost:8080", Identity: webhookIdentity})
+	require.NoError(t, err)
+	assert.NotEqual(t, owner, stub.owner)
+}
+
+func TestWebhookServer_RegisterWebhook_GivenInvalidRequest_ThenInvalidArgument(t *testing.T) {
+	server := NewWebhookServer(&WebhookServiceStub{}, []string{"key"})
+
+	for _, request := range []*api.RegisterWebhookRequest{
+		{Url: "ftp://example.com", Identity: webhookIdentity},
+		{Url: "/relative", Identity: webhookIdentity},
+		{Url: "http://localhost"},
+		{Url: "http://localhost", Identity: "invalid"},
+		{Url: "http://localhost", Identity: webhookIdentity, EventLogs: &api.GetEventLogsRequest{}},
+		{Url: "http://localhost", EventLogs: &api.GetEventLogsRequest{Filters: map[string]string{"tickNumber": "1"}}},
+		{Url: "http://localhost", EventLogs: &api.GetEventLogsRequest{Filters: map[string]string{"unknown": "1"}}},
+	} {
+		_, err := server.RegisterWebhook(withAPIKey("key"), request)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), request.String())
+	}
+}
+
+func TestWebhookServer_RegisterWebhook_GivenTooManyWebhooks_ThenResourceExhausted(t *testing.T) {
+	server := NewWebhookServer(&WebhookServiceStub{err: domain.ErrTooManyWebhooks}, []string{"key"})
+
+	_, err := server.RegisterWebhook(withAPIKey("key"), &api.RegisterWebhookRequest{Url: "http://localhost", Identity: webhookIdentity})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestWebhookServer_RegisterWebhook_GivenAddressNotAllowed_ThenInvalidArgument(t *testing.T) {
+	server := NewWebhookServer(&WebhookServiceStub{err: domain.ErrWebhookAddressNotAllowed}, []string{"key"})
+
+	_, err := server.RegisterWebhook(withAPIKey("key"), &api.RegisterWebhookRequest{Url: "http://localhost", Identity: webhookIdentity})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestWebhookServer_GivenInvalidAPIKey_ThenUnauthenticated(t *testing.T) {
+	server := NewWebhookServer(&WebhookServiceStub{}, []string{"key"})
+
+	for _, ctx := range []context.Context{context.Background(), withAPIKey(""), withAPIKey("other")} {
+		_, err := server.ListWebhooks(ctx, &emptypb.Empty{})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		_, err = server.GetWebhook(ctx, &api.GetWebhookRequest{Id: "id"})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	}
+}
+
+func TestWebhookServer_GetAndDeleteWebhook(t *testing.T) {
+	stub := &WebhookServiceStub{}
+	server := NewWebhookServer(stub, []string{"key"})
+
+	_, err := server.GetWebhook(withAPIKey("key"), &api.GetWebhookRequest{Id: "id"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = server.DeleteWebhook(withAPIKey("key"), &api.DeleteWebhookRequest{Id: "id"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	stub.webhook = &entities.Webhook{ID: "id", URL: "http://localhost", Secret: "secret", LastNotifiedTick: 42,
+		DeliveryStatus: entities.WebhookDeliveryStatus{Delivered: 1, LastStatusCode: 200}}
+	stub.webhooks = []*entities.Webhook{stub.webhook}
+	stub.deleted = true
+	response, err := server.GetWebhook(withAPIKey("key"), &api.GetWebhookRequest{Id: "id"})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(42), response.GetWebhook().GetLastNotifiedTick())
+	assert.Equal(t, uint64(1), response.GetWebhook().GetDeliveryStatus().GetDelivered())
+	assert.Equal(t, int32(200), response.GetWebhook().GetDeliveryStatus().GetLastStatusCode())
+
+	list, err := server.ListWebhooks(withAPIKey("key"), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Len(t, list.GetWebhooks(), 1)
+
+	_, err = server.DeleteWebhook(withAPIKey("key"), &api.DeleteWebhookRequest{Id: "id"})
+	require.NoError(t, err)
+}